@@ -0,0 +1,1117 @@
+// Copyright (C) 2023 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/neocotic/go-sets/internal"
+	"math/rand"
+	"sort"
+)
+
+// DefaultAdaptiveThreshold is the threshold used by an AdaptiveSet that was created without specifying one.
+const DefaultAdaptiveThreshold = 16
+
+// AdaptiveSet is an implementation of MutableSet that contains a unique data set which is backed by a slice while small
+// and transparently promoted to being backed by a map once it grows, providing the benefits of each for data sets of
+// unpredictable size.
+//
+// An AdaptiveSet is promoted once its number of elements exceeds its threshold, which happens within AdaptiveSet.Put
+// (and similar). Once promoted, an AdaptiveSet is only demoted back to being backed by a slice once its number of
+// elements falls to half of its threshold or fewer, preventing it from repeatedly switching back and forth when its
+// number of elements hovers around the threshold. The threshold is DefaultAdaptiveThreshold unless specified using
+// AdaptiveWithThreshold. Regardless of how the AdaptiveSet is backed, its behavior remains consistent.
+//
+// As AdaptiveSet is mutable it is not safe for concurrent use by multiple goroutines.
+type AdaptiveSet[E comparable] struct {
+	elements  []E
+	hash      internal.Hash[E]
+	threshold int
+}
+
+var (
+	_ MutableSet[any]  = (*AdaptiveSet[any])(nil)
+	_ fmt.Stringer     = (*AdaptiveSet[any])(nil)
+	_ json.Marshaler   = (*AdaptiveSet[any])(nil)
+	_ json.Unmarshaler = (*AdaptiveSet[any])(nil)
+)
+
+// All returns an iterator over each element within the AdaptiveSet, which is compatible with iter.Seq so can be used
+// with range-over-func (e.g. `for element := range set.All()`) from Go 1.23 onwards.
+//
+// Iteration order is not guaranteed to be consistent.
+//
+// If the AdaptiveSet is nil, the iterator returned by AdaptiveSet.All yields no elements.
+func (s *AdaptiveSet[E]) All() func(yield func(element E) bool) {
+	return seq[E](s.Range)
+}
+
+// AppendSlice appends all elements of the AdaptiveSet to the dst slice and returns the extended slice, following the
+// same convention as the built-in append function. This allows a buffer to be reused across calls, avoiding the
+// allocation made by AdaptiveSet.Slice each time.
+//
+// The order in which elements are appended is not guaranteed to be consistent. AdaptiveSet.SortedSlice should be used
+// instead for such cases where consistent ordering is required.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.AppendSlice returns dst unchanged.
+func (s *AdaptiveSet[E]) AppendSlice(dst []E) []E {
+	if s == nil {
+		return dst
+	}
+	if s.hash != nil {
+		return internal.AppendSlice(dst, s.hash)
+	}
+	return append(dst, s.elements...)
+}
+
+// Canonical returns a minimal byte representation of the AdaptiveSet that is stable for its elements, which can be
+// useful for hashing, cache keys, or as a shortcut for equality checks. See Set.Canonical for the escaping and
+// separator used.
+//
+// If the AdaptiveSet is nil it is treated as having no elements and so AdaptiveSet.Canonical returns an empty slice.
+func (s *AdaptiveSet[E]) Canonical(convert func(element E) string) []byte {
+	return canonical[E](s, convert)
+}
+
+// Clear removes all elements from the AdaptiveSet.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Clear is a no-op.
+//
+// A reference to the AdaptiveSet is returned for method chaining.
+func (s *AdaptiveSet[E]) Clear() MutableSet[E] {
+	if s == nil {
+		var ns *AdaptiveSet[E]
+		return ns
+	}
+	s.elements, s.hash = nil, nil
+	return s
+}
+
+// Clone returns a clone of the AdaptiveSet.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Clone returns nil.
+func (s *AdaptiveSet[E]) Clone() Set[E] {
+	if s == nil {
+		var ns *AdaptiveSet[E]
+		return ns
+	}
+	if s.hash != nil {
+		return &AdaptiveSet[E]{hash: internal.Clone(s.hash), threshold: s.threshold}
+	}
+	return s.with(s.slice())
+}
+
+// Contains returns whether the AdaptiveSet contains the element.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Contains returns false.
+func (s *AdaptiveSet[E]) Contains(element E) bool {
+	if s == nil {
+		return false
+	}
+	if s.hash != nil {
+		_, ok := s.hash[element]
+		return ok
+	}
+	for _, _element := range s.elements {
+		if _element == element {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsAll returns whether the AdaptiveSet contains the element as well as all additional elements specified,
+// stopping as soon as any element is found not to be contained.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.ContainsAll returns false.
+func (s *AdaptiveSet[E]) ContainsAll(element E, elements ...E) bool {
+	return containsAll[E](element, elements, s.Contains)
+}
+
+// ContainsAny returns whether the AdaptiveSet contains the element or any of the additional elements specified,
+// stopping as soon as any element is found to be contained.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.ContainsAny returns false.
+func (s *AdaptiveSet[E]) ContainsAny(element E, elements ...E) bool {
+	return containsAny[E](element, elements, s.Contains)
+}
+
+// ContainsEach returns a slice containing whether the AdaptiveSet contains each of the elements provided, where each
+// result is at the same index as its element.
+//
+// The returned slice always has the same length as the slice of elements provided.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.ContainsEach returns a slice of false values.
+func (s *AdaptiveSet[E]) ContainsEach(elements []E) []bool {
+	return containsEach[E](elements, s.Contains)
+}
+
+// Count returns the number of elements within the AdaptiveSet that match the predicate function.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Count returns zero.
+func (s *AdaptiveSet[E]) Count(predicate func(element E) bool) int {
+	return count[E](s.Range, predicate)
+}
+
+// Covers returns whether the AdaptiveSet is a superset of every other Set. That is; whether the AdaptiveSet contains
+// all elements within each other Set. Any other Set containing more elements than the AdaptiveSet is never covered and
+// so is rejected before its elements are checked.
+//
+// Any nil other Set is treated as having no elements and so is always covered. If no other Set is provided,
+// AdaptiveSet.Covers returns true.
+//
+// If the AdaptiveSet is nil it is treated as having no elements.
+func (s *AdaptiveSet[E]) Covers(others ...Set[E]) bool {
+	return covers[E](s.Len(), s.Contains, others)
+}
+
+// Delete removes the element from the AdaptiveSet as well as any additional elements specified.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Delete is a no-op.
+//
+// A reference to the AdaptiveSet is returned for method chaining.
+func (s *AdaptiveSet[E]) Delete(element E, elements ...E) MutableSet[E] {
+	if s == nil {
+		var ns *AdaptiveSet[E]
+		return ns
+	}
+	s.delete(element)
+	for _, _element := range elements {
+		s.delete(_element)
+	}
+	s.adapt()
+	return s
+}
+
+// DeleteAll removes all elements in the specified Set from the AdaptiveSet.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.DeleteAll is a no-op.
+//
+// A reference to the AdaptiveSet is returned for method chaining.
+func (s *AdaptiveSet[E]) DeleteAll(elements Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *AdaptiveSet[E]
+		return ns
+	}
+	if elements != nil {
+		s.replace(s.filter(func(element E) bool { return !elements.Contains(element) }))
+	}
+	return s
+}
+
+// DeleteAnyOf removes all elements from the AdaptiveSet that exist within any of the specified Set. Any nil Set is
+// skipped.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.DeleteAnyOf is a no-op.
+//
+// A reference to the AdaptiveSet is returned for method chaining.
+func (s *AdaptiveSet[E]) DeleteAnyOf(sets ...Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *AdaptiveSet[E]
+		return ns
+	}
+	s.replace(s.filter(func(element E) bool { return !containedByAny(sets, element) }))
+	return s
+}
+
+// DeleteExisting removes the element from the AdaptiveSet as well as any additional elements specified, and returns the
+// number of those elements that were present and therefore removed. Unlike AdaptiveSet.Delete, this allows the caller
+// to learn whether anything was actually removed.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.DeleteExisting is a no-op and returns zero.
+func (s *AdaptiveSet[E]) DeleteExisting(element E, elements ...E) int {
+	if s == nil {
+		return 0
+	}
+	return deleteExisting[E](s.Take, element, elements)
+}
+
+// DeleteNth removes the element at index i from the AdaptiveSet, were its elements sorted using the provided less
+// function, and returns the removed element as well as an indication of whether an element was removed.
+//
+// If i is out of range (i.e. negative or not less than AdaptiveSet.Len), AdaptiveSet.DeleteNth is a no-op and returns
+// the zero value for E and false.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.DeleteNth is a no-op and returns the zero value for E and false.
+func (s *AdaptiveSet[E]) DeleteNth(less func(x, y E) bool, i int) (E, bool) {
+	if s == nil || i < 0 || i >= s.Len() {
+		var zero E
+		return zero, false
+	}
+	element := s.SortedSlice(less)[i]
+	s.delete(element)
+	s.adapt()
+	return element, true
+}
+
+// DeleteSlice removes all elements in the specified slice from the AdaptiveSet.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.DeleteSlice is a no-op.
+//
+// A reference to the AdaptiveSet is returned for method chaining.
+func (s *AdaptiveSet[E]) DeleteSlice(elements []E) MutableSet[E] {
+	if s == nil {
+		var ns *AdaptiveSet[E]
+		return ns
+	}
+	for _, element := range elements {
+		s.delete(element)
+	}
+	s.adapt()
+	return s
+}
+
+// DeleteWhere removes all elements that match the predicate function from the AdaptiveSet.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.DeleteWhere is a no-op.
+//
+// A reference to the AdaptiveSet is returned for method chaining.
+func (s *AdaptiveSet[E]) DeleteWhere(predicate func(element E) bool) MutableSet[E] {
+	if s == nil {
+		var ns *AdaptiveSet[E]
+		return ns
+	}
+	s.replace(s.filter(func(element E) bool { return !predicate(element) }))
+	return s
+}
+
+// Diff returns a new AdaptiveSet struct containing only elements of the AdaptiveSet that do not exist in another Set.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Diff returns nil.
+func (s *AdaptiveSet[E]) Diff(other Set[E]) Set[E] {
+	if s == nil {
+		var ns *AdaptiveSet[E]
+		return ns
+	}
+	if other == nil {
+		return s.Clone()
+	}
+	return s.with(s.filter(func(element E) bool { return !other.Contains(element) }))
+}
+
+// DiffSymmetric returns a new AdaptiveSet struct containing elements that exist within the AdaptiveSet or another Set,
+// but not both.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.DiffSymmetric returns nil.
+func (s *AdaptiveSet[E]) DiffSymmetric(other Set[E]) Set[E] {
+	if s == nil {
+		var ns *AdaptiveSet[E]
+		return ns
+	}
+	if other == nil {
+		return s.Clone()
+	}
+	elements := s.filter(func(element E) bool { return !other.Contains(element) })
+	other.Range(func(element E) bool {
+		if !s.Contains(element) {
+			elements = append(elements, element)
+		}
+		return false
+	})
+	return s.with(elements)
+}
+
+// DrainWhere removes all elements that match the predicate function from the AdaptiveSet and returns them within a new
+// AdaptiveSet struct. Unlike AdaptiveSet.DeleteWhere, this allows the caller to inspect the elements that were removed.
+// Together, the AdaptiveSet and the returned AdaptiveSet contain exactly the elements that the AdaptiveSet contained
+// beforehand.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.DrainWhere returns nil.
+func (s *AdaptiveSet[E]) DrainWhere(predicate func(element E) bool) Set[E] {
+	if s == nil {
+		var ns *AdaptiveSet[E]
+		return ns
+	}
+	drained := s.Filter(predicate)
+	s.DeleteAll(drained)
+	return drained
+}
+
+// Equal returns whether the AdaptiveSet contains the exact same elements as another Set.
+//
+// If the AdaptiveSet is nil it is treated as having no elements and the same logic applies to the other Set. To
+// clarify; this means that a nil Set is equal to a non-nil Set that contains no elements.
+func (s *AdaptiveSet[E]) Equal(other Set[E]) bool {
+	if s == nil {
+		return other == nil || other.IsEmpty()
+	} else if other == nil {
+		return s.IsEmpty()
+	}
+	if s.Len() != other.Len() {
+		return false
+	}
+	return !s.Some(func(element E) bool { return !other.Contains(element) })
+}
+
+// Every returns whether the AdaptiveSet contains elements that all match the predicate function.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Every returns false.
+func (s *AdaptiveSet[E]) Every(predicate func(element E) bool) bool {
+	if s.IsEmpty() {
+		return false
+	}
+	return !s.Some(func(element E) bool { return !predicate(element) })
+}
+
+// Filter returns a new AdaptiveSet struct containing only elements of the AdaptiveSet that match the filter function.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Filter returns nil.
+func (s *AdaptiveSet[E]) Filter(filter func(element E) bool) Set[E] {
+	if s == nil {
+		var ns *AdaptiveSet[E]
+		return ns
+	}
+	return s.with(s.filter(filter))
+}
+
+// Find returns an element within the AdaptiveSet that matches the search function as well as an indication of whether
+// a match was found.
+//
+// Iteration order is not guaranteed to be consistent so results may vary.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Find returns the zero value for E and false.
+func (s *AdaptiveSet[E]) Find(search func(element E) bool) (E, bool) {
+	var (
+		found E
+		ok    bool
+	)
+	s.Range(func(element E) bool {
+		if search(element) {
+			found, ok = element, true
+		}
+		return ok
+	})
+	return found, ok
+}
+
+// Grow increases the capacity of the AdaptiveSet, if necessary, to guarantee space for another n elements. After
+// Grow(n), at least n elements can be added to the AdaptiveSet without it needing to allocate further. If the number of
+// elements would exceed its threshold as a result, it is promoted to being backed by a map straight away, pre-sized
+// accordingly.
+//
+// If n is not positive, AdaptiveSet.Grow is a no-op.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Grow is a no-op.
+//
+// A reference to the AdaptiveSet is returned for method chaining.
+func (s *AdaptiveSet[E]) Grow(n int) MutableSet[E] {
+	if s == nil {
+		var ns *AdaptiveSet[E]
+		return ns
+	}
+	if n <= 0 {
+		return s
+	}
+	threshold := s.threshold
+	if threshold <= 0 {
+		threshold = DefaultAdaptiveThreshold
+	}
+	if s.hash != nil {
+		s.hash = internal.Grow(s.hash, n)
+	} else if len(s.elements)+n > threshold {
+		s.elements, s.hash = nil, internal.FromSliceWithCapacity(len(s.elements)+n, s.elements)
+	} else {
+		s.elements = internal.GrowSlice(s.elements, n)
+	}
+	return s
+}
+
+// Immutable returns an immutable clone of the AdaptiveSet.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Immutable returns nil.
+func (s *AdaptiveSet[E]) Immutable() Set[E] {
+	if s == nil {
+		var ns *HashSet[E]
+		return ns
+	}
+	if s.hash != nil {
+		return &HashSet[E]{elements: internal.Clone(s.hash)}
+	}
+	return &HashSet[E]{elements: internal.FromSlice(s.elements)}
+}
+
+// Intersection returns a new AdaptiveSet struct containing only elements of the AdaptiveSet that also exist in another
+// Set.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Intersection returns nil.
+func (s *AdaptiveSet[E]) Intersection(other Set[E]) Set[E] {
+	if s == nil {
+		var ns *AdaptiveSet[E]
+		return ns
+	}
+	if other == nil {
+		return s.with(nil)
+	}
+	return s.with(s.filter(other.Contains))
+}
+
+// IsDisjoint returns whether the AdaptiveSet has no elements in common with another Set. Only the elements within the
+// smaller of the two are iterated, with each being checked for within the larger.
+//
+// Any nil other Set, or other Set containing no elements, is disjoint from the AdaptiveSet.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.IsDisjoint returns true.
+func (s *AdaptiveSet[E]) IsDisjoint(other Set[E]) bool {
+	return disjoint[E](s.Len(), s.Contains, s.Range, other)
+}
+
+// IsEmpty returns whether the AdaptiveSet contains no elements.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.IsEmpty returns true.
+func (s *AdaptiveSet[E]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+// IsMutable always returns true to conform with Set.IsMutable.
+func (s *AdaptiveSet[E]) IsMutable() bool {
+	return true
+}
+
+// Join converts the elements within the AdaptiveSet to strings which are then concatenated to create a single string,
+// placing sep between the converted elements in the resulting string.
+//
+// The order of elements within the resulting string is not guaranteed to be consistent. AdaptiveSet.SortedJoin should
+// be used instead for such cases where consistent ordering is required.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Join returns an empty string.
+func (s *AdaptiveSet[E]) Join(sep string, convert func(element E) string) string {
+	if s == nil {
+		return ""
+	}
+	return joinSlice(s.slice(), sep, convert)
+}
+
+// Kind always returns AdaptiveKind to conform with Set.Kind.
+func (s *AdaptiveSet[E]) Kind() SetKind {
+	return AdaptiveKind
+}
+
+// Len returns the number of elements within the AdaptiveSet.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Len returns zero.
+func (s *AdaptiveSet[E]) Len() int {
+	if s == nil {
+		return 0
+	}
+	if s.hash != nil {
+		return len(s.hash)
+	}
+	return len(s.elements)
+}
+
+// Max returns the maximum element within the AdaptiveSet using the provided less function.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Max returns the zero value for E and false.
+func (s *AdaptiveSet[E]) Max(less func(x, y E) bool) (E, bool) {
+	var (
+		max E
+		ok  bool
+	)
+	s.Range(func(element E) bool {
+		if !ok || less(max, element) {
+			max, ok = element, true
+		}
+		return false
+	})
+	return max, ok
+}
+
+// Min returns the minimum element within the AdaptiveSet using the provided less function.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Min returns the zero value for E and false.
+func (s *AdaptiveSet[E]) Min(less func(x, y E) bool) (E, bool) {
+	var (
+		min E
+		ok  bool
+	)
+	s.Range(func(element E) bool {
+		if !ok || less(element, min) {
+			min, ok = element, true
+		}
+		return false
+	})
+	return min, ok
+}
+
+// Mutable returns a reference to itself to conform with Set.Mutable.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Mutable returns nil.
+func (s *AdaptiveSet[E]) Mutable() MutableSet[E] {
+	if s == nil {
+		var ns *AdaptiveSet[E]
+		return ns
+	}
+	return s
+}
+
+// None returns whether the AdaptiveSet contains no elements that match the predicate function.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.None returns true.
+func (s *AdaptiveSet[E]) None(predicate func(element E) bool) bool {
+	return !s.Some(predicate)
+}
+
+// Pop removes an arbitrary element from the AdaptiveSet and returns it as well as an indication of whether the
+// AdaptiveSet contained any elements.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Pop is a no-op and returns the zero value for E and false.
+func (s *AdaptiveSet[E]) Pop() (E, bool) {
+	var zero E
+	if s == nil {
+		return zero, false
+	}
+	var element E
+	if s.hash != nil {
+		var ok bool
+		if element, ok = internal.Pop[E](s.hash); !ok {
+			return zero, false
+		}
+	} else if last := len(s.elements) - 1; last >= 0 {
+		element = s.elements[last]
+		s.elements[last] = zero
+		s.elements = s.elements[:last]
+	} else {
+		return zero, false
+	}
+	s.adapt()
+	return element, true
+}
+
+// Put adds the element to the AdaptiveSet as well as any additional elements specified. Nothing changes for elements
+// that already exist within the AdaptiveSet.
+//
+// If the number of elements within the AdaptiveSet exceeds its threshold as a result, it is promoted to being backed by
+// a map.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Put is a no-op.
+//
+// A reference to the AdaptiveSet is returned for method chaining.
+func (s *AdaptiveSet[E]) Put(element E, elements ...E) MutableSet[E] {
+	if s == nil {
+		var ns *AdaptiveSet[E]
+		return ns
+	}
+	s.put(element)
+	for _, _element := range elements {
+		s.put(_element)
+	}
+	return s
+}
+
+// PutAll adds all elements in the specified Set to the AdaptiveSet. Nothing changes for elements that already exist
+// within the AdaptiveSet.
+//
+// If the number of elements within the AdaptiveSet exceeds its threshold as a result, it is promoted to being backed by
+// a map.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.PutAll is a no-op.
+//
+// A reference to the AdaptiveSet is returned for method chaining.
+func (s *AdaptiveSet[E]) PutAll(elements Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *AdaptiveSet[E]
+		return ns
+	}
+	if elements != nil {
+		elements.Range(func(element E) bool {
+			s.put(element)
+			return false
+		})
+	}
+	return s
+}
+
+// PutSlice adds all elements in the specified slice to the AdaptiveSet. Nothing changes for elements that already exist
+// within the AdaptiveSet.
+//
+// If the number of elements within the AdaptiveSet exceeds its threshold as a result, it is promoted to being backed by
+// a map.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.PutSlice is a no-op.
+//
+// A reference to the AdaptiveSet is returned for method chaining.
+func (s *AdaptiveSet[E]) PutSlice(elements []E) MutableSet[E] {
+	if s == nil {
+		var ns *AdaptiveSet[E]
+		return ns
+	}
+	for _, element := range elements {
+		s.put(element)
+	}
+	return s
+}
+
+// Range calls the iter function with each element within the AdaptiveSet but will stop early whenever the iter function
+// returns true.
+//
+// Iteration order is not guaranteed to be consistent.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Range is a no-op.
+func (s *AdaptiveSet[E]) Range(iter func(element E) bool) {
+	if s == nil {
+		return
+	}
+	if s.hash != nil {
+		internal.Range(s.hash, iter)
+		return
+	}
+	for _, element := range s.elements {
+		if iter(element) {
+			break
+		}
+	}
+}
+
+// RangeContext calls the iter function with each element within the AdaptiveSet, the same as AdaptiveSet.Range, except
+// that the context is checked before each element. If the context is done, iteration stops and the error of the context
+// is returned.
+//
+// Iteration order is not guaranteed to be consistent.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.RangeContext is a no-op and returns nil.
+func (s *AdaptiveSet[E]) RangeContext(ctx context.Context, iter func(element E) bool) error {
+	if s == nil {
+		return nil
+	}
+	return rangeContext[E](ctx, s.Range, iter)
+}
+
+// ReplaceElement removes the old element from the AdaptiveSet and adds the new element in its place, but only if the
+// old element is present, and returns whether it was present. If the new element already exists within the AdaptiveSet,
+// the old element is simply removed.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.ReplaceElement is a no-op and returns false.
+func (s *AdaptiveSet[E]) ReplaceElement(oldElement, newElement E) bool {
+	if s == nil {
+		return false
+	}
+	if !s.Contains(oldElement) {
+		return false
+	}
+	s.delete(oldElement)
+	s.put(newElement)
+	s.adapt()
+	return true
+}
+
+// Retain removes all elements from the AdaptiveSet except the element(s) specified.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Retain is a no-op.
+//
+// A reference to the AdaptiveSet is returned for method chaining.
+func (s *AdaptiveSet[E]) Retain(element E, elements ...E) MutableSet[E] {
+	if s == nil {
+		var ns *AdaptiveSet[E]
+		return ns
+	}
+	retained := internal.FromSlice(elements)
+	retained[element] = struct{}{}
+	s.replace(s.filter(func(element E) bool {
+		_, ok := retained[element]
+		return ok
+	}))
+	return s
+}
+
+// RetainAll removes all elements from the AdaptiveSet except those in the specified Set.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.RetainAll is a no-op.
+//
+// A reference to the AdaptiveSet is returned for method chaining.
+func (s *AdaptiveSet[E]) RetainAll(elements Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *AdaptiveSet[E]
+		return ns
+	}
+	if elements == nil {
+		s.replace(nil)
+	} else {
+		s.replace(s.filter(elements.Contains))
+	}
+	return s
+}
+
+// RetainAllOf removes all elements from the AdaptiveSet except those that exist within all the specified Set. That is;
+// the AdaptiveSet is intersected with each Set in place. As with AdaptiveSet.RetainAll, any nil Set is treated as
+// having no elements. If no Set is specified, nothing is removed.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.RetainAllOf is a no-op.
+//
+// A reference to the AdaptiveSet is returned for method chaining.
+func (s *AdaptiveSet[E]) RetainAllOf(sets ...Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *AdaptiveSet[E]
+		return ns
+	}
+	s.replace(s.filter(func(element E) bool { return containedByAll(sets, element) }))
+	return s
+}
+
+// RetainSlice removes all elements from the AdaptiveSet except those in the specified slice.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.RetainSlice is a no-op.
+//
+// A reference to the AdaptiveSet is returned for method chaining.
+func (s *AdaptiveSet[E]) RetainSlice(elements []E) MutableSet[E] {
+	if s == nil {
+		var ns *AdaptiveSet[E]
+		return ns
+	}
+	retained := internal.FromSlice(elements)
+	s.replace(s.filter(func(element E) bool {
+		_, ok := retained[element]
+		return ok
+	}))
+	return s
+}
+
+// RetainWhere removes all elements except those that match the predicate function from the AdaptiveSet.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.RetainWhere is a no-op.
+//
+// A reference to the AdaptiveSet is returned for method chaining.
+func (s *AdaptiveSet[E]) RetainWhere(predicate func(element E) bool) MutableSet[E] {
+	if s == nil {
+		var ns *AdaptiveSet[E]
+		return ns
+	}
+	s.replace(s.filter(predicate))
+	return s
+}
+
+// Sample returns a pseudo-random element within the AdaptiveSet as well as an indication of whether the AdaptiveSet
+// contained any elements.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Sample returns the zero value for E and false.
+func (s *AdaptiveSet[E]) Sample() (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	return sample[E](s.Range, s.Len())
+}
+
+// SampleN returns a new AdaptiveSet struct containing up to n distinct elements of the AdaptiveSet chosen
+// pseudo-randomly. All elements are included if n is greater than or equal to the number of elements within the
+// AdaptiveSet, while none are included if n is not positive.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.SampleN returns nil.
+func (s *AdaptiveSet[E]) SampleN(n int) Set[E] {
+	return s.SampleNWithRand(n, nil)
+}
+
+// SampleNWithRand returns a new AdaptiveSet struct containing up to n distinct elements of the AdaptiveSet chosen
+// pseudo-randomly using the provided source, the same as AdaptiveSet.SampleN otherwise. If the source is nil, the
+// default source is used.
+//
+// Iteration order is not guaranteed to be consistent so, even when using sources that are seeded identically, the
+// chosen elements may vary.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.SampleNWithRand returns nil.
+func (s *AdaptiveSet[E]) SampleNWithRand(n int, r *rand.Rand) Set[E] {
+	if s == nil {
+		var ns *AdaptiveSet[E]
+		return ns
+	}
+	return sampleFilter[E](s, n, r)
+}
+
+// Slice returns a slice containing all elements of the AdaptiveSet.
+//
+// The order of elements within the resulting slice is not guaranteed to be consistent. AdaptiveSet.SortedSlice should
+// be used instead for such cases where consistent ordering is required.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Slice returns nil.
+func (s *AdaptiveSet[E]) Slice() []E {
+	if s == nil {
+		return nil
+	}
+	return s.slice()
+}
+
+// Some returns whether the AdaptiveSet contains any element that matches the predicate function.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Some returns false.
+func (s *AdaptiveSet[E]) Some(predicate func(element E) bool) bool {
+	_, ok := s.Find(predicate)
+	return ok
+}
+
+// SortedAppendSlice appends all elements of the AdaptiveSet to the dst slice, sorted using the provided less function,
+// and returns the extended slice. Only the appended elements are sorted so any elements already within dst are left
+// untouched. This allows a buffer to be reused across calls, avoiding the allocation made by AdaptiveSet.SortedSlice
+// each time.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.SortedAppendSlice returns dst unchanged.
+func (s *AdaptiveSet[E]) SortedAppendSlice(dst []E, less func(x, y E) bool) []E {
+	if s == nil {
+		return dst
+	}
+	return sortedAppendSlice[E](s, dst, less)
+}
+
+// SortedJoin sorts the elements within the AdaptiveSet using the provided less function and then converts those
+// elements into strings which are then joined using the specified separator to create the resulting string.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.SortedJoin returns an empty string.
+func (s *AdaptiveSet[E]) SortedJoin(sep string, convert func(element E) string, less func(x, y E) bool) string {
+	if s == nil {
+		return ""
+	}
+	return joinSlice(s.SortedSlice(less), sep, convert)
+}
+
+// SortedRange calls the iter function with each element within the AdaptiveSet, sorted using the provided less
+// function, along with the zero-based index of that element within the sorted order, but will stop early whenever the
+// iter function returns true.
+//
+// Unlike AdaptiveSet.Range, iteration order is deterministic.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.SortedRange is a no-op.
+func (s *AdaptiveSet[E]) SortedRange(less func(x, y E) bool, iter func(index int, element E) bool) {
+	if s == nil {
+		return
+	}
+	sortedRange[E](s, less, iter)
+}
+
+// SortedSlice returns a slice containing all elements of the AdaptiveSet sorted using the provided less function.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.SortedSlice returns nil.
+func (s *AdaptiveSet[E]) SortedSlice(less func(x, y E) bool) []E {
+	if s == nil {
+		return nil
+	}
+	elements := s.slice()
+	sort.Slice(elements, func(i, j int) bool { return less(elements[i], elements[j]) })
+	return elements
+}
+
+// Take removes the element from the AdaptiveSet and returns the element that was stored within the AdaptiveSet as well
+// as an indication of whether it was present. As elements are compared using equality, the returned element is always
+// equal to the element provided when present.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.Take is a no-op and returns the zero value for E and false.
+func (s *AdaptiveSet[E]) Take(element E) (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	if !s.Contains(element) {
+		var zero E
+		return zero, false
+	}
+	s.delete(element)
+	s.adapt()
+	return element, true
+}
+
+// ToMap returns a map containing all elements of the AdaptiveSet as keys, which can be useful when integrating with
+// APIs that expect a map rather than a Set or slice.
+//
+// The returned map is always a copy so can be modified freely without affecting the AdaptiveSet.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.ToMap returns nil.
+func (s *AdaptiveSet[E]) ToMap() map[E]struct{} {
+	if s == nil {
+		return nil
+	}
+	if s.hash != nil {
+		return internal.Clone[E](s.hash)
+	}
+	return internal.FromSlice[E](s.elements)
+}
+
+// TryRange calls the iter function with each element within the AdaptiveSet but will stop early whenever the iter
+// function returns an error.
+//
+// Iteration order is not guaranteed to be consistent.
+//
+// If the AdaptiveSet is nil, AdaptiveSet.TryRange is a no-op.
+func (s *AdaptiveSet[E]) TryRange(iter func(element E) error) error {
+	var err error
+	s.Range(func(element E) bool {
+		err = iter(element)
+		return err != nil
+	})
+	return err
+}
+
+// Union returns a new AdaptiveSet containing a union of the AdaptiveSet with another Set.
+//
+// If the AdaptiveSet is nil but the other Set is not, the returned AdaptiveSet uses DefaultAdaptiveThreshold.
+//
+// If the AdaptiveSet and the other Set are both nil, AdaptiveSet.Union returns nil.
+func (s *AdaptiveSet[E]) Union(other Set[E]) Set[E] {
+	hash := internal.Union[E](s, other)
+	if hash == nil {
+		var ns *AdaptiveSet[E]
+		return ns
+	}
+	var threshold int
+	if s != nil {
+		threshold = s.threshold
+	}
+	us := &AdaptiveSet[E]{elements: internal.Slice(hash), threshold: threshold}
+	us.adapt()
+	return us
+}
+
+func (s *AdaptiveSet[E]) String() string {
+	if s == nil {
+		return internal.NilString
+	}
+	return fmt.Sprintf("%v", s.slice())
+}
+
+func (s *AdaptiveSet[E]) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return internal.MarshalJSONNil()
+	}
+	return json.Marshal(s.slice())
+}
+
+func (s *AdaptiveSet[E]) UnmarshalJSON(data []byte) error {
+	if hash, err := internal.UnmarshalJSON[E](data); err != nil {
+		return err
+	} else {
+		s.elements, s.hash = internal.Slice(hash), nil
+		s.adapt()
+		return nil
+	}
+}
+
+// adapt promotes the AdaptiveSet to being backed by a map if its number of elements exceeds its threshold, or demotes
+// it to being backed by a slice if its number of elements has fallen to half of its threshold or fewer.
+func (s *AdaptiveSet[E]) adapt() {
+	threshold := s.threshold
+	if threshold <= 0 {
+		threshold = DefaultAdaptiveThreshold
+	}
+	if s.hash == nil {
+		if len(s.elements) > threshold {
+			s.elements, s.hash = nil, internal.FromSlice(s.elements)
+		}
+	} else if len(s.hash) <= threshold/2 {
+		s.elements, s.hash = internal.Slice(s.hash), nil
+	}
+}
+
+// delete removes the element from the AdaptiveSet without adapting it.
+func (s *AdaptiveSet[E]) delete(element E) {
+	if s.hash != nil {
+		delete(s.hash, element)
+		return
+	}
+	for i, _element := range s.elements {
+		if _element == element {
+			last := len(s.elements) - 1
+			s.elements[i] = s.elements[last]
+			var zero E
+			s.elements[last] = zero
+			s.elements = s.elements[:last]
+			return
+		}
+	}
+}
+
+// filter returns a new slice containing only elements of the AdaptiveSet that match the filter function.
+func (s *AdaptiveSet[E]) filter(filter func(element E) bool) []E {
+	var elements []E
+	s.Range(func(element E) bool {
+		if filter(element) {
+			elements = append(elements, element)
+		}
+		return false
+	})
+	return elements
+}
+
+// put adds the element to the AdaptiveSet, promoting it if needed.
+func (s *AdaptiveSet[E]) put(element E) {
+	if s.hash != nil {
+		s.hash[element] = struct{}{}
+	} else if !s.Contains(element) {
+		s.elements = append(s.elements, element)
+		s.adapt()
+	}
+}
+
+// replace replaces all elements within the AdaptiveSet with the slice of unique elements provided, adapting it if
+// needed. An AdaptiveSet backed by a map remains so unless it needs to be demoted.
+func (s *AdaptiveSet[E]) replace(elements []E) {
+	if s.hash != nil {
+		s.hash = internal.FromSlice(elements)
+	} else {
+		s.elements = elements
+	}
+	s.adapt()
+}
+
+// slice returns a new slice containing all elements within the AdaptiveSet.
+func (s *AdaptiveSet[E]) slice() []E {
+	if s.hash != nil {
+		return internal.Slice(s.hash)
+	}
+	elements := make([]E, len(s.elements))
+	copy(elements, s.elements)
+	return elements
+}
+
+// with returns a new AdaptiveSet containing the slice of unique elements provided and sharing the same threshold.
+func (s *AdaptiveSet[E]) with(elements []E) *AdaptiveSet[E] {
+	ws := &AdaptiveSet[E]{elements: elements, threshold: s.threshold}
+	ws.adapt()
+	return ws
+}
+
+// Adaptive returns an AdaptiveSet struct that implements MutableSet containing each unique element provided, using
+// DefaultAdaptiveThreshold.
+//
+// As Adaptive returns a mutable struct it is not safe for concurrent use by multiple goroutines.
+func Adaptive[E comparable](elements ...E) *AdaptiveSet[E] {
+	return AdaptiveWithThreshold(DefaultAdaptiveThreshold, elements...)
+}
+
+// AdaptiveFromSlice returns an AdaptiveSet struct that implements MutableSet containing each unique element from the
+// slice provided, using DefaultAdaptiveThreshold.
+//
+// As AdaptiveFromSlice returns a mutable struct it is not safe for concurrent use by multiple goroutines.
+func AdaptiveFromSlice[E comparable](elements []E) *AdaptiveSet[E] {
+	return AdaptiveWithThreshold(DefaultAdaptiveThreshold, elements...)
+}
+
+// AdaptiveWithThreshold returns an AdaptiveSet struct that implements MutableSet containing each unique element
+// provided, which is promoted to being backed by a map once its number of elements exceeds the threshold.
+//
+// If threshold is not positive, DefaultAdaptiveThreshold is used instead.
+//
+// As AdaptiveWithThreshold returns a mutable struct it is not safe for concurrent use by multiple goroutines.
+func AdaptiveWithThreshold[E comparable](threshold int, elements ...E) *AdaptiveSet[E] {
+	if threshold <= 0 {
+		threshold = DefaultAdaptiveThreshold
+	}
+	s := &AdaptiveSet[E]{threshold: threshold}
+	s.PutSlice(elements)
+	return s
+}
@@ -0,0 +1,1424 @@
+// Copyright (C) 2023 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/neocotic/go-sets/internal"
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+func Test_Adaptive(t *testing.T) {
+	set := Adaptive(123, 456, 789, 456)
+	if expect := Hash(123, 456, 789); !set.Equal(expect) {
+		t.Errorf("unexpected Set; want %v, got %v", expect, set)
+	}
+	if set.threshold != DefaultAdaptiveThreshold {
+		t.Errorf("unexpected threshold; want %v, got %v", DefaultAdaptiveThreshold, set.threshold)
+	}
+	if set.hash != nil {
+		t.Error("unexpected promotion of AdaptiveSet")
+	}
+	if !set.IsMutable() {
+		t.Error("unexpected Set mutability; want true, got false")
+	}
+}
+
+func Test_AdaptiveFromSlice(t *testing.T) {
+	elements := make([]int, DefaultAdaptiveThreshold+1)
+	for i := range elements {
+		elements[i] = i
+	}
+	set := AdaptiveFromSlice(elements)
+	if exp, act := len(elements), set.Len(); act != exp {
+		t.Errorf("unexpected Set length; want %v, got %v", exp, act)
+	}
+	if set.hash == nil {
+		t.Error("unexpected lack of promotion of AdaptiveSet")
+	}
+}
+
+func Test_AdaptiveWithThreshold(t *testing.T) {
+	testCases := map[string]struct {
+		elements        []int
+		expectPromoted  bool
+		expectThreshold int
+		threshold       int
+	}{
+		"with elements not exceeding threshold": {
+			elements:        []int{123, 456, 789},
+			expectPromoted:  false,
+			expectThreshold: 3,
+			threshold:       3,
+		},
+		"with elements exceeding threshold": {
+			elements:        []int{123, 456, 789},
+			expectPromoted:  true,
+			expectThreshold: 2,
+			threshold:       2,
+		},
+		"with zero threshold": {
+			elements:        []int{123, 456, 789},
+			expectPromoted:  false,
+			expectThreshold: DefaultAdaptiveThreshold,
+			threshold:       0,
+		},
+		"with negative threshold": {
+			elements:        []int{123, 456, 789},
+			expectPromoted:  false,
+			expectThreshold: DefaultAdaptiveThreshold,
+			threshold:       -1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := AdaptiveWithThreshold(tc.threshold, tc.elements...)
+			if expect := HashFromSlice(tc.elements); !set.Equal(expect) {
+				t.Errorf("unexpected Set; want %v, got %v", expect, set)
+			}
+			if set.threshold != tc.expectThreshold {
+				t.Errorf("unexpected threshold; want %v, got %v", tc.expectThreshold, set.threshold)
+			}
+			if promoted := set.hash != nil; promoted != tc.expectPromoted {
+				t.Errorf("unexpected promotion; want %v, got %v", tc.expectPromoted, promoted)
+			}
+		})
+	}
+}
+
+func Test_AdaptiveSet_All(t *testing.T) {
+	var elements []int
+	Adaptive(123, 456, 789).All()(func(element int) bool {
+		elements = append(elements, element)
+		return true
+	})
+	if diff := cmp.Diff([]int{123, 456, 789}, elements, cmpopts.SortSlices(Asc[int])); diff != "" {
+		t.Errorf("unexpected yielded elements (-want +got):\n%s", diff)
+	}
+}
+
+func Test_AdaptiveSet_All_Break(t *testing.T) {
+	set := Adaptive(123, 456, 789)
+	var callCount int
+	set.All()(func(element int) bool {
+		callCount++
+		if !set.Contains(element) {
+			t.Errorf("unexpected yielded element; got %v", element)
+		}
+		return callCount < 2
+	})
+	if callCount != 2 {
+		t.Errorf("unexpected number of calls to yield; want 2, got %v", callCount)
+	}
+}
+
+func Test_AdaptiveSet_All_Nil(t *testing.T) {
+	var set *AdaptiveSet[int]
+	var callCount int
+	set.All()(func(_ int) bool {
+		callCount++
+		return true
+	})
+	if callCount != 0 {
+		t.Errorf("unexpected number of calls to yield; want 0, got %v", callCount)
+	}
+}
+
+func Test_AdaptiveSet_AppendSlice(t *testing.T) {
+	testCases := map[string]struct {
+		set *AdaptiveSet[int]
+		dst []int
+	}{
+		"with nil dst on non-empty *AdaptiveSet": {
+			set: Adaptive(123, 456, 789),
+			dst: nil,
+		},
+		"with non-empty dst on non-empty *AdaptiveSet": {
+			set: Adaptive(123, 456, 789),
+			dst: []int{1, 2},
+		},
+		"with dst having spare capacity on non-empty *AdaptiveSet": {
+			set: Adaptive(123, 456, 789),
+			dst: make([]int, 0, 8),
+		},
+		"with nil dst on empty *AdaptiveSet": {
+			set: Adaptive[int](),
+			dst: nil,
+		},
+		"with non-empty dst on empty *AdaptiveSet": {
+			set: Adaptive[int](),
+			dst: []int{1, 2},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dst := append([]int(nil), tc.dst...)
+			ret := tc.set.AppendSlice(tc.dst)
+
+			if expectLen := len(tc.dst) + tc.set.Len(); len(ret) != expectLen {
+				t.Fatalf("unexpected slice length; want %v, got %v", expectLen, len(ret))
+			}
+			if !cmp.Equal(dst, ret[:len(tc.dst)], cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected leading elements; want %v, got %v", dst, ret[:len(tc.dst)])
+			}
+			if tail := ret[len(tc.dst):]; !HashFromSlice(tail).Equal(tc.set) {
+				t.Errorf("unexpected appended elements; want %v, got %v", tc.set, tail)
+			}
+			if cap(tc.dst) >= len(ret) && len(ret) > 0 && &tc.dst[:1][0] != &ret[0] {
+				t.Error("unexpected slice allocation; want dst to be reused")
+			}
+		})
+	}
+}
+
+func Test_AdaptiveSet_AppendSlice_Nil(t *testing.T) {
+	var set *AdaptiveSet[int]
+	dst := []int{1, 2}
+	ret := set.AppendSlice(dst)
+
+	if !cmp.Equal(dst, ret) {
+		t.Errorf("unexpected slice; want %v, got %v", dst, ret)
+	}
+}
+
+func Test_AdaptiveSet_DeleteExisting(t *testing.T) {
+	testCases := map[string]struct {
+		element        int
+		elements       []int
+		expect         int
+		expectElements []int
+	}{
+		"with no elements present": {
+			element:        999,
+			elements:       []int{-123},
+			expect:         0,
+			expectElements: []int{123, 456, 789},
+		},
+		"with some elements present": {
+			element:        456,
+			elements:       []int{999, 789},
+			expect:         2,
+			expectElements: []int{123},
+		},
+		"with all elements present": {
+			element:        123,
+			elements:       []int{456, 789},
+			expect:         3,
+			expectElements: []int{},
+		},
+		"with duplicate elements present": {
+			element:        456,
+			elements:       []int{456},
+			expect:         1,
+			expectElements: []int{123, 789},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Adaptive(123, 456, 789)
+			if deleted := set.DeleteExisting(tc.element, tc.elements...); deleted != tc.expect {
+				t.Errorf("unexpected number of deleted elements; want %v, got %v", tc.expect, deleted)
+			}
+			if expect := HashFromSlice(tc.expectElements); !set.Equal(expect) {
+				t.Errorf("unexpected Set; want %v, got %v", expect, set)
+			}
+		})
+	}
+}
+
+func Test_AdaptiveSet_DeleteExisting_Nil(t *testing.T) {
+	var set *AdaptiveSet[int]
+	if deleted := set.DeleteExisting(123, 456); deleted != 0 {
+		t.Errorf("unexpected number of deleted elements; want 0, got %v", deleted)
+	}
+}
+
+func Test_AdaptiveSet_DrainWhere(t *testing.T) {
+	testCases := map[string]struct {
+		expectDrained  []int
+		expectElements []int
+		predicate      func(element int) bool
+	}{
+		"with some elements matching": {
+			expectDrained:  []int{2, 4},
+			expectElements: []int{1, 3, 5},
+			predicate:      func(element int) bool { return element%2 == 0 },
+		},
+		"with no elements matching": {
+			expectDrained:  []int{},
+			expectElements: []int{1, 2, 3, 4, 5},
+			predicate:      func(element int) bool { return element > 5 },
+		},
+		"with all elements matching": {
+			expectDrained:  []int{1, 2, 3, 4, 5},
+			expectElements: []int{},
+			predicate:      func(element int) bool { return element > 0 },
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Adaptive(1, 2, 3, 4, 5)
+			drained := set.DrainWhere(tc.predicate)
+			if internal.IsNil(drained) {
+				t.Fatal("unexpected nil Set")
+			}
+			if kind := drained.Kind(); kind != AdaptiveKind {
+				t.Errorf("unexpected SetKind; want %v, got %v", AdaptiveKind, kind)
+			}
+			if expect := HashFromSlice(tc.expectDrained); !drained.Equal(expect) {
+				t.Errorf("unexpected drained Set; want %v, got %v", expect, drained)
+			}
+			if expect := HashFromSlice(tc.expectElements); !set.Equal(expect) {
+				t.Errorf("unexpected Set; want %v, got %v", expect, set)
+			}
+		})
+	}
+}
+
+func Test_AdaptiveSet_DrainWhere_Nil(t *testing.T) {
+	var set *AdaptiveSet[int]
+	drained := set.DrainWhere(func(element int) bool {
+		t.Errorf("unexpected call to predicate with %v", element)
+		return true
+	})
+	if drained != (*AdaptiveSet[int])(nil) {
+		t.Errorf("unexpected drained Set; want nil, got %v", drained)
+	}
+}
+
+func Test_AdaptiveSet_Grow(t *testing.T) {
+	testCases := map[string]struct {
+		set    *AdaptiveSet[int]
+		n      int
+		expect Set[int]
+	}{
+		"with positive n on non-empty *AdaptiveSet": {
+			set:    Adaptive(123, 456, 789),
+			n:      100,
+			expect: Hash(123, 456, 789),
+		},
+		"with positive n on empty *AdaptiveSet": {
+			set:    Adaptive[int](),
+			n:      100,
+			expect: Hash[int](),
+		},
+		"with zero n on non-empty *AdaptiveSet": {
+			set:    Adaptive(123, 456, 789),
+			n:      0,
+			expect: Hash(123, 456, 789),
+		},
+		"with negative n on non-empty *AdaptiveSet": {
+			set:    Adaptive(123, 456, 789),
+			n:      -1,
+			expect: Hash(123, 456, 789),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ret := tc.set.Grow(tc.n)
+
+			if internal.IsNil(ret) {
+				t.Error("unexpected nil MutableSet")
+			}
+			if tc.set != ret {
+				t.Errorf("unexpected MutableSet; want %v, got %v", tc.set, ret)
+			}
+			if !tc.set.Equal(tc.expect) {
+				t.Errorf("unexpected MutableSet; want %v, got %v", tc.expect, tc.set)
+			}
+
+			tc.set.Put(1000)
+			if !tc.set.Contains(1000) {
+				t.Error("unexpected MutableSet element presence after Grow; want true, got false")
+			}
+		})
+	}
+}
+
+func Test_AdaptiveSet_Grow_Nil(t *testing.T) {
+	var set *AdaptiveSet[int]
+	ret := set.Grow(100)
+
+	if internal.IsNotNil(ret) {
+		t.Errorf("unexpected MutableSet; want nil, got %v", ret)
+	}
+	if !set.IsEmpty() {
+		t.Error("unexpected MutableSet emptiness; want true, got false")
+	}
+}
+
+func Test_AdaptiveSet_RangeContext(t *testing.T) {
+	set := Adaptive(123, 456, 789)
+	var elements []int
+	err := set.RangeContext(context.Background(), func(element int) bool {
+		elements = append(elements, element)
+		return false
+	})
+	if err != nil {
+		t.Errorf("unexpected error; want nil, got %q", err)
+	}
+	if expect := set.Len(); len(elements) != expect {
+		t.Errorf("unexpected number of iter function calls; want %v, got %v", expect, len(elements))
+	}
+	if actual := HashFromSlice(elements); !actual.Equal(set) {
+		t.Errorf("unexpected iterated elements; want %v, got %v", set, actual)
+	}
+}
+
+func Test_AdaptiveSet_RangeContext_Cancel(t *testing.T) {
+	set := Adaptive(123, 456, 789)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var count int
+	err := set.RangeContext(ctx, func(_ int) bool {
+		count++
+		cancel()
+		return false
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error; want %q, got %q", context.Canceled, err)
+	}
+	if count != 1 {
+		t.Errorf("unexpected number of iter function calls; want 1, got %v", count)
+	}
+}
+
+func Test_AdaptiveSet_RangeContext_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var called bool
+	err := Adaptive(123, 456, 789).RangeContext(ctx, func(_ int) bool {
+		called = true
+		return false
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error; want %q, got %q", context.Canceled, err)
+	}
+	if called {
+		t.Error("unexpected iter function call")
+	}
+}
+
+func Test_AdaptiveSet_RangeContext_Nil(t *testing.T) {
+	var set *AdaptiveSet[int]
+	var called bool
+	err := set.RangeContext(context.Background(), func(_ int) bool {
+		called = true
+		return false
+	})
+	if err != nil {
+		t.Errorf("unexpected error; want nil, got %q", err)
+	}
+	if called {
+		t.Error("unexpected iter function call")
+	}
+}
+
+func Test_AdaptiveSet_Sample(t *testing.T) {
+	set := Adaptive(123, 456, 789)
+	for i := 0; i < 10; i++ {
+		element, ok := set.Sample()
+		if !ok {
+			t.Fatal("unexpected indication of no elements; want true, got false")
+		}
+		if !set.Contains(element) {
+			t.Errorf("unexpected element; want element of %v, got %v", set, element)
+		}
+	}
+}
+
+func Test_AdaptiveSet_Sample_Empty(t *testing.T) {
+	set := Adaptive[int]()
+	if element, ok := set.Sample(); ok {
+		t.Errorf("unexpected element; want none, got %v", element)
+	}
+}
+
+func Test_AdaptiveSet_Sample_Nil(t *testing.T) {
+	var set *AdaptiveSet[int]
+	if element, ok := set.Sample(); ok {
+		t.Errorf("unexpected element; want none, got %v", element)
+	}
+}
+
+func Test_AdaptiveSet_SampleN(t *testing.T) {
+	testCases := map[string]struct {
+		expectLen int
+		n         int
+		set       *AdaptiveSet[int]
+	}{
+		"with n less than length": {
+			expectLen: 2,
+			n:         2,
+			set:       Adaptive(123, 456, 789),
+		},
+		"with n equal to length": {
+			expectLen: 3,
+			n:         3,
+			set:       Adaptive(123, 456, 789),
+		},
+		"with n greater than length": {
+			expectLen: 3,
+			n:         10,
+			set:       Adaptive(123, 456, 789),
+		},
+		"with zero n": {
+			expectLen: 0,
+			n:         0,
+			set:       Adaptive(123, 456, 789),
+		},
+		"with negative n": {
+			expectLen: 0,
+			n:         -1,
+			set:       Adaptive(123, 456, 789),
+		},
+		"with empty *AdaptiveSet[int]": {
+			expectLen: 0,
+			n:         2,
+			set:       Adaptive[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sampled := tc.set.SampleN(tc.n)
+			if internal.IsNil(sampled) {
+				t.Fatal("unexpected nil Set")
+			}
+			if kind, expectKind := sampled.Kind(), tc.set.Kind(); kind != expectKind {
+				t.Errorf("unexpected SetKind; want %v, got %v", expectKind, kind)
+			}
+			if l := sampled.Len(); l != tc.expectLen {
+				t.Errorf("unexpected Set length; want %v, got %v", tc.expectLen, l)
+			}
+			if !tc.set.Covers(sampled) {
+				t.Errorf("unexpected sampled Set; want subset of %v, got %v", tc.set, sampled)
+			}
+		})
+	}
+}
+
+func Test_AdaptiveSet_SampleN_Nil(t *testing.T) {
+	var set *AdaptiveSet[int]
+	if sampled := set.SampleN(2); sampled != (*AdaptiveSet[int])(nil) {
+		t.Errorf("unexpected sampled Set; want nil, got %v", sampled)
+	}
+}
+
+func Test_AdaptiveSet_SortedAppendSlice(t *testing.T) {
+	testCases := map[string]struct {
+		set    *AdaptiveSet[int]
+		dst    []int
+		expect []int
+	}{
+		"with nil dst on non-empty *AdaptiveSet": {
+			set:    Adaptive(456, 123, 789),
+			dst:    nil,
+			expect: []int{789, 456, 123},
+		},
+		"with non-empty dst on non-empty *AdaptiveSet": {
+			set:    Adaptive(456, 123, 789),
+			dst:    []int{1, 999},
+			expect: []int{1, 999, 789, 456, 123},
+		},
+		"with nil dst on empty *AdaptiveSet": {
+			set:    Adaptive[int](),
+			dst:    nil,
+			expect: nil,
+		},
+		"with non-empty dst on empty *AdaptiveSet": {
+			set:    Adaptive[int](),
+			dst:    []int{1, 999},
+			expect: []int{1, 999},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ret := tc.set.SortedAppendSlice(tc.dst, Desc[int])
+			if !cmp.Equal(tc.expect, ret, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected slice; want %v, got %v", tc.expect, ret)
+			}
+		})
+	}
+}
+
+func Test_AdaptiveSet_SortedAppendSlice_Nil(t *testing.T) {
+	var set *AdaptiveSet[int]
+	dst := []int{1, 999}
+	ret := set.SortedAppendSlice(dst, Desc[int])
+
+	if !cmp.Equal(dst, ret) {
+		t.Errorf("unexpected slice; want %v, got %v", dst, ret)
+	}
+}
+
+func Test_AdaptiveSet_SortedRange(t *testing.T) {
+	testCases := map[string]struct {
+		expect []int
+		set    *AdaptiveSet[int]
+		stopAt int
+	}{
+		"with non-empty *AdaptiveSet[int]": {
+			expect: []int{789, 456, 345, 123, 12},
+			set:    Adaptive(456, 123, 789, 12, 345),
+			stopAt: -1,
+		},
+		"with non-empty *AdaptiveSet[int] and early stop": {
+			expect: []int{789, 456, 345},
+			set:    Adaptive(456, 123, 789, 12, 345),
+			stopAt: 2,
+		},
+		"with empty *AdaptiveSet[int]": {
+			expect: nil,
+			set:    Adaptive[int](),
+			stopAt: -1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var elements []int
+			tc.set.SortedRange(Desc[int], func(index int, element int) bool {
+				if index != len(elements) {
+					t.Errorf("unexpected index; want %v, got %v", len(elements), index)
+				}
+				elements = append(elements, element)
+				return index == tc.stopAt
+			})
+			if !cmp.Equal(tc.expect, elements, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected elements; want %v, got %v", tc.expect, elements)
+			}
+		})
+	}
+}
+
+func Test_AdaptiveSet_SortedRange_Nil(t *testing.T) {
+	var set *AdaptiveSet[int]
+	set.SortedRange(Desc[int], func(index int, element int) bool {
+		t.Errorf("unexpected call to iter with %v at index %v", element, index)
+		return false
+	})
+}
+
+func Test_AdaptiveSet_Threshold(t *testing.T) {
+	set := AdaptiveWithThreshold[int](4)
+	assertPromoted := func(step string, expect bool) {
+		t.Helper()
+		if promoted := set.hash != nil; promoted != expect {
+			t.Errorf("unexpected promotion %s; want %v, got %v", step, expect, promoted)
+		}
+	}
+
+	set.Put(1, 2, 3, 4)
+	assertPromoted("when reaching threshold", false)
+	set.Put(5)
+	assertPromoted("when exceeding threshold", true)
+	set.Put(6, 7, 8)
+	assertPromoted("when growing beyond threshold", true)
+	set.Delete(8, 7, 6, 5)
+	assertPromoted("when falling to threshold", true)
+	set.Delete(4)
+	assertPromoted("when falling above half of threshold", true)
+	set.Delete(3)
+	assertPromoted("when falling to half of threshold", false)
+	if expect := Hash(1, 2); !set.Equal(expect) {
+		t.Errorf("unexpected Set after demotion; want %v, got %v", expect, set)
+	}
+	set.Put(3, 4, 5)
+	assertPromoted("when exceeding threshold again", true)
+	set.RetainWhere(func(element int) bool { return element < 3 })
+	assertPromoted("when retaining half of threshold", false)
+	if expect := Hash(1, 2); !set.Equal(expect) {
+		t.Errorf("unexpected Set after retaining; want %v, got %v", expect, set)
+	}
+}
+
+func Test_AdaptiveSet_Consistency(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	sets := map[string]*AdaptiveSet[int]{
+		"never promoted":  AdaptiveWithThreshold[int](1_000),
+		"always promoted": AdaptiveWithThreshold[int](1),
+		"often adapted":   AdaptiveWithThreshold[int](16),
+	}
+	expect := MutableHash[int]()
+	for i := 0; i < 5_000; i++ {
+		element := rnd.Intn(48)
+		put := rnd.Intn(2) == 0
+		if put {
+			expect.Put(element)
+		} else {
+			expect.Delete(element)
+		}
+		for name, set := range sets {
+			if put {
+				set.Put(element)
+			} else {
+				set.Delete(element)
+			}
+			if set.Contains(element) != put || set.Len() != expect.Len() {
+				t.Fatalf("unexpected Set after %v operations on %s AdaptiveSet; want %v, got %v", i+1, name, expect, set)
+			}
+		}
+	}
+	for name, set := range sets {
+		if !set.Equal(expect) {
+			t.Errorf("unexpected %s AdaptiveSet; want %v, got %v", name, expect, set)
+		}
+		if diff := cmp.Diff(expect.SortedSlice(Asc[int]), set.SortedSlice(Asc[int])); diff != "" {
+			t.Errorf("unexpected elements of %s AdaptiveSet (-want +got):\n%s", name, diff)
+		}
+	}
+}
+
+func Test_AdaptiveSet_Operations(t *testing.T) {
+	for _, threshold := range []int{1, DefaultAdaptiveThreshold} {
+		t.Run("with threshold "+strconv.Itoa(threshold), func(t *testing.T) {
+			set := AdaptiveWithThreshold(threshold, 123, 456, 789)
+			other := Hash(456, 999)
+
+			if result := set.Diff(other); !result.Equal(Hash(123, 789)) || result.Kind() != AdaptiveKind {
+				t.Errorf("unexpected diff Set; want %v, got %v", Hash(123, 789), result)
+			}
+			if result := set.DiffSymmetric(other); !result.Equal(Hash(123, 789, 999)) {
+				t.Errorf("unexpected symmetric diff Set; want %v, got %v", Hash(123, 789, 999), result)
+			}
+			if result := set.Intersection(other); !result.Equal(Hash(456)) {
+				t.Errorf("unexpected intersection Set; want %v, got %v", Hash(456), result)
+			}
+			if result := set.Union(other); !result.Equal(Hash(123, 456, 789, 999)) {
+				t.Errorf("unexpected union Set; want %v, got %v", Hash(123, 456, 789, 999), result)
+			}
+			if result := set.Filter(func(element int) bool { return element > 200 }); !result.Equal(Hash(456, 789)) {
+				t.Errorf("unexpected filtered Set; want %v, got %v", Hash(456, 789), result)
+			}
+			if max, ok := set.Max(Asc[int]); !ok || max != 789 {
+				t.Errorf("unexpected max; want 789 and true, got %v and %v", max, ok)
+			}
+			if min, ok := set.Min(Asc[int]); !ok || min != 123 {
+				t.Errorf("unexpected min; want 123 and true, got %v and %v", min, ok)
+			}
+			if join := set.SortedJoin(",", strconv.Itoa, Asc[int]); join != "123,456,789" {
+				t.Errorf("unexpected sorted string; want %q, got %q", "123,456,789", join)
+			}
+			if !set.Every(func(element int) bool { return element > 0 }) {
+				t.Error("unexpected every; want true, got false")
+			}
+			if !set.None(func(element int) bool { return element < 0 }) {
+				t.Error("unexpected none; want true, got false")
+			}
+			if immutable := set.Immutable(); immutable.IsMutable() || !immutable.Equal(set) {
+				t.Errorf("unexpected immutable Set; want %v, got %v", set, immutable)
+			}
+
+			clone := set.Clone()
+			if element, ok := set.DeleteNth(Asc[int], 0); !ok || element != 123 {
+				t.Errorf("unexpected deleted element; want 123 and true, got %v and %v", element, ok)
+			}
+			if !clone.Contains(123) {
+				t.Error("unexpected modification of cloned Set")
+			}
+			set.Retain(456, 789, 999).DeleteSlice([]int{789}).PutAll(other)
+			if expect := Hash(456, 999); !set.Equal(expect) {
+				t.Errorf("unexpected Set; want %v, got %v", expect, set)
+			}
+		})
+	}
+}
+
+func Test_AdaptiveSet_Pop(t *testing.T) {
+	set := AdaptiveWithThreshold(2, 123, 456, 789)
+	var popped []int
+	for i := 0; i < 3; i++ {
+		element, ok := set.Pop()
+		if !ok {
+			t.Fatalf("unexpected pop result; want true, got false")
+		}
+		if set.Contains(element) {
+			t.Errorf("unexpected Set containment of %v; want false, got true", element)
+		}
+		if exp, act := 2-i, set.Len(); act != exp {
+			t.Errorf("unexpected Set length; want %v, got %v", exp, act)
+		}
+		popped = append(popped, element)
+	}
+	sort.Ints(popped)
+	if exp := []int{123, 456, 789}; !cmp.Equal(exp, popped) {
+		t.Errorf("unexpected popped elements; want %v, got %v", exp, popped)
+	}
+	if element, ok := set.Pop(); ok || element != 0 {
+		t.Errorf("unexpected pop result; want 0 and false, got %v and %v", element, ok)
+	}
+	if element, ok := Adaptive[int]().Pop(); ok || element != 0 {
+		t.Errorf("unexpected pop result; want 0 and false, got %v and %v", element, ok)
+	}
+}
+
+func Test_AdaptiveSet_Pop_Nil(t *testing.T) {
+	var set *AdaptiveSet[int]
+	if element, ok := set.Pop(); ok || element != 0 {
+		t.Errorf("unexpected pop result; want 0 and false, got %v and %v", element, ok)
+	}
+}
+
+func Test_AdaptiveSet_Take(t *testing.T) {
+	testCases := map[string]struct {
+		element        int
+		expectElement  int
+		expectElements []int
+		expectOk       bool
+	}{
+		"with element present": {
+			element:        456,
+			expectElement:  456,
+			expectElements: []int{123, 789},
+			expectOk:       true,
+		},
+		"with element not present": {
+			element:        999,
+			expectElement:  0,
+			expectElements: []int{123, 456, 789},
+			expectOk:       false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := AdaptiveWithThreshold(2, 123, 456, 789)
+			element, ok := set.Take(tc.element)
+			if element != tc.expectElement || ok != tc.expectOk {
+				t.Errorf(
+					"unexpected taken element; want %v and %v, got %v and %v",
+					tc.expectElement,
+					tc.expectOk,
+					element,
+					ok,
+				)
+			}
+			if expect := HashFromSlice(tc.expectElements); !set.Equal(expect) {
+				t.Errorf("unexpected Set; want %v, got %v", expect, set)
+			}
+		})
+	}
+}
+
+func Test_AdaptiveSet_Take_Nil(t *testing.T) {
+	var set *AdaptiveSet[int]
+	if element, ok := set.Take(123); ok {
+		t.Errorf("unexpected taken element; want 0 and false, got %v and %v", element, ok)
+	}
+}
+
+func Test_AdaptiveSet_DeleteAnyOf(t *testing.T) {
+	testCases := map[string]struct {
+		expect Set[int]
+		sets   []Set[int]
+	}{
+		"with no Sets": {
+			expect: Hash(123, 456, 789),
+			sets:   nil,
+		},
+		"with single Set": {
+			expect: Hash(123, 789),
+			sets:   []Set[int]{Hash(456, 999)},
+		},
+		"with multiple Sets": {
+			expect: Hash(789),
+			sets:   []Set[int]{Hash(456, 999), Hash(123), Hash[int]()},
+		},
+		"with nil Sets": {
+			expect: Hash(123, 789),
+			sets:   []Set[int]{nil, Hash(456), (*HashSet[int])(nil)},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := AdaptiveWithThreshold(2, 123, 456, 789)
+			composed := MutableHash(123, 456, 789)
+			for _, other := range tc.sets {
+				composed.DeleteAll(other)
+			}
+			set.DeleteAnyOf(tc.sets...)
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+			if !set.Equal(composed) {
+				t.Errorf("unexpected Set compared to composing DeleteAll; want %v, got %v", composed, set)
+			}
+		})
+	}
+}
+
+func Test_AdaptiveSet_DeleteAnyOf_Nil(t *testing.T) {
+	var set *AdaptiveSet[int]
+	if result := set.DeleteAnyOf(Hash(123)); internal.IsNotNil(result) {
+		t.Errorf("unexpected MutableSet; want nil, got %v", result)
+	}
+}
+
+func Test_AdaptiveSet_ReplaceElement(t *testing.T) {
+	testCases := map[string]struct {
+		expect     Set[int]
+		expectOk   bool
+		newElement int
+		oldElement int
+	}{
+		"with old element absent": {
+			expect:     Hash(123, 456, 789),
+			expectOk:   false,
+			newElement: 999,
+			oldElement: 0,
+		},
+		"with old element present and new element absent": {
+			expect:     Hash(123, 789, 999),
+			expectOk:   true,
+			newElement: 999,
+			oldElement: 456,
+		},
+		"with old element present and new element already present": {
+			expect:     Hash(123, 789),
+			expectOk:   true,
+			newElement: 789,
+			oldElement: 456,
+		},
+		"with old element equal to new element": {
+			expect:     Hash(123, 456, 789),
+			expectOk:   true,
+			newElement: 456,
+			oldElement: 456,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := AdaptiveWithThreshold(2, 123, 456, 789)
+			if ok := set.ReplaceElement(tc.oldElement, tc.newElement); ok != tc.expectOk {
+				t.Errorf("unexpected replacement; want %v, got %v", tc.expectOk, ok)
+			}
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+		})
+	}
+}
+
+func Test_AdaptiveSet_ReplaceElement_Nil(t *testing.T) {
+	var set *AdaptiveSet[int]
+	if set.ReplaceElement(123, 456) {
+		t.Error("unexpected replacement; want false, got true")
+	}
+}
+
+func Test_AdaptiveSet_RetainAllOf(t *testing.T) {
+	testCases := map[string]struct {
+		expect Set[int]
+		sets   []Set[int]
+	}{
+		"with no Sets": {
+			expect: Hash(123, 456, 789),
+			sets:   nil,
+		},
+		"with single Set": {
+			expect: Hash(456),
+			sets:   []Set[int]{Hash(456, 999)},
+		},
+		"with multiple Sets": {
+			expect: Hash(456),
+			sets:   []Set[int]{Hash(123, 456, 999), Hash(456, 789)},
+		},
+		"with disjoint Sets": {
+			expect: Hash[int](),
+			sets:   []Set[int]{Hash(123), Hash(456)},
+		},
+		"with nil Set": {
+			expect: Hash[int](),
+			sets:   []Set[int]{Hash(123, 456), nil},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := AdaptiveWithThreshold(2, 123, 456, 789)
+			composed := MutableHash(123, 456, 789)
+			for _, other := range tc.sets {
+				composed.RetainAll(other)
+			}
+			set.RetainAllOf(tc.sets...)
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+			if !set.Equal(composed) {
+				t.Errorf("unexpected Set compared to composing RetainAll; want %v, got %v", composed, set)
+			}
+		})
+	}
+}
+
+func Test_AdaptiveSet_RetainAllOf_Nil(t *testing.T) {
+	var set *AdaptiveSet[int]
+	if result := set.RetainAllOf(Hash(123)); internal.IsNotNil(result) {
+		t.Errorf("unexpected MutableSet; want nil, got %v", result)
+	}
+}
+
+func Test_AdaptiveSet_Canonical(t *testing.T) {
+	testCases := map[string]struct {
+		expect string
+		set    *AdaptiveSet[string]
+	}{
+		"with no elements": {
+			expect: "",
+			set:    AdaptiveWithThreshold[string](2),
+		},
+		"with elements": {
+			expect: "bar,baz,foo",
+			set:    AdaptiveWithThreshold[string](2, "foo", "bar", "baz"),
+		},
+		"with elements requiring escaping": {
+			expect: `a\,,b,c\\`,
+			set:    AdaptiveWithThreshold[string](2, "b", `c\`, "a,"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			canonical := string(tc.set.Canonical(func(element string) string { return element }))
+			if canonical != tc.expect {
+				t.Errorf("unexpected canonical; want %q, got %q", tc.expect, canonical)
+			}
+		})
+	}
+}
+
+func Test_AdaptiveSet_Canonical_Nil(t *testing.T) {
+	var set *AdaptiveSet[string]
+	canonical := set.Canonical(func(element string) string { return element })
+	if canonical == nil || len(canonical) != 0 {
+		t.Errorf("unexpected canonical; want %#v, got %#v", []byte{}, canonical)
+	}
+}
+
+func Test_AdaptiveSet_ContainsAll(t *testing.T) {
+	testCases := map[string]struct {
+		element  int
+		elements []int
+		expect   bool
+	}{
+		"with single contained element": {
+			element: 123,
+			expect:  true,
+		},
+		"with single element not contained": {
+			element: -123,
+			expect:  false,
+		},
+		"with all elements contained": {
+			element:  123,
+			elements: []int{456, 789},
+			expect:   true,
+		},
+		"with duplicate contained elements": {
+			element:  123,
+			elements: []int{123, 123},
+			expect:   true,
+		},
+		"with first element not contained": {
+			element:  -123,
+			elements: []int{456, 789},
+			expect:   false,
+		},
+		"with last element not contained": {
+			element:  123,
+			elements: []int{456, -789},
+			expect:   false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Adaptive(123, 456, 789)
+			if result := set.ContainsAll(tc.element, tc.elements...); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_AdaptiveSet_ContainsAll_Nil(t *testing.T) {
+	var set *AdaptiveSet[int]
+	if set.ContainsAll(123, 456) {
+		t.Error("unexpected result; want false, got true")
+	}
+}
+
+func Test_AdaptiveSet_ContainsAny(t *testing.T) {
+	testCases := map[string]struct {
+		element  int
+		elements []int
+		expect   bool
+	}{
+		"with single contained element": {
+			element: 123,
+			expect:  true,
+		},
+		"with single element not contained": {
+			element: -123,
+			expect:  false,
+		},
+		"with first element contained": {
+			element:  123,
+			elements: []int{-456, -789},
+			expect:   true,
+		},
+		"with last element contained": {
+			element:  -123,
+			elements: []int{-456, 789},
+			expect:   true,
+		},
+		"with no elements contained": {
+			element:  -123,
+			elements: []int{-456, -789},
+			expect:   false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Adaptive(123, 456, 789)
+			if result := set.ContainsAny(tc.element, tc.elements...); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_AdaptiveSet_ContainsAny_Nil(t *testing.T) {
+	var set *AdaptiveSet[int]
+	if set.ContainsAny(123, 456) {
+		t.Error("unexpected result; want false, got true")
+	}
+}
+
+func Test_AdaptiveSet_ContainsEach(t *testing.T) {
+	testCases := map[string]struct {
+		elements []int
+		expect   []bool
+	}{
+		"with no elements": {
+			elements: nil,
+			expect:   []bool{},
+		},
+		"with mix of present and absent elements": {
+			elements: []int{999, 123, 0, 789, 456, -1},
+			expect:   []bool{false, true, false, true, true, false},
+		},
+		"with duplicate elements": {
+			elements: []int{456, 999, 456},
+			expect:   []bool{true, false, true},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := AdaptiveWithThreshold(2, 123, 456, 789)
+			if diff := cmp.Diff(tc.expect, set.ContainsEach(tc.elements)); diff != "" {
+				t.Errorf("unexpected results (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_AdaptiveSet_ContainsEach_Nil(t *testing.T) {
+	var set *AdaptiveSet[int]
+	if diff := cmp.Diff([]bool{false, false}, set.ContainsEach([]int{123, 0})); diff != "" {
+		t.Errorf("unexpected results (-want +got):\n%s", diff)
+	}
+}
+
+func Test_AdaptiveSet_Count(t *testing.T) {
+	testCases := map[string]struct {
+		expect    int
+		predicate func(element int) bool
+	}{
+		"with predicate matching all elements": {
+			expect:    5,
+			predicate: func(_ int) bool { return true },
+		},
+		"with predicate matching some elements": {
+			expect:    3,
+			predicate: func(element int) bool { return element > 0 },
+		},
+		"with predicate matching single element": {
+			expect:    1,
+			predicate: func(element int) bool { return element == 0 },
+		},
+		"with predicate matching no elements": {
+			expect:    0,
+			predicate: func(_ int) bool { return false },
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := AdaptiveWithThreshold(2, -123, 0, 123, 456, 789)
+			if result := set.Count(tc.predicate); result != tc.expect {
+				t.Errorf("unexpected count; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_AdaptiveSet_Count_Nil(t *testing.T) {
+	var set *AdaptiveSet[int]
+	if result := set.Count(func(_ int) bool { return true }); result != 0 {
+		t.Errorf("unexpected count; want 0, got %v", result)
+	}
+}
+
+func Test_AdaptiveSet_Covers(t *testing.T) {
+	testCases := map[string]struct {
+		expect bool
+		others []Set[int]
+	}{
+		"with no other Sets": {
+			expect: true,
+			others: nil,
+		},
+		"with covered Sets": {
+			expect: true,
+			others: []Set[int]{Hash(123), Hash(456, 789), Hash(123, 456, 789)},
+		},
+		"with empty and nil Sets": {
+			expect: true,
+			others: []Set[int]{Hash[int](), nil, (*HashSet[int])(nil)},
+		},
+		"with mix of covered and not covered Sets": {
+			expect: false,
+			others: []Set[int]{Hash(123, 456), Hash(456, 999), Hash(789)},
+		},
+		"with Set containing more elements": {
+			expect: false,
+			others: []Set[int]{Hash(0, 123, 456, 789)},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := AdaptiveWithThreshold(2, 123, 456, 789)
+			if covers := set.Covers(tc.others...); covers != tc.expect {
+				t.Errorf("unexpected covers; want %v, got %v", tc.expect, covers)
+			}
+		})
+	}
+}
+
+func Test_AdaptiveSet_Covers_Nil(t *testing.T) {
+	var set *AdaptiveSet[int]
+	if !set.Covers(Hash[int](), nil) {
+		t.Error("unexpected covers of empty Set; want true, got false")
+	}
+	if set.Covers(Hash(123)) {
+		t.Error("unexpected covers of non-empty Set; want false, got true")
+	}
+}
+
+func Test_AdaptiveSet_IsDisjoint(t *testing.T) {
+	testCases := map[string]struct {
+		expect bool
+		other  Set[int]
+	}{
+		"with disjoint Set": {
+			expect: true,
+			other:  Hash(-789, -456, -123),
+		},
+		"with smaller disjoint Set": {
+			expect: true,
+			other:  Singleton(0),
+		},
+		"with larger disjoint Set": {
+			expect: true,
+			other:  Hash(-789, -456, -123, 0, 1),
+		},
+		"with overlapping Set": {
+			expect: false,
+			other:  Hash(-123, 0, 456),
+		},
+		"with smaller overlapping Set": {
+			expect: false,
+			other:  Singleton(789),
+		},
+		"with larger overlapping Set": {
+			expect: false,
+			other:  Hash(-789, -456, -123, 0, 123),
+		},
+		"with equal Set": {
+			expect: false,
+			other:  Hash(123, 456, 789),
+		},
+		"with empty Set": {
+			expect: true,
+			other:  Hash[int](),
+		},
+		"with nil Set": {
+			expect: true,
+			other:  nil,
+		},
+		"with nil *HashSet": {
+			expect: true,
+			other:  (*HashSet[int])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Adaptive(123, 456, 789)
+			if result := set.IsDisjoint(tc.other); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_AdaptiveSet_IsDisjoint_Nil(t *testing.T) {
+	var set *AdaptiveSet[int]
+	if !set.IsDisjoint(Hash(123)) {
+		t.Error("unexpected result; want true, got false")
+	}
+	if !set.IsDisjoint(nil) {
+		t.Error("unexpected result; want true, got false")
+	}
+}
+
+func Test_AdaptiveSet_Kind(t *testing.T) {
+	set := Adaptive(123, 456, 789)
+	if kind := set.Kind(); kind != AdaptiveKind {
+		t.Errorf("unexpected kind; want %v, got %v", AdaptiveKind, kind)
+	}
+}
+
+func Test_AdaptiveSet_Nil(t *testing.T) {
+	var set *AdaptiveSet[int]
+	if internal.IsNotNil(set.Clear()) || internal.IsNotNil(set.Put(123)) || internal.IsNotNil(set.Delete(123)) {
+		t.Error("unexpected non-nil MutableSet")
+	}
+	if internal.IsNotNil(set.Clone()) || internal.IsNotNil(set.Diff(Hash(123))) ||
+		internal.IsNotNil(set.Filter(func(int) bool { return true })) || internal.IsNotNil(set.Immutable()) {
+		t.Error("unexpected non-nil Set")
+	}
+	if set.Contains(123) || set.Len() != 0 || !set.IsEmpty() || set.Slice() != nil {
+		t.Error("unexpected elements within nil Set")
+	}
+	if !set.Equal(nil) || !set.Equal(Hash[int]()) || set.Equal(Hash(123)) {
+		t.Error("unexpected equality of nil Set")
+	}
+	if element, ok := set.DeleteNth(Asc[int], 0); ok {
+		t.Errorf("unexpected deleted element; want 0 and false, got %v and %v", element, ok)
+	}
+	if s := set.String(); s != "[]" {
+		t.Errorf("unexpected string; want %q, got %q", "[]", s)
+	}
+	if union := set.Union(nil); internal.IsNotNil(union) {
+		t.Errorf("unexpected union Set; want nil, got %v", union)
+	}
+	if union := set.Union(Hash(123)); union.Kind() != AdaptiveKind || !union.Equal(Hash(123)) {
+		t.Errorf("unexpected union Set; want %v, got %v", Hash(123), union)
+	}
+}
+
+func Test_AdaptiveSet_MarshalJSON(t *testing.T) {
+	set := AdaptiveWithThreshold(2, 123, 456, 789)
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var elements []int
+	if err = json.Unmarshal(data, &elements); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]int{123, 456, 789}, elements, cmpopts.SortSlices(Asc[int])); diff != "" {
+		t.Errorf("unexpected elements (-want +got):\n%s", diff)
+	}
+}
+
+func Test_AdaptiveSet_ToMap(t *testing.T) {
+	testCases := map[string]struct {
+		expect map[int]struct{}
+		set    *AdaptiveSet[int]
+	}{
+		"with non-empty *AdaptiveSet": {
+			expect: map[int]struct{}{123: {}, 456: {}, 789: {}},
+			set:    AdaptiveWithThreshold(2, 123, 456, 789),
+		},
+		"with empty *AdaptiveSet": {
+			expect: map[int]struct{}{},
+			set:    Adaptive[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := tc.set.ToMap()
+			if m == nil {
+				t.Fatal("unexpected map; want non-nil, got nil")
+			}
+			if !cmp.Equal(tc.expect, m) {
+				t.Errorf("unexpected map; want %v, got %v", tc.expect, m)
+			}
+			m[-1] = struct{}{}
+			if tc.set.Contains(-1) {
+				t.Error("unexpected Set containment of -1; want false, got true")
+			}
+		})
+	}
+}
+
+func Test_AdaptiveSet_ToMap_Nil(t *testing.T) {
+	var set *AdaptiveSet[int]
+	if m := set.ToMap(); m != nil {
+		t.Errorf("unexpected map; want nil, got %v", m)
+	}
+}
+
+func Test_AdaptiveSet_UnmarshalJSON(t *testing.T) {
+	set := AdaptiveWithThreshold(2, 999)
+	if err := json.Unmarshal([]byte("[123,456,789,456]"), set); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expect := Hash(123, 456, 789); !set.Equal(expect) {
+		t.Errorf("unexpected Set; want %v, got %v", expect, set)
+	}
+	if set.hash == nil {
+		t.Error("unexpected lack of promotion of AdaptiveSet")
+	}
+}
@@ -0,0 +1,1130 @@
+// Copyright (C) 2023 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/neocotic/go-sets/internal"
+	"math"
+	"math/bits"
+	"math/rand"
+	"sort"
+)
+
+// MaxBitElement is the largest element that can be put into a BitSet. As memory is proportional to the largest element,
+// this limits a single BitSet to occupying no more than 256MiB.
+const MaxBitElement = math.MaxInt32
+
+// BitSet is an implementation of MutableSet that contains a unique data set of non-negative integers, storing
+// membership as a packed array of bits where each element is represented by a single bit.
+//
+// For dense data sets over a small range of integers (e.g. 0 to 10000), BitSet uses a fraction of the memory of
+// map-based implementations, such as MutableHashSet, and BitSet.Put, BitSet.Delete, and BitSet.Contains are all
+// constant time. BitSet.Union, BitSet.Intersection, BitSet.Diff, BitSet.DiffSymmetric, and BitSet.Equal are also
+// performed word-by-word whenever the other Set is also a BitSet. However, as memory is proportional to the largest
+// element rather than the number of elements, BitSet is unsuitable for sparse data sets containing large integers.
+//
+// Elements are always iterated in ascending order.
+//
+// BitSet cannot contain negative elements, and BitSet.Put, BitSet.PutAll, BitSet.PutSlice, and BitSet.ReplaceElement
+// all panic with an error wrapping ErrBitNegative if any negative element is provided. Likewise, they all panic with an
+// error wrapping ErrBitRange if any element greater than MaxBitElement is provided.
+//
+// The zero value of BitSet is an empty set ready for use.
+//
+// As BitSet is mutable it is not safe for concurrent use by multiple goroutines.
+type BitSet struct {
+	len   int
+	words []uint64
+}
+
+var (
+	_ MutableSet[int]  = (*BitSet)(nil)
+	_ fmt.Stringer     = (*BitSet)(nil)
+	_ json.Marshaler   = (*BitSet)(nil)
+	_ json.Unmarshaler = (*BitSet)(nil)
+)
+
+// All returns an iterator over each element within the BitSet, which is compatible with iter.Seq so can be used with
+// range-over-func (e.g. `for element := range set.All()`) from Go 1.23 onwards.
+//
+// Elements are yielded in ascending order.
+//
+// If the BitSet is nil, the iterator returned by BitSet.All yields no elements.
+func (s *BitSet) All() func(yield func(element int) bool) {
+	return seq[int](s.Range)
+}
+
+// AppendSlice appends all elements of the BitSet to the dst slice and returns the extended slice, following the same
+// convention as the built-in append function. This allows a buffer to be reused across calls, avoiding the allocation
+// made by BitSet.Slice each time.
+//
+// Elements are appended in ascending order.
+//
+// If the BitSet is nil, BitSet.AppendSlice returns dst unchanged.
+func (s *BitSet) AppendSlice(dst []int) []int {
+	if s == nil {
+		return dst
+	}
+	s.Range(func(element int) bool {
+		dst = append(dst, element)
+		return false
+	})
+	return dst
+}
+
+// Canonical returns a minimal byte representation of the BitSet that is stable for its elements, which can be useful
+// for hashing, cache keys, or as a shortcut for equality checks. See Set.Canonical for the escaping and separator used.
+//
+// If the BitSet is nil it is treated as having no elements and so BitSet.Canonical returns an empty slice.
+func (s *BitSet) Canonical(convert func(element int) string) []byte {
+	return canonical[int](s, convert)
+}
+
+// Clear removes all elements from the BitSet.
+//
+// If the BitSet is nil, BitSet.Clear is a no-op.
+//
+// A reference to the BitSet is returned for method chaining.
+func (s *BitSet) Clear() MutableSet[int] {
+	if s == nil {
+		var ns *BitSet
+		return ns
+	}
+	s.len, s.words = 0, nil
+	return s
+}
+
+// Clone returns a clone of the BitSet.
+//
+// If the BitSet is nil, BitSet.Clone returns nil.
+func (s *BitSet) Clone() Set[int] {
+	if s == nil {
+		var ns *BitSet
+		return ns
+	}
+	return s.clone()
+}
+
+// Contains returns whether the BitSet contains the element. A negative element is never contained.
+//
+// If the BitSet is nil, BitSet.Contains returns false.
+func (s *BitSet) Contains(element int) bool {
+	if s == nil || element < 0 {
+		return false
+	}
+	i := element >> 6
+	return i < len(s.words) && s.words[i]&(1<<(uint(element)&63)) != 0
+}
+
+// ContainsAll returns whether the BitSet contains the element as well as all additional elements specified, stopping
+// as soon as any element is found not to be contained.
+//
+// If the BitSet is nil, BitSet.ContainsAll returns false.
+func (s *BitSet) ContainsAll(element int, elements ...int) bool {
+	return containsAll[int](element, elements, s.Contains)
+}
+
+// ContainsAny returns whether the BitSet contains the element or any of the additional elements specified, stopping as
+// soon as any element is found to be contained.
+//
+// If the BitSet is nil, BitSet.ContainsAny returns false.
+func (s *BitSet) ContainsAny(element int, elements ...int) bool {
+	return containsAny[int](element, elements, s.Contains)
+}
+
+// ContainsEach returns a slice containing whether the BitSet contains each of the elements provided, where each result
+// is at the same index as its element.
+//
+// The returned slice always has the same length as the slice of elements provided.
+//
+// If the BitSet is nil, BitSet.ContainsEach returns a slice of false values.
+func (s *BitSet) ContainsEach(elements []int) []bool {
+	return containsEach[int](elements, s.Contains)
+}
+
+// Count returns the number of elements within the BitSet that match the predicate function.
+//
+// If the BitSet is nil, BitSet.Count returns zero.
+func (s *BitSet) Count(predicate func(element int) bool) int {
+	return count[int](s.Range, predicate)
+}
+
+// Covers returns whether the BitSet is a superset of every other Set. That is; whether the BitSet contains all elements
+// within each other Set. Any other Set containing more elements than the BitSet is never covered and so is rejected
+// before its elements are checked.
+//
+// Any nil other Set is treated as having no elements and so is always covered. If no other Set is provided,
+// BitSet.Covers returns true.
+//
+// If the BitSet is nil it is treated as having no elements.
+func (s *BitSet) Covers(others ...Set[int]) bool {
+	return covers[int](s.Len(), s.Contains, others)
+}
+
+// Delete removes the element from the BitSet as well as any additional elements specified.
+//
+// If the BitSet is nil, BitSet.Delete is a no-op.
+//
+// A reference to the BitSet is returned for method chaining.
+func (s *BitSet) Delete(element int, elements ...int) MutableSet[int] {
+	if s == nil {
+		var ns *BitSet
+		return ns
+	}
+	s.delete(element)
+	for _, _element := range elements {
+		s.delete(_element)
+	}
+	return s
+}
+
+// DeleteAll removes all elements in the specified Set from the BitSet. If the specified Set is also a BitSet, this is
+// done word-by-word.
+//
+// If the BitSet is nil, BitSet.DeleteAll is a no-op.
+//
+// A reference to the BitSet is returned for method chaining.
+func (s *BitSet) DeleteAll(elements Set[int]) MutableSet[int] {
+	if s == nil {
+		var ns *BitSet
+		return ns
+	}
+	if other, ok := elements.(*BitSet); ok {
+		if other != nil {
+			s.andNot(other)
+		}
+	} else if elements != nil {
+		elements.Range(func(element int) bool {
+			s.delete(element)
+			return false
+		})
+	}
+	return s
+}
+
+// DeleteAnyOf removes all elements from the BitSet that exist within any of the specified Set. Any nil Set is skipped.
+//
+// If the BitSet is nil, BitSet.DeleteAnyOf is a no-op.
+//
+// A reference to the BitSet is returned for method chaining.
+func (s *BitSet) DeleteAnyOf(sets ...Set[int]) MutableSet[int] {
+	if s == nil {
+		var ns *BitSet
+		return ns
+	}
+	return s.DeleteWhere(func(element int) bool { return containedByAny(sets, element) })
+}
+
+// DeleteExisting removes the element from the BitSet as well as any additional elements specified, and returns the
+// number of those elements that were present and therefore removed. Unlike BitSet.Delete, this allows the caller to
+// learn whether anything was actually removed.
+//
+// If the BitSet is nil, BitSet.DeleteExisting is a no-op and returns zero.
+func (s *BitSet) DeleteExisting(element int, elements ...int) int {
+	if s == nil {
+		return 0
+	}
+	return deleteExisting[int](s.Take, element, elements)
+}
+
+// DeleteNth removes the element at index i from the BitSet, were its elements sorted using the provided less function,
+// and returns the removed element as well as an indication of whether an element was removed.
+//
+// If i is out of range (i.e. negative or not less than BitSet.Len), BitSet.DeleteNth is a no-op and returns zero and
+// false.
+//
+// If the BitSet is nil, BitSet.DeleteNth is a no-op and returns zero and false.
+func (s *BitSet) DeleteNth(less func(x, y int) bool, i int) (int, bool) {
+	if s == nil || i < 0 || i >= s.len {
+		return 0, false
+	}
+	element := s.SortedSlice(less)[i]
+	s.delete(element)
+	return element, true
+}
+
+// DeleteSlice removes all elements in the specified slice from the BitSet.
+//
+// If the BitSet is nil, BitSet.DeleteSlice is a no-op.
+//
+// A reference to the BitSet is returned for method chaining.
+func (s *BitSet) DeleteSlice(elements []int) MutableSet[int] {
+	if s == nil {
+		var ns *BitSet
+		return ns
+	}
+	for _, element := range elements {
+		s.delete(element)
+	}
+	return s
+}
+
+// DeleteWhere removes all elements that match the predicate function from the BitSet.
+//
+// If the BitSet is nil, BitSet.DeleteWhere is a no-op.
+//
+// A reference to the BitSet is returned for method chaining.
+func (s *BitSet) DeleteWhere(predicate func(element int) bool) MutableSet[int] {
+	if s == nil {
+		var ns *BitSet
+		return ns
+	}
+	for _, element := range s.Slice() {
+		if predicate(element) {
+			s.delete(element)
+		}
+	}
+	return s
+}
+
+// Diff returns a new BitSet struct containing only elements of the BitSet that do not exist in another Set. If the
+// other Set is also a BitSet, this is done word-by-word.
+//
+// If the BitSet is nil, BitSet.Diff returns nil.
+func (s *BitSet) Diff(other Set[int]) Set[int] {
+	if s == nil {
+		var ns *BitSet
+		return ns
+	}
+	return s.clone().DeleteAll(other)
+}
+
+// DiffSymmetric returns a new BitSet struct containing elements that exist within the BitSet or another Set, but not
+// both. If the other Set is also a BitSet, this is done word-by-word.
+//
+// As a BitSet cannot contain negative elements, if the other Set contains any negative element that does not exist
+// within the BitSet, a MutableHashSet is returned instead.
+//
+// If the BitSet is nil, BitSet.DiffSymmetric returns nil.
+func (s *BitSet) DiffSymmetric(other Set[int]) Set[int] {
+	if s == nil {
+		var ns *BitSet
+		return ns
+	}
+	if o, ok := other.(*BitSet); ok {
+		result := s.clone()
+		if o != nil {
+			result.xor(o)
+		}
+		return result
+	}
+	if other == nil {
+		return s.Clone()
+	}
+	if hasNegative(other) {
+		return &MutableHashSet[int]{elements: internal.DiffSymmetric[int](internal.FromSlice(s.Slice()), other)}
+	}
+	result := s.clone()
+	other.Range(func(element int) bool {
+		if s.Contains(element) {
+			result.delete(element)
+		} else {
+			result.put(element)
+		}
+		return false
+	})
+	return result
+}
+
+// DrainWhere removes all elements that match the predicate function from the BitSet and returns them within a new
+// BitSet struct. Unlike BitSet.DeleteWhere, this allows the caller to inspect the elements that were removed. Together,
+// the BitSet and the returned BitSet contain exactly the elements that the BitSet contained beforehand.
+//
+// If the BitSet is nil, BitSet.DrainWhere returns nil.
+func (s *BitSet) DrainWhere(predicate func(element int) bool) Set[int] {
+	if s == nil {
+		var ns *BitSet
+		return ns
+	}
+	drained := s.Filter(predicate)
+	s.DeleteAll(drained)
+	return drained
+}
+
+// Equal returns whether the BitSet contains the exact same elements as another Set. If the other Set is also a BitSet,
+// this is done word-by-word.
+//
+// If the BitSet is nil it is treated as having no elements and the same logic applies to the other Set. To clarify;
+// this means that a nil Set is equal to a non-nil Set that contains no elements.
+func (s *BitSet) Equal(other Set[int]) bool {
+	if s == nil {
+		return other == nil || other.IsEmpty()
+	} else if other == nil {
+		return s.IsEmpty()
+	}
+	if s.len != other.Len() {
+		return false
+	}
+	if o, ok := other.(*BitSet); ok {
+		for i, word := range s.words {
+			if i >= len(o.words) {
+				return word == 0
+			} else if word != o.words[i] {
+				return false
+			}
+		}
+		return true
+	}
+	_, found := s.Find(func(element int) bool { return !other.Contains(element) })
+	return !found
+}
+
+// Every returns whether the BitSet contains elements that all match the predicate function.
+//
+// If the BitSet is nil, BitSet.Every returns false.
+func (s *BitSet) Every(predicate func(element int) bool) bool {
+	if s.IsEmpty() {
+		return false
+	}
+	_, ok := s.Find(func(element int) bool { return !predicate(element) })
+	return !ok
+}
+
+// Filter returns a new BitSet struct containing only elements of the BitSet that match the filter function.
+//
+// If the BitSet is nil, BitSet.Filter returns nil.
+func (s *BitSet) Filter(filter func(element int) bool) Set[int] {
+	if s == nil {
+		var ns *BitSet
+		return ns
+	}
+	return s.clone().RetainWhere(filter)
+}
+
+// Find returns an element within the BitSet that matches the search function as well as an indication of whether a
+// match was found.
+//
+// Elements are searched in ascending order.
+//
+// If the BitSet is nil, BitSet.Find returns zero and false.
+func (s *BitSet) Find(search func(element int) bool) (int, bool) {
+	var (
+		found int
+		ok    bool
+	)
+	s.Range(func(element int) bool {
+		if search(element) {
+			found, ok = element, true
+		}
+		return ok
+	})
+	return found, ok
+}
+
+// Grow is a no-op that exists to conform with MutableSet.Grow. A BitSet is sized by the value of its largest element
+// rather than the number of elements it contains, so there is no capacity that can be reserved ahead of time based on
+// n alone.
+//
+// A reference to the BitSet is returned for method chaining.
+func (s *BitSet) Grow(n int) MutableSet[int] {
+	if s == nil {
+		var ns *BitSet
+		return ns
+	}
+	return s
+}
+
+// Immutable returns an immutable clone of the BitSet.
+//
+// If the BitSet is nil, BitSet.Immutable returns nil.
+func (s *BitSet) Immutable() Set[int] {
+	if s == nil {
+		var ns *HashSet[int]
+		return ns
+	}
+	return &HashSet[int]{elements: internal.FromSlice(s.Slice())}
+}
+
+// Intersection returns a new BitSet struct containing only elements of the BitSet that also exist in another Set. If
+// the other Set is also a BitSet, this is done word-by-word.
+//
+// If the BitSet is nil, BitSet.Intersection returns nil.
+func (s *BitSet) Intersection(other Set[int]) Set[int] {
+	if s == nil {
+		var ns *BitSet
+		return ns
+	}
+	return s.clone().RetainAll(other)
+}
+
+// IsDisjoint returns whether the BitSet has no elements in common with another Set. Only the elements within the
+// smaller of the two are iterated, with each being checked for within the larger.
+//
+// Any nil other Set, or other Set containing no elements, is disjoint from the BitSet.
+//
+// If the BitSet is nil, BitSet.IsDisjoint returns true.
+func (s *BitSet) IsDisjoint(other Set[int]) bool {
+	return disjoint[int](s.Len(), s.Contains, s.Range, other)
+}
+
+// IsEmpty returns whether the BitSet contains no elements.
+//
+// If the BitSet is nil, BitSet.IsEmpty returns true.
+func (s *BitSet) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+// IsMutable always returns true to conform with Set.IsMutable.
+func (s *BitSet) IsMutable() bool {
+	return true
+}
+
+// Join converts the elements within the BitSet to strings which are then concatenated to create a single string,
+// placing sep between the converted elements in the resulting string.
+//
+// Elements are joined in ascending order.
+//
+// If the BitSet is nil, BitSet.Join returns an empty string.
+func (s *BitSet) Join(sep string, convert func(element int) string) string {
+	if s == nil {
+		return ""
+	}
+	return joinSlice(s.Slice(), sep, convert)
+}
+
+// Kind always returns BitKind to conform with Set.Kind.
+func (s *BitSet) Kind() SetKind {
+	return BitKind
+}
+
+// Len returns the number of elements within the BitSet.
+//
+// If the BitSet is nil, BitSet.Len returns zero.
+func (s *BitSet) Len() int {
+	if s == nil {
+		return 0
+	}
+	return s.len
+}
+
+// Max returns the maximum element within the BitSet using the provided less function.
+//
+// If the BitSet is nil, BitSet.Max returns zero and false.
+func (s *BitSet) Max(less func(x, y int) bool) (int, bool) {
+	var (
+		max int
+		ok  bool
+	)
+	s.Range(func(element int) bool {
+		if !ok || less(max, element) {
+			max, ok = element, true
+		}
+		return false
+	})
+	return max, ok
+}
+
+// Min returns the minimum element within the BitSet using the provided less function.
+//
+// If the BitSet is nil, BitSet.Min returns zero and false.
+func (s *BitSet) Min(less func(x, y int) bool) (int, bool) {
+	var (
+		min int
+		ok  bool
+	)
+	s.Range(func(element int) bool {
+		if !ok || less(element, min) {
+			min, ok = element, true
+		}
+		return false
+	})
+	return min, ok
+}
+
+// Mutable returns a reference to itself to conform with Set.Mutable.
+//
+// If the BitSet is nil, BitSet.Mutable returns nil.
+func (s *BitSet) Mutable() MutableSet[int] {
+	if s == nil {
+		var ns *BitSet
+		return ns
+	}
+	return s
+}
+
+// None returns whether the BitSet contains no elements that match the predicate function.
+//
+// If the BitSet is nil, BitSet.None returns true.
+func (s *BitSet) None(predicate func(element int) bool) bool {
+	return !s.Some(predicate)
+}
+
+// Pop removes an element from the BitSet and returns it as well as an indication of whether the BitSet contained any
+// elements. The element removed is always the largest, which allows any trailing words to be released.
+//
+// If the BitSet is nil, BitSet.Pop is a no-op and returns zero and false.
+func (s *BitSet) Pop() (int, bool) {
+	if s == nil {
+		return 0, false
+	}
+	for i := len(s.words) - 1; i >= 0; i-- {
+		if word := s.words[i]; word != 0 {
+			element := i<<6 + 63 - bits.LeadingZeros64(word)
+			s.delete(element)
+			s.words = s.words[:i+1]
+			return element, true
+		}
+	}
+	s.words = s.words[:0]
+	return 0, false
+}
+
+// Put adds the element to the BitSet as well as any additional elements specified. Nothing changes for elements that
+// already exist within the BitSet.
+//
+// Put panics with an error wrapping ErrBitNegative if any element is negative, or ErrBitRange if any element is greater
+// than MaxBitElement, in which case any preceding elements will have already been added.
+//
+// If the BitSet is nil, BitSet.Put is a no-op.
+//
+// A reference to the BitSet is returned for method chaining.
+func (s *BitSet) Put(element int, elements ...int) MutableSet[int] {
+	if s == nil {
+		var ns *BitSet
+		return ns
+	}
+	s.put(element)
+	for _, _element := range elements {
+		s.put(_element)
+	}
+	return s
+}
+
+// PutAll adds all elements in the specified Set to the BitSet. Nothing changes for elements that already exist within
+// the BitSet. If the specified Set is also a BitSet, this is done word-by-word.
+//
+// PutAll panics with an error wrapping ErrBitNegative if any element is negative, or ErrBitRange if any element is
+// greater than MaxBitElement, in which case some elements may have already been added.
+//
+// If the BitSet is nil, BitSet.PutAll is a no-op.
+//
+// A reference to the BitSet is returned for method chaining.
+func (s *BitSet) PutAll(elements Set[int]) MutableSet[int] {
+	if s == nil {
+		var ns *BitSet
+		return ns
+	}
+	if other, ok := elements.(*BitSet); ok {
+		if other != nil {
+			s.or(other)
+		}
+	} else if elements != nil {
+		elements.Range(func(element int) bool {
+			s.put(element)
+			return false
+		})
+	}
+	return s
+}
+
+// PutSlice adds all elements in the specified slice to the BitSet. Nothing changes for elements that already exist
+// within the BitSet.
+//
+// PutSlice panics with an error wrapping ErrBitNegative if any element is negative, or ErrBitRange if any element is
+// greater than MaxBitElement, in which case any preceding elements will have already been added.
+//
+// If the BitSet is nil, BitSet.PutSlice is a no-op.
+//
+// A reference to the BitSet is returned for method chaining.
+func (s *BitSet) PutSlice(elements []int) MutableSet[int] {
+	if s == nil {
+		var ns *BitSet
+		return ns
+	}
+	for _, element := range elements {
+		s.put(element)
+	}
+	return s
+}
+
+// Range calls the iter function with each element within the BitSet but will stop early whenever the iter function
+// returns true.
+//
+// Elements are iterated in ascending order.
+//
+// If the BitSet is nil, BitSet.Range is a no-op.
+func (s *BitSet) Range(iter func(element int) bool) {
+	if s == nil {
+		return
+	}
+	for i, word := range s.words {
+		for word != 0 {
+			if iter(i<<6 + bits.TrailingZeros64(word)) {
+				return
+			}
+			word &= word - 1
+		}
+	}
+}
+
+// RangeContext calls the iter function with each element within the BitSet, the same as BitSet.Range, except that the
+// context is checked before each element. If the context is done, iteration stops and the error of the context is
+// returned.
+//
+// Elements are iterated in ascending order.
+//
+// If the BitSet is nil, BitSet.RangeContext is a no-op and returns nil.
+func (s *BitSet) RangeContext(ctx context.Context, iter func(element int) bool) error {
+	if s == nil {
+		return nil
+	}
+	return rangeContext[int](ctx, s.Range, iter)
+}
+
+// ReplaceElement removes the old element from the BitSet and adds the new element in its place, but only if the old
+// element is present, and returns whether it was present. If the new element already exists within the BitSet, the old
+// element is simply removed.
+//
+// ReplaceElement panics with an error wrapping ErrBitNegative if the old element is present and the new element is
+// negative, or ErrBitRange if the new element is greater than MaxBitElement, in which case the BitSet is left
+// unchanged.
+//
+// If the BitSet is nil, BitSet.ReplaceElement is a no-op and returns false.
+func (s *BitSet) ReplaceElement(oldElement, newElement int) bool {
+	if !s.Contains(oldElement) {
+		return false
+	}
+	s.put(newElement)
+	if oldElement != newElement {
+		s.delete(oldElement)
+	}
+	return true
+}
+
+// Retain removes all elements from the BitSet except the element(s) specified.
+//
+// If the BitSet is nil, BitSet.Retain is a no-op.
+//
+// A reference to the BitSet is returned for method chaining.
+func (s *BitSet) Retain(element int, elements ...int) MutableSet[int] {
+	if s == nil {
+		var ns *BitSet
+		return ns
+	}
+	retained := internal.FromSlice(elements)
+	retained[element] = struct{}{}
+	return s.RetainWhere(func(element int) bool {
+		_, ok := retained[element]
+		return ok
+	})
+}
+
+// RetainAll removes all elements from the BitSet except those in the specified Set. If the specified Set is also a
+// BitSet, this is done word-by-word.
+//
+// If the BitSet is nil, BitSet.RetainAll is a no-op.
+//
+// A reference to the BitSet is returned for method chaining.
+func (s *BitSet) RetainAll(elements Set[int]) MutableSet[int] {
+	if s == nil {
+		var ns *BitSet
+		return ns
+	}
+	if other, ok := elements.(*BitSet); ok && other != nil {
+		s.and(other)
+		return s
+	}
+	if elements == nil || elements.IsEmpty() {
+		return s.Clear()
+	}
+	return s.RetainWhere(elements.Contains)
+}
+
+// RetainAllOf removes all elements from the BitSet except those that exist within all the specified Set. That is; the
+// BitSet is intersected with each Set in place. As with BitSet.RetainAll, any nil Set is treated as having no elements.
+// If no Set is specified, nothing is removed.
+//
+// If the BitSet is nil, BitSet.RetainAllOf is a no-op.
+//
+// A reference to the BitSet is returned for method chaining.
+func (s *BitSet) RetainAllOf(sets ...Set[int]) MutableSet[int] {
+	if s == nil {
+		var ns *BitSet
+		return ns
+	}
+	for _, set := range sets {
+		s.RetainAll(set)
+	}
+	return s
+}
+
+// RetainSlice removes all elements from the BitSet except those in the specified slice.
+//
+// If the BitSet is nil, BitSet.RetainSlice is a no-op.
+//
+// A reference to the BitSet is returned for method chaining.
+func (s *BitSet) RetainSlice(elements []int) MutableSet[int] {
+	if s == nil {
+		var ns *BitSet
+		return ns
+	}
+	retained := internal.FromSlice(elements)
+	return s.RetainWhere(func(element int) bool {
+		_, ok := retained[element]
+		return ok
+	})
+}
+
+// RetainWhere removes all elements except those that match the predicate function from the BitSet.
+//
+// If the BitSet is nil, BitSet.RetainWhere is a no-op.
+//
+// A reference to the BitSet is returned for method chaining.
+func (s *BitSet) RetainWhere(predicate func(element int) bool) MutableSet[int] {
+	if s == nil {
+		var ns *BitSet
+		return ns
+	}
+	return s.DeleteWhere(func(element int) bool { return !predicate(element) })
+}
+
+// Sample returns a pseudo-random element within the BitSet as well as an indication of whether the BitSet contained any
+// elements.
+//
+// If the BitSet is nil, BitSet.Sample returns zero and false.
+func (s *BitSet) Sample() (int, bool) {
+	if s == nil {
+		return 0, false
+	}
+	return sample[int](s.Range, s.Len())
+}
+
+// SampleN returns a new BitSet struct containing up to n distinct elements of the BitSet chosen pseudo-randomly. All
+// elements are included if n is greater than or equal to the number of elements within the BitSet, while none are
+// included if n is not positive.
+//
+// If the BitSet is nil, BitSet.SampleN returns nil.
+func (s *BitSet) SampleN(n int) Set[int] {
+	return s.SampleNWithRand(n, nil)
+}
+
+// SampleNWithRand returns a new BitSet struct containing up to n distinct elements of the BitSet chosen pseudo-randomly
+// using the provided source, the same as BitSet.SampleN otherwise. If the source is nil, the default source is used.
+//
+// Elements are chosen in ascending order, so repeated calls on an equal BitSet using sources that are seeded
+// identically always choose the same elements.
+//
+// If the BitSet is nil, BitSet.SampleNWithRand returns nil.
+func (s *BitSet) SampleNWithRand(n int, r *rand.Rand) Set[int] {
+	if s == nil {
+		var ns *BitSet
+		return ns
+	}
+	return sampleFilter[int](s, n, r)
+}
+
+// Slice returns a slice containing all elements of the BitSet.
+//
+// Elements within the resulting slice are in ascending order.
+//
+// If the BitSet is nil, BitSet.Slice returns nil.
+func (s *BitSet) Slice() []int {
+	if s == nil {
+		return nil
+	}
+	elements := make([]int, 0, s.len)
+	s.Range(func(element int) bool {
+		elements = append(elements, element)
+		return false
+	})
+	return elements
+}
+
+// Some returns whether the BitSet contains any element that matches the predicate function.
+//
+// If the BitSet is nil, BitSet.Some returns false.
+func (s *BitSet) Some(predicate func(element int) bool) bool {
+	_, ok := s.Find(predicate)
+	return ok
+}
+
+// SortedAppendSlice appends all elements of the BitSet to the dst slice, sorted using the provided less function, and
+// returns the extended slice. Only the appended elements are sorted so any elements already within dst are left
+// untouched. This allows a buffer to be reused across calls, avoiding the allocation made by BitSet.SortedSlice each
+// time.
+//
+// If the BitSet is nil, BitSet.SortedAppendSlice returns dst unchanged.
+func (s *BitSet) SortedAppendSlice(dst []int, less func(x, y int) bool) []int {
+	if s == nil {
+		return dst
+	}
+	return sortedAppendSlice[int](s, dst, less)
+}
+
+// SortedJoin sorts the elements within the BitSet using the provided less function and then converts those elements
+// into strings which are then joined using the specified separator to create the resulting string.
+//
+// If the BitSet is nil, BitSet.SortedJoin returns an empty string.
+func (s *BitSet) SortedJoin(sep string, convert func(element int) string, less func(x, y int) bool) string {
+	if s == nil {
+		return ""
+	}
+	return joinSlice(s.SortedSlice(less), sep, convert)
+}
+
+// SortedRange calls the iter function with each element within the BitSet, sorted using the provided less function,
+// along with the zero-based index of that element within the sorted order, but will stop early whenever the iter
+// function returns true.
+//
+// Unlike BitSet.Range, iteration order is deterministic.
+//
+// If the BitSet is nil, BitSet.SortedRange is a no-op.
+func (s *BitSet) SortedRange(less func(x, y int) bool, iter func(index int, element int) bool) {
+	if s == nil {
+		return
+	}
+	sortedRange[int](s, less, iter)
+}
+
+// SortedSlice returns a slice containing all elements of the BitSet sorted using the provided less function.
+//
+// If the BitSet is nil, BitSet.SortedSlice returns nil.
+func (s *BitSet) SortedSlice(less func(x, y int) bool) []int {
+	if s == nil {
+		return nil
+	}
+	elements := s.Slice()
+	sort.SliceStable(elements, func(i, j int) bool { return less(elements[i], elements[j]) })
+	return elements
+}
+
+// Take removes the element from the BitSet and returns the element that was stored within the BitSet as well as an
+// indication of whether it was present. As elements are compared using equality, the returned element is always equal
+// to the element provided when present.
+//
+// If the BitSet is nil, BitSet.Take is a no-op and returns zero and false.
+func (s *BitSet) Take(element int) (int, bool) {
+	if !s.Contains(element) {
+		return 0, false
+	}
+	s.delete(element)
+	return element, true
+}
+
+// ToMap returns a map containing all elements of the BitSet as keys, which can be useful when integrating with APIs
+// that expect a map rather than a Set or slice.
+//
+// The returned map is always a copy so can be modified freely without affecting the BitSet.
+//
+// If the BitSet is nil, BitSet.ToMap returns nil.
+func (s *BitSet) ToMap() map[int]struct{} {
+	if s == nil {
+		return nil
+	}
+	return internal.FromSlice(s.Slice())
+}
+
+// TryRange calls the iter function with each element within the BitSet but will stop early whenever the iter function
+// returns an error.
+//
+// Elements are iterated in ascending order.
+//
+// If the BitSet is nil, BitSet.TryRange is a no-op.
+func (s *BitSet) TryRange(iter func(element int) error) error {
+	var err error
+	s.Range(func(element int) bool {
+		err = iter(element)
+		return err != nil
+	})
+	return err
+}
+
+// Union returns a new BitSet containing a union of the BitSet with another Set. If the other Set is also a BitSet, this
+// is done word-by-word.
+//
+// As a BitSet cannot contain negative elements, if the other Set contains any negative element, a MutableHashSet is
+// returned instead.
+//
+// If the BitSet and the other Set are both nil, BitSet.Union returns nil.
+func (s *BitSet) Union(other Set[int]) Set[int] {
+	if s == nil && other == nil {
+		var ns *BitSet
+		return ns
+	}
+	if _, ok := other.(*BitSet); !ok && other != nil && hasNegative(other) {
+		return &MutableHashSet[int]{elements: internal.Union[int](s, other)}
+	}
+	result := &BitSet{}
+	if s != nil {
+		result = s.clone()
+	}
+	return result.PutAll(other)
+}
+
+func (s *BitSet) String() string {
+	if s == nil {
+		return internal.NilString
+	}
+	return fmt.Sprintf("%v", s.Slice())
+}
+
+func (s *BitSet) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return internal.MarshalJSONNil()
+	}
+	return json.Marshal(s.Slice())
+}
+
+// UnmarshalJSON deserializes the given JSON data as a JSON array into the BitSet, replacing any existing elements.
+//
+// If the array contains any negative number, an error wrapping ErrBitNegative is returned, while an error wrapping
+// ErrBitRange is returned if it contains any number greater than MaxBitElement. In either case, the BitSet is left
+// unchanged.
+func (s *BitSet) UnmarshalJSON(data []byte) error {
+	var elements []int
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	for _, element := range elements {
+		if err := checkBit(element); err != nil {
+			return err
+		}
+	}
+	s.Clear()
+	s.PutSlice(elements)
+	return nil
+}
+
+// and removes all elements from the BitSet that do not exist within the other BitSet, word-by-word.
+func (s *BitSet) and(other *BitSet) {
+	for i := range s.words {
+		if i < len(other.words) {
+			s.words[i] &= other.words[i]
+		} else {
+			s.words[i] = 0
+		}
+	}
+	s.recount()
+}
+
+// andNot removes all elements from the BitSet that exist within the other BitSet, word-by-word.
+func (s *BitSet) andNot(other *BitSet) {
+	for i := range s.words {
+		if i >= len(other.words) {
+			break
+		}
+		s.words[i] &^= other.words[i]
+	}
+	s.recount()
+}
+
+// clone returns a copy of the BitSet.
+func (s *BitSet) clone() *BitSet {
+	words := make([]uint64, len(s.words))
+	copy(words, s.words)
+	return &BitSet{len: s.len, words: words}
+}
+
+// delete removes the element from the BitSet, if present. Negative elements are ignored.
+func (s *BitSet) delete(element int) {
+	if !s.Contains(element) {
+		return
+	}
+	s.words[element>>6] &^= 1 << (uint(element) & 63)
+	s.len--
+}
+
+// grow ensures that the BitSet has at least n words. Capacity is increased geometrically so that adding elements in
+// ascending order does not copy the words each time.
+func (s *BitSet) grow(n int) {
+	if n > len(s.words) {
+		s.words = append(s.words, make([]uint64, n-len(s.words))...)
+	}
+}
+
+// or adds all elements within the other BitSet to the BitSet, word-by-word.
+func (s *BitSet) or(other *BitSet) {
+	s.grow(len(other.words))
+	for i, word := range other.words {
+		s.words[i] |= word
+	}
+	s.recount()
+}
+
+// put adds the element to the BitSet, if not already present, but panics if the element is negative or greater than
+// MaxBitElement.
+func (s *BitSet) put(element int) {
+	if err := checkBit(element); err != nil {
+		panic(err)
+	}
+	if s.Contains(element) {
+		return
+	}
+	s.grow(element>>6 + 1)
+	s.words[element>>6] |= 1 << (uint(element) & 63)
+	s.len++
+}
+
+// recount recalculates the number of elements within the BitSet.
+func (s *BitSet) recount() {
+	s.len = 0
+	for _, word := range s.words {
+		s.len += bits.OnesCount64(word)
+	}
+}
+
+// xor toggles all elements within the other BitSet within the BitSet, word-by-word.
+func (s *BitSet) xor(other *BitSet) {
+	s.grow(len(other.words))
+	for i, word := range other.words {
+		s.words[i] ^= word
+	}
+	s.recount()
+}
+
+// Bits returns a BitSet struct that implements MutableSet containing each unique element provided.
+//
+// Bits panics with an error wrapping ErrBitNegative if any element is negative, or ErrBitRange if any element is
+// greater than MaxBitElement.
+//
+// As Bits returns a mutable struct it is not safe for concurrent use by multiple goroutines.
+func Bits(elements ...int) *BitSet {
+	s := &BitSet{}
+	s.PutSlice(elements)
+	return s
+}
+
+// BitsFromSlice returns a BitSet struct that implements MutableSet containing each unique element from the slice
+// provided.
+//
+// BitsFromSlice panics with an error wrapping ErrBitNegative if any element is negative, or ErrBitRange if any element
+// is greater than MaxBitElement.
+//
+// As BitsFromSlice returns a mutable struct it is not safe for concurrent use by multiple goroutines.
+func BitsFromSlice(elements []int) *BitSet {
+	s := &BitSet{}
+	s.PutSlice(elements)
+	return s
+}
+
+// checkBit returns an error if the element cannot be put into a BitSet, otherwise nil.
+func checkBit(element int) error {
+	if element < 0 {
+		return fmtErrBitNegative(element)
+	}
+	if element > MaxBitElement {
+		return fmtErrBitRange(element)
+	}
+	return nil
+}
@@ -0,0 +1,1176 @@
+// Copyright (C) 2023 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/neocotic/go-sets/internal"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func Test_Bits(t *testing.T) {
+	testCases := map[string]struct {
+		elements       []int
+		expectElements []int
+	}{
+		"with multiple elements": {
+			elements:       []int{789, 0, 64, 123, 63},
+			expectElements: []int{0, 63, 64, 123, 789},
+		},
+		"with single element": {
+			elements:       []int{123},
+			expectElements: []int{123},
+		},
+		"with duplicated elements": {
+			elements:       []int{456, 123, 789, 123, 456},
+			expectElements: []int{123, 456, 789},
+		},
+		"with no elements": {
+			elements:       nil,
+			expectElements: []int{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Bits(tc.elements...)
+			if diff := cmp.Diff(tc.expectElements, set.Slice()); diff != "" {
+				t.Errorf("unexpected elements (-want +got):\n%s", diff)
+			}
+			if exp, act := len(tc.expectElements), set.Len(); act != exp {
+				t.Errorf("unexpected Set length; want %v, got %v", exp, act)
+			}
+			if !set.IsMutable() {
+				t.Error("unexpected Set mutability; want true, got false")
+			}
+		})
+	}
+}
+
+func Test_Bits_Negative(t *testing.T) {
+	defer func() {
+		err, _ := recover().(error)
+		if !errors.Is(err, ErrBitNegative) {
+			t.Errorf("unexpected panic; want %v, got %v", ErrBitNegative, err)
+		}
+	}()
+	Bits(123, -1)
+	t.Error("expected panic")
+}
+
+func Test_Bits_Range(t *testing.T) {
+	defer func() {
+		err, _ := recover().(error)
+		if !errors.Is(err, ErrBitRange) {
+			t.Errorf("unexpected panic; want %v, got %v", ErrBitRange, err)
+		}
+	}()
+	Bits(MaxBitElement + 1)
+	t.Error("expected panic")
+}
+
+func Test_BitsFromSlice(t *testing.T) {
+	elements := []int{789, 123, 456, 123}
+	set := BitsFromSlice(elements)
+	if diff := cmp.Diff([]int{123, 456, 789}, set.Slice()); diff != "" {
+		t.Errorf("unexpected elements (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]int{789, 123, 456, 123}, elements); diff != "" {
+		t.Errorf("unexpected modification of slice (-want +got):\n%s", diff)
+	}
+}
+
+func Test_BitSet_All(t *testing.T) {
+	var elements []int
+	Bits(789, 123, 456).All()(func(element int) bool {
+		elements = append(elements, element)
+		return true
+	})
+	if diff := cmp.Diff([]int{123, 456, 789}, elements); diff != "" {
+		t.Errorf("unexpected yielded elements (-want +got):\n%s", diff)
+	}
+}
+
+func Test_BitSet_All_Break(t *testing.T) {
+	set := Bits(789, 123, 456)
+	var callCount int
+	set.All()(func(element int) bool {
+		callCount++
+		if !set.Contains(element) {
+			t.Errorf("unexpected yielded element; got %v", element)
+		}
+		return callCount < 2
+	})
+	if callCount != 2 {
+		t.Errorf("unexpected number of calls to yield; want 2, got %v", callCount)
+	}
+}
+
+func Test_BitSet_All_Nil(t *testing.T) {
+	var set *BitSet
+	var callCount int
+	set.All()(func(_ int) bool {
+		callCount++
+		return true
+	})
+	if callCount != 0 {
+		t.Errorf("unexpected number of calls to yield; want 0, got %v", callCount)
+	}
+}
+
+func Test_BitSet_AppendSlice(t *testing.T) {
+	testCases := map[string]struct {
+		set *BitSet
+		dst []int
+	}{
+		"with nil dst on non-empty *BitSet": {
+			set: Bits(123, 456, 789),
+			dst: nil,
+		},
+		"with non-empty dst on non-empty *BitSet": {
+			set: Bits(123, 456, 789),
+			dst: []int{1, 2},
+		},
+		"with dst having spare capacity on non-empty *BitSet": {
+			set: Bits(123, 456, 789),
+			dst: make([]int, 0, 8),
+		},
+		"with nil dst on empty *BitSet": {
+			set: Bits(),
+			dst: nil,
+		},
+		"with non-empty dst on empty *BitSet": {
+			set: Bits(),
+			dst: []int{1, 2},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dst := append([]int(nil), tc.dst...)
+			ret := tc.set.AppendSlice(tc.dst)
+
+			if expectLen := len(tc.dst) + tc.set.Len(); len(ret) != expectLen {
+				t.Fatalf("unexpected slice length; want %v, got %v", expectLen, len(ret))
+			}
+			if !cmp.Equal(dst, ret[:len(tc.dst)], cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected leading elements; want %v, got %v", dst, ret[:len(tc.dst)])
+			}
+			if tail := ret[len(tc.dst):]; !cmp.Equal(tc.set.Slice(), tail, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected appended elements; want %v, got %v", tc.set.Slice(), tail)
+			}
+			if cap(tc.dst) >= len(ret) && len(ret) > 0 && &tc.dst[:1][0] != &ret[0] {
+				t.Error("unexpected slice allocation; want dst to be reused")
+			}
+		})
+	}
+}
+
+func Test_BitSet_AppendSlice_Nil(t *testing.T) {
+	var set *BitSet
+	dst := []int{1, 2}
+	ret := set.AppendSlice(dst)
+
+	if !cmp.Equal(dst, ret) {
+		t.Errorf("unexpected slice; want %v, got %v", dst, ret)
+	}
+}
+
+func Test_BitSet_Clear(t *testing.T) {
+	set := Bits(123, 456, 789)
+	set.Clear()
+	if !set.IsEmpty() {
+		t.Errorf("unexpected Set; want empty, got %v", set)
+	}
+	set.Put(456, 123)
+	if diff := cmp.Diff([]int{123, 456}, set.Slice()); diff != "" {
+		t.Errorf("unexpected elements after Put (-want +got):\n%s", diff)
+	}
+}
+
+func Test_BitSet_Clone(t *testing.T) {
+	set := Bits(123, 456, 789)
+	clone := set.Clone()
+	set.Delete(456)
+	if diff := cmp.Diff([]int{123, 456, 789}, clone.Slice()); diff != "" {
+		t.Errorf("unexpected elements of clone (-want +got):\n%s", diff)
+	}
+}
+
+func Test_BitSet_Contains(t *testing.T) {
+	testCases := map[string]struct {
+		element int
+		expect  bool
+	}{
+		"with contained element": {
+			element: 456,
+			expect:  true,
+		},
+		"with element within same word": {
+			element: 455,
+			expect:  false,
+		},
+		"with element beyond last word": {
+			element: 100000,
+			expect:  false,
+		},
+		"with negative element": {
+			element: -456,
+			expect:  false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if result := Bits(123, 456, 789).Contains(tc.element); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_BitSet_Delete(t *testing.T) {
+	testCases := map[string]struct {
+		expectElements []int
+		mutate         func(set *BitSet)
+	}{
+		"with Delete": {
+			expectElements: []int{1, 3, 500},
+			mutate:         func(set *BitSet) { set.Delete(2, 4, -1, 1000) },
+		},
+		"with DeleteAll using *BitSet": {
+			expectElements: []int{2, 4},
+			mutate:         func(set *BitSet) { set.DeleteAll(Bits(1, 3, 500, 1000)) },
+		},
+		"with DeleteAll using *HashSet": {
+			expectElements: []int{2, 4},
+			mutate:         func(set *BitSet) { set.DeleteAll(Hash(-1, 1, 3, 500)) },
+		},
+		"with DeleteAnyOf": {
+			expectElements: []int{3},
+			mutate:         func(set *BitSet) { set.DeleteAnyOf(Hash(1, 2), nil, Bits(4, 500)) },
+		},
+		"with DeleteNth": {
+			expectElements: []int{1, 2, 4, 500},
+			mutate:         func(set *BitSet) { set.DeleteNth(Asc[int], 2) },
+		},
+		"with DeleteSlice": {
+			expectElements: []int{1, 500},
+			mutate:         func(set *BitSet) { set.DeleteSlice([]int{2, 3, 4}) },
+		},
+		"with DeleteWhere": {
+			expectElements: []int{1, 3},
+			mutate:         func(set *BitSet) { set.DeleteWhere(func(element int) bool { return element%2 == 0 }) },
+		},
+		"with Retain": {
+			expectElements: []int{2, 500},
+			mutate:         func(set *BitSet) { set.Retain(500, 2, -2) },
+		},
+		"with RetainAll using *BitSet": {
+			expectElements: []int{1, 4},
+			mutate:         func(set *BitSet) { set.RetainAll(Bits(4, 1)) },
+		},
+		"with RetainAll using *HashSet": {
+			expectElements: []int{1, 500},
+			mutate:         func(set *BitSet) { set.RetainAll(Hash(-1, 1, 500)) },
+		},
+		"with RetainAll using nil": {
+			expectElements: []int{},
+			mutate:         func(set *BitSet) { set.RetainAll(nil) },
+		},
+		"with RetainAllOf": {
+			expectElements: []int{3},
+			mutate:         func(set *BitSet) { set.RetainAllOf(Bits(2, 3), Hash(3, 4)) },
+		},
+		"with RetainSlice": {
+			expectElements: []int{3, 4},
+			mutate:         func(set *BitSet) { set.RetainSlice([]int{4, 3}) },
+		},
+		"with RetainWhere": {
+			expectElements: []int{2, 4, 500},
+			mutate:         func(set *BitSet) { set.RetainWhere(func(element int) bool { return element%2 == 0 }) },
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Bits(1, 2, 3, 4, 500)
+			tc.mutate(set)
+			if diff := cmp.Diff(tc.expectElements, set.Slice()); diff != "" {
+				t.Errorf("unexpected elements (-want +got):\n%s", diff)
+			}
+			if exp, act := len(tc.expectElements), set.Len(); act != exp {
+				t.Errorf("unexpected Set length; want %v, got %v", exp, act)
+			}
+		})
+	}
+}
+
+func Test_BitSet_DeleteExisting(t *testing.T) {
+	testCases := map[string]struct {
+		element        int
+		elements       []int
+		expect         int
+		expectElements []int
+	}{
+		"with no elements present": {
+			element:        999,
+			elements:       []int{-123},
+			expect:         0,
+			expectElements: []int{123, 456, 789},
+		},
+		"with some elements present": {
+			element:        456,
+			elements:       []int{999, 789},
+			expect:         2,
+			expectElements: []int{123},
+		},
+		"with all elements present": {
+			element:        123,
+			elements:       []int{456, 789},
+			expect:         3,
+			expectElements: []int{},
+		},
+		"with duplicate elements present": {
+			element:        456,
+			elements:       []int{456},
+			expect:         1,
+			expectElements: []int{123, 789},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Bits(123, 456, 789)
+			if deleted := set.DeleteExisting(tc.element, tc.elements...); deleted != tc.expect {
+				t.Errorf("unexpected number of deleted elements; want %v, got %v", tc.expect, deleted)
+			}
+			if expect := HashFromSlice(tc.expectElements); !set.Equal(expect) {
+				t.Errorf("unexpected Set; want %v, got %v", expect, set)
+			}
+		})
+	}
+}
+
+func Test_BitSet_DeleteExisting_Nil(t *testing.T) {
+	var set *BitSet
+	if deleted := set.DeleteExisting(123, 456); deleted != 0 {
+		t.Errorf("unexpected number of deleted elements; want 0, got %v", deleted)
+	}
+}
+
+func Test_BitSet_Diff(t *testing.T) {
+	testCases := map[string]struct {
+		expectKind SetKind
+		expect     []int
+		op         func(set *BitSet) Set[int]
+	}{
+		"with Diff using *BitSet": {
+			expectKind: BitKind,
+			expect:     []int{1, 3},
+			op:         func(set *BitSet) Set[int] { return set.Diff(Bits(2, 200, 1000)) },
+		},
+		"with Diff using *HashSet": {
+			expectKind: BitKind,
+			expect:     []int{1, 3},
+			op:         func(set *BitSet) Set[int] { return set.Diff(Hash(-1, 2, 200)) },
+		},
+		"with DiffSymmetric using *BitSet": {
+			expectKind: BitKind,
+			expect:     []int{1, 3, 1000},
+			op:         func(set *BitSet) Set[int] { return set.DiffSymmetric(Bits(2, 200, 1000)) },
+		},
+		"with DiffSymmetric using *HashSet": {
+			expectKind: BitKind,
+			expect:     []int{1, 3, 1000},
+			op:         func(set *BitSet) Set[int] { return set.DiffSymmetric(Hash(2, 200, 1000)) },
+		},
+		"with DiffSymmetric using *HashSet containing negative element": {
+			expectKind: MutableHashKind,
+			expect:     []int{-1, 1, 3},
+			op:         func(set *BitSet) Set[int] { return set.DiffSymmetric(Hash(-1, 2, 200)) },
+		},
+		"with Intersection using *BitSet": {
+			expectKind: BitKind,
+			expect:     []int{2, 200},
+			op:         func(set *BitSet) Set[int] { return set.Intersection(Bits(2, 200, 1000)) },
+		},
+		"with Intersection using *HashSet": {
+			expectKind: BitKind,
+			expect:     []int{2, 200},
+			op:         func(set *BitSet) Set[int] { return set.Intersection(Hash(-1, 2, 200)) },
+		},
+		"with Union using *BitSet": {
+			expectKind: BitKind,
+			expect:     []int{1, 2, 3, 200, 1000},
+			op:         func(set *BitSet) Set[int] { return set.Union(Bits(2, 1000)) },
+		},
+		"with Union using *HashSet": {
+			expectKind: BitKind,
+			expect:     []int{1, 2, 3, 200, 1000},
+			op:         func(set *BitSet) Set[int] { return set.Union(Hash(2, 1000)) },
+		},
+		"with Union using *HashSet containing negative element": {
+			expectKind: MutableHashKind,
+			expect:     []int{-1, 1, 2, 3, 200},
+			op:         func(set *BitSet) Set[int] { return set.Union(Hash(-1, 2)) },
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Bits(1, 2, 3, 200)
+			result := tc.op(set)
+			if kind := result.Kind(); kind != tc.expectKind {
+				t.Errorf("unexpected kind; want %v, got %v", tc.expectKind, kind)
+			}
+			if diff := cmp.Diff(tc.expect, result.SortedSlice(Asc[int])); diff != "" {
+				t.Errorf("unexpected elements (-want +got):\n%s", diff)
+			}
+			if exp, act := len(tc.expect), result.Len(); act != exp {
+				t.Errorf("unexpected Set length; want %v, got %v", exp, act)
+			}
+			if diff := cmp.Diff([]int{1, 2, 3, 200}, set.Slice()); diff != "" {
+				t.Errorf("unexpected modification of elements (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_BitSet_DrainWhere(t *testing.T) {
+	testCases := map[string]struct {
+		expectDrained  []int
+		expectElements []int
+		predicate      func(element int) bool
+	}{
+		"with some elements matching": {
+			expectDrained:  []int{2, 4},
+			expectElements: []int{1, 3, 5},
+			predicate:      func(element int) bool { return element%2 == 0 },
+		},
+		"with no elements matching": {
+			expectDrained:  []int{},
+			expectElements: []int{1, 2, 3, 4, 5},
+			predicate:      func(element int) bool { return element > 5 },
+		},
+		"with all elements matching": {
+			expectDrained:  []int{1, 2, 3, 4, 5},
+			expectElements: []int{},
+			predicate:      func(element int) bool { return element > 0 },
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Bits(1, 2, 3, 4, 5)
+			drained := set.DrainWhere(tc.predicate)
+			if internal.IsNil(drained) {
+				t.Fatal("unexpected nil Set")
+			}
+			if kind := drained.Kind(); kind != BitKind {
+				t.Errorf("unexpected SetKind; want %v, got %v", BitKind, kind)
+			}
+			if expect := HashFromSlice(tc.expectDrained); !drained.Equal(expect) {
+				t.Errorf("unexpected drained Set; want %v, got %v", expect, drained)
+			}
+			if expect := HashFromSlice(tc.expectElements); !set.Equal(expect) {
+				t.Errorf("unexpected Set; want %v, got %v", expect, set)
+			}
+		})
+	}
+}
+
+func Test_BitSet_DrainWhere_Nil(t *testing.T) {
+	var set *BitSet
+	drained := set.DrainWhere(func(element int) bool {
+		t.Errorf("unexpected call to predicate with %v", element)
+		return true
+	})
+	if drained != (*BitSet)(nil) {
+		t.Errorf("unexpected drained Set; want nil, got %v", drained)
+	}
+}
+
+func Test_BitSet_Equal(t *testing.T) {
+	testCases := map[string]struct {
+		expect bool
+		other  Set[int]
+	}{
+		"with equal *BitSet": {
+			expect: true,
+			other:  Bits(789, 123, 456),
+		},
+		"with equal *BitSet containing trailing empty words": {
+			expect: true,
+			other:  Bits(123, 456, 789, 10000).Delete(10000),
+		},
+		"with unequal *BitSet": {
+			expect: false,
+			other:  Bits(123, 456, 788),
+		},
+		"with equal *HashSet": {
+			expect: true,
+			other:  Hash(123, 456, 789),
+		},
+		"with unequal *HashSet": {
+			expect: false,
+			other:  Hash(-123, 456, 789),
+		},
+		"with nil": {
+			expect: false,
+			other:  nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Bits(123, 456, 789)
+			if result := set.Equal(tc.other); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+			if tc.other != nil {
+				if result := tc.other.Equal(set); result != tc.expect {
+					t.Errorf("unexpected inverse result; want %v, got %v", tc.expect, result)
+				}
+			}
+		})
+	}
+}
+
+func Test_BitSet_Grow(t *testing.T) {
+	testCases := map[string]struct {
+		set    *BitSet
+		n      int
+		expect Set[int]
+	}{
+		"with positive n on non-empty *BitSet": {
+			set:    Bits(123, 456, 789),
+			n:      100,
+			expect: Hash(123, 456, 789),
+		},
+		"with positive n on empty *BitSet": {
+			set:    Bits(),
+			n:      100,
+			expect: Hash[int](),
+		},
+		"with zero n on non-empty *BitSet": {
+			set:    Bits(123, 456, 789),
+			n:      0,
+			expect: Hash(123, 456, 789),
+		},
+		"with negative n on non-empty *BitSet": {
+			set:    Bits(123, 456, 789),
+			n:      -1,
+			expect: Hash(123, 456, 789),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ret := tc.set.Grow(tc.n)
+
+			if internal.IsNil(ret) {
+				t.Error("unexpected nil MutableSet")
+			}
+			if tc.set != ret {
+				t.Errorf("unexpected MutableSet; want %v, got %v", tc.set, ret)
+			}
+			if !tc.set.Equal(tc.expect) {
+				t.Errorf("unexpected MutableSet; want %v, got %v", tc.expect, tc.set)
+			}
+
+			tc.set.Put(1000)
+			if !tc.set.Contains(1000) {
+				t.Error("unexpected MutableSet element presence after Grow; want true, got false")
+			}
+		})
+	}
+}
+
+func Test_BitSet_Grow_Nil(t *testing.T) {
+	var set *BitSet
+	ret := set.Grow(100)
+
+	if internal.IsNotNil(ret) {
+		t.Errorf("unexpected MutableSet; want nil, got %v", ret)
+	}
+	if !set.IsEmpty() {
+		t.Error("unexpected MutableSet emptiness; want true, got false")
+	}
+}
+
+func Test_BitSet_Join(t *testing.T) {
+	set := Bits(456, 789, 123)
+	if join := set.Join(",", strconv.Itoa); join != "123,456,789" {
+		t.Errorf("unexpected string; want %q, got %q", "123,456,789", join)
+	}
+	if join := set.SortedJoin(",", strconv.Itoa, Desc[int]); join != "789,456,123" {
+		t.Errorf("unexpected sorted string; want %q, got %q", "789,456,123", join)
+	}
+}
+
+func Test_BitSet_Kind(t *testing.T) {
+	set := Bits(123, 456, 789)
+	if kind := set.Kind(); kind != BitKind {
+		t.Errorf("unexpected kind; want %v, got %v", BitKind, kind)
+	}
+}
+
+func Test_BitSet_MaxMin(t *testing.T) {
+	set := Bits(456, 123, 789)
+	if max, ok := set.Max(Asc[int]); !ok || max != 789 {
+		t.Errorf("unexpected max; want 789 and true, got %v and %v", max, ok)
+	}
+	if min, ok := set.Min(Asc[int]); !ok || min != 123 {
+		t.Errorf("unexpected min; want 123 and true, got %v and %v", min, ok)
+	}
+	if max, ok := Bits().Max(Asc[int]); ok {
+		t.Errorf("unexpected max; want 0 and false, got %v and %v", max, ok)
+	}
+}
+
+func Test_BitSet_Pop(t *testing.T) {
+	set := Bits(456, 0, 789, 123)
+	var popped []int
+	for {
+		element, ok := set.Pop()
+		if !ok {
+			break
+		}
+		popped = append(popped, element)
+	}
+	if diff := cmp.Diff([]int{789, 456, 123, 0}, popped); diff != "" {
+		t.Errorf("unexpected popped elements (-want +got):\n%s", diff)
+	}
+	if !set.IsEmpty() {
+		t.Errorf("unexpected Set; want empty, got %v", set)
+	}
+}
+
+func Test_BitSet_Put(t *testing.T) {
+	var set BitSet
+	set.Put(789, 123, 456).PutSlice([]int{0, 999, 789}).PutAll(Bits(234, 64)).PutAll(Hash(65))
+	if diff := cmp.Diff([]int{0, 64, 65, 123, 234, 456, 789, 999}, set.Slice()); diff != "" {
+		t.Errorf("unexpected elements (-want +got):\n%s", diff)
+	}
+	if exp, act := 8, set.Len(); act != exp {
+		t.Errorf("unexpected Set length; want %v, got %v", exp, act)
+	}
+}
+
+func Test_BitSet_Put_Negative(t *testing.T) {
+	testCases := map[string]struct {
+		mutate func(set *BitSet)
+	}{
+		"with Put": {
+			mutate: func(set *BitSet) { set.Put(-1) },
+		},
+		"with PutAll": {
+			mutate: func(set *BitSet) { set.PutAll(Hash(-1)) },
+		},
+		"with PutSlice": {
+			mutate: func(set *BitSet) { set.PutSlice([]int{-1}) },
+		},
+		"with ReplaceElement": {
+			mutate: func(set *BitSet) { set.ReplaceElement(123, -1) },
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Bits(123)
+			defer func() {
+				err, _ := recover().(error)
+				if !errors.Is(err, ErrBitNegative) {
+					t.Errorf("unexpected panic; want %v, got %v", ErrBitNegative, err)
+				}
+				if diff := cmp.Diff([]int{123}, set.Slice()); diff != "" {
+					t.Errorf("unexpected elements (-want +got):\n%s", diff)
+				}
+			}()
+			tc.mutate(set)
+			t.Error("expected panic")
+		})
+	}
+}
+
+func Test_BitSet_Range(t *testing.T) {
+	set := Bits(789, 123, 456)
+	var elements []int
+	set.Range(func(element int) bool {
+		elements = append(elements, element)
+		return element == 456
+	})
+	if diff := cmp.Diff([]int{123, 456}, elements); diff != "" {
+		t.Errorf("unexpected iterated elements (-want +got):\n%s", diff)
+	}
+}
+
+func Test_BitSet_RangeContext(t *testing.T) {
+	set := Bits(123, 456, 789)
+	var elements []int
+	err := set.RangeContext(context.Background(), func(element int) bool {
+		elements = append(elements, element)
+		return false
+	})
+	if err != nil {
+		t.Errorf("unexpected error; want nil, got %q", err)
+	}
+	if expect := set.Len(); len(elements) != expect {
+		t.Errorf("unexpected number of iter function calls; want %v, got %v", expect, len(elements))
+	}
+	if actual := HashFromSlice(elements); !actual.Equal(set) {
+		t.Errorf("unexpected iterated elements; want %v, got %v", set, actual)
+	}
+}
+
+func Test_BitSet_RangeContext_Cancel(t *testing.T) {
+	set := Bits(123, 456, 789)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var count int
+	err := set.RangeContext(ctx, func(_ int) bool {
+		count++
+		cancel()
+		return false
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error; want %q, got %q", context.Canceled, err)
+	}
+	if count != 1 {
+		t.Errorf("unexpected number of iter function calls; want 1, got %v", count)
+	}
+}
+
+func Test_BitSet_RangeContext_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var called bool
+	err := Bits(123, 456, 789).RangeContext(ctx, func(_ int) bool {
+		called = true
+		return false
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error; want %q, got %q", context.Canceled, err)
+	}
+	if called {
+		t.Error("unexpected iter function call")
+	}
+}
+
+func Test_BitSet_RangeContext_Nil(t *testing.T) {
+	var set *BitSet
+	var called bool
+	err := set.RangeContext(context.Background(), func(_ int) bool {
+		called = true
+		return false
+	})
+	if err != nil {
+		t.Errorf("unexpected error; want nil, got %q", err)
+	}
+	if called {
+		t.Error("unexpected iter function call")
+	}
+}
+
+func Test_BitSet_ReplaceElement(t *testing.T) {
+	testCases := map[string]struct {
+		expect         bool
+		expectElements []int
+		newElement     int
+		oldElement     int
+	}{
+		"with present old element": {
+			expect:         true,
+			expectElements: []int{123, 789, 999},
+			newElement:     999,
+			oldElement:     456,
+		},
+		"with present old and new elements": {
+			expect:         true,
+			expectElements: []int{123, 789},
+			newElement:     789,
+			oldElement:     456,
+		},
+		"with same old and new element": {
+			expect:         true,
+			expectElements: []int{123, 456, 789},
+			newElement:     456,
+			oldElement:     456,
+		},
+		"with missing old element": {
+			expect:         false,
+			expectElements: []int{123, 456, 789},
+			newElement:     999,
+			oldElement:     0,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Bits(123, 456, 789)
+			if result := set.ReplaceElement(tc.oldElement, tc.newElement); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+			if diff := cmp.Diff(tc.expectElements, set.Slice()); diff != "" {
+				t.Errorf("unexpected elements (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_BitSet_Sample(t *testing.T) {
+	set := Bits(123, 456, 789)
+	for i := 0; i < 10; i++ {
+		element, ok := set.Sample()
+		if !ok {
+			t.Fatal("unexpected indication of no elements; want true, got false")
+		}
+		if !set.Contains(element) {
+			t.Errorf("unexpected element; want element of %v, got %v", set, element)
+		}
+	}
+}
+
+func Test_BitSet_Sample_Empty(t *testing.T) {
+	set := Bits()
+	if element, ok := set.Sample(); ok {
+		t.Errorf("unexpected element; want none, got %v", element)
+	}
+}
+
+func Test_BitSet_Sample_Nil(t *testing.T) {
+	var set *BitSet
+	if element, ok := set.Sample(); ok {
+		t.Errorf("unexpected element; want none, got %v", element)
+	}
+}
+
+func Test_BitSet_SampleN(t *testing.T) {
+	testCases := map[string]struct {
+		expectLen int
+		n         int
+		set       *BitSet
+	}{
+		"with n less than length": {
+			expectLen: 2,
+			n:         2,
+			set:       Bits(123, 456, 789),
+		},
+		"with n equal to length": {
+			expectLen: 3,
+			n:         3,
+			set:       Bits(123, 456, 789),
+		},
+		"with n greater than length": {
+			expectLen: 3,
+			n:         10,
+			set:       Bits(123, 456, 789),
+		},
+		"with zero n": {
+			expectLen: 0,
+			n:         0,
+			set:       Bits(123, 456, 789),
+		},
+		"with negative n": {
+			expectLen: 0,
+			n:         -1,
+			set:       Bits(123, 456, 789),
+		},
+		"with empty *BitSet": {
+			expectLen: 0,
+			n:         2,
+			set:       Bits(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sampled := tc.set.SampleN(tc.n)
+			if internal.IsNil(sampled) {
+				t.Fatal("unexpected nil Set")
+			}
+			if kind, expectKind := sampled.Kind(), tc.set.Kind(); kind != expectKind {
+				t.Errorf("unexpected SetKind; want %v, got %v", expectKind, kind)
+			}
+			if l := sampled.Len(); l != tc.expectLen {
+				t.Errorf("unexpected Set length; want %v, got %v", tc.expectLen, l)
+			}
+			if !tc.set.Covers(sampled) {
+				t.Errorf("unexpected sampled Set; want subset of %v, got %v", tc.set, sampled)
+			}
+		})
+	}
+}
+
+func Test_BitSet_SampleN_Nil(t *testing.T) {
+	var set *BitSet
+	if sampled := set.SampleN(2); sampled != (*BitSet)(nil) {
+		t.Errorf("unexpected sampled Set; want nil, got %v", sampled)
+	}
+}
+
+func Test_BitSet_SampleNWithRand(t *testing.T) {
+	set := Bits(123, 456, 789)
+	expect := set.SampleNWithRand(2, rand.New(rand.NewSource(42)))
+	for i := 0; i < 10; i++ {
+		if sampled := set.SampleNWithRand(2, rand.New(rand.NewSource(42))); !sampled.Equal(expect) {
+			t.Errorf("unexpected sampled Set; want %v, got %v", expect, sampled)
+		}
+	}
+}
+
+func Test_BitSet_SortedAppendSlice(t *testing.T) {
+	testCases := map[string]struct {
+		set    *BitSet
+		dst    []int
+		expect []int
+	}{
+		"with nil dst on non-empty *BitSet": {
+			set:    Bits(456, 123, 789),
+			dst:    nil,
+			expect: []int{789, 456, 123},
+		},
+		"with non-empty dst on non-empty *BitSet": {
+			set:    Bits(456, 123, 789),
+			dst:    []int{1, 999},
+			expect: []int{1, 999, 789, 456, 123},
+		},
+		"with nil dst on empty *BitSet": {
+			set:    Bits(),
+			dst:    nil,
+			expect: nil,
+		},
+		"with non-empty dst on empty *BitSet": {
+			set:    Bits(),
+			dst:    []int{1, 999},
+			expect: []int{1, 999},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ret := tc.set.SortedAppendSlice(tc.dst, Desc[int])
+			if !cmp.Equal(tc.expect, ret, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected slice; want %v, got %v", tc.expect, ret)
+			}
+		})
+	}
+}
+
+func Test_BitSet_SortedAppendSlice_Nil(t *testing.T) {
+	var set *BitSet
+	dst := []int{1, 999}
+	ret := set.SortedAppendSlice(dst, Desc[int])
+
+	if !cmp.Equal(dst, ret) {
+		t.Errorf("unexpected slice; want %v, got %v", dst, ret)
+	}
+}
+
+func Test_BitSet_SortedRange(t *testing.T) {
+	testCases := map[string]struct {
+		expect []int
+		set    *BitSet
+		stopAt int
+	}{
+		"with non-empty *BitSet": {
+			expect: []int{789, 456, 345, 123, 12},
+			set:    Bits(456, 123, 789, 12, 345),
+			stopAt: -1,
+		},
+		"with non-empty *BitSet and early stop": {
+			expect: []int{789, 456, 345},
+			set:    Bits(456, 123, 789, 12, 345),
+			stopAt: 2,
+		},
+		"with empty *BitSet": {
+			expect: nil,
+			set:    Bits(),
+			stopAt: -1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var elements []int
+			tc.set.SortedRange(Desc[int], func(index int, element int) bool {
+				if index != len(elements) {
+					t.Errorf("unexpected index; want %v, got %v", len(elements), index)
+				}
+				elements = append(elements, element)
+				return index == tc.stopAt
+			})
+			if !cmp.Equal(tc.expect, elements, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected elements; want %v, got %v", tc.expect, elements)
+			}
+		})
+	}
+}
+
+func Test_BitSet_SortedRange_Nil(t *testing.T) {
+	var set *BitSet
+	set.SortedRange(Desc[int], func(index int, element int) bool {
+		t.Errorf("unexpected call to iter with %v at index %v", element, index)
+		return false
+	})
+}
+
+func Test_BitSet_Take(t *testing.T) {
+	set := Bits(123, 456, 789)
+	if element, ok := set.Take(456); !ok || element != 456 {
+		t.Errorf("unexpected take result; want 456 and true, got %v and %v", element, ok)
+	}
+	if element, ok := set.Take(456); ok || element != 0 {
+		t.Errorf("unexpected take result; want 0 and false, got %v and %v", element, ok)
+	}
+	if exp, act := 2, set.Len(); act != exp {
+		t.Errorf("unexpected Set length; want %v, got %v", exp, act)
+	}
+}
+
+func Test_BitSet_Nil(t *testing.T) {
+	var set *BitSet
+	if set.Contains(123) || set.Len() != 0 || !set.IsEmpty() || set.Slice() != nil || set.ToMap() != nil {
+		t.Error("unexpected non-empty nil Set")
+	}
+	if result := set.Clone(); result.(*BitSet) != nil {
+		t.Errorf("unexpected Clone result; want nil, got %v", result)
+	}
+	if result := set.Put(123); result.(*BitSet) != nil {
+		t.Errorf("unexpected Put result; want nil, got %v", result)
+	}
+	if element, ok := set.Pop(); ok || element != 0 {
+		t.Errorf("unexpected pop result; want 0 and false, got %v and %v", element, ok)
+	}
+	if set.ReplaceElement(123, 456) {
+		t.Error("unexpected ReplaceElement result; want false, got true")
+	}
+	if result := set.Union(Hash(456, 123)); !result.Equal(Hash(123, 456)) {
+		t.Errorf("unexpected Union result; want [123 456], got %v", result)
+	}
+	if result := set.Union(nil); result.(*BitSet) != nil {
+		t.Errorf("unexpected Union result; want nil, got %v", result)
+	}
+	if str := set.String(); str != "[]" {
+		t.Errorf("unexpected string; want %q, got %q", "[]", str)
+	}
+}
+
+func Test_BitSet_String(t *testing.T) {
+	if str := Bits(789, 123, 456).String(); str != "[123 456 789]" {
+		t.Errorf("unexpected string; want %q, got %q", "[123 456 789]", str)
+	}
+}
+
+func Test_BitSet_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(Bits(789, 123, 456))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if str := string(data); str != "[123,456,789]" {
+		t.Errorf("unexpected JSON; want %q, got %q", "[123,456,789]", str)
+	}
+}
+
+func Test_BitSet_UnmarshalJSON(t *testing.T) {
+	var set BitSet
+	if err := json.Unmarshal([]byte("[789,123,456,123]"), &set); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]int{123, 456, 789}, set.Slice()); diff != "" {
+		t.Errorf("unexpected elements (-want +got):\n%s", diff)
+	}
+	if err := json.Unmarshal([]byte("[1,-2]"), &set); !errors.Is(err, ErrBitNegative) {
+		t.Errorf("unexpected error; want %v, got %v", ErrBitNegative, err)
+	}
+	if err := json.Unmarshal([]byte("[1,9223372036854775807]"), &set); !errors.Is(err, ErrBitRange) {
+		t.Errorf("unexpected error; want %v, got %v", ErrBitRange, err)
+	}
+	if diff := cmp.Diff([]int{123, 456, 789}, set.Slice()); diff != "" {
+		t.Errorf("unexpected modification of elements (-want +got):\n%s", diff)
+	}
+}
+
+func Benchmark_BitSet_Contains(b *testing.B) {
+	bits, hash := Bits(), MutableHash[int]()
+	for i := 0; i <= 10000; i += 2 {
+		bits.Put(i)
+		hash.Put(i)
+	}
+	b.Run("BitSet", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			bits.Contains(i % 10001)
+		}
+	})
+	b.Run("MutableHashSet", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			hash.Contains(i % 10001)
+		}
+	})
+}
+
+func Benchmark_BitSet_Put(b *testing.B) {
+	b.Run("BitSet", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			set := Bits()
+			for j := 0; j <= 10000; j++ {
+				set.Put(j)
+			}
+		}
+	})
+	b.Run("MutableHashSet", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			set := MutableHash[int]()
+			for j := 0; j <= 10000; j++ {
+				set.Put(j)
+			}
+		}
+	})
+}
+
+func Benchmark_BitSet_Union(b *testing.B) {
+	evens, odds := Bits(), Bits()
+	for i := 0; i <= 10000; i++ {
+		if i%2 == 0 {
+			evens.Put(i)
+		} else {
+			odds.Put(i)
+		}
+	}
+	hashEvens, hashOdds := Hash(evens.Slice()...), Hash(odds.Slice()...)
+	b.Run("BitSet", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			evens.Union(odds)
+		}
+	})
+	b.Run("HashSet", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			hashEvens.Union(hashOdds)
+		}
+	})
+}
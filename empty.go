@@ -21,9 +21,11 @@
 package sets
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/neocotic/go-sets/internal"
+	"math/rand"
 )
 
 // EmptySet is an immutable implementation of Set that contains no data.
@@ -39,6 +41,26 @@ var (
 	_ json.Unmarshaler = (*EmptySet[any])(nil)
 )
 
+// All returns an iterator that never yields any elements to conform with Set.All.
+func (s *EmptySet[E]) All() func(yield func(element E) bool) {
+	return func(_ func(element E) bool) {}
+}
+
+// AppendSlice returns dst unchanged to conform with Set.AppendSlice.
+//
+// If the EmptySet is nil, EmptySet.AppendSlice also returns dst unchanged.
+func (s *EmptySet[E]) AppendSlice(dst []E) []E {
+	return dst
+}
+
+// Canonical returns a minimal byte representation of the EmptySet that is stable for its elements, which can be useful
+// for hashing, cache keys, or as a shortcut for equality checks. See Set.Canonical for the escaping and separator used.
+//
+// If the EmptySet is nil it is treated as having no elements and so EmptySet.Canonical returns an empty slice.
+func (s *EmptySet[E]) Canonical(convert func(element E) string) []byte {
+	return canonical[E](s, convert)
+}
+
 // Clone returns a clone of the EmptySet.
 //
 // If the EmptySet is nil, EmptySet.Clone returns nil.
@@ -55,6 +77,43 @@ func (s *EmptySet[E]) Contains(_ E) bool {
 	return false
 }
 
+// ContainsAll always returns false to conform with Set.ContainsAll.
+func (s *EmptySet[E]) ContainsAll(_ E, _ ...E) bool {
+	return false
+}
+
+// ContainsAny always returns false to conform with Set.ContainsAny.
+func (s *EmptySet[E]) ContainsAny(_ E, _ ...E) bool {
+	return false
+}
+
+// ContainsEach returns a slice containing whether the EmptySet contains each of the elements provided, where each
+// result is at the same index as its element.
+//
+// The returned slice always has the same length as the slice of elements provided.
+//
+// If the EmptySet is nil, EmptySet.ContainsEach returns a slice of false values.
+func (s *EmptySet[E]) ContainsEach(elements []E) []bool {
+	return containsEach[E](elements, s.Contains)
+}
+
+// Count always returns zero to conform with Set.Count.
+func (s *EmptySet[E]) Count(_ func(element E) bool) int {
+	return 0
+}
+
+// Covers returns whether the EmptySet is a superset of every other Set. That is; whether the EmptySet contains all
+// elements within each other Set. Any other Set containing more elements than the EmptySet is never covered and so is
+// rejected before its elements are checked.
+//
+// Any nil other Set is treated as having no elements and so is always covered. If no other Set is provided,
+// EmptySet.Covers returns true.
+//
+// If the EmptySet is nil it is treated as having no elements.
+func (s *EmptySet[E]) Covers(others ...Set[E]) bool {
+	return covers[E](s.Len(), s.Contains, others)
+}
+
 // Diff returns a new EmptySet struct to conform with Set.Diff.
 //
 // If the EmptySet is nil, EmptySet.Diff returns nil.
@@ -132,6 +191,11 @@ func (s *EmptySet[E]) Intersection(_ Set[E]) Set[E] {
 	return &EmptySet[E]{}
 }
 
+// IsDisjoint always returns true to conform with Set.IsDisjoint.
+func (s *EmptySet[E]) IsDisjoint(_ Set[E]) bool {
+	return true
+}
+
 // IsEmpty always returns true to conform with Set.IsEmpty.
 func (s *EmptySet[E]) IsEmpty() bool {
 	return true
@@ -147,6 +211,11 @@ func (s *EmptySet[E]) Join(_ string, _ func(element E) string) string {
 	return ""
 }
 
+// Kind always returns EmptyKind to conform with Set.Kind.
+func (s *EmptySet[E]) Kind() SetKind {
+	return EmptyKind
+}
+
 // Len always returns zero to conform with Set.Len.
 func (s *EmptySet[E]) Len() int {
 	return 0
@@ -183,6 +252,31 @@ func (s *EmptySet[E]) None(_ func(element E) bool) bool {
 // Range does nothing to conform with Set.Range.
 func (s *EmptySet[E]) Range(_ func(element E) bool) {}
 
+// RangeContext is a no-op that returns nil to conform with Set.RangeContext.
+func (s *EmptySet[E]) RangeContext(_ context.Context, _ func(element E) bool) error {
+	return nil
+}
+
+// Sample always returns the zero value for E and false to conform with Set.Sample.
+func (s *EmptySet[E]) Sample() (E, bool) {
+	var zero E
+	return zero, false
+}
+
+// SampleN returns a new EmptySet struct to conform with Set.SampleN.
+//
+// If the EmptySet is nil, EmptySet.SampleN returns nil.
+func (s *EmptySet[E]) SampleN(n int) Set[E] {
+	return s.SampleNWithRand(n, nil)
+}
+
+// SampleNWithRand returns a new EmptySet struct to conform with Set.SampleNWithRand.
+//
+// If the EmptySet is nil, EmptySet.SampleNWithRand returns nil.
+func (s *EmptySet[E]) SampleNWithRand(_ int, _ *rand.Rand) Set[E] {
+	return s.Filter(nil)
+}
+
 // Slice returns an empty slice to conform with Set.Slice.
 //
 // If the EmptySet is nil, EmptySet.Slice returns nil.
@@ -198,11 +292,21 @@ func (s *EmptySet[E]) Some(_ func(element E) bool) bool {
 	return false
 }
 
+// SortedAppendSlice returns dst unchanged to conform with Set.SortedAppendSlice.
+//
+// If the EmptySet is nil, EmptySet.SortedAppendSlice also returns dst unchanged.
+func (s *EmptySet[E]) SortedAppendSlice(dst []E, _ func(x, y E) bool) []E {
+	return dst
+}
+
 // SortedJoin always returns an empty string to conform with Set.SortedJoin.
 func (s *EmptySet[E]) SortedJoin(_ string, _ func(element E) string, _ func(x, y E) bool) string {
 	return ""
 }
 
+// SortedRange is a no-op to conform with Set.SortedRange.
+func (s *EmptySet[E]) SortedRange(_ func(x, y E) bool, _ func(index int, element E) bool) {}
+
 // SortedSlice returns an empty slice to conform with Set.SortedSlice.
 //
 // If the EmptySet is nil, EmptySet.SortedSlice returns nil.
@@ -210,6 +314,16 @@ func (s *EmptySet[E]) SortedSlice(_ func(x, y E) bool) []E {
 	return s.Slice()
 }
 
+// ToMap returns an empty map to conform with Set.ToMap.
+//
+// If the EmptySet is nil, EmptySet.ToMap returns nil.
+func (s *EmptySet[E]) ToMap() map[E]struct{} {
+	if s == nil {
+		return nil
+	}
+	return make(map[E]struct{})
+}
+
 // TryRange does nothing and returns nil to conform with Set.TryRange.
 func (s *EmptySet[E]) TryRange(_ func(element E) error) error {
 	return nil
@@ -223,7 +337,7 @@ func (s *EmptySet[E]) Union(other Set[E]) Set[E] {
 		if len(elements) == 0 {
 			return &EmptySet[E]{}
 		}
-		return &HashSet[E]{elements}
+		return &HashSet[E]{elements: elements}
 	}
 	var ns *EmptySet[E]
 	return ns
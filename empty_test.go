@@ -21,9 +21,11 @@
 package sets
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/neocotic/go-sets/internal"
 	"testing"
 )
@@ -88,6 +90,91 @@ func Test_EmptyFromJSON(t *testing.T) {
 	}
 }
 
+func Test_EmptySet_All(t *testing.T) {
+	var callCount int
+	Empty[int]().All()(func(_ int) bool {
+		callCount++
+		return true
+	})
+	if callCount != 0 {
+		t.Errorf("unexpected number of calls to yield; want 0, got %v", callCount)
+	}
+}
+
+func Test_EmptySet_All_Nil(t *testing.T) {
+	var set *EmptySet[int]
+	var callCount int
+	set.All()(func(_ int) bool {
+		callCount++
+		return true
+	})
+	if callCount != 0 {
+		t.Errorf("unexpected number of calls to yield; want 0, got %v", callCount)
+	}
+}
+
+func Test_EmptySet_AppendSlice(t *testing.T) {
+	testCases := map[string]struct {
+		set *EmptySet[int]
+		dst []int
+	}{
+		"with nil dst on empty *EmptySet": {
+			set: Empty[int](),
+			dst: nil,
+		},
+		"with non-empty dst on empty *EmptySet": {
+			set: Empty[int](),
+			dst: []int{1, 2},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dst := append([]int(nil), tc.dst...)
+			ret := tc.set.AppendSlice(tc.dst)
+
+			if expectLen := len(tc.dst) + tc.set.Len(); len(ret) != expectLen {
+				t.Fatalf("unexpected slice length; want %v, got %v", expectLen, len(ret))
+			}
+			if !cmp.Equal(dst, ret[:len(tc.dst)], cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected leading elements; want %v, got %v", dst, ret[:len(tc.dst)])
+			}
+			if tail := ret[len(tc.dst):]; !HashFromSlice(tail).Equal(tc.set) {
+				t.Errorf("unexpected appended elements; want %v, got %v", tc.set, tail)
+			}
+			if cap(tc.dst) >= len(ret) && len(ret) > 0 && &tc.dst[:1][0] != &ret[0] {
+				t.Error("unexpected slice allocation; want dst to be reused")
+			}
+		})
+	}
+}
+
+func Test_EmptySet_AppendSlice_Nil(t *testing.T) {
+	var set *EmptySet[int]
+	dst := []int{1, 2}
+	ret := set.AppendSlice(dst)
+
+	if !cmp.Equal(dst, ret) {
+		t.Errorf("unexpected slice; want %v, got %v", dst, ret)
+	}
+}
+
+func Test_EmptySet_Canonical(t *testing.T) {
+	set := Empty[string]()
+	canonical := set.Canonical(func(element string) string { return element })
+	if canonical == nil || len(canonical) != 0 {
+		t.Errorf("unexpected canonical; want %#v, got %#v", []byte{}, canonical)
+	}
+}
+
+func Test_EmptySet_Canonical_Nil(t *testing.T) {
+	var set *EmptySet[string]
+	canonical := set.Canonical(func(element string) string { return element })
+	if canonical == nil || len(canonical) != 0 {
+		t.Errorf("unexpected canonical; want %#v, got %#v", []byte{}, canonical)
+	}
+}
+
 func Test_EmptySet_Clone(t *testing.T) {
 	set := Empty[int]()
 	clone := set.Clone()
@@ -123,6 +210,24 @@ func Test_EmptySet_Contains(t *testing.T) {
 	testEmptySetContains(t, Empty[int])
 }
 
+func Test_EmptySet_ContainsAll(t *testing.T) {
+	if Empty[int]().ContainsAll(123, 456) {
+		t.Error("unexpected result; want false, got true")
+	}
+	if (*EmptySet[int])(nil).ContainsAll(123) {
+		t.Error("unexpected result; want false, got true")
+	}
+}
+
+func Test_EmptySet_ContainsAny(t *testing.T) {
+	if Empty[int]().ContainsAny(123, 456) {
+		t.Error("unexpected result; want false, got true")
+	}
+	if (*EmptySet[int])(nil).ContainsAny(123) {
+		t.Error("unexpected result; want false, got true")
+	}
+}
+
 func Test_EmptySet_Contains_Nil(t *testing.T) {
 	testEmptySetContains(t, func() *EmptySet[int] { return nil })
 }
@@ -145,6 +250,62 @@ func testEmptySetContains(t *testing.T, setFunc func() *EmptySet[int]) {
 	}
 }
 
+func Test_EmptySet_ContainsEach(t *testing.T) {
+	set := Empty[int]()
+	if diff := cmp.Diff([]bool{false, false, false}, set.ContainsEach([]int{123, 0, 123})); diff != "" {
+		t.Errorf("unexpected results (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]bool{}, set.ContainsEach(nil)); diff != "" {
+		t.Errorf("unexpected results (-want +got):\n%s", diff)
+	}
+}
+
+func Test_EmptySet_ContainsEach_Nil(t *testing.T) {
+	var set *EmptySet[int]
+	if diff := cmp.Diff([]bool{false, false}, set.ContainsEach([]int{123, 0})); diff != "" {
+		t.Errorf("unexpected results (-want +got):\n%s", diff)
+	}
+}
+
+func Test_EmptySet_Count(t *testing.T) {
+	predicate := func(_ int) bool { return true }
+	if result := Empty[int]().Count(predicate); result != 0 {
+		t.Errorf("unexpected count; want 0, got %v", result)
+	}
+	if result := (*EmptySet[int])(nil).Count(predicate); result != 0 {
+		t.Errorf("unexpected count; want 0, got %v", result)
+	}
+}
+
+func Test_EmptySet_Covers(t *testing.T) {
+	testCases := map[string]struct {
+		expect bool
+		others []Set[int]
+	}{
+		"with no other Sets": {
+			expect: true,
+			others: nil,
+		},
+		"with empty and nil Sets": {
+			expect: true,
+			others: []Set[int]{Empty[int](), Hash[int](), nil},
+		},
+		"with non-empty Set": {
+			expect: false,
+			others: []Set[int]{Hash[int](), Hash(123)},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Empty[int]()
+			if covers := set.Covers(tc.others...); covers != tc.expect {
+				t.Errorf("unexpected covers; want %v, got %v", tc.expect, covers)
+			}
+		})
+	}
+}
+
 func Test_EmptySet_Diff(t *testing.T) {
 	testCases := map[string]struct {
 		expect Set[int]
@@ -610,6 +771,33 @@ func Test_EmptySet_Intersection_Nil(t *testing.T) {
 	}
 }
 
+func Test_EmptySet_IsDisjoint(t *testing.T) {
+	testCases := map[string]struct {
+		other Set[int]
+	}{
+		"with non-empty Set": {
+			other: Hash(123, 456, 789),
+		},
+		"with empty Set": {
+			other: Hash[int](),
+		},
+		"with nil Set": {
+			other: nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if !Empty[int]().IsDisjoint(tc.other) {
+				t.Error("unexpected result; want true, got false")
+			}
+			if !(*EmptySet[int])(nil).IsDisjoint(tc.other) {
+				t.Error("unexpected result; want true, got false")
+			}
+		})
+	}
+}
+
 func Test_EmptySet_IsEmpty(t *testing.T) {
 	testEmptySetIsEmpty(t, Empty[int])
 }
@@ -656,6 +844,20 @@ func testEmptySetJoin(t *testing.T, setFunc func() *EmptySet[int]) {
 	}
 }
 
+func Test_EmptySet_Kind(t *testing.T) {
+	set := Empty[int]()
+	if kind := set.Kind(); kind != EmptyKind {
+		t.Errorf("unexpected kind; want %v, got %v", EmptyKind, kind)
+	}
+}
+
+func Test_EmptySet_Kind_Nil(t *testing.T) {
+	var set *EmptySet[int]
+	if kind := set.Kind(); kind != EmptyKind {
+		t.Errorf("unexpected kind; want %v, got %v", EmptyKind, kind)
+	}
+}
+
 func Test_EmptySet_Len(t *testing.T) {
 	testEmptySetLen(t, Empty[int])
 }
@@ -767,6 +969,35 @@ func Test_EmptySet_Range(t *testing.T) {
 	testEmptySetRange(t, Empty[int])
 }
 
+func Test_EmptySet_RangeContext(t *testing.T) {
+	var called bool
+	err := Empty[int]().RangeContext(context.Background(), func(_ int) bool {
+		called = true
+		return false
+	})
+	if err != nil {
+		t.Errorf("unexpected error; want nil, got %q", err)
+	}
+	if called {
+		t.Error("unexpected iter function call")
+	}
+}
+
+func Test_EmptySet_RangeContext_Nil(t *testing.T) {
+	var set *EmptySet[int]
+	var called bool
+	err := set.RangeContext(context.Background(), func(_ int) bool {
+		called = true
+		return false
+	})
+	if err != nil {
+		t.Errorf("unexpected error; want nil, got %q", err)
+	}
+	if called {
+		t.Error("unexpected iter function call")
+	}
+}
+
 func Test_EmptySet_Range_Nil(t *testing.T) {
 	testEmptySetRange(t, func() *EmptySet[int] { return nil })
 }
@@ -783,6 +1014,45 @@ func testEmptySetRange(t *testing.T, setFunc func() *EmptySet[int]) {
 	}
 }
 
+func Test_EmptySet_Sample(t *testing.T) {
+	testCases := map[string]struct {
+		set *EmptySet[int]
+	}{
+		"with non-nil *EmptySet": {
+			set: Empty[int](),
+		},
+		"with nil *EmptySet": {
+			set: nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if element, ok := tc.set.Sample(); ok {
+				t.Errorf("unexpected element; want none, got %v", element)
+			}
+		})
+	}
+}
+
+func Test_EmptySet_SampleN(t *testing.T) {
+	set := Empty[int]()
+	sampled := set.SampleN(2)
+	if internal.IsNil(sampled) {
+		t.Fatal("unexpected nil Set")
+	}
+	if kind := sampled.Kind(); kind != EmptyKind {
+		t.Errorf("unexpected SetKind; want %v, got %v", EmptyKind, kind)
+	}
+}
+
+func Test_EmptySet_SampleN_Nil(t *testing.T) {
+	var set *EmptySet[int]
+	if sampled := set.SampleN(2); sampled != (*EmptySet[int])(nil) {
+		t.Errorf("unexpected sampled Set; want nil, got %v", sampled)
+	}
+}
+
 func Test_EmptySet_Slice(t *testing.T) {
 	set := Empty[int]()
 	elements := set.Slice()
@@ -825,6 +1095,44 @@ func testEmptySetSome(t *testing.T, setFunc func() *EmptySet[int]) {
 	}
 }
 
+func Test_EmptySet_SortedAppendSlice(t *testing.T) {
+	testCases := map[string]struct {
+		set    *EmptySet[int]
+		dst    []int
+		expect []int
+	}{
+		"with nil dst on empty *EmptySet": {
+			set:    Empty[int](),
+			dst:    nil,
+			expect: nil,
+		},
+		"with non-empty dst on empty *EmptySet": {
+			set:    Empty[int](),
+			dst:    []int{1, 999},
+			expect: []int{1, 999},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ret := tc.set.SortedAppendSlice(tc.dst, Desc[int])
+			if !cmp.Equal(tc.expect, ret, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected slice; want %v, got %v", tc.expect, ret)
+			}
+		})
+	}
+}
+
+func Test_EmptySet_SortedAppendSlice_Nil(t *testing.T) {
+	var set *EmptySet[int]
+	dst := []int{1, 999}
+	ret := set.SortedAppendSlice(dst, Desc[int])
+
+	if !cmp.Equal(dst, ret) {
+		t.Errorf("unexpected slice; want %v, got %v", dst, ret)
+	}
+}
+
 func Test_EmptySet_SortedJoin(t *testing.T) {
 	testEmptySetSortedJoin(t, Empty[int])
 }
@@ -841,6 +1149,28 @@ func testEmptySetSortedJoin(t *testing.T, setFunc func() *EmptySet[int]) {
 	}
 }
 
+func Test_EmptySet_SortedRange(t *testing.T) {
+	testCases := map[string]struct {
+		set *EmptySet[int]
+	}{
+		"with non-nil *EmptySet": {
+			set: Empty[int](),
+		},
+		"with nil *EmptySet": {
+			set: nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.set.SortedRange(Asc[int], func(index int, element int) bool {
+				t.Errorf("unexpected call to iter with %v at index %v", element, index)
+				return false
+			})
+		})
+	}
+}
+
 func Test_EmptySet_SortedSlice(t *testing.T) {
 	set := Empty[int]()
 	elements := set.SortedSlice(Asc[int])
@@ -860,6 +1190,15 @@ func Test_EmptySet_SortedSlice_Nil(t *testing.T) {
 	}
 }
 
+func Test_EmptySet_ToMap(t *testing.T) {
+	if m := Empty[int]().ToMap(); m == nil || len(m) != 0 {
+		t.Errorf("unexpected map; want empty, got %v", m)
+	}
+	if m := (*EmptySet[int])(nil).ToMap(); m != nil {
+		t.Errorf("unexpected map; want nil, got %v", m)
+	}
+}
+
 func Test_EmptySet_TryRange(t *testing.T) {
 	testEmptySetTryRange(t, Empty[int])
 }
@@ -21,16 +21,96 @@
 package sets
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 )
 
+// ErrBitNegative is returned, or used to panic, when attempting to put a negative element into a BitSet, which can only
+// contain non-negative elements.
+var ErrBitNegative = errors.New("negative element cannot be put into bit set")
+
+// ErrBitRange is returned, or used to panic, when attempting to put an element greater than MaxBitElement into a
+// BitSet.
+var ErrBitRange = errors.New("element too large to be put into bit set")
+
+// ErrDelimitedToken is returned when parsing a delimited string into a Set where a token cannot be parsed into an
+// element.
+var ErrDelimitedToken = errors.New("invalid token parsed from delimited string")
+
+// ErrJSONArray is returned when decoding JSON into a Set where the top-level value is neither an array nor null.
+var ErrJSONArray = errors.New("invalid non-array value decoded from json")
+
 // ErrJSONElementCount is returned by a fixed-size Set implementation of json.Unmarshaler when the number of
 // unmarshalled elements do not meet the requirements of the Set.
 var ErrJSONElementCount = errors.New("invalid number of elements unmarshalled from json")
 
+// ErrJSONInteger is returned when unmarshalling JSON into a Set of integers where a number cannot be represented
+// exactly by the integer type (e.g. it has a fractional part or overflows).
+var ErrJSONInteger = errors.New("invalid integer unmarshalled from json")
+
+// ErrJSONLess is returned when unmarshalling JSON into a sorted Set implementation that was not created with a less
+// function, as the unmarshalled elements cannot be sorted.
+var ErrJSONLess = errors.New("missing less function to sort elements unmarshalled from json")
+
+// ErrJSONNaN is returned when marshalling a Set into JSON where it contains NaN, which JSON cannot represent.
+var ErrJSONNaN = errors.New("nan cannot be marshalled into json")
+
+// ErrPowerSetLen is used to panic when attempting to generate the power set of a Set containing too many elements for
+// the slice of subsets to be allocated.
+var ErrPowerSetLen = errors.New("too many elements to generate power set")
+
+// ErrScanSource is returned when scanning a value from a database into a Set where the source is of an unsupported
+// type. Only NULL, []byte, and string sources containing JSON are supported.
+var ErrScanSource = errors.New("unsupported source scanned from database")
+
+// ErrTextElement is returned when marshalling a Set into text where an element cannot be represented without being
+// misinterpreted when parsed back (e.g. it is empty or contains the separator).
+var ErrTextElement = errors.New("invalid element marshalled into text")
+
+// fmtErrBitNegative returns an ErrBitNegative formatted with the negative element.
+func fmtErrBitNegative(element int) error {
+	return fmt.Errorf("%w; got %v", ErrBitNegative, element)
+}
+
+// fmtErrBitRange returns an ErrBitRange formatted with the element that is too large.
+func fmtErrBitRange(element int) error {
+	return fmt.Errorf("%w; want at most %v, got %v", ErrBitRange, MaxBitElement, element)
+}
+
+// fmtErrDelimitedToken returns an ErrDelimitedToken formatted with the token parsed from a delimited string, wrapping
+// the error returned by the parse function.
+func fmtErrDelimitedToken(token string, err error) error {
+	return fmt.Errorf("%w; got %q: %w", ErrDelimitedToken, token, err)
+}
+
+// fmtErrJSONArray returns an ErrJSONArray formatted with the first token decoded from JSON.
+func fmtErrJSONArray(token json.Token) error {
+	return fmt.Errorf("%w; got %v", ErrJSONArray, token)
+}
+
 // fmtErrJSONElementCount returns an ErrJSONElementCount formatted with the expected and actual number of elements
 // unmarshalled from JSON.
 func fmtErrJSONElementCount(expect, actual int) error {
 	return fmt.Errorf("%w; want %v, got %v", ErrJSONElementCount, expect, actual)
 }
+
+// fmtErrJSONInteger returns an ErrJSONInteger formatted with the number unmarshalled from JSON.
+func fmtErrJSONInteger(number json.Number) error {
+	return fmt.Errorf("%w; got %v", ErrJSONInteger, number)
+}
+
+// fmtErrPowerSetLen returns an ErrPowerSetLen formatted with the number of elements within the Set.
+func fmtErrPowerSetLen(n int) error {
+	return fmt.Errorf("%w; got %v", ErrPowerSetLen, n)
+}
+
+// fmtErrScanSource returns an ErrScanSource formatted with the type of the source scanned from a database.
+func fmtErrScanSource(src any) error {
+	return fmt.Errorf("%w; got %T", ErrScanSource, src)
+}
+
+// fmtErrTextElement returns an ErrTextElement formatted with the element and the separator it was marshalled with.
+func fmtErrTextElement(element, sep string) error {
+	return fmt.Errorf("%w; got %q with separator %q", ErrTextElement, element, sep)
+}
@@ -0,0 +1,687 @@
+// Copyright (C) 2023 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/neocotic/go-sets/internal"
+	"golang.org/x/exp/constraints"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// FloatHashSet is an immutable implementation of Set that contains a unique data set of floating-point numbers where
+// NaN behaves as a single element.
+//
+// As NaN is never equal to itself (i.e. NaN != NaN), a HashSet of floating-point numbers can contain any number of NaN
+// elements, each of which is indistinguishable from the others, and HashSet.Contains always returns false for NaN.
+// FloatHashSet instead canonicalizes all NaN elements, regardless of their bit pattern, into a single representative
+// NaN so that it is only ever contained once and FloatHashSet.Contains returns true for any NaN once it has been added.
+// All other elements, including positive and negative zero which are equal, behave exactly as they do within a
+// HashSet.
+//
+// As FloatHashSet is immutable it is safe for concurrent use by multiple goroutines without additional locking or
+// coordination.
+//
+// The exception to its immutability is when passed to json.Unmarshal, however, JSON cannot represent NaN so it only
+// supports the same use as HashSet. For the same reason, marshalling a FloatHashSet that contains NaN into JSON returns
+// ErrJSONNaN.
+type FloatHashSet[E constraints.Float] struct {
+	elements internal.Hash[E]
+	nan      bool
+}
+
+var (
+	_ Set[float64]     = (*FloatHashSet[float64])(nil)
+	_ fmt.Stringer     = (*FloatHashSet[float64])(nil)
+	_ json.Marshaler   = (*FloatHashSet[float64])(nil)
+	_ json.Unmarshaler = (*FloatHashSet[float64])(nil)
+)
+
+// All returns an iterator over each element within the FloatHashSet, which is compatible with iter.Seq so can be used
+// with range-over-func (e.g. `for element := range set.All()`) from Go 1.23 onwards.
+//
+// Iteration order is not guaranteed to be consistent.
+//
+// If the FloatHashSet is nil, the iterator returned by FloatHashSet.All yields no elements.
+func (s *FloatHashSet[E]) All() func(yield func(element E) bool) {
+	return seq[E](s.Range)
+}
+
+// AppendSlice appends all elements of the FloatHashSet to the dst slice and returns the extended slice, following the
+// same convention as the built-in append function. This allows a buffer to be reused across calls, avoiding the
+// allocation made by FloatHashSet.Slice each time.
+//
+// The order in which elements are appended is not guaranteed to be consistent. FloatHashSet.SortedSlice should be used
+// instead for such cases where consistent ordering is required.
+//
+// If the FloatHashSet is nil, FloatHashSet.AppendSlice returns dst unchanged.
+func (s *FloatHashSet[E]) AppendSlice(dst []E) []E {
+	if s == nil {
+		return dst
+	}
+	s.Range(func(element E) bool {
+		dst = append(dst, element)
+		return false
+	})
+	return dst
+}
+
+// Canonical returns a minimal byte representation of the FloatHashSet that is stable for its elements, which can be
+// useful for hashing, cache keys, or as a shortcut for equality checks. See Set.Canonical for the escaping and
+// separator used.
+//
+// If the FloatHashSet is nil it is treated as having no elements and so FloatHashSet.Canonical returns an empty slice.
+func (s *FloatHashSet[E]) Canonical(convert func(element E) string) []byte {
+	return canonical[E](s, convert)
+}
+
+// Clone returns a clone of the FloatHashSet.
+//
+// If the FloatHashSet is nil, FloatHashSet.Clone returns nil.
+func (s *FloatHashSet[E]) Clone() Set[E] {
+	if s == nil {
+		var ns *FloatHashSet[E]
+		return ns
+	}
+	return &FloatHashSet[E]{elements: internal.Clone[E](s.elements), nan: s.nan}
+}
+
+// Contains returns whether the FloatHashSet contains the element. Unlike HashSet.Contains, this returns true for any
+// NaN element if the FloatHashSet contains NaN.
+//
+// If the FloatHashSet is nil, FloatHashSet.Contains returns false.
+func (s *FloatHashSet[E]) Contains(element E) bool {
+	if s == nil {
+		return false
+	}
+	if isNaN(element) {
+		return s.nan
+	}
+	_, ok := s.elements[element]
+	return ok
+}
+
+// ContainsAll returns whether the FloatHashSet contains the element as well as all additional elements specified,
+// stopping as soon as any element is found not to be contained.
+//
+// If the FloatHashSet is nil, FloatHashSet.ContainsAll returns false.
+func (s *FloatHashSet[E]) ContainsAll(element E, elements ...E) bool {
+	return containsAll[E](element, elements, s.Contains)
+}
+
+// ContainsAny returns whether the FloatHashSet contains the element or any of the additional elements specified,
+// stopping as soon as any element is found to be contained.
+//
+// If the FloatHashSet is nil, FloatHashSet.ContainsAny returns false.
+func (s *FloatHashSet[E]) ContainsAny(element E, elements ...E) bool {
+	return containsAny[E](element, elements, s.Contains)
+}
+
+// ContainsEach returns a slice containing whether the FloatHashSet contains each of the elements provided, where each
+// result is at the same index as its element.
+//
+// The returned slice always has the same length as the slice of elements provided.
+//
+// If the FloatHashSet is nil, FloatHashSet.ContainsEach returns a slice of false values.
+func (s *FloatHashSet[E]) ContainsEach(elements []E) []bool {
+	return containsEach[E](elements, s.Contains)
+}
+
+// Count returns the number of elements within the FloatHashSet that match the predicate function.
+//
+// If the FloatHashSet is nil, FloatHashSet.Count returns zero.
+func (s *FloatHashSet[E]) Count(predicate func(element E) bool) int {
+	return count[E](s.Range, predicate)
+}
+
+// Covers returns whether the FloatHashSet is a superset of every other Set. That is; whether the FloatHashSet contains
+// all elements within each other Set. Any other Set containing more elements than the FloatHashSet is never covered
+// and so is rejected before its elements are checked.
+//
+// Any nil other Set is treated as having no elements and so is always covered. If no other Set is provided,
+// FloatHashSet.Covers returns true.
+//
+// If the FloatHashSet is nil it is treated as having no elements.
+func (s *FloatHashSet[E]) Covers(others ...Set[E]) bool {
+	return covers[E](s.Len(), s.Contains, others)
+}
+
+// Diff returns a new FloatHashSet struct containing only elements of the FloatHashSet that do not exist in another
+// Set.
+//
+// If the FloatHashSet is nil, FloatHashSet.Diff returns nil.
+func (s *FloatHashSet[E]) Diff(other Set[E]) Set[E] {
+	if s == nil {
+		var ns *FloatHashSet[E]
+		return ns
+	}
+	if other == nil {
+		return s.Clone()
+	}
+	return s.filter(func(element E) bool {
+		return !other.Contains(element)
+	})
+}
+
+// DiffSymmetric returns a new FloatHashSet struct containing elements that exist within the FloatHashSet or another
+// Set, but not both.
+//
+// If the FloatHashSet is nil, FloatHashSet.DiffSymmetric returns nil.
+func (s *FloatHashSet[E]) DiffSymmetric(other Set[E]) Set[E] {
+	if s == nil {
+		var ns *FloatHashSet[E]
+		return ns
+	}
+	if other == nil {
+		return s.Clone()
+	}
+	ds := s.filter(func(element E) bool {
+		return !other.Contains(element)
+	})
+	other.Range(func(element E) bool {
+		if !s.Contains(element) {
+			ds.put(element)
+		}
+		return false
+	})
+	return ds
+}
+
+// Equal returns whether the FloatHashSet contains the exact same elements as another Set.
+//
+// If the FloatHashSet is nil it is treated as having no elements and the same logic applies to the other Set. To
+// clarify; this means that a nil Set is equal to a non-nil Set that contains no elements.
+func (s *FloatHashSet[E]) Equal(other Set[E]) bool {
+	if s == nil {
+		return other == nil || other.IsEmpty()
+	} else if other == nil {
+		return s.IsEmpty()
+	}
+	return s.Len() == other.Len() && !other.Some(func(element E) bool {
+		return !s.Contains(element)
+	})
+}
+
+// Every returns whether the FloatHashSet contains elements that all match the predicate function.
+//
+// If the FloatHashSet is nil, FloatHashSet.Every returns false.
+func (s *FloatHashSet[E]) Every(predicate func(element E) bool) bool {
+	if s.IsEmpty() {
+		return false
+	}
+	return !s.Some(func(element E) bool {
+		return !predicate(element)
+	})
+}
+
+// Filter returns a new FloatHashSet struct containing only elements of the FloatHashSet that match the filter
+// function.
+//
+// If the FloatHashSet is nil, FloatHashSet.Filter returns nil.
+func (s *FloatHashSet[E]) Filter(filter func(element E) bool) Set[E] {
+	if s == nil {
+		var ns *FloatHashSet[E]
+		return ns
+	}
+	return s.filter(filter)
+}
+
+// Find returns an element within the FloatHashSet that matches the search function as well as an indication of
+// whether a match was found.
+//
+// Iteration order is not guaranteed to be consistent so results may vary.
+//
+// If the FloatHashSet is nil, FloatHashSet.Find returns the zero value for E and false.
+func (s *FloatHashSet[E]) Find(search func(element E) bool) (match E, found bool) {
+	s.Range(func(element E) bool {
+		if search(element) {
+			match, found = element, true
+		}
+		return found
+	})
+	return
+}
+
+// Immutable returns a reference to itself to conform with Set.Immutable.
+//
+// If the FloatHashSet is nil, FloatHashSet.Immutable returns nil.
+func (s *FloatHashSet[E]) Immutable() Set[E] {
+	if s == nil {
+		var ns *FloatHashSet[E]
+		return ns
+	}
+	return s
+}
+
+// Intersection returns a new FloatHashSet struct containing only elements of the FloatHashSet that also exist in
+// another Set.
+//
+// If the FloatHashSet is nil, FloatHashSet.Intersection returns nil.
+func (s *FloatHashSet[E]) Intersection(other Set[E]) Set[E] {
+	if s == nil {
+		var ns *FloatHashSet[E]
+		return ns
+	}
+	if other == nil {
+		return &FloatHashSet[E]{elements: make(internal.Hash[E])}
+	}
+	return s.filter(other.Contains)
+}
+
+// IsDisjoint returns whether the FloatHashSet has no elements in common with another Set. Only the elements within the
+// smaller of the two are iterated, with each being checked for within the larger.
+//
+// Any nil other Set, or other Set containing no elements, is disjoint from the FloatHashSet.
+//
+// If the FloatHashSet is nil, FloatHashSet.IsDisjoint returns true.
+func (s *FloatHashSet[E]) IsDisjoint(other Set[E]) bool {
+	return disjoint[E](s.Len(), s.Contains, s.Range, other)
+}
+
+// IsEmpty returns whether the FloatHashSet contains no elements.
+//
+// If the FloatHashSet is nil, FloatHashSet.IsEmpty returns true.
+func (s *FloatHashSet[E]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+// IsMutable always returns false to conform with Set.IsMutable.
+func (s *FloatHashSet[E]) IsMutable() bool {
+	return false
+}
+
+// Join converts the elements within the FloatHashSet to strings which are then concatenated to create a single
+// string, placing sep between the converted elements in the resulting string.
+//
+// The order of elements within the resulting string is not guaranteed to be consistent. FloatHashSet.SortedJoin should
+// be used instead for such cases where consistent ordering is required.
+//
+// If the FloatHashSet is nil, FloatHashSet.Join returns an empty string.
+func (s *FloatHashSet[E]) Join(sep string, convert func(element E) string) string {
+	if s == nil {
+		return ""
+	}
+	return joinSlice(s.slice(), sep, convert)
+}
+
+// Kind always returns FloatHashKind to conform with Set.Kind.
+func (s *FloatHashSet[E]) Kind() SetKind {
+	return FloatHashKind
+}
+
+// Keys returns a map containing all elements of the FloatHashSet as keys, which can be useful when integrating with
+// APIs that expect a map rather than a slice. If the FloatHashSet contains NaN, the map contains a single NaN key,
+// which can only be found by ranging over the map.
+//
+// The returned map is always a copy so can be modified freely without affecting the FloatHashSet.
+//
+// If the FloatHashSet is nil, FloatHashSet.Keys returns nil.
+func (s *FloatHashSet[E]) Keys() map[E]struct{} {
+	if s == nil {
+		return nil
+	}
+	keys := internal.Clone[E](s.elements)
+	if s.nan {
+		keys[nan[E]()] = struct{}{}
+	}
+	return keys
+}
+
+// Len returns the number of elements within the FloatHashSet, where NaN is only ever counted once.
+//
+// If the FloatHashSet is nil, FloatHashSet.Len returns zero.
+func (s *FloatHashSet[E]) Len() int {
+	if s == nil {
+		return 0
+	}
+	if s.nan {
+		return len(s.elements) + 1
+	}
+	return len(s.elements)
+}
+
+// Max returns the maximum element within the FloatHashSet using the provided less function.
+//
+// If the FloatHashSet is nil, FloatHashSet.Max returns the zero value for E and false.
+func (s *FloatHashSet[E]) Max(less func(x, y E) bool) (max E, ok bool) {
+	s.Range(func(element E) bool {
+		if !ok || less(max, element) {
+			max, ok = element, true
+		}
+		return false
+	})
+	return
+}
+
+// Min returns the minimum element within the FloatHashSet using the provided less function.
+//
+// If the FloatHashSet is nil, FloatHashSet.Min returns the zero value for E and false.
+func (s *FloatHashSet[E]) Min(less func(x, y E) bool) (min E, ok bool) {
+	s.Range(func(element E) bool {
+		if !ok || less(element, min) {
+			min, ok = element, true
+		}
+		return false
+	})
+	return
+}
+
+// Mutable returns a mutable clone of the FloatHashSet. As the returned MutableHashSet does not canonicalize NaN, it
+// will contain a single NaN element if the FloatHashSet contains NaN, but any NaN elements added to it afterwards will
+// behave as they do within any HashSet.
+//
+// If the FloatHashSet is nil, FloatHashSet.Mutable returns nil.
+func (s *FloatHashSet[E]) Mutable() MutableSet[E] {
+	if s == nil {
+		var ns *MutableHashSet[E]
+		return ns
+	}
+	return &MutableHashSet[E]{elements: s.Keys()}
+}
+
+// None returns whether the FloatHashSet contains no elements that match the predicate function.
+//
+// If the FloatHashSet is nil, FloatHashSet.None returns true.
+func (s *FloatHashSet[E]) None(predicate func(element E) bool) bool {
+	return !s.Some(predicate)
+}
+
+// Range calls the iter function with each element within the FloatHashSet but will stop early whenever the iter
+// function returns true.
+//
+// Iteration order is not guaranteed to be consistent.
+//
+// If the FloatHashSet is nil, FloatHashSet.Range is a no-op.
+func (s *FloatHashSet[E]) Range(iter func(element E) bool) {
+	if s == nil {
+		return
+	}
+	for element := range s.elements {
+		if iter(element) {
+			return
+		}
+	}
+	if s.nan {
+		iter(nan[E]())
+	}
+}
+
+// RangeContext calls the iter function with each element within the FloatHashSet, the same as FloatHashSet.Range,
+// except that the context is checked before each element. If the context is done, iteration stops and the error of the
+// context is returned.
+//
+// Iteration order is not guaranteed to be consistent.
+//
+// If the FloatHashSet is nil, FloatHashSet.RangeContext is a no-op and returns nil.
+func (s *FloatHashSet[E]) RangeContext(ctx context.Context, iter func(element E) bool) error {
+	if s == nil {
+		return nil
+	}
+	return rangeContext[E](ctx, s.Range, iter)
+}
+
+// Sample returns a pseudo-random element within the FloatHashSet as well as an indication of whether the FloatHashSet
+// contained any elements.
+//
+// If the FloatHashSet is nil, FloatHashSet.Sample returns the zero value for E and false.
+func (s *FloatHashSet[E]) Sample() (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	return sample[E](s.Range, s.Len())
+}
+
+// SampleN returns a new FloatHashSet struct containing up to n distinct elements of the FloatHashSet chosen
+// pseudo-randomly. All elements are included if n is greater than or equal to the number of elements within the
+// FloatHashSet, while none are included if n is not positive.
+//
+// If the FloatHashSet is nil, FloatHashSet.SampleN returns nil.
+func (s *FloatHashSet[E]) SampleN(n int) Set[E] {
+	return s.SampleNWithRand(n, nil)
+}
+
+// SampleNWithRand returns a new FloatHashSet struct containing up to n distinct elements of the FloatHashSet chosen
+// pseudo-randomly using the provided source, the same as FloatHashSet.SampleN otherwise. If the source is nil, the
+// default source is used.
+//
+// Iteration order is not guaranteed to be consistent so, even when using sources that are seeded identically, the
+// chosen elements may vary.
+//
+// If the FloatHashSet is nil, FloatHashSet.SampleNWithRand returns nil.
+func (s *FloatHashSet[E]) SampleNWithRand(n int, r *rand.Rand) Set[E] {
+	if s == nil {
+		var ns *FloatHashSet[E]
+		return ns
+	}
+	return sampleFilter[E](s, n, r)
+}
+
+// Slice returns a slice containing all elements of the FloatHashSet.
+//
+// The order of elements within the resulting slice is not guaranteed to be consistent. FloatHashSet.SortedSlice should
+// be used instead for such cases where consistent ordering is required.
+//
+// If the FloatHashSet is nil, FloatHashSet.Slice returns nil.
+func (s *FloatHashSet[E]) Slice() []E {
+	if s == nil {
+		return nil
+	}
+	return s.slice()
+}
+
+// Some returns whether the FloatHashSet contains any element that matches the predicate function.
+//
+// If the FloatHashSet is nil, FloatHashSet.Some returns false.
+func (s *FloatHashSet[E]) Some(predicate func(element E) bool) (match bool) {
+	s.Range(func(element E) bool {
+		match = predicate(element)
+		return match
+	})
+	return
+}
+
+// SortedAppendSlice appends all elements of the FloatHashSet to the dst slice, sorted using the provided less function,
+// and returns the extended slice. Only the appended elements are sorted so any elements already within dst are left
+// untouched. This allows a buffer to be reused across calls, avoiding the allocation made by FloatHashSet.SortedSlice
+// each time.
+//
+// If the FloatHashSet is nil, FloatHashSet.SortedAppendSlice returns dst unchanged.
+func (s *FloatHashSet[E]) SortedAppendSlice(dst []E, less func(x, y E) bool) []E {
+	if s == nil {
+		return dst
+	}
+	return sortedAppendSlice[E](s, dst, less)
+}
+
+// SortedJoin sorts the elements within the FloatHashSet using the provided less function and then converts those
+// elements into strings which are then joined using the specified separator to create the resulting string.
+//
+// If the FloatHashSet is nil, FloatHashSet.SortedJoin returns an empty string.
+func (s *FloatHashSet[E]) SortedJoin(sep string, convert func(element E) string, less func(x, y E) bool) string {
+	if s == nil {
+		return ""
+	}
+	return joinSlice(s.SortedSlice(less), sep, convert)
+}
+
+// SortedRange calls the iter function with each element within the FloatHashSet, sorted using the provided less
+// function, along with the zero-based index of that element within the sorted order, but will stop early whenever the
+// iter function returns true.
+//
+// Unlike FloatHashSet.Range, iteration order is deterministic.
+//
+// If the FloatHashSet is nil, FloatHashSet.SortedRange is a no-op.
+func (s *FloatHashSet[E]) SortedRange(less func(x, y E) bool, iter func(index int, element E) bool) {
+	if s == nil {
+		return
+	}
+	sortedRange[E](s, less, iter)
+}
+
+// SortedSlice returns a slice containing all elements of the FloatHashSet sorted using the provided less function.
+//
+// If the FloatHashSet is nil, FloatHashSet.SortedSlice returns nil.
+func (s *FloatHashSet[E]) SortedSlice(less func(x, y E) bool) []E {
+	if s == nil {
+		return nil
+	}
+	elements := s.slice()
+	sort.Slice(elements, func(i, j int) bool { return less(elements[i], elements[j]) })
+	return elements
+}
+
+// ToMap returns a map containing all elements of the FloatHashSet as keys, which is equivalent to FloatHashSet.Keys.
+//
+// The returned map is always a copy so can be modified freely without affecting the FloatHashSet.
+//
+// If the FloatHashSet is nil, FloatHashSet.ToMap returns nil.
+func (s *FloatHashSet[E]) ToMap() map[E]struct{} {
+	return s.Keys()
+}
+
+// TryRange calls the iter function with each element within the FloatHashSet but will stop early whenever the iter
+// function returns an error.
+//
+// Iteration order is not guaranteed to be consistent.
+//
+// If the FloatHashSet is nil, FloatHashSet.TryRange is a no-op.
+func (s *FloatHashSet[E]) TryRange(iter func(element E) error) (err error) {
+	s.Range(func(element E) bool {
+		err = iter(element)
+		return err != nil
+	})
+	return
+}
+
+// Union returns a new FloatHashSet containing a union of the FloatHashSet with another Set.
+//
+// If the FloatHashSet and the other Set are both nil, FloatHashSet.Union returns nil.
+func (s *FloatHashSet[E]) Union(other Set[E]) Set[E] {
+	if s == nil && other == nil {
+		var ns *FloatHashSet[E]
+		return ns
+	}
+	us := &FloatHashSet[E]{elements: make(internal.Hash[E], s.Len())}
+	for _, set := range []Set[E]{s, other} {
+		if internal.IsNotNil(set) {
+			set.Range(func(element E) bool {
+				us.put(element)
+				return false
+			})
+		}
+	}
+	return us
+}
+
+func (s *FloatHashSet[E]) String() string {
+	if s == nil {
+		return internal.NilString
+	}
+	return fmt.Sprintf("%v", s.slice())
+}
+
+func (s *FloatHashSet[E]) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return internal.MarshalJSONNil()
+	}
+	if s.nan {
+		return nil, ErrJSONNaN
+	}
+	return json.Marshal(s.slice())
+}
+
+func (s *FloatHashSet[E]) UnmarshalJSON(data []byte) error {
+	if elements, err := internal.UnmarshalJSON[E](data); err != nil {
+		return err
+	} else {
+		s.elements, s.nan = elements, false
+		return nil
+	}
+}
+
+// filter returns a new FloatHashSet containing only elements of the FloatHashSet that match the filter function.
+func (s *FloatHashSet[E]) filter(filter func(element E) bool) *FloatHashSet[E] {
+	fs := &FloatHashSet[E]{elements: make(internal.Hash[E])}
+	s.Range(func(element E) bool {
+		if filter(element) {
+			fs.put(element)
+		}
+		return false
+	})
+	return fs
+}
+
+// put adds the element to the FloatHashSet, canonicalizing it if it is NaN.
+func (s *FloatHashSet[E]) put(element E) {
+	if isNaN(element) {
+		s.nan = true
+	} else {
+		s.elements[element] = struct{}{}
+	}
+}
+
+// slice returns a new slice containing all elements within the FloatHashSet.
+func (s *FloatHashSet[E]) slice() []E {
+	elements := make([]E, 0, s.Len())
+	s.Range(func(element E) bool {
+		elements = append(elements, element)
+		return false
+	})
+	return elements
+}
+
+// HashFloat returns an immutable FloatHashSet struct that implements Set containing each unique element provided,
+// where all NaN elements are canonicalized into a single NaN element.
+//
+// As HashFloat returns an immutable struct it is safe for concurrent use by multiple goroutines without additional
+// locking or coordination.
+func HashFloat[E constraints.Float](elements ...E) *FloatHashSet[E] {
+	return HashFloatFromSlice(elements)
+}
+
+// HashFloatFromSlice returns an immutable FloatHashSet struct that implements Set containing each unique element from
+// the slice provided, where all NaN elements are canonicalized into a single NaN element.
+//
+// As HashFloatFromSlice returns an immutable struct it is safe for concurrent use by multiple goroutines without
+// additional locking or coordination.
+func HashFloatFromSlice[E constraints.Float](elements []E) *FloatHashSet[E] {
+	set := &FloatHashSet[E]{elements: make(internal.Hash[E], len(elements))}
+	for _, element := range elements {
+		set.put(element)
+	}
+	return set
+}
+
+// isNaN returns whether the element is NaN, which is only ever possible for floating-point elements as NaN is the only
+// value that is never equal to itself.
+func isNaN[E comparable](element E) bool {
+	return element != element
+}
+
+// nan returns the NaN used to represent all NaN elements within a FloatHashSet.
+func nan[E constraints.Float]() E {
+	return E(math.NaN())
+}
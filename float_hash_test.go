@@ -0,0 +1,883 @@
+// Copyright (C) 2023 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/neocotic/go-sets/internal"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func Test_HashFloat(t *testing.T) {
+	testCases := map[string]struct {
+		elements  []float64
+		expectLen int
+	}{
+		"with multiple elements": {
+			elements:  []float64{1.5, 2.5, 3.5},
+			expectLen: 3,
+		},
+		"with duplicate elements": {
+			elements:  []float64{1.5, 1.5, 2.5},
+			expectLen: 2,
+		},
+		"with multiple NaN elements": {
+			elements:  []float64{math.NaN(), 1.5, math.NaN(), math.Float64frombits(0x7ff8000000000001), math.NaN()},
+			expectLen: 2,
+		},
+		"with single NaN element": {
+			elements:  []float64{math.NaN()},
+			expectLen: 1,
+		},
+		"with no elements": {
+			elements:  []float64{},
+			expectLen: 0,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := HashFloat(tc.elements...)
+			if act := set.Len(); act != tc.expectLen {
+				t.Errorf("unexpected Set length; want %v, got %v", tc.expectLen, act)
+			}
+			if set.IsMutable() {
+				t.Error("unexpected Set mutability; want false, got true")
+			}
+		})
+	}
+}
+
+func Test_HashFloat_HashDifference(t *testing.T) {
+	elements := []float64{math.NaN(), math.NaN(), 1.5}
+
+	hs := Hash(elements...)
+	if exp, act := 3, hs.Len(); act != exp {
+		t.Errorf("unexpected HashSet length; want %v, got %v", exp, act)
+	}
+	if hs.Contains(math.NaN()) {
+		t.Error("unexpected HashSet containment of NaN; want false, got true")
+	}
+
+	fs := HashFloat(elements...)
+	if exp, act := 2, fs.Len(); act != exp {
+		t.Errorf("unexpected FloatHashSet length; want %v, got %v", exp, act)
+	}
+	if !fs.Contains(math.NaN()) {
+		t.Error("unexpected FloatHashSet containment of NaN; want true, got false")
+	}
+}
+
+func Test_HashFloatFromSlice(t *testing.T) {
+	set := HashFloatFromSlice([]float32{float32(math.NaN()), 1.5, float32(math.NaN())})
+	if exp, act := 2, set.Len(); act != exp {
+		t.Errorf("unexpected Set length; want %v, got %v", exp, act)
+	}
+	if !set.Contains(float32(math.NaN())) {
+		t.Error("unexpected Set containment of NaN; want true, got false")
+	}
+}
+
+func Test_FloatHashSet_All(t *testing.T) {
+	var callCount, nanCount int
+	HashFloat(1.5, math.NaN(), math.NaN()).All()(func(element float64) bool {
+		callCount++
+		if math.IsNaN(element) {
+			nanCount++
+		}
+		return true
+	})
+	if callCount != 2 {
+		t.Errorf("unexpected number of calls to yield; want 2, got %v", callCount)
+	}
+	if nanCount != 1 {
+		t.Errorf("unexpected number of NaN elements yielded; want 1, got %v", nanCount)
+	}
+}
+
+func Test_FloatHashSet_All_Nil(t *testing.T) {
+	var set *FloatHashSet[float64]
+	var callCount int
+	set.All()(func(_ float64) bool {
+		callCount++
+		return true
+	})
+	if callCount != 0 {
+		t.Errorf("unexpected number of calls to yield; want 0, got %v", callCount)
+	}
+}
+
+func Test_FloatHashSet_AppendSlice(t *testing.T) {
+	testCases := map[string]struct {
+		set *FloatHashSet[float64]
+		dst []float64
+	}{
+		"with nil dst on non-empty *FloatHashSet": {
+			set: HashFloat(1.23, 4.56, 7.89),
+			dst: nil,
+		},
+		"with non-empty dst on non-empty *FloatHashSet": {
+			set: HashFloat(1.23, 4.56, 7.89),
+			dst: []float64{0.1, 0.2},
+		},
+		"with dst having spare capacity on non-empty *FloatHashSet": {
+			set: HashFloat(1.23, 4.56, 7.89),
+			dst: make([]float64, 0, 8),
+		},
+		"with nil dst on empty *FloatHashSet": {
+			set: HashFloat[float64](),
+			dst: nil,
+		},
+		"with non-empty dst on empty *FloatHashSet": {
+			set: HashFloat[float64](),
+			dst: []float64{0.1, 0.2},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dst := append([]float64(nil), tc.dst...)
+			ret := tc.set.AppendSlice(tc.dst)
+
+			if expectLen := len(tc.dst) + tc.set.Len(); len(ret) != expectLen {
+				t.Fatalf("unexpected slice length; want %v, got %v", expectLen, len(ret))
+			}
+			if !cmp.Equal(dst, ret[:len(tc.dst)], cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected leading elements; want %v, got %v", dst, ret[:len(tc.dst)])
+			}
+			if tail := ret[len(tc.dst):]; !HashFromSlice(tail).Equal(tc.set) {
+				t.Errorf("unexpected appended elements; want %v, got %v", tc.set, tail)
+			}
+			if cap(tc.dst) >= len(ret) && len(ret) > 0 && &tc.dst[:1][0] != &ret[0] {
+				t.Error("unexpected slice allocation; want dst to be reused")
+			}
+		})
+	}
+}
+
+func Test_FloatHashSet_AppendSlice_Nil(t *testing.T) {
+	var set *FloatHashSet[float64]
+	dst := []float64{0.1, 0.2}
+	ret := set.AppendSlice(dst)
+
+	if !cmp.Equal(dst, ret) {
+		t.Errorf("unexpected slice; want %v, got %v", dst, ret)
+	}
+}
+
+func Test_FloatHashSet_Contains(t *testing.T) {
+	testCases := map[string]struct {
+		element float64
+		expect  bool
+		set     *FloatHashSet[float64]
+	}{
+		"with NaN element within Set containing NaN": {
+			element: math.NaN(),
+			expect:  true,
+			set:     HashFloat(1.5, math.NaN()),
+		},
+		"with NaN element with different bit pattern within Set containing NaN": {
+			element: math.Float64frombits(0x7ff8000000000001),
+			expect:  true,
+			set:     HashFloat(1.5, math.NaN()),
+		},
+		"with NaN element within Set not containing NaN": {
+			element: math.NaN(),
+			expect:  false,
+			set:     HashFloat(1.5, 2.5),
+		},
+		"with element within Set": {
+			element: 1.5,
+			expect:  true,
+			set:     HashFloat(1.5, math.NaN()),
+		},
+		"with element not within Set": {
+			element: 3.5,
+			expect:  false,
+			set:     HashFloat(1.5, math.NaN()),
+		},
+		"with negative zero element within Set containing positive zero": {
+			element: math.Copysign(0, -1),
+			expect:  true,
+			set:     HashFloat(0.0),
+		},
+		"with nil Set": {
+			element: math.NaN(),
+			expect:  false,
+			set:     nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if result := tc.set.Contains(tc.element); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_FloatHashSet_ContainsAll(t *testing.T) {
+	set := HashFloat(1.5, math.NaN())
+	if !set.ContainsAll(math.NaN(), 1.5) {
+		t.Error("unexpected result; want true, got false")
+	}
+	if set.ContainsAll(1.5, 2.5) {
+		t.Error("unexpected result; want false, got true")
+	}
+}
+
+func Test_FloatHashSet_ContainsAny(t *testing.T) {
+	set := HashFloat(1.5, math.NaN())
+	if !set.ContainsAny(2.5, math.NaN()) {
+		t.Error("unexpected result; want true, got false")
+	}
+	if set.ContainsAny(2.5, 3.5) {
+		t.Error("unexpected result; want false, got true")
+	}
+}
+
+func Test_FloatHashSet_Count(t *testing.T) {
+	set := HashFloat(1.5, 2.5, math.NaN(), math.NaN())
+	if result := set.Count(math.IsNaN); result != 1 {
+		t.Errorf("unexpected count; want 1, got %v", result)
+	}
+	if result := set.Count(func(element float64) bool { return element > 2 }); result != 1 {
+		t.Errorf("unexpected count; want 1, got %v", result)
+	}
+}
+
+func Test_FloatHashSet_Diff(t *testing.T) {
+	testCases := map[string]struct {
+		expectLen int
+		expectNaN bool
+		other     Set[float64]
+		set       *FloatHashSet[float64]
+	}{
+		"with other FloatHashSet containing NaN": {
+			expectLen: 1,
+			expectNaN: false,
+			other:     HashFloat(math.NaN()),
+			set:       HashFloat(1.5, math.NaN()),
+		},
+		"with other HashSet containing NaN": {
+			expectLen: 2,
+			expectNaN: true,
+			other:     Hash(math.NaN()),
+			set:       HashFloat(1.5, math.NaN()),
+		},
+		"with other Set not containing NaN": {
+			expectLen: 1,
+			expectNaN: true,
+			other:     Hash(1.5),
+			set:       HashFloat(1.5, math.NaN()),
+		},
+		"with nil other Set": {
+			expectLen: 2,
+			expectNaN: true,
+			other:     nil,
+			set:       HashFloat(1.5, math.NaN()),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := tc.set.Diff(tc.other)
+			if act := result.Len(); act != tc.expectLen {
+				t.Errorf("unexpected Set length; want %v, got %v", tc.expectLen, act)
+			}
+			if act := result.Contains(math.NaN()); act != tc.expectNaN {
+				t.Errorf("unexpected Set containment of NaN; want %v, got %v", tc.expectNaN, act)
+			}
+		})
+	}
+}
+
+func Test_FloatHashSet_Diff_Nil(t *testing.T) {
+	var set *FloatHashSet[float64]
+	if result := set.Diff(HashFloat(1.5)); result != (*FloatHashSet[float64])(nil) {
+		t.Errorf("unexpected Set; want nil, got %v", result)
+	}
+}
+
+func Test_FloatHashSet_DiffSymmetric(t *testing.T) {
+	result := HashFloat(1.5, math.NaN()).DiffSymmetric(HashFloat(2.5, math.NaN()))
+	if exp, act := []float64{1.5, 2.5}, result.SortedSlice(Asc[float64]); !cmp.Equal(exp, act) {
+		t.Errorf("unexpected Set elements; want %v, got %v", exp, act)
+	}
+
+	result = HashFloat(1.5).DiffSymmetric(Hash(math.NaN(), math.NaN()))
+	if exp, act := 2, result.Len(); act != exp {
+		t.Errorf("unexpected Set length; want %v, got %v", exp, act)
+	}
+	if !result.Contains(math.NaN()) {
+		t.Error("unexpected Set containment of NaN; want true, got false")
+	}
+}
+
+func Test_FloatHashSet_Equal(t *testing.T) {
+	testCases := map[string]struct {
+		expect bool
+		other  Set[float64]
+		set    *FloatHashSet[float64]
+	}{
+		"with other FloatHashSet containing same elements including NaN": {
+			expect: true,
+			other:  HashFloat(math.NaN(), 1.5, math.NaN()),
+			set:    HashFloat(1.5, math.NaN()),
+		},
+		"with other FloatHashSet containing same elements": {
+			expect: true,
+			other:  HashFloat(1.5, 2.5),
+			set:    HashFloat(2.5, 1.5),
+		},
+		"with other FloatHashSet not containing NaN": {
+			expect: false,
+			other:  HashFloat(1.5, 2.5),
+			set:    HashFloat(1.5, math.NaN()),
+		},
+		"with other HashSet containing same elements excluding NaN": {
+			expect: true,
+			other:  Hash(1.5, 2.5),
+			set:    HashFloat(1.5, 2.5),
+		},
+		"with other Set containing no elements and nil Set": {
+			expect: true,
+			other:  Hash[float64](),
+			set:    nil,
+		},
+		"with nil other Set and Set containing no elements": {
+			expect: true,
+			other:  nil,
+			set:    HashFloat[float64](),
+		},
+		"with nil other Set and Set containing NaN": {
+			expect: false,
+			other:  nil,
+			set:    HashFloat(math.NaN()),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if result := tc.set.Equal(tc.other); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_FloatHashSet_Filter(t *testing.T) {
+	set := HashFloat(1.5, 2.5, math.NaN())
+	result := set.Filter(func(element float64) bool {
+		return element != 1.5
+	})
+	if exp, act := 2, result.Len(); act != exp {
+		t.Errorf("unexpected Set length; want %v, got %v", exp, act)
+	}
+	if !result.Contains(math.NaN()) {
+		t.Error("unexpected Set containment of NaN; want true, got false")
+	}
+	if result.Contains(1.5) {
+		t.Error("unexpected Set containment of 1.5; want false, got true")
+	}
+
+	var ns *FloatHashSet[float64]
+	if result = ns.Filter(func(float64) bool { return true }); result != (*FloatHashSet[float64])(nil) {
+		t.Errorf("unexpected Set; want nil, got %v", result)
+	}
+}
+
+func Test_FloatHashSet_Intersection(t *testing.T) {
+	testCases := map[string]struct {
+		expect []float64
+		nan    bool
+		other  Set[float64]
+		set    *FloatHashSet[float64]
+	}{
+		"with other FloatHashSet containing NaN": {
+			expect: []float64{1.5},
+			nan:    true,
+			other:  HashFloat(1.5, math.NaN()),
+			set:    HashFloat(1.5, 2.5, math.NaN()),
+		},
+		"with other HashSet containing NaN": {
+			expect: []float64{1.5},
+			nan:    false,
+			other:  Hash(1.5, math.NaN()),
+			set:    HashFloat(1.5, 2.5, math.NaN()),
+		},
+		"with nil other Set": {
+			expect: []float64{},
+			nan:    false,
+			other:  nil,
+			set:    HashFloat(1.5, math.NaN()),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := tc.set.Intersection(tc.other)
+			var elements []float64
+			result.Range(func(element float64) bool {
+				if element == element {
+					elements = append(elements, element)
+				}
+				return false
+			})
+			if !cmp.Equal(tc.expect, elements, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected Set elements; want %v, got %v", tc.expect, elements)
+			}
+			if act := ContainsNaN[float64](result); act != tc.nan {
+				t.Errorf("unexpected Set containment of NaN; want %v, got %v", tc.nan, act)
+			}
+		})
+	}
+}
+
+func Test_FloatHashSet_IsDisjoint(t *testing.T) {
+	testCases := map[string]struct {
+		expect bool
+		other  Set[float64]
+	}{
+		"with disjoint Set": {
+			expect: true,
+			other:  Hash(2.5, 3.5),
+		},
+		"with Set overlapping on NaN": {
+			expect: false,
+			other:  HashFloat(2.5, math.NaN()),
+		},
+		"with larger Set overlapping on NaN": {
+			expect: false,
+			other:  HashFloat(2.5, 3.5, 4.5, math.NaN()),
+		},
+		"with overlapping Set": {
+			expect: false,
+			other:  Hash(1.5),
+		},
+		"with nil Set": {
+			expect: true,
+			other:  nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := HashFloat(1.5, math.NaN())
+			if result := set.IsDisjoint(tc.other); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_FloatHashSet_Join(t *testing.T) {
+	set := HashFloat(1.5, math.NaN(), math.NaN())
+	convert := func(element float64) string {
+		return strconv.FormatFloat(element, 'f', -1, 64)
+	}
+	assertSetJoin(t, set.Join(",", convert), ",", []string{"1.5", "NaN"})
+	if exp, act := "1.5,NaN", set.SortedJoin(",", convert, Asc[float64]); act != exp {
+		t.Errorf("unexpected string; want %q, got %q", exp, act)
+	}
+}
+
+func Test_FloatHashSet_Keys(t *testing.T) {
+	keys := HashFloat(1.5, math.NaN(), math.NaN()).Keys()
+	if exp, act := 2, len(keys); act != exp {
+		t.Errorf("unexpected map length; want %v, got %v", exp, act)
+	}
+	if _, ok := keys[1.5]; !ok {
+		t.Error("unexpected map key 1.5; want true, got false")
+	}
+
+	var set *FloatHashSet[float64]
+	if keys = set.Keys(); keys != nil {
+		t.Errorf("unexpected map; want nil, got %v", keys)
+	}
+}
+
+func Test_FloatHashSet_Kind(t *testing.T) {
+	if kind := HashFloat(1.5).Kind(); kind != FloatHashKind {
+		t.Errorf("unexpected SetKind; want %v, got %v", FloatHashKind, kind)
+	}
+	if kind := (*FloatHashSet[float64])(nil).Kind(); kind != FloatHashKind {
+		t.Errorf("unexpected SetKind; want %v, got %v", FloatHashKind, kind)
+	}
+}
+
+func Test_FloatHashSet_Len_Nil(t *testing.T) {
+	var set *FloatHashSet[float64]
+	if act := set.Len(); act != 0 {
+		t.Errorf("unexpected Set length; want 0, got %v", act)
+	}
+}
+
+func Test_FloatHashSet_Mutable(t *testing.T) {
+	set := HashFloat(1.5, math.NaN(), math.NaN())
+	result := set.Mutable()
+	if exp, act := 2, result.Len(); act != exp {
+		t.Errorf("unexpected Set length; want %v, got %v", exp, act)
+	}
+	if !result.IsMutable() {
+		t.Error("unexpected Set mutability; want true, got false")
+	}
+	result.Put(3.5)
+	if set.Contains(3.5) {
+		t.Error("unexpected Set containment of 3.5; want false, got true")
+	}
+}
+
+func Test_FloatHashSet_Range(t *testing.T) {
+	var count, nanCount int
+	HashFloat(1.5, 2.5, math.NaN(), math.NaN()).Range(func(element float64) bool {
+		count++
+		if math.IsNaN(element) {
+			nanCount++
+		}
+		return false
+	})
+	if count != 3 {
+		t.Errorf("unexpected iteration count; want 3, got %v", count)
+	}
+	if nanCount != 1 {
+		t.Errorf("unexpected NaN iteration count; want 1, got %v", nanCount)
+	}
+
+	count = 0
+	HashFloat(1.5, 2.5, math.NaN()).Range(func(float64) bool {
+		count++
+		return true
+	})
+	if count != 1 {
+		t.Errorf("unexpected iteration count; want 1, got %v", count)
+	}
+}
+
+func Test_FloatHashSet_RangeContext(t *testing.T) {
+	set := HashFloat(1.23, 4.56, 7.89)
+	var elements []float64
+	err := set.RangeContext(context.Background(), func(element float64) bool {
+		elements = append(elements, element)
+		return false
+	})
+	if err != nil {
+		t.Errorf("unexpected error; want nil, got %q", err)
+	}
+	if expect := set.Len(); len(elements) != expect {
+		t.Errorf("unexpected number of iter function calls; want %v, got %v", expect, len(elements))
+	}
+	if actual := HashFromSlice(elements); !actual.Equal(set) {
+		t.Errorf("unexpected iterated elements; want %v, got %v", set, actual)
+	}
+}
+
+func Test_FloatHashSet_RangeContext_Cancel(t *testing.T) {
+	set := HashFloat(1.23, 4.56, 7.89)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var count int
+	err := set.RangeContext(ctx, func(_ float64) bool {
+		count++
+		cancel()
+		return false
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error; want %q, got %q", context.Canceled, err)
+	}
+	if count != 1 {
+		t.Errorf("unexpected number of iter function calls; want 1, got %v", count)
+	}
+}
+
+func Test_FloatHashSet_RangeContext_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var called bool
+	err := HashFloat(1.23, 4.56, 7.89).RangeContext(ctx, func(_ float64) bool {
+		called = true
+		return false
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error; want %q, got %q", context.Canceled, err)
+	}
+	if called {
+		t.Error("unexpected iter function call")
+	}
+}
+
+func Test_FloatHashSet_RangeContext_Nil(t *testing.T) {
+	var set *FloatHashSet[float64]
+	var called bool
+	err := set.RangeContext(context.Background(), func(_ float64) bool {
+		called = true
+		return false
+	})
+	if err != nil {
+		t.Errorf("unexpected error; want nil, got %q", err)
+	}
+	if called {
+		t.Error("unexpected iter function call")
+	}
+}
+
+func Test_FloatHashSet_Sample(t *testing.T) {
+	set := HashFloat(1.23, 4.56, 7.89)
+	for i := 0; i < 10; i++ {
+		element, ok := set.Sample()
+		if !ok {
+			t.Fatal("unexpected indication of no elements; want true, got false")
+		}
+		if !set.Contains(element) {
+			t.Errorf("unexpected element; want element of %v, got %v", set, element)
+		}
+	}
+}
+
+func Test_FloatHashSet_Sample_Empty(t *testing.T) {
+	set := HashFloat[float64]()
+	if element, ok := set.Sample(); ok {
+		t.Errorf("unexpected element; want none, got %v", element)
+	}
+}
+
+func Test_FloatHashSet_Sample_Nil(t *testing.T) {
+	var set *FloatHashSet[float64]
+	if element, ok := set.Sample(); ok {
+		t.Errorf("unexpected element; want none, got %v", element)
+	}
+}
+
+func Test_FloatHashSet_SampleN(t *testing.T) {
+	testCases := map[string]struct {
+		expectLen int
+		n         int
+		set       *FloatHashSet[float64]
+	}{
+		"with n less than length": {
+			expectLen: 2,
+			n:         2,
+			set:       HashFloat(1.23, 4.56, 7.89),
+		},
+		"with n equal to length": {
+			expectLen: 3,
+			n:         3,
+			set:       HashFloat(1.23, 4.56, 7.89),
+		},
+		"with n greater than length": {
+			expectLen: 3,
+			n:         10,
+			set:       HashFloat(1.23, 4.56, 7.89),
+		},
+		"with zero n": {
+			expectLen: 0,
+			n:         0,
+			set:       HashFloat(1.23, 4.56, 7.89),
+		},
+		"with negative n": {
+			expectLen: 0,
+			n:         -1,
+			set:       HashFloat(1.23, 4.56, 7.89),
+		},
+		"with empty *FloatHashSet[float64]": {
+			expectLen: 0,
+			n:         2,
+			set:       HashFloat[float64](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sampled := tc.set.SampleN(tc.n)
+			if internal.IsNil(sampled) {
+				t.Fatal("unexpected nil Set")
+			}
+			if kind, expectKind := sampled.Kind(), tc.set.Kind(); kind != expectKind {
+				t.Errorf("unexpected SetKind; want %v, got %v", expectKind, kind)
+			}
+			if l := sampled.Len(); l != tc.expectLen {
+				t.Errorf("unexpected Set length; want %v, got %v", tc.expectLen, l)
+			}
+			if !tc.set.Covers(sampled) {
+				t.Errorf("unexpected sampled Set; want subset of %v, got %v", tc.set, sampled)
+			}
+		})
+	}
+}
+
+func Test_FloatHashSet_SampleN_Nil(t *testing.T) {
+	var set *FloatHashSet[float64]
+	if sampled := set.SampleN(2); sampled != (*FloatHashSet[float64])(nil) {
+		t.Errorf("unexpected sampled Set; want nil, got %v", sampled)
+	}
+}
+
+func Test_FloatHashSet_SortedAppendSlice(t *testing.T) {
+	testCases := map[string]struct {
+		set    *FloatHashSet[float64]
+		dst    []float64
+		expect []float64
+	}{
+		"with nil dst on non-empty *FloatHashSet": {
+			set:    HashFloat(4.56, 1.23, 7.89),
+			dst:    nil,
+			expect: []float64{7.89, 4.56, 1.23},
+		},
+		"with non-empty dst on non-empty *FloatHashSet": {
+			set:    HashFloat(4.56, 1.23, 7.89),
+			dst:    []float64{0.1, 9.99},
+			expect: []float64{0.1, 9.99, 7.89, 4.56, 1.23},
+		},
+		"with nil dst on empty *FloatHashSet": {
+			set:    HashFloat[float64](),
+			dst:    nil,
+			expect: nil,
+		},
+		"with non-empty dst on empty *FloatHashSet": {
+			set:    HashFloat[float64](),
+			dst:    []float64{0.1, 9.99},
+			expect: []float64{0.1, 9.99},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ret := tc.set.SortedAppendSlice(tc.dst, Desc[float64])
+			if !cmp.Equal(tc.expect, ret, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected slice; want %v, got %v", tc.expect, ret)
+			}
+		})
+	}
+}
+
+func Test_FloatHashSet_SortedAppendSlice_Nil(t *testing.T) {
+	var set *FloatHashSet[float64]
+	dst := []float64{0.1, 9.99}
+	ret := set.SortedAppendSlice(dst, Desc[float64])
+
+	if !cmp.Equal(dst, ret) {
+		t.Errorf("unexpected slice; want %v, got %v", dst, ret)
+	}
+}
+
+func Test_FloatHashSet_SortedRange(t *testing.T) {
+	testCases := map[string]struct {
+		expect []float64
+		set    *FloatHashSet[float64]
+		stopAt int
+	}{
+		"with non-empty *FloatHashSet[float64]": {
+			expect: []float64{7.89, 4.56, 3.45, 1.23, 0.12},
+			set:    HashFloat(4.56, 1.23, 7.89, 0.12, 3.45),
+			stopAt: -1,
+		},
+		"with non-empty *FloatHashSet[float64] and early stop": {
+			expect: []float64{7.89, 4.56, 3.45},
+			set:    HashFloat(4.56, 1.23, 7.89, 0.12, 3.45),
+			stopAt: 2,
+		},
+		"with empty *FloatHashSet[float64]": {
+			expect: nil,
+			set:    HashFloat[float64](),
+			stopAt: -1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var elements []float64
+			tc.set.SortedRange(Desc[float64], func(index int, element float64) bool {
+				if index != len(elements) {
+					t.Errorf("unexpected index; want %v, got %v", len(elements), index)
+				}
+				elements = append(elements, element)
+				return index == tc.stopAt
+			})
+			if !cmp.Equal(tc.expect, elements, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected elements; want %v, got %v", tc.expect, elements)
+			}
+		})
+	}
+}
+
+func Test_FloatHashSet_SortedRange_Nil(t *testing.T) {
+	var set *FloatHashSet[float64]
+	set.SortedRange(Desc[float64], func(index int, element float64) bool {
+		t.Errorf("unexpected call to iter with %v at index %v", element, index)
+		return false
+	})
+}
+
+func Test_FloatHashSet_ToMap(t *testing.T) {
+	m := HashFloat(1.5, math.NaN(), math.NaN()).ToMap()
+	if exp, act := 2, len(m); act != exp {
+		t.Errorf("unexpected map length; want %v, got %v", exp, act)
+	}
+}
+
+func Test_FloatHashSet_Union(t *testing.T) {
+	result := HashFloat(1.5, math.NaN()).Union(Hash(2.5, math.NaN(), math.NaN()))
+	if exp, act := 3, result.Len(); act != exp {
+		t.Errorf("unexpected Set length; want %v, got %v", exp, act)
+	}
+	if !result.Contains(math.NaN()) {
+		t.Error("unexpected Set containment of NaN; want true, got false")
+	}
+
+	var set *FloatHashSet[float64]
+	if result = set.Union(nil); result != (*FloatHashSet[float64])(nil) {
+		t.Errorf("unexpected Set; want nil, got %v", result)
+	}
+	if result = set.Union(Hash(math.NaN(), math.NaN())); result.Len() != 1 {
+		t.Errorf("unexpected Set length; want 1, got %v", result.Len())
+	}
+}
+
+func Test_FloatHashSet_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(HashFloat(1.5, 1.5))
+	if err != nil {
+		t.Fatalf("unexpected error; want nil, got %v", err)
+	}
+	if exp, act := "[1.5]", string(data); act != exp {
+		t.Errorf("unexpected JSON; want %q, got %q", exp, act)
+	}
+
+	if _, err = json.Marshal(HashFloat(math.NaN())); !errors.Is(err, ErrJSONNaN) {
+		t.Errorf("unexpected error; want %v, got %v", ErrJSONNaN, err)
+	}
+}
+
+func Test_FloatHashSet_UnmarshalJSON(t *testing.T) {
+	var set FloatHashSet[float64]
+	if err := json.Unmarshal([]byte("[1.5,2.5,1.5]"), &set); err != nil {
+		t.Fatalf("unexpected error; want nil, got %v", err)
+	}
+	if exp, act := []float64{1.5, 2.5}, set.SortedSlice(Asc[float64]); !cmp.Equal(exp, act) {
+		t.Errorf("unexpected Set elements; want %v, got %v", exp, act)
+	}
+}
@@ -21,9 +21,17 @@
 package sets
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/gob"
 	"encoding/json"
 	"fmt"
 	"github.com/neocotic/go-sets/internal"
+	"golang.org/x/exp/constraints"
+	"io"
+	"math/rand"
+	"sync/atomic"
 )
 
 // HashSet is an immutable implementation of Set that contains a unique data set.
@@ -34,18 +42,57 @@ import (
 // The exception to its immutability is when passed to json.Unmarshal, however, this has been implemented in a way that
 // is safe for concurrent use. That said; HashSet only implements json.Unmarshaler for the purpose of being able to have
 // a HashSet field value on a struct being unmarshalled. It's recommended to unmarshal JSON into a HashSet using
-// HashFromJSON as JSON is typically only unmarshalled into a struct once.
+// HashFromJSON as JSON is typically only unmarshalled into a struct once. The same applies to HashSet implementing
+// gob.GobDecoder when decoded using a gob.Decoder, and sql.Scanner when scanned from a database row.
 type HashSet[E comparable] struct {
 	elements internal.Hash[E]
+	order    atomic.Pointer[internal.Order[E]]
 }
 
 var (
 	_ Set[any]         = (*HashSet[any])(nil)
 	_ fmt.Stringer     = (*HashSet[any])(nil)
+	_ driver.Valuer    = (*HashSet[any])(nil)
+	_ gob.GobDecoder   = (*HashSet[any])(nil)
+	_ gob.GobEncoder   = (*HashSet[any])(nil)
 	_ json.Marshaler   = (*HashSet[any])(nil)
 	_ json.Unmarshaler = (*HashSet[any])(nil)
+	_ sql.Scanner      = (*HashSet[any])(nil)
 )
 
+// All returns an iterator over each element within the HashSet, which is compatible with iter.Seq so can be used with
+// range-over-func (e.g. `for element := range set.All()`) from Go 1.23 onwards.
+//
+// Iteration order is not guaranteed to be consistent.
+//
+// If the HashSet is nil, the iterator returned by HashSet.All yields no elements.
+func (s *HashSet[E]) All() func(yield func(element E) bool) {
+	return seq[E](s.Range)
+}
+
+// AppendSlice appends all elements of the HashSet to the dst slice and returns the extended slice, following the same
+// convention as the built-in append function. This allows a buffer to be reused across calls, avoiding the allocation
+// made by HashSet.Slice each time.
+//
+// The order in which elements are appended is not guaranteed to be consistent. HashSet.SortedSlice should be used
+// instead for such cases where consistent ordering is required.
+//
+// If the HashSet is nil, HashSet.AppendSlice returns dst unchanged.
+func (s *HashSet[E]) AppendSlice(dst []E) []E {
+	if s == nil {
+		return dst
+	}
+	return internal.AppendSlice(dst, s.elements)
+}
+
+// Canonical returns a minimal byte representation of the HashSet that is stable for its elements, which can be useful
+// for hashing, cache keys, or as a shortcut for equality checks. See Set.Canonical for the escaping and separator used.
+//
+// If the HashSet is nil it is treated as having no elements and so HashSet.Canonical returns an empty slice.
+func (s *HashSet[E]) Canonical(convert func(element E) string) []byte {
+	return canonical[E](s, convert)
+}
+
 // Clone returns a clone of the HashSet.
 //
 // If the HashSet is nil, HashSet.Clone returns nil.
@@ -54,7 +101,7 @@ func (s *HashSet[E]) Clone() Set[E] {
 		var ns *HashSet[E]
 		return ns
 	}
-	return &HashSet[E]{internal.Clone[E](s.elements)}
+	return &HashSet[E]{elements: internal.Clone[E](s.elements)}
 }
 
 // Contains returns whether the HashSet contains the element.
@@ -68,6 +115,51 @@ func (s *HashSet[E]) Contains(element E) bool {
 	return ok
 }
 
+// ContainsAll returns whether the HashSet contains the element as well as all additional elements specified, stopping
+// as soon as any element is found not to be contained.
+//
+// If the HashSet is nil, HashSet.ContainsAll returns false.
+func (s *HashSet[E]) ContainsAll(element E, elements ...E) bool {
+	return containsAll[E](element, elements, s.Contains)
+}
+
+// ContainsAny returns whether the HashSet contains the element or any of the additional elements specified, stopping
+// as soon as any element is found to be contained.
+//
+// If the HashSet is nil, HashSet.ContainsAny returns false.
+func (s *HashSet[E]) ContainsAny(element E, elements ...E) bool {
+	return containsAny[E](element, elements, s.Contains)
+}
+
+// ContainsEach returns a slice containing whether the HashSet contains each of the elements provided, where each result
+// is at the same index as its element.
+//
+// The returned slice always has the same length as the slice of elements provided.
+//
+// If the HashSet is nil, HashSet.ContainsEach returns a slice of false values.
+func (s *HashSet[E]) ContainsEach(elements []E) []bool {
+	return containsEach[E](elements, s.Contains)
+}
+
+// Count returns the number of elements within the HashSet that match the predicate function.
+//
+// If the HashSet is nil, HashSet.Count returns zero.
+func (s *HashSet[E]) Count(predicate func(element E) bool) int {
+	return count[E](s.Range, predicate)
+}
+
+// Covers returns whether the HashSet is a superset of every other Set. That is; whether the HashSet contains all
+// elements within each other Set. Any other Set containing more elements than the HashSet is never covered and so is
+// rejected before its elements are checked.
+//
+// Any nil other Set is treated as having no elements and so is always covered. If no other Set is provided,
+// HashSet.Covers returns true.
+//
+// If the HashSet is nil it is treated as having no elements.
+func (s *HashSet[E]) Covers(others ...Set[E]) bool {
+	return covers[E](s.Len(), s.Contains, others)
+}
+
 // Diff returns a new HashSet struct containing only elements of the HashSet that do not exist in another Set.
 //
 // If the HashSet is nil, HashSet.Diff returns nil.
@@ -76,7 +168,7 @@ func (s *HashSet[E]) Diff(other Set[E]) Set[E] {
 		var ns *HashSet[E]
 		return ns
 	}
-	return &HashSet[E]{internal.Diff[E](s.elements, other)}
+	return &HashSet[E]{elements: internal.Diff[E](s.elements, other)}
 }
 
 // DiffSymmetric returns a new HashSet struct containing elements that exist within the HashSet or another Set, but not
@@ -88,11 +180,13 @@ func (s *HashSet[E]) DiffSymmetric(other Set[E]) Set[E] {
 		var ns *HashSet[E]
 		return ns
 	}
-	return &HashSet[E]{internal.DiffSymmetric[E](s.elements, other)}
+	return &HashSet[E]{elements: internal.DiffSymmetric[E](s.elements, other)}
 }
 
 // Equal returns whether the HashSet contains the exact same elements as another Set.
 //
+// If the other Set is a HashSet or MutableHashSet, their elements are compared directly without any allocation.
+//
 // If the HashSet is nil it is treated as having no elements and the same logic applies to the other Set. To clarify;
 // this means that a nil Set is equal to a non-nil Set that contains no elements.
 func (s *HashSet[E]) Equal(other Set[E]) bool {
@@ -101,6 +195,9 @@ func (s *HashSet[E]) Equal(other Set[E]) bool {
 	} else if other == nil {
 		return s.IsEmpty()
 	}
+	if elements, ok := hashOf[E](other); ok {
+		return internal.Equal[E](s.elements, elements)
+	}
 	return internal.ContainsOnly[E](s.elements, other.Slice())
 }
 
@@ -122,7 +219,7 @@ func (s *HashSet[E]) Filter(filter func(element E) bool) Set[E] {
 		var ns *HashSet[E]
 		return ns
 	}
-	return &HashSet[E]{internal.Filter[E](s.elements, filter)}
+	return &HashSet[E]{elements: internal.Filter[E](s.elements, filter)}
 }
 
 // Find returns an element within the HashSet that matches the search function as well as an indication of whether a
@@ -158,7 +255,17 @@ func (s *HashSet[E]) Intersection(other Set[E]) Set[E] {
 		var ns *HashSet[E]
 		return ns
 	}
-	return &HashSet[E]{internal.Intersection[E](s.elements, other)}
+	return &HashSet[E]{elements: internal.Intersection[E](s.elements, other)}
+}
+
+// IsDisjoint returns whether the HashSet has no elements in common with another Set. Only the elements within the
+// smaller of the two are iterated, with each being checked for within the larger.
+//
+// Any nil other Set, or other Set containing no elements, is disjoint from the HashSet.
+//
+// If the HashSet is nil, HashSet.IsDisjoint returns true.
+func (s *HashSet[E]) IsDisjoint(other Set[E]) bool {
+	return disjoint[E](s.Len(), s.Contains, s.Range, other)
 }
 
 // IsEmpty returns whether the HashSet contains no elements.
@@ -190,6 +297,24 @@ func (s *HashSet[E]) Join(sep string, convert func(element E) string) string {
 	return internal.Join[E](s.elements, sep, convert)
 }
 
+// Kind always returns HashKind to conform with Set.Kind.
+func (s *HashSet[E]) Kind() SetKind {
+	return HashKind
+}
+
+// Keys returns a map containing all elements of the HashSet as keys, which can be useful when integrating with APIs
+// that expect a map rather than a slice.
+//
+// The returned map is always a copy so can be modified freely without affecting the HashSet.
+//
+// If the HashSet is nil, HashSet.Keys returns nil.
+func (s *HashSet[E]) Keys() map[E]struct{} {
+	if s == nil {
+		return nil
+	}
+	return internal.Clone[E](s.elements)
+}
+
 // Len returns the number of elements within the HashSet.
 //
 // If the HashSet is nil, HashSet.Len returns zero.
@@ -230,7 +355,7 @@ func (s *HashSet[E]) Mutable() MutableSet[E] {
 		var ns *MutableHashSet[E]
 		return ns
 	}
-	return &MutableHashSet[E]{internal.Clone[E](s.elements)}
+	return &MutableHashSet[E]{elements: internal.Clone[E](s.elements)}
 }
 
 // None returns whether the HashSet contains no elements that match the predicate function.
@@ -255,6 +380,59 @@ func (s *HashSet[E]) Range(iter func(element E) bool) {
 	}
 }
 
+// RangeContext calls the iter function with each element within the HashSet, the same as HashSet.Range, except that the
+// context is checked before each element. If the context is done, iteration stops and the error of the context is
+// returned.
+//
+// Iteration order is not guaranteed to be consistent.
+//
+// If the HashSet is nil, HashSet.RangeContext is a no-op and returns nil.
+func (s *HashSet[E]) RangeContext(ctx context.Context, iter func(element E) bool) error {
+	if s == nil {
+		return nil
+	}
+	return rangeContext[E](ctx, s.Range, iter)
+}
+
+// Sample returns a pseudo-random element within the HashSet as well as an indication of whether the HashSet contained
+// any elements.
+//
+// If the HashSet is nil, HashSet.Sample returns the zero value for E and false.
+func (s *HashSet[E]) Sample() (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	return sample[E](s.Range, s.Len())
+}
+
+// SampleN returns a new HashSet struct containing up to n distinct elements of the HashSet chosen pseudo-randomly. All
+// elements are included if n is greater than or equal to the number of elements within the HashSet, while none are
+// included if n is not positive.
+//
+// If the HashSet is nil, HashSet.SampleN returns nil.
+func (s *HashSet[E]) SampleN(n int) Set[E] {
+	return s.SampleNWithRand(n, nil)
+}
+
+// SampleNWithRand returns a new HashSet struct containing up to n distinct elements of the HashSet chosen
+// pseudo-randomly using the provided source, the same as HashSet.SampleN otherwise. If the source is nil, the default
+// source is used.
+//
+// Elements are chosen in an arbitrary order unless HashSet.StableRange has already been called on the HashSet, in which
+// case the order it maintains is used instead. HashSet.SampleNWithRand never starts maintaining that order itself.
+// Therefore, repeated calls on the same HashSet using sources that are seeded identically are only guaranteed to choose
+// the same elements once HashSet.StableRange has been called.
+//
+// If the HashSet is nil, HashSet.SampleNWithRand returns nil.
+func (s *HashSet[E]) SampleNWithRand(n int, r *rand.Rand) Set[E] {
+	if s == nil {
+		var ns *HashSet[E]
+		return ns
+	}
+	return &HashSet[E]{elements: sampleN[E](s.order.Load().Snapshot(s.elements), n, r)}
+}
+
 // Slice returns a slice containing all elements of the HashSet.
 //
 // The order of elements within the resulting slice is not guaranteed to be consistent. HashSet.SortedSlice should be
@@ -278,6 +456,19 @@ func (s *HashSet[E]) Some(predicate func(element E) bool) bool {
 	return internal.Some[E](s.elements, predicate)
 }
 
+// SortedAppendSlice appends all elements of the HashSet to the dst slice, sorted using the provided less function, and
+// returns the extended slice. Only the appended elements are sorted so any elements already within dst are left
+// untouched. This allows a buffer to be reused across calls, avoiding the allocation made by HashSet.SortedSlice each
+// time.
+//
+// If the HashSet is nil, HashSet.SortedAppendSlice returns dst unchanged.
+func (s *HashSet[E]) SortedAppendSlice(dst []E, less func(x, y E) bool) []E {
+	if s == nil {
+		return dst
+	}
+	return sortedAppendSlice[E](s, dst, less)
+}
+
 // SortedJoin sorts the elements within the HashSet using the provided less function and then converts those elements
 // into strings which are then joined using the specified separator to create the resulting string.
 //
@@ -289,6 +480,20 @@ func (s *HashSet[E]) SortedJoin(sep string, convert func(element E) string, less
 	return internal.SortedJoin[E](s.elements, sep, convert, less)
 }
 
+// SortedRange calls the iter function with each element within the HashSet, sorted using the provided less function,
+// along with the zero-based index of that element within the sorted order, but will stop early whenever the iter
+// function returns true.
+//
+// Unlike HashSet.Range, iteration order is deterministic.
+//
+// If the HashSet is nil, HashSet.SortedRange is a no-op.
+func (s *HashSet[E]) SortedRange(less func(x, y E) bool, iter func(index int, element E) bool) {
+	if s == nil {
+		return
+	}
+	sortedRange[E](s, less, iter)
+}
+
 // SortedSlice returns a slice containing all elements of the HashSet sorted using the provided less function.
 //
 // If the HashSet is nil, HashSet.SortedSlice returns nil.
@@ -299,6 +504,37 @@ func (s *HashSet[E]) SortedSlice(less func(x, y E) bool) []E {
 	return internal.SortedSlice[E](s.elements, less)
 }
 
+// StableRange calls the iter function with each element within the HashSet but will stop early whenever the iter
+// function returns true.
+//
+// Unlike HashSet.Range, iteration order is stable, though arbitrary, across repeated calls on the same HashSet, which
+// can be useful for reproducibility (e.g. within tests). No less function is required, unlike HashSet.SortedSlice.
+// Instead, the order of elements is only tracked from the first call to HashSet.StableRange, so the cost of doing so is
+// only incurred when needed. Although a HashSet is immutable, it holds a reference to its order so that the order can
+// be established lazily, which is safe to do concurrently. Its order never changes once established, however, it is
+// reset whenever the HashSet is decoded or unmarshalled into.
+//
+// If the HashSet is nil, HashSet.StableRange is a no-op.
+func (s *HashSet[E]) StableRange(iter func(element E) bool) {
+	if s == nil {
+		return
+	}
+	for _, element := range s.stableOrder().Snapshot(s.elements) {
+		if iter(element) {
+			break
+		}
+	}
+}
+
+// ToMap returns a map containing all elements of the HashSet as keys, which is equivalent to HashSet.Keys.
+//
+// The returned map is always a copy so can be modified freely without affecting the HashSet.
+//
+// If the HashSet is nil, HashSet.ToMap returns nil.
+func (s *HashSet[E]) ToMap() map[E]struct{} {
+	return s.Keys()
+}
+
 // TryRange calls the iter function with each element within the HashSet but will stop early whenever the iter function
 // returns an error.
 //
@@ -317,7 +553,7 @@ func (s *HashSet[E]) TryRange(iter func(element E) error) error {
 // If the HashSet and the other Set are both nil, HashSet.Union returns nil.
 func (s *HashSet[E]) Union(other Set[E]) Set[E] {
 	if elements := internal.Union[E](s, other); elements != nil {
-		return &HashSet[E]{elements}
+		return &HashSet[E]{elements: elements}
 	}
 	var ns *HashSet[E]
 	return ns
@@ -330,6 +566,23 @@ func (s *HashSet[E]) String() string {
 	return internal.String[E](s.elements)
 }
 
+func (s *HashSet[E]) GobEncode() ([]byte, error) {
+	if s == nil {
+		return internal.MarshalGobNil[E]()
+	}
+	return internal.MarshalGob[E](s.elements)
+}
+
+func (s *HashSet[E]) GobDecode(data []byte) error {
+	if elements, err := internal.UnmarshalGob[E](data); err != nil {
+		return err
+	} else {
+		s.elements = elements
+		s.order.Store(nil)
+		return nil
+	}
+}
+
 func (s *HashSet[E]) MarshalJSON() ([]byte, error) {
 	if s == nil {
 		return internal.MarshalJSONNil()
@@ -342,10 +595,40 @@ func (s *HashSet[E]) UnmarshalJSON(data []byte) error {
 		return err
 	} else {
 		s.elements = elements
+		s.order.Store(nil)
 		return nil
 	}
 }
 
+func (s *HashSet[E]) Scan(src any) error {
+	data, err := scanJSON(src)
+	if err != nil {
+		return err
+	}
+	return s.UnmarshalJSON(data)
+}
+
+func (s *HashSet[E]) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	data, err := s.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// stableOrder returns the internal.Order used to maintain a stable iteration order for HashSet.StableRange, creating it
+// from the elements currently within the HashSet if it does not already exist.
+func (s *HashSet[E]) stableOrder() *internal.Order[E] {
+	if o := s.order.Load(); o != nil {
+		return o
+	}
+	s.order.CompareAndSwap(nil, internal.NewOrder[E](s.elements))
+	return s.order.Load()
+}
+
 // Hash returns an immutable HashSet struct that implements Set containing each unique element provided.
 //
 // As Hash returns an immutable struct it is safe for concurrent use by multiple goroutines without additional locking
@@ -358,7 +641,29 @@ func (s *HashSet[E]) UnmarshalJSON(data []byte) error {
 // a HashSet field value on a struct being unmarshalled. It's recommended to unmarshal JSON into a HashSet using
 // HashFromJSON as JSON is typically only unmarshalled into a struct once.
 func Hash[E comparable](elements ...E) *HashSet[E] {
-	return &HashSet[E]{internal.FromSlice[E](elements)}
+	return &HashSet[E]{elements: internal.FromSlice[E](elements)}
+}
+
+// HashFromDelimited returns an immutable HashSet struct that implements Set containing each unique element parsed from
+// the delimited string provided, where the string is split into tokens separated by sep and each token is converted
+// into an element using the parse function. This is useful for parsing configuration (e.g. an allowlist within an
+// environment variable or CLI flag) such as "a, b, c".
+//
+// Whitespace surrounding each token is trimmed and any token that is empty as a result is skipped, so a string that
+// is empty, or only contains separators and whitespace, produces an empty HashSet. If sep is empty, the string is split
+// after each UTF-8 sequence, as with strings.Split.
+//
+// If the parse function returns an error for any token, parsing stops and an ErrDelimitedToken is returned, including
+// the offending token and wrapping the error.
+//
+// As HashFromDelimited returns an immutable struct it is safe for concurrent use by multiple goroutines without
+// additional locking or coordination.
+func HashFromDelimited[E comparable](s, sep string, parse func(token string) (E, error)) (*HashSet[E], error) {
+	elements, err := parseDelimited[E](s, sep, parse)
+	if err != nil {
+		return nil, err
+	}
+	return &HashSet[E]{elements: elements}, nil
 }
 
 // HashFromJSON returns an immutable HashSet struct that implements Set containing each unique element parsed from the
@@ -378,6 +683,50 @@ func HashFromJSON[E comparable](data []byte) (*HashSet[E], error) {
 	return set, nil
 }
 
+// HashFromJSONInteger returns an immutable HashSet struct that implements Set containing each unique integer element
+// parsed from the JSON-encoded data provided.
+//
+// Unlike HashFromJSON, each number is first decoded as a json.Number so that an ErrJSONInteger is returned for any
+// number that cannot be represented exactly by E (e.g. 1.5, or 256 when E is uint8) instead of it being silently
+// truncated. Numbers using exponents are accepted so long as they represent integers (e.g. 1e3).
+func HashFromJSONInteger[E constraints.Integer](data []byte) (*HashSet[E], error) {
+	elements, err := unmarshalJSONInteger[E](data)
+	if err != nil {
+		return nil, err
+	}
+	return &HashSet[E]{elements: elements}, nil
+}
+
+// HashFromJSONReader returns an immutable HashSet struct that implements Set containing each unique element decoded
+// from the JSON array read from the io.Reader provided.
+//
+// Unlike HashFromJSON, elements are decoded and added one at a time so that the full array is never held in memory,
+// which is better suited to large arrays. A top-level JSON null produces an empty HashSet, while an ErrJSONArray is
+// returned for any other value that is not an array. Any data following the array may be read from the io.Reader into a
+// buffer and lost.
+//
+// As HashFromJSONReader returns an immutable struct it is safe for concurrent use by multiple goroutines without
+// additional locking or coordination.
+func HashFromJSONReader[E comparable](r io.Reader) (*HashSet[E], error) {
+	elements, err := unmarshalJSONReader[E](r)
+	if err != nil {
+		return nil, err
+	}
+	return &HashSet[E]{elements: elements}, nil
+}
+
+// HashFromMap returns an immutable HashSet struct that implements Set containing each key within the map provided,
+// which can be useful when integrating with APIs that use maps to represent sets. This is the inverse of Set.ToMap.
+//
+// The map is copied so can be modified freely without affecting the HashSet. If the map is nil, the HashSet contains no
+// elements.
+//
+// As HashFromMap returns an immutable struct it is safe for concurrent use by multiple goroutines without additional
+// locking or coordination.
+func HashFromMap[E comparable](m map[E]struct{}) *HashSet[E] {
+	return &HashSet[E]{elements: internal.Clone[E](m)}
+}
+
 // HashFromSlice returns an immutable HashSet struct that implements Set containing each unique element from the slice
 // provided.
 //
@@ -387,5 +736,48 @@ func HashFromJSON[E comparable](data []byte) (*HashSet[E], error) {
 // The exception to its immutability is when passed to json.Unmarshal, however, this has been implemented in a way that
 // is safe for concurrent use.
 func HashFromSlice[E comparable](elements []E) *HashSet[E] {
-	return &HashSet[E]{internal.FromSlice[E](elements)}
+	return &HashSet[E]{elements: internal.FromSlice[E](elements)}
+}
+
+// HashFromSliceFilter returns an immutable HashSet struct that implements Set containing each unique element from the
+// slice provided for which the keep function returns true. This avoids building a HashSet only to then filter it.
+//
+// If keep is nil, all elements within the slice are kept, making HashFromSliceFilter equivalent to HashFromSlice.
+//
+// As HashFromSliceFilter returns an immutable struct it is safe for concurrent use by multiple goroutines without
+// additional locking or coordination.
+//
+// The exception to its immutability is when passed to json.Unmarshal, however, this has been implemented in a way that
+// is safe for concurrent use.
+func HashFromSliceFilter[E comparable](elements []E, keep func(element E) bool) *HashSet[E] {
+	return &HashSet[E]{elements: internal.FromSliceFilter[E](elements, keep)}
+}
+
+// HashWithCapacity returns an immutable HashSet struct that implements Set containing each unique element provided,
+// pre-sized to hold at least capacity elements. This avoids the cost of the underlying map being grown repeatedly while
+// it is being populated when the number of elements is known, or can be estimated, in advance.
+//
+// If capacity is less than the number of elements provided, the HashSet is instead sized to hold all of them.
+//
+// As HashWithCapacity returns an immutable struct it is safe for concurrent use by multiple goroutines without
+// additional locking or coordination.
+//
+// The exception to its immutability is when passed to json.Unmarshal, however, this has been implemented in a way that
+// is safe for concurrent use.
+func HashWithCapacity[E comparable](capacity int, elements ...E) *HashSet[E] {
+	return &HashSet[E]{elements: internal.FromSliceWithCapacity[E](capacity, elements)}
+}
+
+// Project returns an immutable HashSet struct that implements Set containing each unique value returned by the field
+// function for each item in the slice provided. For example; this can be used to collect the IDs of a slice of users.
+//
+// If items is nil, an empty HashSet is returned.
+//
+// As Project returns an immutable struct it is safe for concurrent use by multiple goroutines without additional
+// locking or coordination.
+//
+// The exception to its immutability is when passed to json.Unmarshal, however, this has been implemented in a way that
+// is safe for concurrent use.
+func Project[T any, K comparable](items []T, field func(item T) K) *HashSet[K] {
+	return &HashSet[K]{elements: internal.Project(items, field)}
 }
@@ -21,11 +21,18 @@
 package sets
 
 import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"encoding/gob"
 	"encoding/json"
 	"errors"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/neocotic/go-sets/internal"
+	"math/rand"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -57,6 +64,81 @@ func Test_Hash(t *testing.T) {
 	}
 }
 
+func Test_HashFromDelimited(t *testing.T) {
+	testCases := map[string]struct {
+		expectElements []int
+		s              string
+		sep            string
+	}{
+		"with comma-separated string": {
+			expectElements: []int{123, 456, 789},
+			s:              "123,456,789",
+			sep:            ",",
+		},
+		"with whitespace surrounding tokens": {
+			expectElements: []int{123, 456, 789},
+			s:              " 123 ,\t456,789\n",
+			sep:            ",",
+		},
+		"with empty tokens": {
+			expectElements: []int{123, 456},
+			s:              ",123,, ,456,",
+			sep:            ",",
+		},
+		"with duplicated tokens": {
+			expectElements: []int{123, 456},
+			s:              "123,456,123",
+			sep:            ",",
+		},
+		"with multi-character separator": {
+			expectElements: []int{123, 456},
+			s:              "123 | 456",
+			sep:            " | ",
+		},
+		"with empty string": {
+			expectElements: []int{},
+			s:              "",
+			sep:            ",",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set, err := HashFromDelimited(tc.s, tc.sep, strconv.Atoi)
+			if err != nil {
+				t.Errorf("unexpected error; want nil, got %q", err)
+			} else if set == nil {
+				t.Error("unexpected nil Set")
+			} else {
+				if set.IsMutable() != false {
+					t.Errorf("unexpected Set mutability; want %v, got %v", false, set.IsMutable())
+				}
+
+				opts := []cmp.Option{cmpopts.SortSlices(Asc[int])}
+				if diff := cmp.Diff(tc.expectElements, set.Slice(), opts...); diff != "" {
+					t.Errorf("unexpected parsed elements (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func Test_HashFromDelimited_Error(t *testing.T) {
+	set, err := HashFromDelimited("123, abc ,456", ",", strconv.Atoi)
+	if set != nil {
+		t.Errorf("unexpected Set; want nil, got %v", set)
+	}
+	if !errors.Is(err, ErrDelimitedToken) {
+		t.Errorf("unexpected error; want %q, got %q", ErrDelimitedToken, err)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("unexpected wrapped error; want %q, got %q", strconv.ErrSyntax, err)
+	}
+	if err != nil && !strings.Contains(err.Error(), `"abc"`) {
+		t.Errorf("unexpected error message; want offending token %q, got %q", "abc", err)
+	}
+}
+
 func Test_HashFromJSON(t *testing.T) {
 	testCases := map[string]struct {
 		expectElements []int
@@ -109,6 +191,253 @@ func Test_HashFromJSON(t *testing.T) {
 	}
 }
 
+func Test_HashFromJSONInteger(t *testing.T) {
+	testCases := map[string]struct {
+		expectElements []int64
+		json           string
+	}{
+		"with JSON string for array containing multiple elements": {
+			expectElements: []int64{-123, 456, 789},
+			json:           "[-123,456,789]",
+		},
+		"with JSON string for array containing elements using exponents": {
+			expectElements: []int64{1000, 120},
+			json:           "[1e3,1.2E2,1000]",
+		},
+		"with JSON string for array containing elements using zero fractional parts": {
+			expectElements: []int64{123},
+			json:           "[123.0]",
+		},
+		"with JSON string for array containing duplicated elements": {
+			expectElements: []int64{123, 456},
+			json:           "[123,456,123]",
+		},
+		"with JSON string for array containing null element": {
+			expectElements: []int64{0},
+			json:           "[null]",
+		},
+		"with JSON string for array containing large elements": {
+			expectElements: []int64{9223372036854775807, -9223372036854775808},
+			json:           "[9223372036854775807,-9223372036854775808]",
+		},
+		"with JSON string for empty array": {
+			expectElements: []int64{},
+			json:           "[]",
+		},
+		"with JSON string for null": {
+			expectElements: []int64{},
+			json:           "null",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set, err := HashFromJSONInteger[int64]([]byte(tc.json))
+			if err != nil {
+				t.Errorf("unexpected error; want nil, got %q", err)
+			} else if set == nil {
+				t.Error("unexpected nil Set")
+			} else {
+				if set.IsMutable() {
+					t.Error("unexpected Set mutability; want false, got true")
+				}
+
+				opts := []cmp.Option{cmpopts.SortSlices(Asc[int64])}
+				if actualElements := set.Slice(); !cmp.Equal(tc.expectElements, actualElements, opts...) {
+					t.Errorf("unexpected unmarshalled elements; got diff %v", cmp.Diff(tc.expectElements, actualElements, opts...))
+				}
+			}
+		})
+	}
+}
+
+func Test_HashFromJSONInteger_Error(t *testing.T) {
+	testCases := map[string]struct {
+		expectErr error
+		json      string
+	}{
+		"with JSON string for array containing element with fractional part": {
+			expectErr: ErrJSONInteger,
+			json:      "[123,1.5]",
+		},
+		"with JSON string for array containing element using exponent with fractional part": {
+			expectErr: ErrJSONInteger,
+			json:      "[1e-3]",
+		},
+		"with JSON string for array containing element overflowing int8": {
+			expectErr: ErrJSONInteger,
+			json:      "[128]",
+		},
+		"with JSON string for array containing element underflowing int8": {
+			expectErr: ErrJSONInteger,
+			json:      "[-129]",
+		},
+		"with JSON string for array containing string element": {
+			json: "[\"abc\"]",
+		},
+		"with JSON string for object": {
+			json: "{}",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set, err := HashFromJSONInteger[int8]([]byte(tc.json))
+			if err == nil {
+				t.Error("unexpected nil error")
+			} else if tc.expectErr != nil && !errors.Is(err, tc.expectErr) {
+				t.Errorf("unexpected error; want %q, got %q", tc.expectErr, err)
+			}
+			if set != nil {
+				t.Errorf("unexpected Set; want nil, got %v", set)
+			}
+		})
+	}
+}
+
+func Test_HashFromJSONReader(t *testing.T) {
+	testCases := map[string]struct {
+		expectElements []int
+		json           string
+	}{
+		"with JSON string for array containing multiple elements": {
+			expectElements: []int{123, 456, 789},
+			json:           "[123,456,789]",
+		},
+		"with JSON string for array containing single element": {
+			expectElements: []int{123},
+			json:           "[123]",
+		},
+		"with JSON string for array containing duplicated elements": {
+			expectElements: []int{123, 456, 789},
+			json:           "[123,456,789,456,123]",
+		},
+		"with JSON string for array containing null element": {
+			expectElements: []int{0},
+			json:           "[null]",
+		},
+		"with JSON string for array containing whitespace": {
+			expectElements: []int{123, 456},
+			json:           " [ 123 ,\n 456 ] ",
+		},
+		"with JSON string for empty array": {
+			expectElements: []int{},
+			json:           "[]",
+		},
+		"with JSON string for null": {
+			expectElements: []int{},
+			json:           "null",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set, err := HashFromJSONReader[int](strings.NewReader(tc.json))
+			if err != nil {
+				t.Errorf("unexpected error; want nil, got %q", err)
+			} else if set == nil {
+				t.Error("unexpected nil Set")
+			} else {
+				if set.IsMutable() {
+					t.Error("unexpected Set mutability; want false, got true")
+				}
+
+				opts := []cmp.Option{cmpopts.SortSlices(Asc[int])}
+				if actualElements := set.Slice(); !cmp.Equal(tc.expectElements, actualElements, opts...) {
+					t.Errorf("unexpected unmarshalled elements; got diff %v", cmp.Diff(tc.expectElements, actualElements, opts...))
+				}
+			}
+		})
+	}
+}
+
+func Test_HashFromJSONReader_Error(t *testing.T) {
+	testCases := map[string]struct {
+		expectErr error
+		json      string
+	}{
+		"with JSON string for object": {
+			expectErr: ErrJSONArray,
+			json:      "{\"foo\":123}",
+		},
+		"with JSON string for string": {
+			expectErr: ErrJSONArray,
+			json:      "\"abc\"",
+		},
+		"with JSON string for number": {
+			expectErr: ErrJSONArray,
+			json:      "123",
+		},
+		"with JSON string for array containing string element": {
+			json: "[123,\"abc\"]",
+		},
+		"with JSON string for unterminated array": {
+			json: "[123,456",
+		},
+		"with empty JSON string": {
+			json: "",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set, err := HashFromJSONReader[int](strings.NewReader(tc.json))
+			if err == nil {
+				t.Error("unexpected nil error")
+			} else if tc.expectErr != nil && !errors.Is(err, tc.expectErr) {
+				t.Errorf("unexpected error; want %q, got %q", tc.expectErr, err)
+			}
+			if set != nil {
+				t.Errorf("unexpected Set; want nil, got %v", set)
+			}
+		})
+	}
+}
+
+func Test_HashFromMap(t *testing.T) {
+	testCases := map[string]struct {
+		expect []int
+		m      map[int]struct{}
+	}{
+		"with non-empty map": {
+			expect: []int{123, 456, 789},
+			m:      map[int]struct{}{123: {}, 456: {}, 789: {}},
+		},
+		"with empty map": {
+			expect: []int{},
+			m:      map[int]struct{}{},
+		},
+		"with nil map": {
+			expect: []int{},
+			m:      nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := HashFromMap(tc.m)
+			if elements := set.SortedSlice(Asc[int]); !cmp.Equal(tc.expect, elements, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected elements; want %v, got %v", tc.expect, elements)
+			}
+			if tc.m != nil {
+				tc.m[-1] = struct{}{}
+				if set.Contains(-1) {
+					t.Error("unexpected Set containment of -1; want false, got true")
+				}
+			}
+		})
+	}
+}
+
+func Test_HashFromMap_RoundTrip(t *testing.T) {
+	sets := []Set[int]{Hash(123, 456, 789), MutableHash(123), SyncHash[int](), Small(Asc[int], 1, 2), Singleton(0)}
+	for _, set := range sets {
+		if result := HashFromMap(set.ToMap()); !result.Equal(set) {
+			t.Errorf("unexpected Set; want %v, got %v", set, result)
+		}
+	}
+}
+
 func Test_HashFromSlice(t *testing.T) {
 	testCases := map[string]struct {
 		elements []int
@@ -137,6 +466,316 @@ func Test_HashFromSlice(t *testing.T) {
 	}
 }
 
+func Test_HashFromSliceFilter(t *testing.T) {
+	testCases := map[string]struct {
+		elements []int
+		keep     func(element int) bool
+		expect   Set[int]
+	}{
+		"with nil slice": {
+			elements: nil,
+			keep:     func(element int) bool { return element > 0 },
+			expect:   Hash[int](),
+		},
+		"with slice containing no elements": {
+			elements: []int{},
+			keep:     func(element int) bool { return element > 0 },
+			expect:   Hash[int](),
+		},
+		"with slice containing elements all kept": {
+			elements: []int{123, 456, 789},
+			keep:     func(element int) bool { return element > 0 },
+			expect:   Hash(123, 456, 789),
+		},
+		"with slice containing elements some excluded": {
+			elements: []int{-123, 123, 456, -456, 456, 789},
+			keep:     func(element int) bool { return element > 0 },
+			expect:   Hash(123, 456, 789),
+		},
+		"with slice containing elements all excluded": {
+			elements: []int{-123, -456, -789},
+			keep:     func(element int) bool { return element > 0 },
+			expect:   Hash[int](),
+		},
+		"with nil keep": {
+			elements: []int{-123, 123, 456, 456},
+			keep:     nil,
+			expect:   Hash(-123, 123, 456),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := HashFromSliceFilter(tc.elements, tc.keep)
+			if internal.IsNil(set) {
+				t.Fatal("unexpected nil Set")
+			}
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+			if set.IsMutable() != false {
+				t.Error("unexpected Set mutability; want false, got true")
+			}
+		})
+	}
+}
+
+func Test_HashWithCapacity(t *testing.T) {
+	testCases := map[string]struct {
+		capacity int
+		elements []int
+		expect   Set[int]
+	}{
+		"with zero capacity and no elements": {
+			capacity: 0,
+			elements: nil,
+			expect:   Hash[int](),
+		},
+		"with negative capacity and no elements": {
+			capacity: -1,
+			elements: nil,
+			expect:   Hash[int](),
+		},
+		"with capacity and no elements": {
+			capacity: 16,
+			elements: nil,
+			expect:   Hash[int](),
+		},
+		"with capacity greater than number of elements": {
+			capacity: 16,
+			elements: []int{123, 456, 789},
+			expect:   Hash(123, 456, 789),
+		},
+		"with capacity less than number of elements": {
+			capacity: 1,
+			elements: []int{123, 456, 456, 789},
+			expect:   Hash(123, 456, 789),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := HashWithCapacity(tc.capacity, tc.elements...)
+			if internal.IsNil(set) {
+				t.Fatal("unexpected nil Set")
+			}
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+			if set.IsMutable() != false {
+				t.Error("unexpected Set mutability; want false, got true")
+			}
+		})
+	}
+}
+
+func Test_Project(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	testCases := map[string]struct {
+		items  []user
+		expect Set[int]
+	}{
+		"with nil slice": {
+			items:  nil,
+			expect: Hash[int](),
+		},
+		"with slice containing no items": {
+			items:  []user{},
+			expect: Hash[int](),
+		},
+		"with slice containing single item": {
+			items:  []user{{id: 123, name: "alpha"}},
+			expect: Hash(123),
+		},
+		"with slice containing multiple items with unique field values": {
+			items:  []user{{id: 123, name: "alpha"}, {id: 456, name: "beta"}, {id: 789, name: "gamma"}},
+			expect: Hash(123, 456, 789),
+		},
+		"with slice containing multiple items with duplicate field values": {
+			items:  []user{{id: 123, name: "alpha"}, {id: 456, name: "beta"}, {id: 123, name: "gamma"}},
+			expect: Hash(123, 456),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Project(tc.items, func(item user) int { return item.id })
+			if internal.IsNil(set) {
+				t.Fatal("unexpected nil Set")
+			}
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+			if set.IsMutable() != false {
+				t.Error("unexpected Set mutability; want false, got true")
+			}
+		})
+	}
+}
+
+func Test_HashSet_All(t *testing.T) {
+	var elements []int
+	Hash(123, 456, 789).All()(func(element int) bool {
+		elements = append(elements, element)
+		return true
+	})
+	if diff := cmp.Diff([]int{123, 456, 789}, elements, cmpopts.SortSlices(Asc[int])); diff != "" {
+		t.Errorf("unexpected yielded elements (-want +got):\n%s", diff)
+	}
+}
+
+func Test_HashSet_All_Break(t *testing.T) {
+	set := Hash(123, 456, 789)
+	var callCount int
+	set.All()(func(element int) bool {
+		callCount++
+		if !set.Contains(element) {
+			t.Errorf("unexpected yielded element; got %v", element)
+		}
+		return callCount < 2
+	})
+	if callCount != 2 {
+		t.Errorf("unexpected number of calls to yield; want 2, got %v", callCount)
+	}
+}
+
+func Test_HashSet_All_Nil(t *testing.T) {
+	var set *HashSet[int]
+	var callCount int
+	set.All()(func(_ int) bool {
+		callCount++
+		return true
+	})
+	if callCount != 0 {
+		t.Errorf("unexpected number of calls to yield; want 0, got %v", callCount)
+	}
+}
+
+func Test_HashSet_AppendSlice(t *testing.T) {
+	testCases := map[string]struct {
+		set *HashSet[int]
+		dst []int
+	}{
+		"with nil dst on non-empty *HashSet": {
+			set: Hash(123, 456, 789),
+			dst: nil,
+		},
+		"with non-empty dst on non-empty *HashSet": {
+			set: Hash(123, 456, 789),
+			dst: []int{1, 2},
+		},
+		"with dst having spare capacity on non-empty *HashSet": {
+			set: Hash(123, 456, 789),
+			dst: make([]int, 0, 8),
+		},
+		"with nil dst on empty *HashSet": {
+			set: Hash[int](),
+			dst: nil,
+		},
+		"with non-empty dst on empty *HashSet": {
+			set: Hash[int](),
+			dst: []int{1, 2},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dst := append([]int(nil), tc.dst...)
+			ret := tc.set.AppendSlice(tc.dst)
+
+			if expectLen := len(tc.dst) + tc.set.Len(); len(ret) != expectLen {
+				t.Fatalf("unexpected slice length; want %v, got %v", expectLen, len(ret))
+			}
+			if !cmp.Equal(dst, ret[:len(tc.dst)], cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected leading elements; want %v, got %v", dst, ret[:len(tc.dst)])
+			}
+			if tail := ret[len(tc.dst):]; !HashFromSlice(tail).Equal(tc.set) {
+				t.Errorf("unexpected appended elements; want %v, got %v", tc.set, tail)
+			}
+			if cap(tc.dst) >= len(ret) && len(ret) > 0 && &tc.dst[:1][0] != &ret[0] {
+				t.Error("unexpected slice allocation; want dst to be reused")
+			}
+		})
+	}
+}
+
+func Test_HashSet_AppendSlice_Nil(t *testing.T) {
+	var set *HashSet[int]
+	dst := []int{1, 2}
+	ret := set.AppendSlice(dst)
+
+	if !cmp.Equal(dst, ret) {
+		t.Errorf("unexpected slice; want %v, got %v", dst, ret)
+	}
+}
+
+func Test_HashSet_Canonical(t *testing.T) {
+	testCases := map[string]struct {
+		expect string
+		set    *HashSet[string]
+	}{
+		"with no elements": {
+			expect: "",
+			set:    Hash[string](),
+		},
+		"with elements": {
+			expect: "bar,baz,foo",
+			set:    Hash[string]("foo", "bar", "baz"),
+		},
+		"with elements requiring escaping": {
+			expect: `a\,,b,c\\`,
+			set:    Hash[string]("b", `c\`, "a,"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			canonical := string(tc.set.Canonical(func(element string) string { return element }))
+			if canonical != tc.expect {
+				t.Errorf("unexpected canonical; want %q, got %q", tc.expect, canonical)
+			}
+		})
+	}
+}
+
+func Test_HashSet_Canonical_Identity(t *testing.T) {
+	convert := func(element string) string { return element }
+	sets := []*HashSet[string]{
+		Hash[string](),
+		Hash("a"),
+		Hash("b"),
+		Hash("a", "b"),
+		Hash("a,b"),
+		Hash(`a\`, "b"),
+		Hash(`a\,b`),
+		Hash(`a\`, `\`),
+		Hash(`a\\`),
+		Hash("a", "b", "c"),
+	}
+	seen := make(map[string]int, len(sets))
+	for i, set := range sets {
+		canonical := string(set.Canonical(convert))
+		if j, ok := seen[canonical]; ok {
+			t.Errorf("unexpected duplicate canonical for Sets %v and %v: %q", sets[j], set, canonical)
+		}
+		seen[canonical] = i
+		if other := string(Hash(set.SortedSlice(Desc[string])...).Canonical(convert)); other != canonical {
+			t.Errorf("unexpected canonical for equal Set; want %q, got %q", canonical, other)
+		}
+	}
+}
+
+func Test_HashSet_Canonical_Nil(t *testing.T) {
+	var set *HashSet[string]
+	canonical := set.Canonical(func(element string) string { return element })
+	if canonical == nil || len(canonical) != 0 {
+		t.Errorf("unexpected canonical; want %#v, got %#v", []byte{}, canonical)
+	}
+}
+
 func Test_HashSet_Clone(t *testing.T) {
 	set := Hash(123, 456, 789)
 	clone := set.Clone()
@@ -166,59 +805,283 @@ func Test_HashSet_Clone_Nil(t *testing.T) {
 	if !clone.IsEmpty() {
 		t.Error("unexpected cloned Set emptiness; want true, got false")
 	}
-	if clone.IsMutable() {
-		t.Error("unexpected cloned Set mutability; want false, got true")
+	if clone.IsMutable() {
+		t.Error("unexpected cloned Set mutability; want false, got true")
+	}
+}
+
+func Test_HashSet_Contains(t *testing.T) {
+	testCases := map[string]struct {
+		element int
+		expect  bool
+	}{
+		"with matching element": {
+			element: 123,
+			expect:  true,
+		},
+		"with non-matching zero value for element": {
+			element: 0,
+			expect:  false,
+		},
+		"with non-matching non-zero value for element": {
+			element: 1,
+			expect:  false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Hash(123, 456, 789)
+			result := set.Contains(tc.element)
+			if result != tc.expect {
+				t.Errorf("unexpected element contained within Set: %q; want %v, got %v", tc.element, tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_HashSet_ContainsAll(t *testing.T) {
+	testCases := map[string]struct {
+		element  int
+		elements []int
+		expect   bool
+	}{
+		"with single contained element": {
+			element: 123,
+			expect:  true,
+		},
+		"with single element not contained": {
+			element: -123,
+			expect:  false,
+		},
+		"with all elements contained": {
+			element:  123,
+			elements: []int{456, 789},
+			expect:   true,
+		},
+		"with duplicate contained elements": {
+			element:  123,
+			elements: []int{123, 123},
+			expect:   true,
+		},
+		"with first element not contained": {
+			element:  -123,
+			elements: []int{456, 789},
+			expect:   false,
+		},
+		"with last element not contained": {
+			element:  123,
+			elements: []int{456, -789},
+			expect:   false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Hash(123, 456, 789)
+			if result := set.ContainsAll(tc.element, tc.elements...); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_HashSet_ContainsAll_Nil(t *testing.T) {
+	var set *HashSet[int]
+	if set.ContainsAll(123, 456) {
+		t.Error("unexpected result; want false, got true")
+	}
+}
+
+func Test_HashSet_ContainsAny(t *testing.T) {
+	testCases := map[string]struct {
+		element  int
+		elements []int
+		expect   bool
+	}{
+		"with single contained element": {
+			element: 123,
+			expect:  true,
+		},
+		"with single element not contained": {
+			element: -123,
+			expect:  false,
+		},
+		"with first element contained": {
+			element:  123,
+			elements: []int{-456, -789},
+			expect:   true,
+		},
+		"with last element contained": {
+			element:  -123,
+			elements: []int{-456, 789},
+			expect:   true,
+		},
+		"with no elements contained": {
+			element:  -123,
+			elements: []int{-456, -789},
+			expect:   false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Hash(123, 456, 789)
+			if result := set.ContainsAny(tc.element, tc.elements...); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_HashSet_ContainsAny_Nil(t *testing.T) {
+	var set *HashSet[int]
+	if set.ContainsAny(123, 456) {
+		t.Error("unexpected result; want false, got true")
+	}
+}
+
+func Test_HashSet_Contains_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		element int
+	}{
+		"with non-matching zero value for element":     {0},
+		"with non-matching non-zero value for element": {1},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var set *HashSet[int]
+			if set.Contains(tc.element) {
+				t.Errorf("unexpected element contained within Set: %q; want false, got true", tc.element)
+			}
+		})
+	}
+}
+
+func Test_HashSet_ContainsEach(t *testing.T) {
+	testCases := map[string]struct {
+		elements []int
+		expect   []bool
+	}{
+		"with no elements": {
+			elements: nil,
+			expect:   []bool{},
+		},
+		"with mix of present and absent elements": {
+			elements: []int{999, 123, 0, 789, 456, -1},
+			expect:   []bool{false, true, false, true, true, false},
+		},
+		"with duplicate elements": {
+			elements: []int{456, 999, 456},
+			expect:   []bool{true, false, true},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Hash(123, 456, 789)
+			if diff := cmp.Diff(tc.expect, set.ContainsEach(tc.elements)); diff != "" {
+				t.Errorf("unexpected results (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_HashSet_ContainsEach_Nil(t *testing.T) {
+	var set *HashSet[int]
+	if diff := cmp.Diff([]bool{false, false}, set.ContainsEach([]int{123, 0})); diff != "" {
+		t.Errorf("unexpected results (-want +got):\n%s", diff)
 	}
 }
 
-func Test_HashSet_Contains(t *testing.T) {
+func Test_HashSet_Count(t *testing.T) {
 	testCases := map[string]struct {
-		element int
-		expect  bool
+		expect    int
+		predicate func(element int) bool
 	}{
-		"with matching element": {
-			element: 123,
-			expect:  true,
+		"with predicate matching all elements": {
+			expect:    5,
+			predicate: func(_ int) bool { return true },
 		},
-		"with non-matching zero value for element": {
-			element: 0,
-			expect:  false,
+		"with predicate matching some elements": {
+			expect:    3,
+			predicate: func(element int) bool { return element > 0 },
 		},
-		"with non-matching non-zero value for element": {
-			element: 1,
-			expect:  false,
+		"with predicate matching single element": {
+			expect:    1,
+			predicate: func(element int) bool { return element == 0 },
+		},
+		"with predicate matching no elements": {
+			expect:    0,
+			predicate: func(_ int) bool { return false },
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			set := Hash(123, 456, 789)
-			result := set.Contains(tc.element)
-			if result != tc.expect {
-				t.Errorf("unexpected element contained within Set: %q; want %v, got %v", tc.element, tc.expect, result)
+			set := Hash(-123, 0, 123, 456, 789)
+			if result := set.Count(tc.predicate); result != tc.expect {
+				t.Errorf("unexpected count; want %v, got %v", tc.expect, result)
 			}
 		})
 	}
 }
 
-func Test_HashSet_Contains_Nil(t *testing.T) {
+func Test_HashSet_Count_Nil(t *testing.T) {
+	var set *HashSet[int]
+	if result := set.Count(func(_ int) bool { return true }); result != 0 {
+		t.Errorf("unexpected count; want 0, got %v", result)
+	}
+}
+
+func Test_HashSet_Covers(t *testing.T) {
 	testCases := map[string]struct {
-		element int
+		expect bool
+		others []Set[int]
 	}{
-		"with non-matching zero value for element":     {0},
-		"with non-matching non-zero value for element": {1},
+		"with no other Sets": {
+			expect: true,
+			others: nil,
+		},
+		"with covered Sets": {
+			expect: true,
+			others: []Set[int]{Hash(123), Hash(456, 789), Hash(123, 456, 789)},
+		},
+		"with empty and nil Sets": {
+			expect: true,
+			others: []Set[int]{Hash[int](), nil, (*HashSet[int])(nil)},
+		},
+		"with mix of covered and not covered Sets": {
+			expect: false,
+			others: []Set[int]{Hash(123, 456), Hash(456, 999), Hash(789)},
+		},
+		"with Set containing more elements": {
+			expect: false,
+			others: []Set[int]{Hash(0, 123, 456, 789)},
+		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			var set *HashSet[int]
-			if set.Contains(tc.element) {
-				t.Errorf("unexpected element contained within Set: %q; want false, got true", tc.element)
+			set := Hash(123, 456, 789)
+			if covers := set.Covers(tc.others...); covers != tc.expect {
+				t.Errorf("unexpected covers; want %v, got %v", tc.expect, covers)
 			}
 		})
 	}
 }
 
+func Test_HashSet_Covers_Nil(t *testing.T) {
+	var set *HashSet[int]
+	if !set.Covers(Hash[int](), nil) {
+		t.Error("unexpected covers of empty Set; want true, got false")
+	}
+	if set.Covers(Hash(123)) {
+		t.Error("unexpected covers of non-empty Set; want false, got true")
+	}
+}
+
 func Test_HashSet_Diff(t *testing.T) {
 	testCases := map[string]struct {
 		expect Set[int]
@@ -610,6 +1473,39 @@ func Test_HashSet_Equal(t *testing.T) {
 	}
 }
 
+func Test_HashSet_Equal_Allocs(t *testing.T) {
+	elements := make([]int, 1000)
+	for i := range elements {
+		elements[i] = i
+	}
+	set := HashFromSlice(elements)
+	testCases := map[string]struct {
+		other Set[int]
+	}{
+		"with equal *HashSet": {
+			other: HashFromSlice(elements),
+		},
+		"with equal *MutableHashSet": {
+			other: MutableHashFromSlice(elements),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var result bool
+			allocs := testing.AllocsPerRun(10, func() {
+				result = set.Equal(tc.other)
+			})
+			if !result {
+				t.Error("unexpected result; want true, got false")
+			}
+			if allocs != 0 {
+				t.Errorf("unexpected number of allocations; want 0, got %v", allocs)
+			}
+		})
+	}
+}
+
 func Test_HashSet_Equal_Nil(t *testing.T) {
 	testCases := map[string]struct {
 		expect bool
@@ -1074,6 +1970,73 @@ func Test_HashSet_Intersection_Nil(t *testing.T) {
 	}
 }
 
+func Test_HashSet_IsDisjoint(t *testing.T) {
+	testCases := map[string]struct {
+		expect bool
+		other  Set[int]
+	}{
+		"with disjoint Set": {
+			expect: true,
+			other:  Hash(-789, -456, -123),
+		},
+		"with smaller disjoint Set": {
+			expect: true,
+			other:  Singleton(0),
+		},
+		"with larger disjoint Set": {
+			expect: true,
+			other:  Hash(-789, -456, -123, 0, 1),
+		},
+		"with overlapping Set": {
+			expect: false,
+			other:  Hash(-123, 0, 456),
+		},
+		"with smaller overlapping Set": {
+			expect: false,
+			other:  Singleton(789),
+		},
+		"with larger overlapping Set": {
+			expect: false,
+			other:  Hash(-789, -456, -123, 0, 123),
+		},
+		"with equal Set": {
+			expect: false,
+			other:  Hash(123, 456, 789),
+		},
+		"with empty Set": {
+			expect: true,
+			other:  Hash[int](),
+		},
+		"with nil Set": {
+			expect: true,
+			other:  nil,
+		},
+		"with nil *HashSet": {
+			expect: true,
+			other:  (*HashSet[int])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Hash(123, 456, 789)
+			if result := set.IsDisjoint(tc.other); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_HashSet_IsDisjoint_Nil(t *testing.T) {
+	var set *HashSet[int]
+	if !set.IsDisjoint(Hash(123)) {
+		t.Error("unexpected result; want true, got false")
+	}
+	if !set.IsDisjoint(nil) {
+		t.Error("unexpected result; want true, got false")
+	}
+}
+
 func Test_HashSet_IsEmpty(t *testing.T) {
 	testCases := map[string]struct {
 		expect bool
@@ -1154,6 +2117,59 @@ func Test_HashSet_Join_Nil(t *testing.T) {
 	assertSetJoin(t, set.Join(sep, getIntStringConverterWithDefaultOptions[int]()), sep, []string{})
 }
 
+func Test_HashSet_Kind(t *testing.T) {
+	set := Hash(123, 456, 789)
+	if kind := set.Kind(); kind != HashKind {
+		t.Errorf("unexpected kind; want %v, got %v", HashKind, kind)
+	}
+}
+
+func Test_HashSet_Kind_Nil(t *testing.T) {
+	var set *HashSet[int]
+	if kind := set.Kind(); kind != HashKind {
+		t.Errorf("unexpected kind; want %v, got %v", HashKind, kind)
+	}
+}
+
+func Test_HashSet_Keys(t *testing.T) {
+	testCases := map[string]struct {
+		set *HashSet[int]
+	}{
+		"on *HashSet containing multiple elements": {
+			set: Hash(123, 456, 789),
+		},
+		"on *HashSet containing single element": {
+			set: Hash(123),
+		},
+		"on *HashSet containing no elements": {
+			set: Hash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			keys := tc.set.Keys()
+			if keys == nil {
+				t.Error("unexpected nil map")
+			}
+			if !internal.ContainsOnly[int](keys, tc.set.Slice()) {
+				t.Errorf("unexpected map keys; want %v, got %v", tc.set.Slice(), keys)
+			}
+			keys[0] = struct{}{}
+			if tc.set.Contains(0) {
+				t.Error("unexpected element within HashSet following modification of map; want false, got true")
+			}
+		})
+	}
+}
+
+func Test_HashSet_Keys_Nil(t *testing.T) {
+	var set *HashSet[int]
+	if keys := set.Keys(); keys != nil {
+		t.Errorf("unexpected map; want nil, got %v", keys)
+	}
+}
+
 func Test_HashSet_Len(t *testing.T) {
 	testCases := map[string]struct {
 		expect int
@@ -1410,58 +2426,232 @@ func Test_HashSet_None_Nil(t *testing.T) {
 	}
 }
 
-func Test_HashSet_Range(t *testing.T) {
+func Test_HashSet_Range(t *testing.T) {
+	testCases := map[string]struct {
+		expectCallCount int
+		iterFunc        func(element int) bool
+		set             *HashSet[int]
+	}{
+		"with non-breaking iterator on non-empty *HashSet": {
+			expectCallCount: 3,
+			iterFunc:        func(_ int) bool { return false },
+			set:             Hash(123, 456, 789),
+		},
+		"with breaking iterator on non-empty *HashSet": {
+			expectCallCount: 3,
+			iterFunc: func() func(element int) bool {
+				var i int
+				return func(_ int) bool {
+					i++
+					return i == 3
+				}
+			}(),
+			set: Hash(-789, -456, -123, 0, 123, 456, 789),
+		},
+		"with non-breaking iterator on empty *HashSet": {
+			expectCallCount: 0,
+			iterFunc:        func(_ int) bool { return false },
+			set:             Hash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var funcCallCount int
+			tc.set.Range(func(element int) bool {
+				funcCallCount++
+				return tc.iterFunc(element)
+			})
+			if funcCallCount != tc.expectCallCount {
+				t.Errorf("unexpected number of calls to iterator; want %v, got %v", tc.expectCallCount, funcCallCount)
+			}
+		})
+	}
+}
+
+func Test_HashSet_RangeContext(t *testing.T) {
+	set := Hash(123, 456, 789)
+	var elements []int
+	err := set.RangeContext(context.Background(), func(element int) bool {
+		elements = append(elements, element)
+		return false
+	})
+	if err != nil {
+		t.Errorf("unexpected error; want nil, got %q", err)
+	}
+	if expect := set.Len(); len(elements) != expect {
+		t.Errorf("unexpected number of iter function calls; want %v, got %v", expect, len(elements))
+	}
+	if actual := HashFromSlice(elements); !actual.Equal(set) {
+		t.Errorf("unexpected iterated elements; want %v, got %v", set, actual)
+	}
+}
+
+func Test_HashSet_RangeContext_Cancel(t *testing.T) {
+	set := Hash(123, 456, 789)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var count int
+	err := set.RangeContext(ctx, func(_ int) bool {
+		count++
+		cancel()
+		return false
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error; want %q, got %q", context.Canceled, err)
+	}
+	if count != 1 {
+		t.Errorf("unexpected number of iter function calls; want 1, got %v", count)
+	}
+}
+
+func Test_HashSet_RangeContext_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var called bool
+	err := Hash(123, 456, 789).RangeContext(ctx, func(_ int) bool {
+		called = true
+		return false
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error; want %q, got %q", context.Canceled, err)
+	}
+	if called {
+		t.Error("unexpected iter function call")
+	}
+}
+
+func Test_HashSet_RangeContext_Nil(t *testing.T) {
+	var set *HashSet[int]
+	var called bool
+	err := set.RangeContext(context.Background(), func(_ int) bool {
+		called = true
+		return false
+	})
+	if err != nil {
+		t.Errorf("unexpected error; want nil, got %q", err)
+	}
+	if called {
+		t.Error("unexpected iter function call")
+	}
+}
+
+func Test_HashSet_Range_Nil(t *testing.T) {
+	var funcCallCount int
+	var set *HashSet[int]
+	set.Range(func(_ int) bool {
+		funcCallCount++
+		return false
+	})
+	if funcCallCount != 0 {
+		t.Errorf("unexpected number of calls to iterator; want 0, got %v", funcCallCount)
+	}
+}
+
+func Test_HashSet_Sample(t *testing.T) {
+	set := Hash(123, 456, 789)
+	for i := 0; i < 10; i++ {
+		element, ok := set.Sample()
+		if !ok {
+			t.Fatal("unexpected indication of no elements; want true, got false")
+		}
+		if !set.Contains(element) {
+			t.Errorf("unexpected element; want element of %v, got %v", set, element)
+		}
+	}
+}
+
+func Test_HashSet_Sample_Empty(t *testing.T) {
+	set := Hash[int]()
+	if element, ok := set.Sample(); ok {
+		t.Errorf("unexpected element; want none, got %v", element)
+	}
+}
+
+func Test_HashSet_Sample_Nil(t *testing.T) {
+	var set *HashSet[int]
+	if element, ok := set.Sample(); ok {
+		t.Errorf("unexpected element; want none, got %v", element)
+	}
+}
+
+func Test_HashSet_SampleN(t *testing.T) {
 	testCases := map[string]struct {
-		expectCallCount int
-		iterFunc        func(element int) bool
-		set             *HashSet[int]
+		expectLen int
+		n         int
+		set       *HashSet[int]
 	}{
-		"with non-breaking iterator on non-empty *HashSet": {
-			expectCallCount: 3,
-			iterFunc:        func(_ int) bool { return false },
-			set:             Hash(123, 456, 789),
+		"with n less than length": {
+			expectLen: 2,
+			n:         2,
+			set:       Hash(123, 456, 789),
 		},
-		"with breaking iterator on non-empty *HashSet": {
-			expectCallCount: 3,
-			iterFunc: func() func(element int) bool {
-				var i int
-				return func(_ int) bool {
-					i++
-					return i == 3
-				}
-			}(),
-			set: Hash(-789, -456, -123, 0, 123, 456, 789),
+		"with n equal to length": {
+			expectLen: 3,
+			n:         3,
+			set:       Hash(123, 456, 789),
 		},
-		"with non-breaking iterator on empty *HashSet": {
-			expectCallCount: 0,
-			iterFunc:        func(_ int) bool { return false },
-			set:             Hash[int](),
+		"with n greater than length": {
+			expectLen: 3,
+			n:         10,
+			set:       Hash(123, 456, 789),
+		},
+		"with zero n": {
+			expectLen: 0,
+			n:         0,
+			set:       Hash(123, 456, 789),
+		},
+		"with negative n": {
+			expectLen: 0,
+			n:         -1,
+			set:       Hash(123, 456, 789),
+		},
+		"with empty *HashSet[int]": {
+			expectLen: 0,
+			n:         2,
+			set:       Hash[int](),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			var funcCallCount int
-			tc.set.Range(func(element int) bool {
-				funcCallCount++
-				return tc.iterFunc(element)
-			})
-			if funcCallCount != tc.expectCallCount {
-				t.Errorf("unexpected number of calls to iterator; want %v, got %v", tc.expectCallCount, funcCallCount)
+			sampled := tc.set.SampleN(tc.n)
+			if internal.IsNil(sampled) {
+				t.Fatal("unexpected nil Set")
+			}
+			if kind, expectKind := sampled.Kind(), tc.set.Kind(); kind != expectKind {
+				t.Errorf("unexpected SetKind; want %v, got %v", expectKind, kind)
+			}
+			if l := sampled.Len(); l != tc.expectLen {
+				t.Errorf("unexpected Set length; want %v, got %v", tc.expectLen, l)
+			}
+			if !tc.set.Covers(sampled) {
+				t.Errorf("unexpected sampled Set; want subset of %v, got %v", tc.set, sampled)
 			}
 		})
 	}
 }
 
-func Test_HashSet_Range_Nil(t *testing.T) {
-	var funcCallCount int
+func Test_HashSet_SampleN_Nil(t *testing.T) {
 	var set *HashSet[int]
-	set.Range(func(_ int) bool {
-		funcCallCount++
-		return false
-	})
-	if funcCallCount != 0 {
-		t.Errorf("unexpected number of calls to iterator; want 0, got %v", funcCallCount)
+	if sampled := set.SampleN(2); sampled != (*HashSet[int])(nil) {
+		t.Errorf("unexpected sampled Set; want nil, got %v", sampled)
+	}
+}
+
+func Test_HashSet_SampleNWithRand(t *testing.T) {
+	set := Hash(123, 456, 789)
+	set.SampleNWithRand(2, rand.New(rand.NewSource(42)))
+	if set.order.Load() != nil {
+		t.Fatal("unexpected order tracked by SampleNWithRand")
+	}
+
+	set.StableRange(func(_ int) bool { return false })
+	expect := set.SampleNWithRand(2, rand.New(rand.NewSource(42)))
+	for i := 0; i < 10; i++ {
+		if sampled := set.SampleNWithRand(2, rand.New(rand.NewSource(42))); !sampled.Equal(expect) {
+			t.Errorf("unexpected sampled Set; want %v, got %v", expect, sampled)
+		}
 	}
 }
 
@@ -1583,6 +2773,54 @@ func Test_HashSet_Some_Nil(t *testing.T) {
 	}
 }
 
+func Test_HashSet_SortedAppendSlice(t *testing.T) {
+	testCases := map[string]struct {
+		set    *HashSet[int]
+		dst    []int
+		expect []int
+	}{
+		"with nil dst on non-empty *HashSet": {
+			set:    Hash(456, 123, 789),
+			dst:    nil,
+			expect: []int{789, 456, 123},
+		},
+		"with non-empty dst on non-empty *HashSet": {
+			set:    Hash(456, 123, 789),
+			dst:    []int{1, 999},
+			expect: []int{1, 999, 789, 456, 123},
+		},
+		"with nil dst on empty *HashSet": {
+			set:    Hash[int](),
+			dst:    nil,
+			expect: nil,
+		},
+		"with non-empty dst on empty *HashSet": {
+			set:    Hash[int](),
+			dst:    []int{1, 999},
+			expect: []int{1, 999},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ret := tc.set.SortedAppendSlice(tc.dst, Desc[int])
+			if !cmp.Equal(tc.expect, ret, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected slice; want %v, got %v", tc.expect, ret)
+			}
+		})
+	}
+}
+
+func Test_HashSet_SortedAppendSlice_Nil(t *testing.T) {
+	var set *HashSet[int]
+	dst := []int{1, 999}
+	ret := set.SortedAppendSlice(dst, Desc[int])
+
+	if !cmp.Equal(dst, ret) {
+		t.Errorf("unexpected slice; want %v, got %v", dst, ret)
+	}
+}
+
 func Test_HashSet_SortedJoin(t *testing.T) {
 	testCases := map[string]struct {
 		expect string
@@ -1620,6 +2858,54 @@ func Test_HashSet_SortedJoin_Nil(t *testing.T) {
 	}
 }
 
+func Test_HashSet_SortedRange(t *testing.T) {
+	testCases := map[string]struct {
+		expect []int
+		set    *HashSet[int]
+		stopAt int
+	}{
+		"with non-empty *HashSet[int]": {
+			expect: []int{789, 456, 345, 123, 12},
+			set:    Hash(456, 123, 789, 12, 345),
+			stopAt: -1,
+		},
+		"with non-empty *HashSet[int] and early stop": {
+			expect: []int{789, 456, 345},
+			set:    Hash(456, 123, 789, 12, 345),
+			stopAt: 2,
+		},
+		"with empty *HashSet[int]": {
+			expect: nil,
+			set:    Hash[int](),
+			stopAt: -1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var elements []int
+			tc.set.SortedRange(Desc[int], func(index int, element int) bool {
+				if index != len(elements) {
+					t.Errorf("unexpected index; want %v, got %v", len(elements), index)
+				}
+				elements = append(elements, element)
+				return index == tc.stopAt
+			})
+			if !cmp.Equal(tc.expect, elements, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected elements; want %v, got %v", tc.expect, elements)
+			}
+		})
+	}
+}
+
+func Test_HashSet_SortedRange_Nil(t *testing.T) {
+	var set *HashSet[int]
+	set.SortedRange(Desc[int], func(index int, element int) bool {
+		t.Errorf("unexpected call to iter with %v at index %v", element, index)
+		return false
+	})
+}
+
 func Test_HashSet_SortedSlice(t *testing.T) {
 	testCases := map[string]struct {
 		expect []int
@@ -1656,6 +2942,88 @@ func Test_HashSet_SortedSlice_Nil(t *testing.T) {
 	}
 }
 
+func Test_HashSet_StableRange(t *testing.T) {
+	set := Hash(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	var first []int
+	set.StableRange(func(element int) bool {
+		first = append(first, element)
+		return false
+	})
+	if diff := cmp.Diff(set.SortedSlice(Asc[int]), first, cmpopts.SortSlices(Asc[int])); diff != "" {
+		t.Fatalf("unexpected iterated elements (-want +got):\n%s", diff)
+	}
+	for i := 0; i < 10; i++ {
+		var elements []int
+		set.StableRange(func(element int) bool {
+			elements = append(elements, element)
+			return false
+		})
+		if diff := cmp.Diff(first, elements); diff != "" {
+			t.Fatalf("unexpected order of iterated elements (-want +got):\n%s", diff)
+		}
+	}
+
+	var callCount int
+	set.StableRange(func(element int) bool {
+		callCount++
+		return element == first[2]
+	})
+	if callCount != 3 {
+		t.Errorf("unexpected number of calls to iter; want 3, got %v", callCount)
+	}
+}
+
+func Test_HashSet_StableRange_Nil(t *testing.T) {
+	var set *HashSet[int]
+	var callCount int
+	set.StableRange(func(_ int) bool {
+		callCount++
+		return false
+	})
+	if callCount != 0 {
+		t.Errorf("unexpected number of calls to iter; want 0, got %v", callCount)
+	}
+}
+
+func Test_HashSet_ToMap(t *testing.T) {
+	testCases := map[string]struct {
+		expect map[int]struct{}
+		set    *HashSet[int]
+	}{
+		"with non-empty *HashSet": {
+			expect: map[int]struct{}{123: {}, 456: {}, 789: {}},
+			set:    Hash(123, 456, 789),
+		},
+		"with empty *HashSet": {
+			expect: map[int]struct{}{},
+			set:    Hash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := tc.set.ToMap()
+			if m == nil {
+				t.Fatal("unexpected map; want non-nil, got nil")
+			}
+			if !cmp.Equal(tc.expect, m) {
+				t.Errorf("unexpected map; want %v, got %v", tc.expect, m)
+			}
+			m[-1] = struct{}{}
+			if tc.set.Contains(-1) {
+				t.Error("unexpected Set containment of -1; want false, got true")
+			}
+		})
+	}
+}
+
+func Test_HashSet_ToMap_Nil(t *testing.T) {
+	var set *HashSet[int]
+	if m := set.ToMap(); m != nil {
+		t.Errorf("unexpected map; want nil, got %v", m)
+	}
+}
+
 func Test_HashSet_TryRange(t *testing.T) {
 	testError := errors.New("test")
 	testCases := map[string]struct {
@@ -2044,6 +3412,77 @@ func Test_HashSet_String_Nil(t *testing.T) {
 	assertSetString(t, set.String(), []string{})
 }
 
+func Test_HashSet_GobEncode(t *testing.T) {
+	testCases := map[string]struct {
+		set *HashSet[int]
+	}{
+		"with empty *HashSet": {
+			set: Hash[int](),
+		},
+		"with single element *HashSet": {
+			set: Hash(123),
+		},
+		"with multiple elements *HashSet": {
+			set: Hash(123, 456, 789),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := gob.NewEncoder(&buf).Encode(tc.set); err != nil {
+				t.Fatalf("unexpected error; want nil, got %q", err)
+			}
+			set := &HashSet[int]{}
+			if err := gob.NewDecoder(&buf).Decode(set); err != nil {
+				t.Fatalf("unexpected error; want nil, got %q", err)
+			}
+			if !set.Equal(tc.set) {
+				t.Errorf("unexpected decoded Set; want %v, got %v", tc.set, set)
+			}
+		})
+	}
+}
+
+func Test_HashSet_GobEncode_Field(t *testing.T) {
+	type wrapper struct {
+		Set *HashSet[string]
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(wrapper{Set: Hash("foo", "bar")}); err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	var decoded wrapper
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	if expect := Hash("foo", "bar"); !expect.Equal(decoded.Set) {
+		t.Errorf("unexpected decoded Set; want %v, got %v", expect, decoded.Set)
+	}
+}
+
+func Test_HashSet_GobEncode_Nil(t *testing.T) {
+	var set *HashSet[int]
+	data, err := set.GobEncode()
+	if err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	decoded := Hash(123)
+	if err = decoded.GobDecode(data); err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	if !decoded.IsEmpty() {
+		t.Errorf("unexpected decoded Set; want [], got %v", decoded)
+	}
+}
+
+func Test_HashSet_GobDecode_Error(t *testing.T) {
+	set := Hash(123)
+	if err := set.GobDecode([]byte("invalid")); err == nil {
+		t.Error("unexpected error; want non-nil, got nil")
+	}
+}
+
 func Test_HashSet_MarshalJSON(t *testing.T) {
 	testCases := map[string]struct {
 		expect []string
@@ -2130,3 +3569,151 @@ func Test_HashSet_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func Test_HashSet_UnmarshalJSON_Replace(t *testing.T) {
+	set := &HashSet[int]{}
+	if err := json.Unmarshal([]byte("[1,2,3]"), set); err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	if err := json.Unmarshal([]byte("[4,5]"), set); err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	if expect := Hash(4, 5); !set.Equal(expect) {
+		t.Errorf("unexpected unmarshalled Set; want %v, got %v", expect, set)
+	}
+}
+
+func Test_HashSet_Scan(t *testing.T) {
+	testCases := map[string]struct {
+		expect Set[int]
+		src    any
+	}{
+		"with string source": {
+			expect: Hash(1, 2, 3),
+			src:    "[1,2,3]",
+		},
+		"with []byte source": {
+			expect: Hash(1, 2, 3),
+			src:    []byte("[1,2,3]"),
+		},
+		"with string source for empty array": {
+			expect: Hash[int](),
+			src:    "[]",
+		},
+		"with string source for null": {
+			expect: Hash[int](),
+			src:    "null",
+		},
+		"with NULL source": {
+			expect: Hash[int](),
+			src:    nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Hash(4, 5)
+			if err := set.Scan(tc.src); err != nil {
+				t.Fatalf("unexpected error; want nil, got %q", err)
+			}
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected scanned Set; want %v, got %v", tc.expect, set)
+			}
+		})
+	}
+}
+
+func Test_HashSet_Scan_Error(t *testing.T) {
+	testCases := map[string]struct {
+		expectErr error
+		src       any
+	}{
+		"with unsupported source": {
+			expectErr: ErrScanSource,
+			src:       int64(123),
+		},
+		"with string source for invalid JSON": {
+			src: "[1,2",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := Hash(4, 5).Scan(tc.src)
+			if err == nil {
+				t.Error("unexpected nil error")
+			} else if tc.expectErr != nil && !errors.Is(err, tc.expectErr) {
+				t.Errorf("unexpected error; want %q, got %q", tc.expectErr, err)
+			}
+		})
+	}
+}
+
+func Test_HashSet_Value(t *testing.T) {
+	testCases := map[string]struct {
+		set *HashSet[int]
+	}{
+		"with empty *HashSet": {
+			set: Hash[int](),
+		},
+		"with single element *HashSet": {
+			set: Hash(123),
+		},
+		"with multiple elements *HashSet": {
+			set: Hash(123, 456, 789),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			value, err := tc.set.Value()
+			if err != nil {
+				t.Fatalf("unexpected error; want nil, got %q", err)
+			}
+			if !driver.IsValue(value) {
+				t.Fatalf("unexpected driver.Value; got %T", value)
+			}
+			set := &HashSet[int]{}
+			if err = set.Scan(value); err != nil {
+				t.Fatalf("unexpected error; want nil, got %q", err)
+			}
+			if !set.Equal(tc.set) {
+				t.Errorf("unexpected scanned Set; want %v, got %v", tc.set, set)
+			}
+		})
+	}
+}
+
+func Test_HashSet_Value_Nil(t *testing.T) {
+	var set *HashSet[int]
+	value, err := set.Value()
+	if err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	if value != nil {
+		t.Errorf("unexpected driver.Value; want nil, got %v", value)
+	}
+}
+
+func Benchmark_HashSet_Equal(b *testing.B) {
+	for _, size := range []int{16, 1024, 65536} {
+		elements := make([]int, size)
+		for i := range elements {
+			elements[i] = i
+		}
+		set := HashFromSlice(elements)
+		others := []Set[int]{
+			HashFromSlice(elements),
+			MutableHashFromSlice(elements),
+			SyncHashFromSlice(elements),
+		}
+		for _, other := range others {
+			b.Run(other.Kind().String()+"/"+strconv.Itoa(size), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					set.Equal(other)
+				}
+			})
+		}
+	}
+}
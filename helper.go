@@ -21,12 +21,22 @@
 package sets
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"github.com/neocotic/go-sets/internal"
 	"golang.org/x/exp/constraints"
+	"io"
+	"math/big"
+	"math/rand"
+	"sort"
 	"strconv"
 	"strings"
 )
 
+// DefaultTextSeparator is the separator used by MarshalSetText and UnmarshalSetText when one is not specified.
+const DefaultTextSeparator = ","
+
 const (
 	// collectionFlagMutable flags an internal.Collection as mutable.
 	collectionFlagMutable internal.CollectionFlag = 1 << iota
@@ -34,11 +44,148 @@ const (
 	collectionFlagSync
 )
 
+// maxPowerSetLen is the maximum number of elements for which PowerSet can allocate a slice containing every subset, as
+// the runtime limits the size of an allocation to 2^48 bytes on 64-bit platforms and 2^31 bytes on 32-bit platforms,
+// while each subset within the slice occupies 16 and 8 bytes respectively.
+const maxPowerSetLen = 28 + (strconv.IntSize-32)/2
+
+// canonicalEscaper escapes the separator, and the escape character itself, within strings used by canonical.
+var canonicalEscaper = strings.NewReplacer(`\`, `\\`, ",", `\,`)
+
+// Aggregate returns the final result of running the step function across all elements within the Set, starting with
+// the initial value, along with a new Set struct containing the same elements. This allows callers computing a summary
+// (e.g. a sum or a histogram) to also keep an independent snapshot of the Set in a single pass, avoiding a separate
+// call to Set.Clone.
+//
+// The order in which elements are passed to the step function is not guaranteed to be consistent.
+//
+// The returned struct implementation of Set is determined by important characteristics of the Set provided. That is; if
+// the Set is mutable, then the returned struct implementation of Set will also be mutable. Otherwise, it will be
+// immutable. Likewise for whether the Set is synchronized.
+//
+// If the Set is nil, Aggregate returns the initial value and nil.
+func Aggregate[E comparable, A any](set Set[E], init A, step func(acc A, element E) A) (A, Set[E]) {
+	if internal.IsNil(set) {
+		return init, createSet[E](nil, 0)
+	}
+	acc := init
+	hash := make(internal.Hash[E], set.Len())
+	set.Range(func(element E) bool {
+		acc = step(acc, element)
+		hash[element] = struct{}{}
+		return false
+	})
+	return acc, createSet(hash, flagSet[E](set))
+}
+
 // Asc is a convenient generic less function sorts in ascending order.
 func Asc[E constraints.Ordered](x, y E) bool {
 	return x < y
 }
 
+// CartesianProduct returns a new Set struct containing values returned by the combine function for every pair of
+// elements from the first and second Set, allowing all pairs to be enumerated. Equal values returned for different
+// pairs are naturally collapsed.
+//
+// The returned struct implementation of Set should match that of the first Set, where possible, but must never differ
+// in mutability, consistent with Map. As a SingletonSet may be combined with any number of elements, an immutable
+// HashSet is returned in its place.
+//
+// If the second Set is nil or contains no elements, the returned Set contains no elements.
+//
+// If the first Set is nil, CartesianProduct returns nil and the combine function is never called.
+func CartesianProduct[E comparable, F comparable, T comparable](
+	a Set[E],
+	b Set[F],
+	combine func(first E, second F) T,
+) Set[T] {
+	if a == nil {
+		return nil
+	}
+	switch v := a.(type) {
+	case *EmptySet[E]:
+		var product *EmptySet[T]
+		if v != nil {
+			product = &EmptySet[T]{}
+		}
+		return product
+	case *SyncHashSet[E]:
+		var product *SyncHashSet[T]
+		if v != nil {
+			product = &SyncHashSet[T]{elements: cartesianProduct[E, F, T](a, b, combine)}
+		}
+		return product
+	default:
+		if a.IsMutable() {
+			var product *MutableHashSet[T]
+			if internal.IsNotNil(a) {
+				product = &MutableHashSet[T]{elements: cartesianProduct[E, F, T](a, b, combine)}
+			}
+			return product
+		}
+		var product *HashSet[T]
+		if internal.IsNotNil(a) {
+			product = &HashSet[T]{elements: cartesianProduct[E, F, T](a, b, combine)}
+		}
+		return product
+	}
+}
+
+// Complement returns a new Set struct containing only elements of the universe Set that do not exist in the Set. Any
+// elements of the Set that do not exist in the universe Set are ignored.
+//
+// This is equivalent to calling Diff with the universe Set and the Set, however, it better conveys intent. As such, the
+// returned struct implementation of Set is determined by important characteristics of the universe Set. That is; if the
+// universe Set is mutable, then the returned struct implementation of Set will also be mutable. Otherwise, it will be
+// immutable. Likewise for whether the universe Set is synchronized.
+//
+// If the universe Set is nil, Complement returns nil.
+func Complement[E comparable](set Set[E], universe Set[E]) Set[E] {
+	return Diff(universe, set)
+}
+
+// ContainsNaN returns whether the Set contains any NaN element. Unlike Set.Contains, which always returns false for NaN
+// unless the Set is a FloatHashSet, this detects NaN elements within any Set by ranging over its elements.
+//
+// If the Set is nil, ContainsNaN returns false.
+func ContainsNaN[E constraints.Float](set Set[E]) bool {
+	if internal.IsNil(set) {
+		return false
+	}
+	return set.Some(isNaN[E])
+}
+
+// CountDistinct returns the number of distinct keys, as returned by the key function, for elements within the Set. For
+// example; this can be used to count how many categories are represented by the elements within the Set. Only a
+// temporary map of keys is used and no Set is built.
+//
+// If the Set is nil, CountDistinct returns zero.
+func CountDistinct[E comparable, K comparable](set Set[E], key func(element E) K) int {
+	if internal.IsNil(set) {
+		return 0
+	}
+	keys := make(map[K]struct{})
+	set.Range(func(element E) bool {
+		keys[key(element)] = struct{}{}
+		return false
+	})
+	return len(keys)
+}
+
+// DeleteNil removes any nil element from the MutableSet of pointers, which is a common data hygiene need when elements
+// originate from sources that may contain nil entries. As elements are constrained to pointers, no reflection is
+// required to identify a nil element.
+//
+// A reference to the MutableSet is returned for method chaining.
+//
+// If the MutableSet is nil, DeleteNil is a no-op.
+func DeleteNil[E any](set MutableSet[*E]) MutableSet[*E] {
+	if internal.IsNil(set) {
+		return set
+	}
+	return set.Delete(nil)
+}
+
 // Desc is a convenient generic less function sorts in descending order.
 func Desc[E constraints.Ordered](x, y E) bool {
 	return x > y
@@ -55,6 +202,39 @@ func Diff[E comparable](set Set[E], others ...Set[E]) Set[E] {
 	return internal.DiffAll[E, Set[E]](createSet[E], flagSet[E], set, asCollections(others))
 }
 
+// DiffBy returns a new Set struct containing only elements of Set a whose key, as returned by the key function, is not
+// the key of any element within Set b. This allows for the difference of Sets whose elements are records that are only
+// to be compared by a key field (e.g. an ID) rather than by their entire value.
+//
+// The key function is called once for each element within both Sets. Set a elements sharing a key are all retained or
+// all excluded together.
+//
+// Unlike Set.Diff, the return struct implementation of Set is determined by important characteristics of Set a. That
+// is; if Set a is mutable, then the returned struct implementation of Set will also be mutable. Otherwise, it will be
+// immutable. Likewise for whether Set a is synchronized.
+//
+// If Set b is nil it is treated as having no elements. If Set a is nil, DiffBy returns nil.
+func DiffBy[E comparable, K comparable](a Set[E], b Set[E], key func(element E) K) Set[E] {
+	if internal.IsNil(a) {
+		return createSet[E](nil, 0)
+	}
+	keys := make(map[K]struct{})
+	if internal.IsNotNil(b) {
+		b.Range(func(element E) bool {
+			keys[key(element)] = struct{}{}
+			return false
+		})
+	}
+	diff := make(internal.Hash[E])
+	a.Range(func(element E) bool {
+		if _, exists := keys[key(element)]; !exists {
+			diff[element] = struct{}{}
+		}
+		return false
+	})
+	return createSet[E](diff, flagSet[E](a))
+}
+
 // DiffSymmetric returns a new Set struct containing elements that exist within the Set or any other Set, but not in
 // more than one.
 //
@@ -67,6 +247,56 @@ func DiffSymmetric[E comparable](set Set[E], others ...Set[E]) Set[E] {
 	return internal.DiffSymmetricAll[E, Set[E]](createSet[E], flagSet[E], set, asCollections(others))
 }
 
+// Disjoint returns whether every Set is pairwise disjoint. That is; whether no element exists within more than one of
+// the sets. Each pair is checked using Set.IsDisjoint so no allocation is required.
+//
+// Any nil Set is treated as having no elements and so is disjoint from every other Set. If fewer than two sets are
+// provided, Disjoint returns true.
+func Disjoint[E comparable](sets ...Set[E]) bool {
+	for i, set := range sets {
+		if internal.IsNil(set) {
+			continue
+		}
+		for _, other := range sets[i+1:] {
+			if !set.IsDisjoint(other) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ElementsMatch returns the elements of the expected slice that are missing from the Set and the elements within the
+// Set that are not in the expected slice, as well as an indication of whether both are empty. This is intended to be
+// used within tests where an actionable diff is more useful than Equal.
+//
+// Elements within missing retain their order from the expected slice, with any duplicates only being reported once,
+// while the order of elements within extra is not guaranteed to be consistent. Both missing and extra are nil when
+// empty.
+//
+// If the Set is nil it is treated as having no elements.
+func ElementsMatch[E comparable](set Set[E], expected []E) (missing, extra []E, ok bool) {
+	expect := internal.FromSlice(expected)
+	if set == nil {
+		set = (*EmptySet[E])(nil)
+	}
+	set.Range(func(element E) bool {
+		if _, ok := expect[element]; !ok {
+			extra = append(extra, element)
+		}
+		return false
+	})
+	for _, element := range expected {
+		if _, pending := expect[element]; pending {
+			if !set.Contains(element) {
+				missing = append(missing, element)
+			}
+			delete(expect, element)
+		}
+	}
+	return missing, extra, len(missing) == 0 && len(extra) == 0
+}
+
 // Equal is a convenient shorthand for Set.Equal where the Set can be compared against one or more other Set.
 //
 // If the Set is nil it is treated as having no elements and the same logic applies to the others. To clarify; this
@@ -79,6 +309,169 @@ func Equal[E comparable](set Set[E], others ...Set[E]) bool {
 	return equalAll(set, others)
 }
 
+// EqualApprox returns whether the Set contains the same number of elements as the other Set and each of its elements
+// can be paired with a distinct element within the other Set whose absolute difference is no greater than epsilon. This
+// is useful as exact equality of floating-point numbers is often too fragile.
+//
+// Elements are paired after sorting both Set in ascending order. As elements are one-dimensional, this pairing
+// minimizes the greatest difference between any pair, so EqualApprox only returns false when no such pairing exists.
+// Since NaN is never within epsilon of any number, EqualApprox always returns false if either Set contains NaN.
+//
+// If either Set is nil it is treated as having no elements. To clarify; this means that a nil Set is approximately
+// equal to a non-nil Set that contains no elements.
+func EqualApprox[E constraints.Float](set, other Set[E], epsilon E) bool {
+	if set == nil {
+		set = (*EmptySet[E])(nil)
+	}
+	if other == nil {
+		other = (*EmptySet[E])(nil)
+	}
+	if set.Len() != other.Len() {
+		return false
+	}
+	isNaN := func(element E) bool { return element != element }
+	if set.Some(isNaN) || other.Some(isNaN) {
+		return false
+	}
+	elements, otherElements := set.SortedSlice(Asc[E]), other.SortedSlice(Asc[E])
+	for i, element := range elements {
+		diff := element - otherElements[i]
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff > epsilon {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualFunc returns whether the Set contains the same number of elements as the other Set and each of its elements can
+// be paired with a distinct element within the other Set for which the eq function returns true. This is useful where
+// exact equality is too strict (e.g. floating-point numbers within a tolerance or case-insensitive strings).
+//
+// As elements cannot be looked up using the eq function, every element within the Set may be compared with every
+// element within the other Set, making EqualFunc O(n·m) in calls to eq. The eq function need not be transitive since
+// a pairing is searched for rather than simply taking the first match for each element, however, that search adds
+// further cost when elements have many possible matches.
+//
+// If either Set is nil it is treated as having no elements. To clarify; this means that a nil Set is equal to a
+// non-nil Set that contains no elements.
+func EqualFunc[E comparable](set, other Set[E], eq func(x, y E) bool) bool {
+	if set == nil {
+		set = (*EmptySet[E])(nil)
+	}
+	if other == nil {
+		other = (*EmptySet[E])(nil)
+	}
+	if set.Len() != other.Len() {
+		return false
+	}
+	return pairable(set.Slice(), other.Slice(), eq)
+}
+
+// FilterMap returns a new Set struct containing the values returned by the mapper function for each element within
+// the Set, but only for those elements for which the mapper function also returns true. Equal values returned for
+// different elements are naturally collapsed.
+//
+// The returned struct implementation of Set should match that of the Set being mapped, where possible, but must never
+// differ in mutability. As a SingletonSet may be mapped to no values, an immutable HashSet is returned in its place.
+//
+// If the Set is nil, FilterMap returns nil and the mapper function is never called.
+func FilterMap[E comparable, T comparable](set Set[E], mapper func(element E) (T, bool)) Set[T] {
+	if set == nil {
+		return nil
+	}
+	switch v := set.(type) {
+	case *EmptySet[E]:
+		var mapped *EmptySet[T]
+		if v != nil {
+			mapped = &EmptySet[T]{}
+		}
+		return mapped
+	case *SyncHashSet[E]:
+		var mapped *SyncHashSet[T]
+		if v != nil {
+			mapped = &SyncHashSet[T]{elements: filterMap[E, T](set, mapper)}
+		}
+		return mapped
+	default:
+		if set.IsMutable() {
+			var mapped *MutableHashSet[T]
+			if internal.IsNotNil(set) {
+				mapped = &MutableHashSet[T]{elements: filterMap[E, T](set, mapper)}
+			}
+			return mapped
+		}
+		var mapped *HashSet[T]
+		if internal.IsNotNil(set) {
+			mapped = &HashSet[T]{elements: filterMap[E, T](set, mapper)}
+		}
+		return mapped
+	}
+}
+
+// FindDifferences returns the indices of each candidate Set that does not contain the exact same elements as the
+// reference Set, in the order in which they were provided. This can be useful when validating many Set against a
+// baseline. If every candidate Set is equal to the reference Set, FindDifferences returns nil.
+//
+// Equality is determined in the same way as Equal, meaning that a nil Set is treated as having no elements. To clarify;
+// this means that a nil candidate Set is only equal to a reference Set that contains no elements, and vice versa.
+func FindDifferences[E comparable](reference Set[E], candidates ...Set[E]) []int {
+	if reference == nil {
+		reference = (*EmptySet[E])(nil)
+	}
+	var indices []int
+	for i, candidate := range candidates {
+		if !reference.Equal(candidate) {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// FlatMap returns a new Set struct containing a union of all values returned by the mapper function for each element
+// within the Set, allowing each element to be expanded into any number of values. Equal values returned for different
+// elements are naturally collapsed.
+//
+// The returned struct implementation of Set should match that of the Set being mapped, where possible, but must never
+// differ in mutability. As a SingletonSet may be mapped to any number of values, an immutable HashSet is returned in
+// its place.
+//
+// If the Set is nil, FlatMap returns nil and the mapper function is never called.
+func FlatMap[E comparable, T comparable](set Set[E], mapper func(element E) []T) Set[T] {
+	if set == nil {
+		return nil
+	}
+	switch v := set.(type) {
+	case *EmptySet[E]:
+		var mapped *EmptySet[T]
+		if v != nil {
+			mapped = &EmptySet[T]{}
+		}
+		return mapped
+	case *SyncHashSet[E]:
+		var mapped *SyncHashSet[T]
+		if v != nil {
+			mapped = &SyncHashSet[T]{elements: flatMap[E, T](set, mapper)}
+		}
+		return mapped
+	default:
+		if set.IsMutable() {
+			var mapped *MutableHashSet[T]
+			if internal.IsNotNil(set) {
+				mapped = &MutableHashSet[T]{elements: flatMap[E, T](set, mapper)}
+			}
+			return mapped
+		}
+		var mapped *HashSet[T]
+		if internal.IsNotNil(set) {
+			mapped = &HashSet[T]{elements: flatMap[E, T](set, mapper)}
+		}
+		return mapped
+	}
+}
+
 // Group returns a map containing the elements within the Set grouped using the grouper function.
 //
 // The mapped struct implementations of Set are always immutable.
@@ -97,6 +490,111 @@ func Group[E comparable, G comparable](set Set[E], grouper func(element E) G) ma
 	return groups
 }
 
+// GroupKeysOf returns an immutable HashSet containing the keys of the map of groups, as returned by Group, which can be
+// useful for inspecting the structure of the groups.
+//
+// If the map is nil, GroupKeysOf returns an empty HashSet.
+func GroupKeysOf[K comparable, E comparable](groups map[K]Set[E]) *HashSet[K] {
+	keys := make(internal.Hash[K], len(groups))
+	for key := range groups {
+		keys[key] = struct{}{}
+	}
+	return &HashSet[K]{elements: keys}
+}
+
+// GroupMutable returns a map containing the elements within the Set grouped using the grouper function.
+//
+// Unlike Group, the mapped struct implementations of Set are always mutable (i.e. MutableHashSet) and are independent
+// of the Set, allowing each group to be modified without affecting the Set or any other group.
+//
+// If the Set is nil, GroupMutable returns nil and the grouper function is never called.
+func GroupMutable[E comparable, G comparable](set Set[E], grouper func(element E) G) map[G]MutableSet[E] {
+	if internal.IsNil(set) {
+		return nil
+	}
+	groups := make(map[G]MutableSet[E])
+	set.Range(func(element E) bool {
+		group := grouper(element)
+		if _, ok := groups[group]; !ok {
+			groups[group] = MutableHash[E]()
+		}
+		groups[group].Put(element)
+		return false
+	})
+	return groups
+}
+
+// GroupedSortedJoin sorts the elements within the Set using the provided less function and groups them using the
+// classify function before converting those elements into strings. Elements within the same group are joined using
+// sep and each group is then joined using groupSep to create the resulting string (e.g. "a,b | c,d").
+//
+// Groups are ordered by their class name in ascending order while elements within each group retain the order
+// determined by the less function.
+//
+// If the Set is nil, GroupedSortedJoin returns an empty string.
+func GroupedSortedJoin[E comparable](
+	set Set[E],
+	sep, groupSep string,
+	convert func(element E) string,
+	classify func(element E) string,
+	less func(x, y E) bool,
+) string {
+	if set == nil {
+		return ""
+	}
+	groups := make(map[string][]string)
+	for _, element := range set.SortedSlice(less) {
+		class := classify(element)
+		groups[class] = append(groups[class], convert(element))
+	}
+	classes := make([]string, 0, len(groups))
+	for class := range groups {
+		classes = append(classes, class)
+	}
+	sort.Strings(classes)
+	var sb strings.Builder
+	for i, class := range classes {
+		if i > 0 {
+			sb.WriteString(groupSep)
+		}
+		sb.WriteString(strings.Join(groups[class], sep))
+	}
+	return sb.String()
+}
+
+// HasNilElement returns whether the Set of pointers contains a nil element. As elements are constrained to pointers, no
+// reflection is required to identify a nil element.
+//
+// If the Set is nil, HasNilElement returns false.
+func HasNilElement[E any](set Set[*E]) bool {
+	if internal.IsNil(set) {
+		return false
+	}
+	return set.Contains(nil)
+}
+
+// IndexBy returns a map containing each item within the slice indexed by the value returned by the key function, where
+// items with equal keys are contained within the same HashSet. This is useful for building lookup indexes.
+//
+// If items is nil or empty, IndexBy returns an empty map.
+func IndexBy[T comparable, K comparable](items []T, key func(item T) K) map[K]*HashSet[T] {
+	hashes := make(map[K]internal.Hash[T])
+	for _, item := range items {
+		k := key(item)
+		hash, ok := hashes[k]
+		if !ok {
+			hash = make(internal.Hash[T])
+			hashes[k] = hash
+		}
+		hash[item] = struct{}{}
+	}
+	index := make(map[K]*HashSet[T], len(hashes))
+	for k, hash := range hashes {
+		index[k] = &HashSet[T]{elements: hash}
+	}
+	return index
+}
+
 // Intersection returns a new Set struct containing only elements of the Set that also exist in any other provided Set.
 //
 // Unlike Set.Intersection, the return struct implementation of Set is determined by important characteristics of the
@@ -108,6 +606,64 @@ func Intersection[E comparable](set Set[E], others ...Set[E]) Set[E] {
 	return internal.IntersectionAll[E, Set[E]](createSet[E], flagSet[E], set, asCollections(others))
 }
 
+// IntersectionCardinality returns the number of elements that exist within both Set without creating a new Set to
+// contain them, which avoids the allocation that Set.Intersection incurs when only the count is needed. Only the
+// elements within the smaller of the two are iterated, with each being checked for within the larger.
+//
+// Any nil Set is treated as having no elements.
+func IntersectionCardinality[E comparable](a, b Set[E]) int {
+	intersection, _ := cardinality(a, b)
+	return intersection
+}
+
+// IntersectionChannel returns a new Set struct containing only elements that exist within every Set received from the
+// channel until it is closed, allowing Set to be aggregated as they are streamed from concurrent producers. Any nil Set
+// received from the channel is skipped.
+//
+// Once the intersection is empty, no further elements can be contained within it, however, IntersectionChannel will
+// continue to drain the channel until it is closed without inspecting any further Set, so that producers are never left
+// blocked.
+//
+// Like Intersection, the return struct implementation of Set is determined by important characteristics of the first
+// non-nil Set received. That is; if that Set is mutable, then the returned struct implementation of Set will also be
+// mutable. Otherwise, it will be immutable. Likewise for whether that Set is synchronized.
+//
+// If no non-nil Set is received before the channel is closed, IntersectionChannel returns nil.
+func IntersectionChannel[E comparable](ch <-chan Set[E]) Set[E] {
+	var (
+		flags internal.CollectionFlag
+		hash  internal.Hash[E]
+	)
+	for set := range ch {
+		if internal.IsNil(set) {
+			continue
+		}
+		if hash == nil {
+			flags = flagSet[E](set)
+			hash = internal.Union[E](set, nil)
+		} else if len(hash) > 0 {
+			internal.DeleteWhere(hash, func(element E) bool { return !set.Contains(element) })
+		}
+	}
+	return createSet(hash, flags)
+}
+
+// JaccardIndex returns the Jaccard similarity coefficient of both Set. That is; the number of elements within their
+// intersection divided by the number of elements within their union, resulting in a value between zero, where the Set
+// have no elements in common, and one, where they are equal. Both are counted using IntersectionCardinality and
+// UnionCardinality so no Set is created.
+//
+// By convention, if both Set contain no elements, JaccardIndex returns one.
+//
+// Any nil Set is treated as having no elements.
+func JaccardIndex[E comparable](a, b Set[E]) float64 {
+	intersection, union := cardinality(a, b)
+	if union == 0 {
+		return 1
+	}
+	return float64(intersection) / float64(union)
+}
+
 // JoinBool is a convenient shorthand for Set.Join where the generic type is a bool, replacing the need for a convert
 // function to be provided for casting each element to a string with strconv.FormatBool.
 //
@@ -121,6 +677,54 @@ func JoinBool[E ~bool](set Set[E], sep string) string {
 	})
 }
 
+// JoinByte is a convenient shorthand for Set.Join where the generic type is a byte, replacing the need for a convert
+// function to be provided for casting each element to a string with strconv.FormatUint which can be controlled by
+// passing options.
+//
+// By default, the elements are formatted using base-10 and are not sorted. Passing a sorting option (e.g.
+// WithUintSortingAsc) sorts the elements accordingly.
+//
+// If the Set is nil, JoinByte returns an empty string.
+func JoinByte[E ~byte](set Set[E], sep string, opts ...JoinUintOption) string {
+	if set == nil {
+		return ""
+	}
+	o := applyJoinUintOptions(opts)
+	if o.sorted {
+		return set.SortedJoin(sep, getUintStringConverter[E](o), func(x, y E) bool {
+			return o.less(uint64(x), uint64(y))
+		})
+	}
+	return set.Join(sep, getUintStringConverter[E](o))
+}
+
+// JoinBytes is a convenient function for joining the elements of a Set of bytes as individual bytes, similar to a dump
+// of a byte slice. The elements are sorted and each is formatted with strconv.FormatUint before being zero-padded to
+// the width of the largest byte in the same base, so that every element has the same width. This means an empty sep can
+// be used to produce, for example, a hex string when combined with WithUintBase(16). Both the base and sorting can be
+// controlled by passing options.
+//
+// By default, the elements are formatted using base-10 and sorted in ascending order.
+//
+// If the Set is nil, JoinBytes returns an empty string.
+func JoinBytes[E ~byte](set Set[E], sep string, opts ...JoinUintOption) string {
+	if set == nil {
+		return ""
+	}
+	o := applyJoinUintOptions(opts)
+	width := len(strconv.FormatUint(255, o.base))
+	convert := getUintStringConverter[E](o)
+	return set.SortedJoin(sep, func(element E) string {
+		s := convert(element)
+		if pad := width - len(s); pad > 0 {
+			s = strings.Repeat("0", pad) + s
+		}
+		return s
+	}, func(x, y E) bool {
+		return o.less(uint64(x), uint64(y))
+	})
+}
+
 // JoinComplex64 is a convenient shorthand for Set.Join where the generic type is a complex64, replacing the need for a
 // convert function to be provided for casting each element to a string with strconv.FormatComplex which can be
 // controlled by passing options.
@@ -187,9 +791,10 @@ func JoinFloat64[E ~float64](set Set[E], sep string, opts ...JoinFloatOption) st
 
 // JoinInt is a convenient shorthand for Set.Join where the generic type is a signed integer, replacing the need for a
 // convert function to be provided for casting each element to a string with strconv.FormatInt which can be controlled
-// by passing options (excluding sorting options).
+// by passing options.
 //
-// By default, the elements are formatted using base-10.
+// By default, the elements are formatted using base-10 and are not sorted. Passing a sorting option (e.g.
+// WithIntSortingAsc) sorts the elements accordingly, making JoinInt equivalent to SortedJoinInt.
 //
 // If the Set is nil, JoinInt returns an empty string.
 func JoinInt[E constraints.Signed](set Set[E], sep string, opts ...JoinIntOption) string {
@@ -197,9 +802,36 @@ func JoinInt[E constraints.Signed](set Set[E], sep string, opts ...JoinIntOption
 		return ""
 	}
 	o := applyJoinIntOptions(opts)
+	if o.sorted {
+		return set.SortedJoin(sep, getIntStringConverter[E](o), func(x, y E) bool {
+			return o.less(int64(x), int64(y))
+		})
+	}
 	return set.Join(sep, getIntStringConverter[E](o))
 }
 
+// JoinNonZero is a convenient shorthand for Set.Join where the element that is the zero value for E (e.g. 0 or "") is
+// omitted from the resulting string, as it is often not desirable to display it. This only affects the resulting
+// string and so the element is neither removed from the Set nor otherwise treated as not being contained within it.
+//
+// The order of elements within the resulting string is not guaranteed to be consistent.
+//
+// If the Set is nil, JoinNonZero returns an empty string.
+func JoinNonZero[E comparable](set Set[E], sep string, convert func(element E) string) string {
+	if set == nil {
+		return ""
+	}
+	var zero E
+	elements := make([]E, 0, set.Len())
+	set.Range(func(element E) bool {
+		if element != zero {
+			elements = append(elements, element)
+		}
+		return false
+	})
+	return joinSlice(elements, sep, convert)
+}
+
 // JoinRune is a convenient shorthand for Set.Join where the generic type is a rune, removing the need for a convert
 // function to be provided for casting each element to a string (excluding sorting options).
 //
@@ -246,11 +878,26 @@ func JoinString[E ~string](set Set[E], sep string) string {
 	return sb.String()
 }
 
+// JoinStringer is a convenient shorthand for Set.Join where the generic type implements fmt.Stringer, removing the need
+// for a convert function to be provided for converting each element into a string using its String method.
+//
+// If the Set is nil, JoinStringer returns an empty string.
+func JoinStringer[E interface {
+	comparable
+	fmt.Stringer
+}](set Set[E], sep string) string {
+	if set == nil {
+		return ""
+	}
+	return set.Join(sep, stringerConverter[E])
+}
+
 // JoinUint is a convenient shorthand for Set.Join where the generic type is an unsigned integer, replacing the need for
 // a convert function to be provided for casting each element to a string with strconv.FormatUint which can be
-// controlled by passing options (excluding sorting options).
+// controlled by passing options.
 //
-// By default, the elements are formatted using base-10.
+// By default, the elements are formatted using base-10 and are not sorted. Passing a sorting option (e.g.
+// WithUintSortingAsc) sorts the elements accordingly, making JoinUint equivalent to SortedJoinUint.
 //
 // If the Set is nil, JoinUint returns an empty string.
 func JoinUint[E constraints.Unsigned](set Set[E], sep string, opts ...JoinUintOption) string {
@@ -258,9 +905,60 @@ func JoinUint[E constraints.Unsigned](set Set[E], sep string, opts ...JoinUintOp
 		return ""
 	}
 	o := applyJoinUintOptions(opts)
+	if o.sorted {
+		return set.SortedJoin(sep, getUintStringConverter[E](o), func(x, y E) bool {
+			return o.less(uint64(x), uint64(y))
+		})
+	}
 	return set.Join(sep, getUintStringConverter[E](o))
 }
 
+// JoinWrap is a convenient shorthand for Set.Join where each converted element is wrapped with prefix and suffix before
+// being joined (e.g. producing "[a], [b]" or quoting elements).
+//
+// The order of elements within the resulting string is not guaranteed to be consistent. SortedJoinWrap should be used
+// instead for such cases where consistent ordering is required.
+//
+// If the Set is nil, JoinWrap returns an empty string.
+func JoinWrap[E comparable](set Set[E], sep, prefix, suffix string, convert func(element E) string) string {
+	if set == nil {
+		return ""
+	}
+	return set.Join(sep, wrapStringConverter(prefix, suffix, convert))
+}
+
+// MajorityUnion returns a new HashSet struct containing only elements that exist within at least threshold of the
+// given Set. This is a voting (or quorum) operation, which can be useful for consensus and fuzzy matching.
+//
+// A threshold of one or less is equivalent to a union of all given Set, while a threshold of len(sets) or more is
+// equivalent to an intersection of all given Set.
+//
+// Any nil Set is treated as having no elements but still counts towards len(sets). If no Set is given, an empty
+// HashSet is returned.
+func MajorityUnion[E comparable](threshold int, sets ...Set[E]) *HashSet[E] {
+	if threshold < 1 {
+		threshold = 1
+	} else if threshold > len(sets) {
+		threshold = len(sets)
+	}
+	counts := make(map[E]int)
+	for _, set := range sets {
+		if internal.IsNotNil(set) {
+			set.Range(func(element E) bool {
+				counts[element]++
+				return false
+			})
+		}
+	}
+	hash := make(internal.Hash[E])
+	for element, count := range counts {
+		if count >= threshold {
+			hash[element] = struct{}{}
+		}
+	}
+	return &HashSet[E]{elements: hash}
+}
+
 // Map returns a new Set struct containing values converted from elements within the Set using the mapper function.
 //
 // The returned struct implementation of Set should match that of the Set being mapped, where possible, but must never
@@ -281,13 +979,13 @@ func Map[E comparable, T comparable](set Set[E], mapper func(element E) T) Set[T
 	case *HashSet[E]:
 		var mapped *HashSet[T]
 		if v != nil {
-			mapped = &HashSet[T]{internal.Map[E, T](set, mapper)}
+			mapped = &HashSet[T]{elements: internal.Map[E, T](set, mapper)}
 		}
 		return mapped
 	case *MutableHashSet[E]:
 		var mapped *MutableHashSet[T]
 		if v != nil {
-			mapped = &MutableHashSet[T]{internal.Map[E, T](set, mapper)}
+			mapped = &MutableHashSet[T]{elements: internal.Map[E, T](set, mapper)}
 		}
 		return mapped
 	case *SingletonSet[E]:
@@ -306,18 +1004,81 @@ func Map[E comparable, T comparable](set Set[E], mapper func(element E) T) Set[T
 		if set.IsMutable() {
 			var mapped *MutableHashSet[T]
 			if internal.IsNotNil(set) {
-				mapped = &MutableHashSet[T]{internal.Map[E, T](set, mapper)}
+				mapped = &MutableHashSet[T]{elements: internal.Map[E, T](set, mapper)}
 			}
 			return mapped
 		}
 		var mapped *HashSet[T]
 		if internal.IsNotNil(set) {
-			mapped = &HashSet[T]{internal.Map[E, T](set, mapper)}
+			mapped = &HashSet[T]{elements: internal.Map[E, T](set, mapper)}
 		}
 		return mapped
 	}
 }
 
+// MapSlice returns a slice containing values converted from elements within the Set using the mapper function, where
+// one value is included for each element. Unlike Map, equal values are not collapsed and the values are not required
+// to be comparable, which is useful for cases where the mapped type is not suitable for a Set or where all values are
+// wanted.
+//
+// The order of values within the resulting slice is not guaranteed to be consistent.
+//
+// If the Set is nil, MapSlice returns nil.
+func MapSlice[E comparable, T any](set Set[E], mapper func(element E) T) []T {
+	if internal.IsNil(set) {
+		return nil
+	}
+	values := make([]T, 0, set.Len())
+	set.Range(func(element E) bool {
+		values = append(values, mapper(element))
+		return false
+	})
+	return values
+}
+
+// MarshalJSONSorted returns a JSON array containing the elements within the Set sorted using the provided less
+// function. Unlike Set.MarshalJSON, where the order of elements is not guaranteed to be consistent for most
+// implementations, the output is deterministic which can be useful for reproducible fixtures (e.g. snapshot tests) or
+// diffing.
+//
+// If the Set is nil, MarshalJSONSorted returns a JSON null, the same as Set.MarshalJSON.
+func MarshalJSONSorted[E comparable](set Set[E], less func(x, y E) bool) ([]byte, error) {
+	if internal.IsNil(set) {
+		return internal.MarshalJSONNil()
+	}
+	return json.Marshal(set.SortedSlice(less))
+}
+
+// MarshalSetText returns a text representation of the string elements within the Set, sorted in ascending order and
+// joined using the specified separator, which can be parsed back into an equal Set using UnmarshalSetText. This is
+// useful for implementing encoding.TextMarshaler on types that wrap a Set (e.g. for use as a flag or within config). If
+// sep is empty, DefaultTextSeparator is used.
+//
+// As no escaping is performed, an ErrTextElement is returned if any element is empty or contains sep, as it could not
+// otherwise be parsed back into the same element.
+//
+// If the Set is nil, MarshalSetText returns an empty slice.
+func MarshalSetText[E ~string](set Set[E], sep string) ([]byte, error) {
+	if internal.IsNil(set) {
+		return []byte{}, nil
+	}
+	if sep == "" {
+		sep = DefaultTextSeparator
+	}
+	elements := set.SortedSlice(Asc[E])
+	var b strings.Builder
+	for i, element := range elements {
+		if element == "" || strings.Contains(string(element), sep) {
+			return nil, fmtErrTextElement(string(element), sep)
+		}
+		if i > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(string(element))
+	}
+	return []byte(b.String()), nil
+}
+
 // Max is a convenient shorthand for Set.Max where the generic type is ordered, removing the need for a less function to
 // be provided to control sorting.
 //
@@ -330,6 +1091,30 @@ func Max[E constraints.Ordered](set Set[E]) (E, bool) {
 	return set.Max(Asc[E])
 }
 
+// MaxBy returns the element within the Set whose key, as derived using the key function, is the maximum, removing the
+// need for a less function to be provided when comparing elements by a field or other projection.
+//
+// Iteration order is not guaranteed to be consistent so, where multiple elements share the maximum key, any of those
+// elements may be returned.
+//
+// If the Set is nil, MaxBy returns the zero value for E and false.
+func MaxBy[E comparable, K constraints.Ordered](set Set[E], key func(element E) K) (E, bool) {
+	var (
+		max    E
+		maxKey K
+		ok     bool
+	)
+	if set != nil {
+		set.Range(func(element E) bool {
+			if elementKey := key(element); !ok || maxKey < elementKey {
+				max, maxKey, ok = element, elementKey, true
+			}
+			return false
+		})
+	}
+	return max, ok
+}
+
 // Min is a convenient shorthand for Set.Min where the generic type is ordered, removing the need for a less function to
 // be provided to control sorting.
 //
@@ -342,6 +1127,87 @@ func Min[E constraints.Ordered](set Set[E]) (E, bool) {
 	return set.Min(Asc[E])
 }
 
+// MinBy returns the element within the Set whose key, as derived using the key function, is the minimum, removing the
+// need for a less function to be provided when comparing elements by a field or other projection.
+//
+// Iteration order is not guaranteed to be consistent so, where multiple elements share the minimum key, any of those
+// elements may be returned.
+//
+// If the Set is nil, MinBy returns the zero value for E and false.
+func MinBy[E comparable, K constraints.Ordered](set Set[E], key func(element E) K) (E, bool) {
+	var (
+		min    E
+		minKey K
+		ok     bool
+	)
+	if set != nil {
+		set.Range(func(element E) bool {
+			if elementKey := key(element); !ok || elementKey < minKey {
+				min, minKey, ok = element, elementKey, true
+			}
+			return false
+		})
+	}
+	return min, ok
+}
+
+// PowerSet returns a slice containing every subset of the Set, including one containing no elements and one containing
+// all elements, which can be useful for combinatorial tests. Each subset is created using Set.Filter so its struct
+// implementation of Set matches that of the Set, where possible, and never differs in mutability.
+//
+// As a Set containing n elements has 2^n subsets, both the time taken and memory used grow exponentially, so PowerSet
+// is only practical for a Set containing few elements (e.g. a Set containing 20 elements has over a million subsets).
+// PowerSet panics with an error wrapping ErrPowerSetLen if the Set contains too many elements for the slice of subsets
+// to ever be allocated (i.e. more than 44 elements on 64-bit platforms, or 28 on 32-bit platforms).
+//
+// As NaN is never equal to itself, all NaN elements within the Set are treated as a single element, so they are either
+// all included within a subset or all excluded from it.
+//
+// The order of subsets within the returned slice is not guaranteed to be consistent, unless the Set iterates over its
+// elements in a consistent order.
+//
+// If the Set is nil, PowerSet returns nil.
+func PowerSet[E comparable](set Set[E]) []Set[E] {
+	if internal.IsNil(set) {
+		return nil
+	}
+	elements := set.Slice()
+	index := make(map[E]int, len(elements))
+	var containsNaN bool
+	for _, element := range elements {
+		if isNaN(element) {
+			containsNaN = true
+		} else {
+			index[element] = len(index)
+		}
+	}
+	n, nanIndex := len(index), len(index)
+	if containsNaN {
+		n++
+	}
+	if n > maxPowerSetLen {
+		panic(fmtErrPowerSetLen(n))
+	}
+	subsets := make([]Set[E], 1<<n)
+	for mask := range subsets {
+		subsets[mask] = set.Filter(func(element E) bool {
+			if isNaN(element) {
+				return mask&(1<<nanIndex) != 0
+			}
+			return mask&(1<<index[element]) != 0
+		})
+	}
+	return subsets
+}
+
+// Product is a convenient shorthand for Reduce that returns the product of all elements within the Set, removing the
+// need for a reducer function to be provided. Integer overflow wraps around, as with Go's arithmetic operators.
+//
+// If the Set is nil or contains no elements, Product returns one.
+func Product[E constraints.Integer | constraints.Float](set Set[E]) E {
+	return Reduce(set, func(acc E, element E) E { return acc * element }, 1)
+}
+
 // Reduce returns the final result of running the reducer function across all elements within the Set as a single value.
 //
 // Optionally, an initial value can be specified. Otherwise, the zero value of R is used.
@@ -361,6 +1227,53 @@ func Reduce[E comparable, R any](set Set[E], reducer func(acc R, element E) R, i
 	return acc
 }
 
+// Relate returns a map containing each element within the Set mapped to a Set containing every other element within
+// the Set for which the related function returns true, where the mapped element is passed as x and the other element
+// as y. This can be useful for building adjacency structures (e.g. which elements conflict with each other).
+//
+// The related function is not assumed to be symmetric so, unless it is, an element being related to another does not
+// mean that the other element is related to it. Since every element is compared against every other element, Relate
+// has a time complexity of O(n²) so should be used sparingly on large sets.
+//
+// The mapped struct implementations of Set are always immutable.
+//
+// If the Set is nil, Relate returns nil.
+func Relate[E comparable](set Set[E], related func(x, y E) bool) map[E]Set[E] {
+	if internal.IsNil(set) {
+		return nil
+	}
+	elements := set.Slice()
+	relations := make(map[E]Set[E], len(elements))
+	for _, x := range elements {
+		hash := make(internal.Hash[E])
+		for _, y := range elements {
+			if x != y && related(x, y) {
+				hash[y] = struct{}{}
+			}
+		}
+		relations[x] = &HashSet[E]{elements: hash}
+	}
+	return relations
+}
+
+// SortedJoin is a convenient shorthand for Set.SortedJoin where the generic type is ordered, removing the need for a
+// less function to be provided to control sorting. However, a less function can still be passed optionally for more
+// granular control over sorting.
+//
+// If the Set is nil, SortedJoin returns an empty string.
+func SortedJoin[E constraints.Ordered](
+	set Set[E],
+	sep string,
+	convert func(element E) string,
+	less ...func(x, y E) bool,
+) string {
+	if set == nil {
+		return ""
+	}
+	_less := unwrapLess(less)
+	return set.SortedJoin(sep, convert, _less)
+}
+
 // SortedJoinFloat32 is a convenient shorthand for Set.Join where the generic type is a float32, removing the need for a
 // less function to be provided for sorting elements and replacing the need for a convert function to be provided for
 // casting each element to a string with strconv.FormatFloat which can be controlled by passing options.
@@ -460,6 +1373,21 @@ func SortedJoinString[E ~string](set Set[E], sep string, opts ...SortedJoinStrin
 	return sb.String()
 }
 
+// SortedJoinStringer is a convenient shorthand for Set.SortedJoin where the generic type implements fmt.Stringer,
+// removing the need for a convert function to be provided for converting each element into a string using its String
+// method.
+//
+// If the Set is nil, SortedJoinStringer returns an empty string.
+func SortedJoinStringer[E interface {
+	comparable
+	fmt.Stringer
+}](set Set[E], sep string, less func(x, y E) bool) string {
+	if set == nil {
+		return ""
+	}
+	return set.SortedJoin(sep, stringerConverter[E], less)
+}
+
 // SortedJoinUint is a convenient shorthand for Set.Join where the generic type is an unsigned integer, removing the
 // need for a less function to be provided for sorting elements and replacing the need for a convert function to be
 // provided for casting each element to a string with strconv.FormatUint which can be controlled by passing options.
@@ -477,6 +1405,37 @@ func SortedJoinUint[E constraints.Unsigned](set Set[E], sep string, opts ...Join
 	})
 }
 
+// SortedJoinWrap is a convenient shorthand for Set.SortedJoin where each converted element is wrapped with prefix and
+// suffix before being joined (e.g. producing "[a], [b]" or quoting elements).
+//
+// If the Set is nil, SortedJoinWrap returns an empty string.
+func SortedJoinWrap[E comparable](
+	set Set[E],
+	sep, prefix, suffix string,
+	convert func(element E) string,
+	less func(x, y E) bool,
+) string {
+	if set == nil {
+		return ""
+	}
+	return set.SortedJoin(sep, wrapStringConverter(prefix, suffix, convert), less)
+}
+
+// SortedMerge returns a slice containing all unique elements from across each Set sorted using the provided less
+// function. This is a convenient shorthand for the common combination of Union and Set.SortedSlice, however, the union
+// is only sorted once and no intermediate Set is created.
+//
+// Any nil Set is skipped. If no Set contains any elements, SortedMerge returns an empty slice.
+func SortedMerge[E comparable](less func(x, y E) bool, sets ...Set[E]) []E {
+	hash := make(internal.Hash[E])
+	for _, set := range sets {
+		if internal.IsNotNil(set) {
+			internal.PutAll[E](hash, set)
+		}
+	}
+	return internal.SortedSlice(hash, less)
+}
+
 // SortedSlice is a convenient shorthand for Set.SortedSlice where the generic type is ordered, removing the need for a
 // less function to be provided to control sorting. However, a less function can still be passed optionally for more
 // granular control over sorting.
@@ -486,8 +1445,198 @@ func SortedSlice[E constraints.Ordered](set Set[E], less ...func(x, y E) bool) [
 	if set == nil {
 		return nil
 	}
-	_less := unwrapLess(less)
-	return set.SortedSlice(_less)
+	_less := unwrapLess(less)
+	return set.SortedSlice(_less)
+}
+
+// Split returns n new Set structs that partition the elements within the Set into roughly equal sizes, where the number
+// of elements within any two partitions differs by at most one. This can be useful for sharding work across multiple
+// goroutines.
+//
+// The partition to which each element is assigned is not guaranteed to be consistent. If n is not less than the number
+// of elements within the Set, each element is contained within its own partition and any remaining partitions are
+// empty.
+//
+// The returned struct implementations of Set will match the mutability of the Set provided. That is; if the Set is
+// mutable, then each partition will also be mutable. Otherwise, each partition will be immutable. Likewise for whether
+// the Set is synchronized.
+//
+// If the Set is nil or n is not positive, Split returns nil.
+func Split[E comparable](set Set[E], n int) []Set[E] {
+	if internal.IsNil(set) || n <= 0 {
+		return nil
+	}
+	hashes := make([]internal.Hash[E], n)
+	size := (set.Len() + n - 1) / n
+	for i := range hashes {
+		hashes[i] = make(internal.Hash[E], size)
+	}
+	var i int
+	set.Range(func(element E) bool {
+		hashes[i%n][element] = struct{}{}
+		i++
+		return false
+	})
+	flags := flagSet[E](set)
+	partitions := make([]Set[E], n)
+	for i, hash := range hashes {
+		partitions[i] = createSet(hash, flags)
+	}
+	return partitions
+}
+
+// StreamDedup returns a channel that forwards each element received from the in channel, unless an equal element was
+// forwarded within the last window distinct elements to have been forwarded. This is useful for de-duplicating
+// elements within a pipeline (e.g. logs or events).
+//
+// Once more than window distinct elements have been forwarded, the earliest forwarded element is evicted, after which
+// an equal element will be forwarded again. Receiving an element that is dropped does not affect when it is evicted.
+// If window is not positive, no element is ever evicted and so each distinct element is only ever forwarded once.
+//
+// The returned channel is closed once the in channel is closed or the context is done, whichever happens first. Until
+// then, elements are only received from the in channel as quickly as they are received from the returned channel, so
+// callers must either keep receiving from the returned channel until it is closed or cancel the context. Otherwise,
+// the goroutine forwarding elements, along with anything sending to the in channel, blocks forever.
+func StreamDedup[E comparable](ctx context.Context, in <-chan E, window int) <-chan E {
+	out := make(chan E)
+	go func() {
+		defer close(out)
+		seen := make(internal.Hash[E])
+		var queue []E
+		for {
+			var element E
+			select {
+			case <-ctx.Done():
+				return
+			case _element, ok := <-in:
+				if !ok {
+					return
+				}
+				element = _element
+			}
+			if _, ok := seen[element]; ok {
+				continue
+			}
+			seen[element] = struct{}{}
+			if window > 0 {
+				queue = append(queue, element)
+				if len(queue) > window {
+					delete(seen, queue[0])
+					var zero E
+					queue[0] = zero
+					queue = queue[1:]
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case out <- element:
+			}
+		}
+	}()
+	return out
+}
+
+// Sum is a convenient shorthand for Reduce that returns the sum of all elements within the Set, removing the need for a
+// reducer function to be provided. Integer overflow wraps around, as with Go's arithmetic operators.
+//
+// If the Set is nil or contains no elements, Sum returns zero.
+func Sum[E constraints.Integer | constraints.Float](set Set[E]) E {
+	return Reduce(set, func(acc E, element E) E { return acc + element })
+}
+
+// SymmetricParts returns the two asymmetric differences between Set a and Set b; a new Set struct containing only
+// elements of a that do not exist in b, and another containing only elements of b that do not exist in a. Together,
+// they form the symmetric difference of both Set (i.e. DiffSymmetric), however, they are often useful individually
+// (e.g. when determining which elements were added and removed).
+//
+// The returned struct implementations of Set are determined by important characteristics of the Set from which they
+// were derived, in the same way as Diff.
+//
+// If either Set is nil, the difference derived from it is also nil.
+func SymmetricParts[E comparable](a, b Set[E]) (aMinusB, bMinusA Set[E]) {
+	return Diff(a, b), Diff(b, a)
+}
+
+// Sync mutates the current MutableSet in place so that it contains the exact same elements as the target Set,
+// returning how many elements were added and removed. This is useful for reconciling a live Set to a desired state with
+// minimal churn as elements that exist within both are left untouched.
+//
+// If the current MutableSet is a SyncHashSet, all mutations are performed within a single SyncHashSet.Transaction so
+// no intermediate state is observable by other goroutines. The target Set is cloned before the write lock is acquired
+// so that it is never held while waiting on the target Set, should it also be a SyncHashSet.
+//
+// If the target Set is nil it is treated as having no elements and so all elements are removed from the current
+// MutableSet.
+//
+// If the current MutableSet is nil, Sync is a no-op and returns zero for both.
+func Sync[E comparable](current MutableSet[E], target Set[E]) (added, removed int) {
+	if internal.IsNil(current) || Set[E](current) == target {
+		return 0, 0
+	}
+	if s, ok := current.(*SyncHashSet[E]); ok {
+		var elements Set[E]
+		if internal.IsNotNil(target) {
+			elements = target.Clone()
+		}
+		s.Transaction(func(tx MutableSet[E]) {
+			added, removed = applySync[E](tx, elements)
+		})
+		return added, removed
+	}
+	return applySync[E](current, target)
+}
+
+// TaggedUnion returns an immutable HashSet containing a union of Set a and Set b, where each element is wrapped in a
+// Tagged struct that records whether it exists within Set a, Set b, or both. This can be useful for reporting.
+//
+// A nil Set is treated as having no elements.
+func TaggedUnion[E comparable](a, b Set[E]) *HashSet[Tagged[E]] {
+	hash := make(internal.Hash[Tagged[E]])
+	if internal.IsNotNil(a) {
+		a.Range(func(element E) bool {
+			inB := internal.IsNotNil(b) && b.Contains(element)
+			hash[Tagged[E]{Value: element, InA: true, InB: inB}] = struct{}{}
+			return false
+		})
+	}
+	if internal.IsNotNil(b) {
+		b.Range(func(element E) bool {
+			if internal.IsNil(a) || !a.Contains(element) {
+				hash[Tagged[E]{Value: element, InB: true}] = struct{}{}
+			}
+			return false
+		})
+	}
+	return &HashSet[Tagged[E]]{elements: hash}
+}
+
+// ToDelimited converts the elements within the Set to strings which are then concatenated to create a single string,
+// placing sep between the converted elements in the resulting string. It is the inverse of HashFromDelimited (and its
+// variants), which can be useful for serializing a Set into configuration (e.g. an environment variable).
+//
+// If sorted is true, the converted elements are sorted in ascending lexicographic order before being joined so that the
+// resulting string is deterministic (e.g. for configuration files under version control). Otherwise, the order of
+// elements within the resulting string is not guaranteed to be consistent.
+//
+// No escaping is performed, so the resulting string can only be parsed back into an equal Set if no converted element
+// contains sep, has surrounding whitespace, or is empty, as HashFromDelimited would split, trim, or skip it
+// respectively.
+//
+// If the Set is nil, ToDelimited returns an empty string.
+func ToDelimited[E comparable](set Set[E], sep string, convert func(element E) string, sorted bool) string {
+	if internal.IsNil(set) {
+		return ""
+	}
+	converted := make([]string, 0, set.Len())
+	set.Range(func(element E) bool {
+		converted = append(converted, convert(element))
+		return false
+	})
+	if sorted {
+		sort.Strings(converted)
+	}
+	return strings.Join(converted, sep)
 }
 
 // TryMap returns a new Set struct containing values converted from elements within the Set using the mapper function,
@@ -515,7 +1664,7 @@ func TryMap[E comparable, T comparable](set Set[E], mapper func(element E) (T, e
 		} else if elements, err := internal.TryMap[E, T](set, mapper); err != nil {
 			return mapped, err
 		} else {
-			mapped = &HashSet[T]{elements}
+			mapped = &HashSet[T]{elements: elements}
 			return mapped, nil
 		}
 	case *MutableHashSet[E]:
@@ -525,7 +1674,7 @@ func TryMap[E comparable, T comparable](set Set[E], mapper func(element E) (T, e
 		} else if elements, err := internal.TryMap[E, T](set, mapper); err != nil {
 			return mapped, err
 		} else {
-			mapped = &MutableHashSet[T]{elements}
+			mapped = &MutableHashSet[T]{elements: elements}
 			return mapped, nil
 		}
 	case *SingletonSet[E]:
@@ -556,7 +1705,7 @@ func TryMap[E comparable, T comparable](set Set[E], mapper func(element E) (T, e
 			} else if elements, err := internal.TryMap[E, T](set, mapper); err != nil {
 				return mapped, err
 			} else {
-				mapped = &MutableHashSet[T]{elements}
+				mapped = &MutableHashSet[T]{elements: elements}
 				return mapped, nil
 			}
 		}
@@ -566,7 +1715,7 @@ func TryMap[E comparable, T comparable](set Set[E], mapper func(element E) (T, e
 		} else if elements, err := internal.TryMap[E, T](set, mapper); err != nil {
 			return mapped, err
 		} else {
-			mapped = &HashSet[T]{elements}
+			mapped = &HashSet[T]{elements: elements}
 			return mapped, nil
 		}
 	}
@@ -575,6 +1724,10 @@ func TryMap[E comparable, T comparable](set Set[E], mapper func(element E) (T, e
 // TryReduce returns the final result of running the reducer function across all elements within the Set as a single
 // value, which may return an error should an element fail to be reduced.
 //
+// If the reducer function returns an error, no further elements are reduced and the error is returned along with the
+// accumulated value so far. That is; the value accumulated from the elements reduced before the one that failed, with
+// any value returned by the reducer function alongside the error being discarded.
+//
 // Optionally, an initial value can be specified. Otherwise, the zero value of T is used.
 //
 // If the Set is nil, TryReduce returns initial value or the zero value of T if not specified.
@@ -586,13 +1739,29 @@ func TryReduce[E comparable, T any](set Set[E], reducer func(acc T, element E) (
 	var err error
 	if set != nil {
 		set.Range(func(element E) bool {
-			acc, err = reducer(acc, element)
-			return err != nil
+			var next T
+			if next, err = reducer(acc, element); err != nil {
+				return true
+			}
+			acc = next
+			return false
 		})
 	}
 	return acc, err
 }
 
+// Ungroup returns an immutable HashSet containing a union of every Set within the map of groups, as returned by Group,
+// effectively reversing the grouping. Any nil Set within the map is treated as having no elements.
+//
+// If the map is nil, Ungroup returns an empty HashSet.
+func Ungroup[K comparable, E comparable](groups map[K]Set[E]) *HashSet[E] {
+	elements := make(internal.Hash[E])
+	for _, group := range groups {
+		internal.PutAll[E](elements, group)
+	}
+	return &HashSet[E]{elements: elements}
+}
+
 // Union returns a new Set containing a union of each Set.
 //
 // Unlike Set.Union, the return struct implementation of Set is determined by important characteristics of each Set
@@ -604,6 +1773,163 @@ func Union[E comparable](set Set[E], others ...Set[E]) Set[E] {
 	return internal.UnionAll[E, Set[E]](createSet[E], flagSet[E], set, asCollections(others))
 }
 
+// UnionCardinality returns the number of elements that exist within either Set without creating a new Set to contain
+// them, which avoids the allocation that Set.Union incurs when only the count is needed.
+//
+// Any nil Set is treated as having no elements.
+func UnionCardinality[E comparable](a, b Set[E]) int {
+	_, union := cardinality(a, b)
+	return union
+}
+
+// UnionChannel returns a new Set containing a union of every Set received from the channel until it is closed, allowing
+// Set to be aggregated as they are streamed from concurrent producers. Any nil Set received from the channel is
+// skipped.
+//
+// Like Union, the return struct implementation of Set is determined by important characteristics of each Set received.
+// That is; if any Set is mutable, then the returned struct implementation of Set will also be mutable. Otherwise, it
+// will be immutable. Likewise for whether any Set is synchronized.
+//
+// If no non-nil Set is received before the channel is closed, UnionChannel returns nil.
+func UnionChannel[E comparable](ch <-chan Set[E]) Set[E] {
+	var (
+		flags internal.CollectionFlag
+		hash  internal.Hash[E]
+	)
+	for set := range ch {
+		if internal.IsNil(set) {
+			continue
+		}
+		flags |= flagSet[E](set)
+		if hash == nil {
+			hash = make(internal.Hash[E])
+		}
+		internal.PutAll[E](hash, set)
+	}
+	return createSet(hash, flags)
+}
+
+// UnionWith returns a new Set containing a union of Set a and Set b while calling the onCollision function with each
+// element that exists within both, allowing overlaps to be detected (e.g. conflicting keys) during the union without
+// the need for a separate intersection. onCollision may be nil.
+//
+// Like Union, the return struct implementation of Set is determined by important characteristics of each Set provided.
+// That is; if either Set is mutable, then the returned struct implementation of Set will also be mutable. Otherwise, it
+// will be immutable. Likewise for whether either Set is synchronized.
+//
+// If both Set a and Set b are nil, UnionWith returns nil.
+func UnionWith[E comparable](a, b Set[E], onCollision func(element E)) Set[E] {
+	var (
+		flags internal.CollectionFlag
+		hash  internal.Hash[E]
+	)
+	if internal.IsNotNil(a) {
+		flags |= flagSet[E](a)
+		hash = make(internal.Hash[E])
+		a.Range(func(element E) bool {
+			hash[element] = struct{}{}
+			return false
+		})
+	}
+	if internal.IsNotNil(b) {
+		flags |= flagSet[E](b)
+		if hash == nil {
+			hash = make(internal.Hash[E])
+		}
+		b.Range(func(element E) bool {
+			if _, ok := hash[element]; ok {
+				if onCollision != nil {
+					onCollision(element)
+				}
+			} else {
+				hash[element] = struct{}{}
+			}
+			return false
+		})
+	}
+	return createSet(hash, flags)
+}
+
+// UnmarshalSetText returns an immutable HashSet struct that implements Set containing each unique string element
+// parsed from the text provided, where the text is split into elements separated by sep. This is the inverse of
+// MarshalSetText and is useful for implementing encoding.TextUnmarshaler on types that wrap a Set (e.g. for use as a
+// flag or within config). If sep is empty, DefaultTextSeparator is used.
+//
+// Unlike HashFromDelimited, whitespace surrounding each element is preserved. However, empty elements are skipped, so
+// text that is empty, or only contains separators, produces an empty HashSet.
+func UnmarshalSetText[E ~string](text []byte, sep string) *HashSet[E] {
+	if sep == "" {
+		sep = DefaultTextSeparator
+	}
+	hash := make(internal.Hash[E])
+	for _, token := range strings.Split(string(text), sep) {
+		if token != "" {
+			hash[E(token)] = struct{}{}
+		}
+	}
+	return &HashSet[E]{elements: hash}
+}
+
+// ValidateSubset returns a new Set struct containing only elements of the Set that do not exist within the allowed Set
+// as well as an indication of whether the Set is a subset of the allowed Set. That is; the returned bool is only true
+// when the returned Set is empty.
+//
+// The returned struct implementation of Set is determined by important characteristics of the Set, in the same way as
+// Diff.
+//
+// If the Set is nil, ValidateSubset returns nil and true.
+func ValidateSubset[E comparable](set Set[E], allowed Set[E]) (invalid Set[E], ok bool) {
+	invalid = Diff(set, allowed)
+	return invalid, invalid.IsEmpty()
+}
+
+// WriteJoin writes each element within the Set, converted into a string using the provided convert function, to the
+// io.Writer with each separated by sep. Unlike Set.Join, the elements are streamed to the io.Writer as they are
+// converted rather than being joined into a single string in memory first, which is better suited to large sets.
+//
+// The number of bytes written is returned along with the first error encountered while writing, at which point no
+// further elements are written.
+//
+// The order in which elements are written is the same as that of Set.Range and so is not guaranteed to be consistent
+// for most implementations. For a Set that is safe for concurrent use, the Set is locked for reading until all elements
+// have been written, so any writes to the Set will block until then.
+//
+// If the Set is nil, WriteJoin writes nothing.
+func WriteJoin[E comparable](w io.Writer, set Set[E], sep string, convert func(element E) string) (int64, error) {
+	if internal.IsNil(set) {
+		return 0, nil
+	}
+	var (
+		err   error
+		first = true
+		n     int64
+	)
+	write := func(s string) bool {
+		var written int
+		written, err = io.WriteString(w, s)
+		n += int64(written)
+		return err != nil
+	}
+	set.Range(func(element E) bool {
+		if !first && write(sep) {
+			return true
+		}
+		first = false
+		return write(convert(element))
+	})
+	return n, err
+}
+
+// Tagged wraps an element of a union returned by TaggedUnion, recording which Set the element exists within.
+type Tagged[E comparable] struct {
+	// Value is the element.
+	Value E
+	// InA is whether the element exists within Set a.
+	InA bool
+	// InB is whether the element exists within Set b.
+	InB bool
+}
+
 type (
 	// JoinComplexOption allows control over the conversion of complex64/complex128 elements into strings when calling
 	// JoinComplex64 or JoinComplex128 respectively.
@@ -699,14 +2025,16 @@ func WithFloatSortingDesc() JoinFloatOption {
 
 type (
 	// JoinIntOption allows control over the conversion of signed integer elements into strings when calling JoinInt or
-	// SortedJoinInt. Sorting is also controllable for the latter functions.
+	// SortedJoinInt. Sorting is also controllable for both functions, however, JoinInt only sorts elements when a
+	// sorting option is passed.
 	JoinIntOption func(opts *joinIntOptions)
 
 	// joinIntOptions contains information used to control the conversion of signed integer elements into strings using
 	// strconv.FormatInt as well as how signed integer elements are sorted.
 	joinIntOptions struct {
-		base int
-		less func(x, y int64) bool
+		base   int
+		less   func(x, y int64) bool
+		sorted bool
 	}
 )
 
@@ -725,6 +2053,7 @@ func WithIntBase(base int) JoinIntOption {
 func WithIntSorting(less func(x, y int64) bool) JoinIntOption {
 	return func(opts *joinIntOptions) {
 		opts.less = less
+		opts.sorted = true
 	}
 }
 
@@ -734,6 +2063,7 @@ func WithIntSorting(less func(x, y int64) bool) JoinIntOption {
 func WithIntSortingAsc() JoinIntOption {
 	return func(opts *joinIntOptions) {
 		opts.less = Asc[int64]
+		opts.sorted = true
 	}
 }
 
@@ -743,19 +2073,22 @@ func WithIntSortingAsc() JoinIntOption {
 func WithIntSortingDesc() JoinIntOption {
 	return func(opts *joinIntOptions) {
 		opts.less = Desc[int64]
+		opts.sorted = true
 	}
 }
 
 type (
 	// JoinUintOption allows control over the conversion of unsigned integer elements into strings when calling
-	// JoinUint or SortedJoinUint. Sorting is also controllable for the latter functions.
+	// JoinByte, JoinBytes, JoinUint, or SortedJoinUint. Sorting is also controllable for all functions, however,
+	// JoinByte and JoinUint only sort elements when a sorting option is passed.
 	JoinUintOption func(opts *joinUintOptions)
 
 	// joinUintOptions contains information used to control over the conversion of unsigned integer elements into
 	// strings using strconv.FormatUint as well as how unsigned integer elements are sorted.
 	joinUintOptions struct {
-		base int
-		less func(x, y uint64) bool
+		base   int
+		less   func(x, y uint64) bool
+		sorted bool
 	}
 )
 
@@ -774,6 +2107,7 @@ func WithUintBase(base int) JoinUintOption {
 func WithUintSorting(less func(x, y uint64) bool) JoinUintOption {
 	return func(opts *joinUintOptions) {
 		opts.less = less
+		opts.sorted = true
 	}
 }
 
@@ -783,6 +2117,7 @@ func WithUintSorting(less func(x, y uint64) bool) JoinUintOption {
 func WithUintSortingAsc() JoinUintOption {
 	return func(opts *joinUintOptions) {
 		opts.less = Asc[uint64]
+		opts.sorted = true
 	}
 }
 
@@ -792,6 +2127,7 @@ func WithUintSortingAsc() JoinUintOption {
 func WithUintSortingDesc() JoinUintOption {
 	return func(opts *joinUintOptions) {
 		opts.less = Desc[uint64]
+		opts.sorted = true
 	}
 }
 
@@ -944,6 +2280,35 @@ func applySortedJoinStringOptions(opts []SortedJoinStringOption) *sortedJoinStri
 	return o
 }
 
+// applySync mutates the current MutableSet so that it contains the exact same elements as the target Set, returning how
+// many elements were added and removed. A nil target Set is treated as having no elements.
+func applySync[E comparable](current MutableSet[E], target Set[E]) (added, removed int) {
+	var stale []E
+	current.Range(func(element E) bool {
+		if internal.IsNil(target) || !target.Contains(element) {
+			stale = append(stale, element)
+		}
+		return false
+	})
+	if len(stale) > 0 {
+		current.DeleteSlice(stale)
+	}
+	if internal.IsNotNil(target) {
+		var missing []E
+		target.Range(func(element E) bool {
+			if !current.Contains(element) {
+				missing = append(missing, element)
+			}
+			return false
+		})
+		if len(missing) > 0 {
+			current.PutSlice(missing)
+		}
+		added = len(missing)
+	}
+	return added, len(stale)
+}
+
 // asCollections returns a clone of the given slice of Set interfaces as a slice of internal.Collection interfaces.
 func asCollections[E comparable](sets []Set[E]) []internal.Collection[E] {
 	cols := make([]internal.Collection[E], len(sets))
@@ -953,6 +2318,152 @@ func asCollections[E comparable](sets []Set[E]) []internal.Collection[E] {
 	return cols
 }
 
+// canonical returns the canonical byte representation of the Set, which is formed by escaping the strings converted
+// from each element using canonicalEscaper, sorting them, and then joining them using a comma separator.
+//
+// If set is nil, canonical returns an empty slice.
+func canonical[E comparable](set Set[E], convert func(element E) string) []byte {
+	var strs []string
+	if internal.IsNotNil(set) {
+		strs = make([]string, 0, set.Len())
+		set.Range(func(element E) bool {
+			strs = append(strs, canonicalEscaper.Replace(convert(element)))
+			return false
+		})
+	}
+	sort.Strings(strs)
+	return []byte(strings.Join(strs, ","))
+}
+
+// cardinality returns the number of elements within the intersection and union of both Set, where any nil Set is
+// treated as having no elements. Only the elements within the smaller of the two are iterated, with each being checked
+// for within the larger.
+func cardinality[E comparable](a, b Set[E]) (intersection, union int) {
+	if internal.IsNil(a) {
+		if internal.IsNil(b) {
+			return 0, 0
+		}
+		return 0, b.Len()
+	} else if internal.IsNil(b) {
+		return 0, a.Len()
+	}
+	if a == b {
+		return a.Len(), a.Len()
+	}
+	if b.Len() < a.Len() {
+		a, b = b, a
+	}
+	intersection = a.Count(b.Contains)
+	return intersection, a.Len() + b.Len() - intersection
+}
+
+// cartesianProduct returns an internal.Hash containing values returned by the combine function for every pair of
+// elements from the first and second Set.
+func cartesianProduct[E comparable, F comparable, T comparable](
+	a Set[E],
+	b Set[F],
+	combine func(first E, second F) T,
+) internal.Hash[T] {
+	if internal.IsNil(b) {
+		return make(internal.Hash[T])
+	}
+	product := make(internal.Hash[T], a.Len()*b.Len())
+	a.Range(func(first E) bool {
+		b.Range(func(second F) bool {
+			product[combine(first, second)] = struct{}{}
+			return false
+		})
+		return false
+	})
+	return product
+}
+
+// containedByAll returns whether the element exists within all the given Set, where any nil Set is treated as having no
+// elements. If no Set is given, containedByAll returns true.
+func containedByAll[E comparable](sets []Set[E], element E) bool {
+	for _, set := range sets {
+		if internal.IsNil(set) || !set.Contains(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// containedByAny returns whether the element exists within any of the given Set, where any nil Set is skipped.
+func containedByAny[E comparable](sets []Set[E], element E) bool {
+	for _, set := range sets {
+		if internal.IsNotNil(set) && set.Contains(element) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsAll returns whether the contains function returns true for the element as well as all additional elements,
+// stopping as soon as it returns false.
+func containsAll[E comparable](element E, elements []E, contains func(element E) bool) bool {
+	if !contains(element) {
+		return false
+	}
+	for _, _element := range elements {
+		if !contains(_element) {
+			return false
+		}
+	}
+	return true
+}
+
+// containsAny returns whether the contains function returns true for the element or any of the additional elements,
+// stopping as soon as it returns true.
+func containsAny[E comparable](element E, elements []E, contains func(element E) bool) bool {
+	if contains(element) {
+		return true
+	}
+	for _, _element := range elements {
+		if contains(_element) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsEach returns a slice containing the result of the contains function for each element provided, where each
+// result is at the same index as its element.
+func containsEach[E comparable](elements []E, contains func(element E) bool) []bool {
+	results := make([]bool, len(elements))
+	for i, element := range elements {
+		results[i] = contains(element)
+	}
+	return results
+}
+
+// count returns the number of elements passed to the iter function by the range function that match the predicate
+// function.
+func count[E comparable](rangeFn func(iter func(element E) bool), predicate func(element E) bool) int {
+	var n int
+	rangeFn(func(element E) bool {
+		if predicate(element) {
+			n++
+		}
+		return false
+	})
+	return n
+}
+
+// covers returns whether a Set, with n elements and the given contains function, is a superset of every other Set. Any
+// other Set containing more than n elements is rejected before its elements are checked.
+func covers[E comparable](n int, contains func(element E) bool, others []Set[E]) bool {
+	for _, other := range others {
+		if other == nil {
+			continue
+		}
+		if other.Len() > n || other.Some(func(element E) bool { return !contains(element) }) {
+			return false
+		}
+	}
+	return true
+}
+
 // createSet returns a new Set struct for the given internal.Hash based on the flags provided.
 //
 // If hash is nil, createSet returns a nil reference to an EmptySet.
@@ -963,9 +2474,46 @@ func createSet[E comparable](hash internal.Hash[E], flags internal.CollectionFla
 	} else if flags&collectionFlagSync != 0 {
 		return &SyncHashSet[E]{elements: hash}
 	} else if flags&collectionFlagMutable != 0 {
-		return &MutableHashSet[E]{hash}
+		return &MutableHashSet[E]{elements: hash}
+	}
+	return &HashSet[E]{elements: hash}
+}
+
+// deleteExisting removes the element as well as any additional elements using the take function and returns the
+// number of those elements that were removed.
+func deleteExisting[E comparable](take func(element E) (E, bool), element E, elements []E) int {
+	var deleted int
+	if _, ok := take(element); ok {
+		deleted++
+	}
+	for _, _element := range elements {
+		if _, ok := take(_element); ok {
+			deleted++
+		}
+	}
+	return deleted
+}
+
+// disjoint returns whether a Set, represented by its length, contains, and range functions, has no elements in common
+// with the other Set, iterating the elements of the smaller of the two.
+func disjoint[E comparable](
+	n int,
+	contains func(element E) bool,
+	iterate func(iter func(element E) bool),
+	other Set[E],
+) bool {
+	if n == 0 || internal.IsNil(other) {
+		return true
 	}
-	return &HashSet[E]{hash}
+	if other.Len() < n {
+		return other.None(contains)
+	}
+	var found bool
+	iterate(func(element E) bool {
+		found = other.Contains(element)
+		return found
+	})
+	return !found
 }
 
 // equalAll is a convenient shorthand for calling Set.Equal on multiple others.
@@ -978,6 +2526,19 @@ func equalAll[E comparable](set Set[E], others []Set[E]) bool {
 	return true
 }
 
+// filterMap returns an internal.Hash containing the values returned by the mapper function for each element within the
+// Set for which the mapper function also returns true.
+func filterMap[E comparable, T comparable](set Set[E], mapper func(element E) (T, bool)) internal.Hash[T] {
+	mapped := make(internal.Hash[T], set.Len())
+	set.Range(func(element E) bool {
+		if value, ok := mapper(element); ok {
+			mapped[value] = struct{}{}
+		}
+		return false
+	})
+	return mapped
+}
+
 // flagSet returns characteristic flags for the given internal.Collection.
 func flagSet[E comparable](col internal.Collection[E]) internal.CollectionFlag {
 	if _, ok := col.(*SyncHashSet[E]); ok {
@@ -989,6 +2550,17 @@ func flagSet[E comparable](col internal.Collection[E]) internal.CollectionFlag {
 	return 0
 }
 
+// flatMap returns an internal.Hash containing a union of all values returned by the mapper function for each element
+// within the Set.
+func flatMap[E comparable, T comparable](set Set[E], mapper func(element E) []T) internal.Hash[T] {
+	mapped := make(internal.Hash[T], set.Len())
+	set.Range(func(element E) bool {
+		internal.PutSlice[T](mapped, mapper(element))
+		return false
+	})
+	return mapped
+}
+
 // getComplexStringConverter returns a function that can be used to convert a complex64/complex128 element into a string
 // using strconv.FormatComplex while allowing options to be passed to control the formatting.
 //
@@ -1031,6 +2603,295 @@ func getUintStringConverter[E constraints.Unsigned](opts *joinUintOptions) func(
 	}
 }
 
+// hasNegative returns whether the Set contains any negative element.
+func hasNegative(set Set[int]) bool {
+	return set.Some(func(element int) bool { return element < 0 })
+}
+
+// hashOf returns the internal.Hash used by the Set along with an indication of whether it could be accessed directly,
+// which is only the case for a non-nil HashSet or MutableHashSet. This allows operations between such sets to avoid
+// calling Set methods for each element.
+func hashOf[E comparable](set Set[E]) (internal.Hash[E], bool) {
+	switch v := set.(type) {
+	case *HashSet[E]:
+		if v != nil {
+			return v.elements, true
+		}
+	case *MutableHashSet[E]:
+		if v != nil {
+			return v.elements, true
+		}
+	}
+	return nil, false
+}
+
+// joinSlice converts the elements within the slice to strings which are then concatenated to create a single string,
+// placing sep between the converted elements in the resulting string.
+func joinSlice[E comparable](elements []E, sep string, convert func(element E) string) string {
+	converted := make([]string, len(elements))
+	for i, element := range elements {
+		converted[i] = convert(element)
+	}
+	return strings.Join(converted, sep)
+}
+
+// pairable returns whether each of the elements can be paired with a distinct element within the other elements for
+// which the eq function returns true, searching for augmenting paths whenever a pairing is already taken.
+func pairable[E any](elements, otherElements []E, eq func(x, y E) bool) bool {
+	candidates := make([][]int, len(elements))
+	for i, element := range elements {
+		for j, otherElement := range otherElements {
+			if eq(element, otherElement) {
+				candidates[i] = append(candidates[i], j)
+			}
+		}
+		if len(candidates[i]) == 0 {
+			return false
+		}
+	}
+	pairs := make([]int, len(otherElements))
+	for j := range pairs {
+		pairs[j] = -1
+	}
+	var pair func(i int, visited []bool) bool
+	pair = func(i int, visited []bool) bool {
+		for _, j := range candidates[i] {
+			if visited[j] {
+				continue
+			}
+			visited[j] = true
+			if pairs[j] < 0 || pair(pairs[j], visited) {
+				pairs[j] = i
+				return true
+			}
+		}
+		return false
+	}
+	for i := range elements {
+		if !pair(i, make([]bool, len(otherElements))) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseDelimited splits the string into tokens separated by sep and returns an internal.Hash containing each unique
+// element returned by the parse function for each token. Whitespace surrounding each token is trimmed and any empty
+// token is skipped.
+//
+// An ErrDelimitedToken wrapping the error is returned for the first token that the parse function fails to parse.
+func parseDelimited[E comparable](s, sep string, parse func(token string) (E, error)) (internal.Hash[E], error) {
+	hash := make(internal.Hash[E])
+	for _, token := range strings.Split(s, sep) {
+		if token = strings.TrimSpace(token); token == "" {
+			continue
+		}
+		element, err := parse(token)
+		if err != nil {
+			return nil, fmtErrDelimitedToken(token, err)
+		}
+		hash[element] = struct{}{}
+	}
+	return hash, nil
+}
+
+// rangeContext calls the iter function with each element passed to it by the range function, stopping early whenever
+// the iter function returns true. The context is checked before each element and, if it is done, iteration stops and
+// the error of the context is returned.
+func rangeContext[E comparable](
+	ctx context.Context,
+	rangeFn func(iter func(element E) bool),
+	iter func(element E) bool,
+) error {
+	var err error
+	rangeFn(func(element E) bool {
+		if err = ctx.Err(); err != nil {
+			return true
+		}
+		return iter(element)
+	})
+	return err
+}
+
+// sample returns a pseudo-random element passed to it by the range function, which must pass exactly n elements, as
+// well as an indication of whether n is positive.
+func sample[E comparable](rangeFn func(iter func(element E) bool), n int) (E, bool) {
+	var sampled E
+	if n <= 0 {
+		return sampled, false
+	}
+	i := rand.Intn(n)
+	rangeFn(func(element E) bool {
+		sampled = element
+		i--
+		return i < 0
+	})
+	return sampled, true
+}
+
+// sampleFilter returns a new Set struct containing up to n distinct elements of the Set chosen pseudo-randomly using
+// the provided source, relying on Set.Filter to retain the struct implementation of the Set.
+func sampleFilter[E comparable](set Set[E], n int, r *rand.Rand) Set[E] {
+	sampled := sampleN[E](set.Slice(), n, r)
+	return set.Filter(func(element E) bool {
+		_, ok := sampled[element]
+		return ok
+	})
+}
+
+// sampleN returns an internal.Hash containing up to n distinct elements chosen pseudo-randomly from the elements using
+// the provided source, or the default source if nil. The elements are shuffled in place.
+func sampleN[E comparable](elements []E, n int, r *rand.Rand) internal.Hash[E] {
+	if n > len(elements) {
+		n = len(elements)
+	} else if n < 0 {
+		n = 0
+	}
+	intn := rand.Intn
+	if r != nil {
+		intn = r.Intn
+	}
+	for i := 0; i < n; i++ {
+		j := i + intn(len(elements)-i)
+		elements[i], elements[j] = elements[j], elements[i]
+	}
+	return internal.FromSlice[E](elements[:n])
+}
+
+// scanJSON returns the JSON data within the source provided to sql.Scanner, where a NULL source is treated as a JSON
+// null. An ErrScanSource is returned if the source is neither a []byte nor a string.
+func scanJSON(src any) ([]byte, error) {
+	switch v := src.(type) {
+	case nil:
+		return []byte("null"), nil
+	case []byte:
+		return v, nil
+	case string:
+		return []byte(v), nil
+	default:
+		return nil, fmtErrScanSource(src)
+	}
+}
+
+// seq returns an iterator, compatible with iter.Seq, that yields each element passed to the iter function by the range
+// function, stopping the range function once the yield function returns false.
+func seq[E comparable](rangeFn func(iter func(element E) bool)) func(yield func(element E) bool) {
+	return func(yield func(element E) bool) {
+		rangeFn(func(element E) bool {
+			return !yield(element)
+		})
+	}
+}
+
+// sortedAppendSlice appends all elements of the Set to the dst slice and then sorts only the appended elements using
+// the provided less function, leaving any elements already within dst untouched, before returning the extended slice.
+func sortedAppendSlice[E comparable](set Set[E], dst []E, less func(x, y E) bool) []E {
+	n := len(dst)
+	dst = set.AppendSlice(dst)
+	appended := dst[n:]
+	sort.SliceStable(appended, func(i, j int) bool { return less(appended[i], appended[j]) })
+	return dst
+}
+
+// sortedRange calls the iter function with each element within the Set, sorted using the provided less function, along
+// with the zero-based index of that element within the sorted order, stopping early whenever the iter function returns
+// true.
+func sortedRange[E comparable](set Set[E], less func(x, y E) bool, iter func(index int, element E) bool) {
+	for i, element := range set.SortedSlice(less) {
+		if iter(i, element) {
+			break
+		}
+	}
+}
+
+// stringerConverter converts the element into a string using its String method.
+func stringerConverter[E fmt.Stringer](element E) string {
+	return element.String()
+}
+
+// unmarshalJSONInteger deserializes the given JSON data as a JSON array of numbers and returns an internal.Hash
+// containing each unique element.
+//
+// Unlike internal.UnmarshalJSON, each number is decoded as a json.Number and an ErrJSONInteger is returned for any
+// number that cannot be represented exactly by E (e.g. 1.5 or 256 for uint8), rather than it being truncated. Numbers
+// using exponents are accepted so long as they represent integers (e.g. 1e3).
+func unmarshalJSONInteger[E constraints.Integer](data []byte) (internal.Hash[E], error) {
+	var numbers []json.Number
+	if err := json.Unmarshal(data, &numbers); err != nil {
+		return nil, err
+	}
+	hash := make(internal.Hash[E], len(numbers))
+	for _, number := range numbers {
+		if number == "" {
+			// Number is null so treat as zero value like json.Unmarshal
+			hash[0] = struct{}{}
+			continue
+		}
+		r, ok := new(big.Rat).SetString(number.String())
+		if !ok || !r.IsInt() {
+			return nil, fmtErrJSONInteger(number)
+		}
+		var element E
+		if i := r.Num(); i.Sign() < 0 {
+			if !i.IsInt64() {
+				return nil, fmtErrJSONInteger(number)
+			}
+			v := i.Int64()
+			if element = E(v); element >= 0 || int64(element) != v {
+				return nil, fmtErrJSONInteger(number)
+			}
+		} else {
+			if !i.IsUint64() {
+				return nil, fmtErrJSONInteger(number)
+			}
+			v := i.Uint64()
+			if element = E(v); element < 0 || uint64(element) != v {
+				return nil, fmtErrJSONInteger(number)
+			}
+		}
+		hash[element] = struct{}{}
+	}
+	return hash, nil
+}
+
+// unmarshalJSONReader deserializes a JSON array read from the given io.Reader and returns an internal.Hash containing
+// each unique element. Elements are decoded and added one at a time so that the full array is never held in memory.
+//
+// A top-level JSON null is treated as an empty array, while an ErrJSONArray is returned for any other value that is not
+// an array. As the io.Reader is read via a buffer, any data that follows the array may be read from the io.Reader and
+// lost.
+func unmarshalJSONReader[E comparable](r io.Reader) (internal.Hash[E], error) {
+	dec := json.NewDecoder(r)
+	token, err := dec.Token()
+	if err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	hash := make(internal.Hash[E])
+	if token == nil {
+		return hash, nil
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return nil, fmtErrJSONArray(token)
+	}
+	for dec.More() {
+		var element E
+		if err = dec.Decode(&element); err != nil {
+			return nil, err
+		}
+		hash[element] = struct{}{}
+	}
+	if _, err = dec.Token(); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return hash, nil
+}
+
 // unwrapLess is a convenient function for unwrapping an optional less function while supporting the accepted default of
 // ascending order.
 func unwrapLess[E constraints.Ordered](less []func(x, y E) bool) func(x, y E) bool {
@@ -1039,3 +2900,11 @@ func unwrapLess[E constraints.Ordered](less []func(x, y E) bool) func(x, y E) bo
 	}
 	return Asc[E]
 }
+
+// wrapStringConverter returns a function that can be used to convert an element into a string using the convert
+// function before wrapping it with prefix and suffix.
+func wrapStringConverter[E comparable](prefix, suffix string, convert func(element E) string) func(element E) string {
+	return func(element E) string {
+		return prefix + convert(element) + suffix
+	}
+}
@@ -21,15 +21,104 @@
 package sets
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/neocotic/go-sets/internal"
 	"golang.org/x/exp/constraints"
+	"math"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 )
 
+func Test_Aggregate(t *testing.T) {
+	sum := func(acc int, element int) int { return acc + element }
+	testCases := map[string]struct {
+		expectAgg      int
+		expectElements []int
+		expectKind     SetKind
+		init           int
+		set            Set[int]
+	}{
+		"with HashSet": {
+			expectAgg:      1368,
+			expectElements: []int{123, 456, 789},
+			expectKind:     HashKind,
+			set:            Hash(123, 456, 789),
+		},
+		"with HashSet and initial value": {
+			expectAgg:      1378,
+			expectElements: []int{123, 456, 789},
+			expectKind:     HashKind,
+			init:           10,
+			set:            Hash(123, 456, 789),
+		},
+		"with MutableHashSet": {
+			expectAgg:      579,
+			expectElements: []int{123, 456},
+			expectKind:     MutableHashKind,
+			set:            MutableHash(123, 456),
+		},
+		"with SyncHashSet": {
+			expectAgg:      123,
+			expectElements: []int{123},
+			expectKind:     SyncHashKind,
+			set:            SyncHash(123),
+		},
+		"with Set containing no elements": {
+			expectAgg:      10,
+			expectElements: []int{},
+			expectKind:     HashKind,
+			init:           10,
+			set:            Hash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			agg, result := Aggregate(tc.set, tc.init, sum)
+			if agg != tc.expectAgg {
+				t.Errorf("unexpected aggregate; want %v, got %v", tc.expectAgg, agg)
+			}
+			if kind := result.Kind(); kind != tc.expectKind {
+				t.Errorf("unexpected SetKind; want %v, got %v", tc.expectKind, kind)
+			}
+			elements := result.SortedSlice(Asc[int])
+			if !cmp.Equal(tc.expectElements, elements, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected elements; want %v, got %v", tc.expectElements, elements)
+			}
+		})
+	}
+}
+
+func Test_Aggregate_Independent(t *testing.T) {
+	set := MutableHash(123, 456)
+	_, result := Aggregate[int, int](set, 0, func(acc int, element int) int { return acc + 1 })
+	set.Put(789)
+	if result.Contains(789) {
+		t.Error("unexpected Set containment of 789; want false, got true")
+	}
+	result.(MutableSet[int]).Delete(123)
+	if !set.Contains(123) {
+		t.Error("unexpected Set containment of 123; want true, got false")
+	}
+}
+
+func Test_Aggregate_Nil(t *testing.T) {
+	agg, result := Aggregate[int](nil, 10, func(acc int, element int) int { return acc + element })
+	if agg != 10 {
+		t.Errorf("unexpected aggregate; want 10, got %v", agg)
+	}
+	if !internal.IsNil(result) {
+		t.Errorf("unexpected Set; want nil, got %v", result)
+	}
+}
+
 func Test_Asc(t *testing.T) {
 	elements := []int{789, 456, 123, 0, -123, -456, -789}
 	expect := []int{-789, -456, -123, 0, 123, 456, 789}
@@ -41,6 +130,349 @@ func Test_Asc(t *testing.T) {
 	}
 }
 
+func Test_CartesianProduct(t *testing.T) {
+	testCases := map[string]struct {
+		a      Set[int]
+		b      Set[string]
+		expect Set[string]
+	}{
+		"with *EmptySet": {
+			a:      Empty[int](),
+			b:      Hash("a", "b"),
+			expect: Empty[string](),
+		},
+		"with *HashSet": {
+			a:      Hash(1, 2),
+			b:      Hash("a", "b"),
+			expect: Hash("1a", "1b", "2a", "2b"),
+		},
+		"with *HashSet and empty Set": {
+			a:      Hash(1, 2),
+			b:      Hash[string](),
+			expect: Hash[string](),
+		},
+		"with *HashSet and nil Set": {
+			a:      Hash(1, 2),
+			b:      nil,
+			expect: Hash[string](),
+		},
+		"with *MutableHashSet": {
+			a:      MutableHash(1, 2),
+			b:      Singleton("a"),
+			expect: MutableHash("1a", "2a"),
+		},
+		"with *SingletonSet": {
+			a:      Singleton(1),
+			b:      Hash("a", "b"),
+			expect: Hash("1a", "1b"),
+		},
+		"with *SmallSet": {
+			a:      Small(Asc[int], 1, 2),
+			b:      Hash("a"),
+			expect: MutableHash("1a", "2a"),
+		},
+		"with *SyncHashSet": {
+			a:      SyncHash(1, 2),
+			b:      Hash("a", "b"),
+			expect: SyncHash("1a", "1b", "2a", "2b"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			product := CartesianProduct(tc.a, tc.b, func(first int, second string) string {
+				return strconv.Itoa(first) + second
+			})
+			if internal.IsNil(product) {
+				t.Fatal("unexpected nil Set")
+			}
+			if !product.Equal(tc.expect) {
+				t.Errorf("unexpected product Set; want %v, got %v", tc.expect, product)
+			}
+			if kind := product.Kind(); kind != tc.expect.Kind() {
+				t.Errorf("unexpected kind; want %v, got %v", tc.expect.Kind(), kind)
+			}
+		})
+	}
+}
+
+func Test_CartesianProduct_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		set Set[int]
+	}{
+		"with nil Set": {
+			set: nil,
+		},
+		"with nil *EmptySet": {
+			set: (*EmptySet[int])(nil),
+		},
+		"with nil *HashSet": {
+			set: (*HashSet[int])(nil),
+		},
+		"with nil *MutableHashSet": {
+			set: (*MutableHashSet[int])(nil),
+		},
+		"with nil *SyncHashSet": {
+			set: (*SyncHashSet[int])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			product := CartesianProduct[int, string, string](tc.set, Hash("a"), func(first int, second string) string {
+				t.Error("unexpected call to combine function")
+				return ""
+			})
+			if internal.IsNotNil(product) {
+				t.Errorf("unexpected product Set; want nil, got %v", product)
+			}
+		})
+	}
+}
+
+func Test_Complement(t *testing.T) {
+	testCases := map[string]struct {
+		expect   Set[int]
+		set      Set[int]
+		universe Set[int]
+	}{
+		"with non-empty *HashSet universe and non-empty *HashSet subset": {
+			expect:   Hash(0, 123),
+			set:      Hash(456, 789),
+			universe: Hash(0, 123, 456, 789),
+		},
+		"with non-empty *HashSet universe and *HashSet containing elements outside universe": {
+			expect:   Hash(0, 123),
+			set:      Hash(-789, -456, 456, 789),
+			universe: Hash(0, 123, 456, 789),
+		},
+		"with non-empty *HashSet universe and empty *HashSet": {
+			expect:   Hash(0, 123, 456, 789),
+			set:      Hash[int](),
+			universe: Hash(0, 123, 456, 789),
+		},
+		"with non-empty *HashSet universe and nil Set": {
+			expect:   Hash(0, 123, 456, 789),
+			set:      nil,
+			universe: Hash(0, 123, 456, 789),
+		},
+		"with non-empty *HashSet universe and equal *HashSet": {
+			expect:   Hash[int](),
+			set:      Hash(0, 123, 456, 789),
+			universe: Hash(0, 123, 456, 789),
+		},
+		"with empty *HashSet universe and non-empty *HashSet": {
+			expect:   Hash[int](),
+			set:      Hash(123, 456, 789),
+			universe: Hash[int](),
+		},
+		"with non-empty *MutableHashSet universe and non-empty *HashSet subset": {
+			expect:   MutableHash(0, 123),
+			set:      Hash(456, 789),
+			universe: MutableHash(0, 123, 456, 789),
+		},
+		"with non-empty *SyncHashSet universe and non-empty *HashSet subset": {
+			expect:   SyncHash(0, 123),
+			set:      Hash(456, 789),
+			universe: SyncHash(0, 123, 456, 789),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			complement := Complement(tc.set, tc.universe)
+			if internal.IsNil(complement) {
+				t.Error("unexpected nil Set")
+			}
+			if !tc.expect.Equal(complement) {
+				t.Errorf("unexpected complement Set; want %v, got %v", tc.expect, complement)
+			}
+			if tc.expect.IsMutable() != complement.IsMutable() {
+				t.Errorf("unexpected complement Set mutability; want %v, got %v", tc.expect.IsMutable(), complement.IsMutable())
+			}
+			_, expectSync := tc.expect.(*SyncHashSet[int])
+			if _, sync := complement.(*SyncHashSet[int]); expectSync != sync {
+				t.Errorf("unexpected complement Set synchronization; want %v, got %v", expectSync, sync)
+			}
+		})
+	}
+}
+
+func Test_Complement_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		set      Set[int]
+		universe Set[int]
+	}{
+		"with nil universe Set and nil Set": {
+			set:      nil,
+			universe: nil,
+		},
+		"with nil universe *HashSet and non-empty *HashSet": {
+			set:      Hash(123, 456, 789),
+			universe: (*HashSet[int])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			complement := Complement(tc.set, tc.universe)
+			if internal.IsNotNil(complement) {
+				t.Errorf("unexpected complement Set; want nil, got %v", complement)
+			}
+		})
+	}
+}
+
+func Test_ContainsNaN(t *testing.T) {
+	testCases := map[string]struct {
+		expect bool
+		set    Set[float64]
+	}{
+		"with HashSet containing NaN": {
+			expect: true,
+			set:    Hash(1.5, math.NaN()),
+		},
+		"with HashSet not containing NaN": {
+			expect: false,
+			set:    Hash(1.5, 2.5),
+		},
+		"with FloatHashSet containing NaN": {
+			expect: true,
+			set:    HashFloat(1.5, math.NaN()),
+		},
+		"with FloatHashSet not containing NaN": {
+			expect: false,
+			set:    HashFloat(1.5, 2.5),
+		},
+		"with Set containing no elements": {
+			expect: false,
+			set:    Hash[float64](),
+		},
+		"with nil Set": {
+			expect: false,
+			set:    nil,
+		},
+		"with nil *HashSet": {
+			expect: false,
+			set:    (*HashSet[float64])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if result := ContainsNaN(tc.set); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_CountDistinct(t *testing.T) {
+	testCases := map[string]struct {
+		expect int
+		key    func(element int) int
+		set    Set[int]
+	}{
+		"with elements sharing keys": {
+			expect: 2,
+			key:    func(element int) int { return element % 2 },
+			set:    Hash(1, 2, 3, 4, 5),
+		},
+		"with elements all sharing same key": {
+			expect: 1,
+			key:    func(element int) int { return 0 },
+			set:    Hash(1, 2, 3),
+		},
+		"with elements all having distinct keys": {
+			expect: 3,
+			key:    func(element int) int { return element * 10 },
+			set:    Hash(1, 2, 3),
+		},
+		"with Set containing no elements": {
+			expect: 0,
+			key:    func(element int) int { return element },
+			set:    Hash[int](),
+		},
+		"with nil Set": {
+			expect: 0,
+			key:    func(element int) int { return element },
+			set:    nil,
+		},
+		"with nil *HashSet": {
+			expect: 0,
+			key:    func(element int) int { return element },
+			set:    (*HashSet[int])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if count := CountDistinct(tc.set, tc.key); count != tc.expect {
+				t.Errorf("unexpected count; want %v, got %v", tc.expect, count)
+			}
+		})
+	}
+}
+
+func Test_DeleteNil(t *testing.T) {
+	a, b := 123, 456
+	testCases := map[string]struct {
+		expect []*int
+		set    MutableSet[*int]
+	}{
+		"with MutableHashSet containing nil and non-nil elements": {
+			expect: []*int{&a, &b},
+			set:    MutableHash(&a, nil, &b),
+		},
+		"with MutableHashSet containing only nil element": {
+			expect: []*int{},
+			set:    MutableHash[*int](nil),
+		},
+		"with MutableHashSet containing no nil elements": {
+			expect: []*int{&a, &b},
+			set:    MutableHash(&a, &b),
+		},
+		"with SyncHashSet containing nil and non-nil elements": {
+			expect: []*int{&a},
+			set:    SyncHash(nil, &a),
+		},
+		"with MutableSet containing no elements": {
+			expect: []*int{},
+			set:    MutableHash[*int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := DeleteNil(tc.set)
+			if result != tc.set {
+				t.Errorf("unexpected MutableSet; want %v, got %v", tc.set, result)
+			}
+			if HasNilElement[int](tc.set) {
+				t.Error("unexpected nil element; want false, got true")
+			}
+			if exp, act := len(tc.expect), tc.set.Len(); act != exp {
+				t.Errorf("unexpected MutableSet length; want %v, got %v", exp, act)
+			}
+			for _, element := range tc.expect {
+				if !tc.set.Contains(element) {
+					t.Errorf("unexpected MutableSet containment of %v; want true, got false", *element)
+				}
+			}
+		})
+	}
+}
+
+func Test_DeleteNil_Nil(t *testing.T) {
+	if result := DeleteNil[int](nil); result != nil {
+		t.Errorf("unexpected MutableSet; want nil, got %v", result)
+	}
+	var set *MutableHashSet[*int]
+	if result := DeleteNil[int](set); result != MutableSet[*int](set) {
+		t.Errorf("unexpected MutableSet; want %v, got %v", set, result)
+	}
+}
+
 func Test_Desc(t *testing.T) {
 	elements := []int{-789, -456, -123, 0, 123, 456, 789}
 	expect := []int{789, 456, 123, 0, -123, -456, -789}
@@ -271,6 +703,93 @@ func Test_Diff_Nil(t *testing.T) {
 	}
 }
 
+func Test_DiffBy(t *testing.T) {
+	type record struct {
+		ID   int
+		Name string
+	}
+	key := func(element record) int { return element.ID }
+
+	testCases := map[string]struct {
+		a             Set[record]
+		b             Set[record]
+		expect        Set[record]
+		expectKind    SetKind
+		expectMutable bool
+	}{
+		"with elements sharing keys but differing in other fields": {
+			a:          Hash(record{1, "foo"}, record{2, "bar"}),
+			b:          Hash(record{1, "FOO"}, record{2, "BAR"}),
+			expect:     Hash[record](),
+			expectKind: HashKind,
+		},
+		"with elements differing in keys": {
+			a:          Hash(record{1, "foo"}, record{2, "bar"}, record{3, "baz"}),
+			b:          Hash(record{2, "bar"}, record{4, "fizz"}),
+			expect:     Hash(record{1, "foo"}, record{3, "baz"}),
+			expectKind: HashKind,
+		},
+		"with elements differing in keys but sharing other fields": {
+			a:          Hash(record{1, "foo"}, record{2, "foo"}),
+			b:          Hash(record{3, "foo"}),
+			expect:     Hash(record{1, "foo"}, record{2, "foo"}),
+			expectKind: HashKind,
+		},
+		"with empty Set b": {
+			a:          Hash(record{1, "foo"}),
+			b:          Empty[record](),
+			expect:     Hash(record{1, "foo"}),
+			expectKind: HashKind,
+		},
+		"with nil Set b": {
+			a:          Hash(record{1, "foo"}),
+			b:          nil,
+			expect:     Hash(record{1, "foo"}),
+			expectKind: HashKind,
+		},
+		"with mutable Set a": {
+			a:             MutableHash(record{1, "foo"}, record{2, "bar"}),
+			b:             Hash(record{2, "baz"}),
+			expect:        Hash(record{1, "foo"}),
+			expectKind:    MutableHashKind,
+			expectMutable: true,
+		},
+		"with sync Set a": {
+			a:             SyncHash(record{1, "foo"}, record{2, "bar"}),
+			b:             Hash(record{2, "baz"}),
+			expect:        Hash(record{1, "foo"}),
+			expectKind:    SyncHashKind,
+			expectMutable: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diff := DiffBy(tc.a, tc.b, key)
+			if !diff.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, diff)
+			}
+			if kind := diff.Kind(); kind != tc.expectKind {
+				t.Errorf("unexpected Set kind; want %v, got %v", tc.expectKind, kind)
+			}
+			if mutable := diff.IsMutable(); mutable != tc.expectMutable {
+				t.Errorf("unexpected Set mutability; want %v, got %v", tc.expectMutable, mutable)
+			}
+		})
+	}
+}
+
+func Test_DiffBy_Nil(t *testing.T) {
+	diff := DiffBy[int, int](nil, Hash(123), func(element int) int { return element })
+	if internal.IsNotNil(diff) {
+		t.Errorf("unexpected Set; want nil, got %v", diff)
+	}
+	diff = DiffBy[int, int]((*HashSet[int])(nil), Hash(123), func(element int) int { return element })
+	if internal.IsNotNil(diff) {
+		t.Errorf("unexpected Set; want nil, got %v", diff)
+	}
+}
+
 func Test_DiffSymmetric(t *testing.T) {
 	testCases := map[string]struct {
 		expect Set[int]
@@ -490,6 +1009,113 @@ func Test_DiffSymmetric_Nil(t *testing.T) {
 	}
 }
 
+func Test_Disjoint(t *testing.T) {
+	testCases := map[string]struct {
+		expect bool
+		sets   []Set[int]
+	}{
+		"with pairwise disjoint Sets": {
+			expect: true,
+			sets:   []Set[int]{Hash(123, 456), MutableHash(789), SyncHash(-123, -456), Singleton(0)},
+		},
+		"with first and last Sets overlapping": {
+			expect: false,
+			sets:   []Set[int]{Hash(123, 456), Hash(789), Hash(-123, 456)},
+		},
+		"with middle Sets overlapping": {
+			expect: false,
+			sets:   []Set[int]{Hash(123), Hash(456, 789), Hash(-789, 789), Hash(0)},
+		},
+		"with empty and nil Sets": {
+			expect: true,
+			sets:   []Set[int]{Hash(123), nil, Hash[int](), (*HashSet[int])(nil)},
+		},
+		"with single Set": {
+			expect: true,
+			sets:   []Set[int]{Hash(123, 456)},
+		},
+		"with no Sets": {
+			expect: true,
+			sets:   nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if result := Disjoint(tc.sets...); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_ElementsMatch(t *testing.T) {
+	testCases := map[string]struct {
+		expectExtra   []int
+		expectMissing []int
+		expectOk      bool
+		expected      []int
+		set           Set[int]
+	}{
+		"with exact match": {
+			expectOk: true,
+			expected: []int{789, 123, 456},
+			set:      Hash(123, 456, 789),
+		},
+		"with exact match containing duplicates": {
+			expectOk: true,
+			expected: []int{123, 456, 123, 789},
+			set:      Hash(123, 456, 789),
+		},
+		"with missing elements only": {
+			expectMissing: []int{999, 0},
+			expected:      []int{123, 999, 456, 0, 999},
+			set:           Hash(123, 456),
+		},
+		"with extra elements only": {
+			expectExtra: []int{456, 789},
+			expected:    []int{123},
+			set:         Hash(123, 456, 789),
+		},
+		"with missing and extra elements": {
+			expectExtra:   []int{789},
+			expectMissing: []int{999},
+			expected:      []int{123, 999},
+			set:           Hash(123, 789),
+		},
+		"with nil slice": {
+			expectExtra: []int{123},
+			expected:    nil,
+			set:         Hash(123),
+		},
+		"with nil slice and empty Set": {
+			expectOk: true,
+			expected: nil,
+			set:      Hash[int](),
+		},
+		"with nil Set": {
+			expectMissing: []int{123},
+			expected:      []int{123},
+			set:           nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			missing, extra, ok := ElementsMatch(tc.set, tc.expected)
+			if diff := cmp.Diff(tc.expectMissing, missing); diff != "" {
+				t.Errorf("unexpected missing elements (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.expectExtra, extra, cmpopts.SortSlices(Asc[int])); diff != "" {
+				t.Errorf("unexpected extra elements (-want +got):\n%s", diff)
+			}
+			if ok != tc.expectOk {
+				t.Errorf("unexpected match; want %v, got %v", tc.expectOk, ok)
+			}
+		})
+	}
+}
+
 func Test_Equal(t *testing.T) {
 	testCases := map[string]struct {
 		expect bool
@@ -712,665 +1338,3435 @@ func Test_Equal_Nil(t *testing.T) {
 	}
 }
 
-func Test_Group(t *testing.T) {
+func Test_EqualApprox(t *testing.T) {
 	testCases := map[string]struct {
-		expect      map[string]Set[int]
-		grouperFunc func(element int) string
-		set         Set[int]
+		epsilon float64
+		expect  bool
+		other   Set[float64]
+		set     Set[float64]
 	}{
-		"with non-empty *HashSet with multi-group grouper": {
-			expect: map[string]Set[int]{
-				"negative": Hash(-789, -456, -123),
-				"positive": Hash(123, 456, 789),
-			},
-			grouperFunc: func(element int) string {
-				if element < 0 {
-					return "negative"
-				}
-				return "positive"
-			},
-			set: Hash(-789, -456, -123, 123, 456, 789),
+		"with empty Sets": {
+			epsilon: 0.01,
+			expect:  true,
+			other:   Hash[float64](),
+			set:     Hash[float64](),
+		},
+		"with Sets containing same elements": {
+			epsilon: 0,
+			expect:  true,
+			other:   Hash(1.23, 4.56, 7.89),
+			set:     Hash(1.23, 4.56, 7.89),
+		},
+		"with Sets containing elements differing by less than epsilon": {
+			epsilon: 0.01,
+			expect:  true,
+			other:   Hash(1.231, 4.559, 7.895),
+			set:     Hash(1.23, 4.56, 7.89),
+		},
+		"with Sets containing elements differing by exactly epsilon": {
+			epsilon: 0.5,
+			expect:  true,
+			other:   Hash(1.5, 2.5),
+			set:     Hash(1.0, 2.0),
+		},
+		"with Sets containing elements differing by more than epsilon": {
+			epsilon: 0.01,
+			expect:  false,
+			other:   Hash(1.23, 4.56, 7.95),
+			set:     Hash(1.23, 4.56, 7.89),
+		},
+		"with Sets containing elements only pairable in sorted order": {
+			epsilon: 0.1,
+			expect:  true,
+			other:   Hash(1.05, 1.15),
+			set:     Hash(1.0, 1.1),
+		},
+		"with Sets containing different number of elements": {
+			epsilon: 1,
+			expect:  false,
+			other:   Hash(1.23, 4.56),
+			set:     Hash(1.23, 4.56, 7.89),
+		},
+		"with Sets containing infinities": {
+			epsilon: 0.01,
+			expect:  true,
+			other:   Hash(math.Inf(-1), math.Inf(1)),
+			set:     Hash(math.Inf(-1), math.Inf(1)),
+		},
+		"with Set containing NaN": {
+			epsilon: math.Inf(1),
+			expect:  false,
+			other:   Hash(1.23, 4.56),
+			set:     Hash(1.23, math.NaN()),
+		},
+		"with other Set containing NaN": {
+			epsilon: math.Inf(1),
+			expect:  false,
+			other:   Hash(1.23, math.NaN()),
+			set:     Hash(1.23, 4.56),
 		},
-		"with non-empty *HashSet with single-group grouper": {
-			expect: map[string]Set[int]{
-				"positive": Hash(123, 456, 789),
-			},
-			grouperFunc: func(element int) string { return "positive" },
-			set:         Hash(123, 456, 789),
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if equal := EqualApprox(tc.set, tc.other, tc.epsilon); equal != tc.expect {
+				t.Errorf("unexpected approximate equality; want %v, got %v", tc.expect, equal)
+			}
+		})
+	}
+}
+
+func Test_EqualApprox_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		expect bool
+		other  Set[float64]
+	}{
+		"with nil other Set": {
+			expect: true,
+			other:  nil,
 		},
-		"with empty *HashSet": {
-			expect:      map[string]Set[int]{},
-			grouperFunc: func(element int) string { return "" },
-			set:         Hash[int](),
+		"with empty other Set": {
+			expect: true,
+			other:  Hash[float64](),
+		},
+		"with non-empty other Set": {
+			expect: false,
+			other:  Hash(1.23),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			groups := Group(tc.set, tc.grouperFunc)
-			if groups == nil {
-				t.Error("unexpected nil map")
+			if equal := EqualApprox(nil, tc.other, 0.01); equal != tc.expect {
+				t.Errorf("unexpected approximate equality; want %v, got %v", tc.expect, equal)
 			}
-			opts := []cmp.Option{cmp.Transformer("Set", func(in Set[int]) []int {
-				return in.SortedSlice(Asc[int])
-			})}
-			if !cmp.Equal(groups, tc.expect, opts...) {
-				t.Errorf("unexpected map; got diff %v", cmp.Diff(tc.expect, groups, opts...))
+		})
+	}
+}
+
+func Test_EqualFunc(t *testing.T) {
+	within := func(epsilon float64) func(x, y float64) bool {
+		return func(x, y float64) bool { return math.Abs(x-y) <= epsilon }
+	}
+	testCases := map[string]struct {
+		eq     func(x, y float64) bool
+		expect bool
+		other  Set[float64]
+		set    Set[float64]
+	}{
+		"with empty Sets": {
+			eq:     within(0.01),
+			expect: true,
+			other:  Hash[float64](),
+			set:    Hash[float64](),
+		},
+		"with Sets containing same elements": {
+			eq:     within(0),
+			expect: true,
+			other:  Hash(1.23, 4.56, 7.89),
+			set:    Hash(1.23, 4.56, 7.89),
+		},
+		"with Sets containing elements within tolerance": {
+			eq:     within(0.01),
+			expect: true,
+			other:  Hash(1.231, 4.559, 7.895),
+			set:    Hash(1.23, 4.56, 7.89),
+		},
+		"with Sets containing elements outside tolerance": {
+			eq:     within(0.01),
+			expect: false,
+			other:  Hash(1.23, 4.56, 7.95),
+			set:    Hash(1.23, 4.56, 7.89),
+		},
+		"with Sets containing elements only pairable by reassignment": {
+			eq:     within(0.1),
+			expect: true,
+			other:  Hash(1.05, 1.15),
+			set:    Hash(1.1, 1.0),
+		},
+		"with Sets containing elements all matching one element": {
+			eq:     within(0.1),
+			expect: false,
+			other:  Hash(1.0, 5.0),
+			set:    Hash(0.95, 1.05),
+		},
+		"with Sets containing different number of elements": {
+			eq:     within(1),
+			expect: false,
+			other:  Hash(1.23, 4.56),
+			set:    Hash(1.23, 4.56, 7.89),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if equal := EqualFunc(tc.set, tc.other, tc.eq); equal != tc.expect {
+				t.Errorf("unexpected equality; want %v, got %v", tc.expect, equal)
 			}
 		})
 	}
 }
 
-func Test_Group_Nil(t *testing.T) {
+func Test_EqualFunc_CaseInsensitive(t *testing.T) {
 	testCases := map[string]struct {
-		set Set[int]
+		expect bool
+		other  Set[string]
+		set    Set[string]
 	}{
-		"with nil Set": {
-			set: nil,
+		"with Sets containing same elements in different case": {
+			expect: true,
+			other:  Hash("FOO", "Bar", "baz"),
+			set:    Hash("foo", "bar", "BAZ"),
 		},
-		"with nil *HashSet": {
-			set: (*HashSet[int])(nil),
+		"with Sets containing different elements": {
+			expect: false,
+			other:  Hash("FOO", "Bar", "qux"),
+			set:    Hash("foo", "bar", "BAZ"),
+		},
+		"with Set containing elements equal ignoring case": {
+			expect: false,
+			other:  Hash("foo", "bar"),
+			set:    Hash("foo", "FOO"),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			var funcCallCount int
-			groups := Group(tc.set, func(element int) string {
-				return ""
+			if equal := EqualFunc(tc.set, tc.other, strings.EqualFold); equal != tc.expect {
+				t.Errorf("unexpected equality; want %v, got %v", tc.expect, equal)
+			}
+		})
+	}
+}
+
+func Test_EqualFunc_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		expect bool
+		other  Set[int]
+	}{
+		"with nil other Set": {
+			expect: true,
+			other:  nil,
+		},
+		"with empty other Set": {
+			expect: true,
+			other:  Hash[int](),
+		},
+		"with non-empty other Set": {
+			expect: false,
+			other:  Hash(123),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			equal := EqualFunc(nil, tc.other, func(x, y int) bool {
+				t.Errorf("unexpected call to eq with %v and %v", x, y)
+				return false
 			})
-			if groups != nil {
-				t.Errorf("unexpected map; want nil, got %v", groups)
+			if equal != tc.expect {
+				t.Errorf("unexpected equality; want %v, got %v", tc.expect, equal)
 			}
-			if funcCallCount != 0 {
-				t.Errorf("unexpected number of calls to grouper; want 0, got %v", funcCallCount)
+		})
+	}
+}
+
+func Test_FilterMap(t *testing.T) {
+	halveEven := func(element int) (int, bool) { return element / 2, element%2 == 0 }
+	testCases := map[string]struct {
+		expect     Set[int]
+		expectKind SetKind
+		mapper     func(element int) (int, bool)
+		set        Set[int]
+	}{
+		"with *EmptySet": {
+			expect:     Empty[int](),
+			expectKind: EmptyKind,
+			mapper:     halveEven,
+			set:        Empty[int](),
+		},
+		"with empty *HashSet": {
+			expect:     Hash[int](),
+			expectKind: HashKind,
+			mapper:     halveEven,
+			set:        Hash[int](),
+		},
+		"with non-empty *HashSet and some skipped values": {
+			expect:     Hash(1, 2),
+			expectKind: HashKind,
+			mapper:     halveEven,
+			set:        Hash(1, 2, 3, 4),
+		},
+		"with non-empty *HashSet and all skipped values": {
+			expect:     Hash[int](),
+			expectKind: HashKind,
+			mapper:     func(element int) (int, bool) { return element, false },
+			set:        Hash(1, 2, 3),
+		},
+		"with non-empty *HashSet and colliding values": {
+			expect:     Hash(0, 1),
+			expectKind: HashKind,
+			mapper:     func(element int) (int, bool) { return element % 2, element > 0 },
+			set:        Hash(-2, -1, 1, 2, 3, 4),
+		},
+		"with non-empty *MutableHashSet": {
+			expect:     MutableHash(1, 3),
+			expectKind: MutableHashKind,
+			mapper:     halveEven,
+			set:        MutableHash(1, 2, 5, 6),
+		},
+		"with *SingletonSet and kept value": {
+			expect:     Hash(2),
+			expectKind: HashKind,
+			mapper:     halveEven,
+			set:        Singleton(4),
+		},
+		"with *SingletonSet and skipped value": {
+			expect:     Hash[int](),
+			expectKind: HashKind,
+			mapper:     halveEven,
+			set:        Singleton(3),
+		},
+		"with non-empty *SyncHashSet": {
+			expect:     SyncHash(1),
+			expectKind: SyncHashKind,
+			mapper:     func(element int) (int, bool) { return 1, element > 1 },
+			set:        SyncHash(1, 2, 3),
+		},
+		"with non-empty *SmallSet": {
+			expect:     MutableHash(1),
+			expectKind: MutableHashKind,
+			mapper:     halveEven,
+			set:        Small(Asc[int], 1, 2, 3),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mapped := FilterMap(tc.set, tc.mapper)
+			if internal.IsNil(mapped) {
+				t.Fatal("unexpected nil Set")
+			}
+			if kind := mapped.Kind(); kind != tc.expectKind {
+				t.Errorf("unexpected SetKind; want %v, got %v", tc.expectKind, kind)
+			}
+			if !mapped.Equal(tc.expect) {
+				t.Errorf("unexpected mapped Set; want %v, got %v", tc.expect, mapped)
 			}
 		})
 	}
 }
 
-func Test_Intersection(t *testing.T) {
+func Test_FilterMap_Nil(t *testing.T) {
+	mapper := func(element int) (string, bool) {
+		t.Errorf("unexpected call to mapper with %v", element)
+		return "", true
+	}
 	testCases := map[string]struct {
-		expect Set[int]
-		others []Set[int]
+		expect Set[string]
 		set    Set[int]
 	}{
-		"with non-empty *HashSet and mix of nil, empty, and non-empty Sets containing no intersections": {
-			expect: Hash[int](),
-			others: []Set[int]{
-				Singleton(-789),
-				nil,
-				Empty[int](),
-				(*HashSet[int])(nil),
-				Hash(-456, -123),
-			},
-			set: Hash(123, 456, 789),
+		"with nil Set": {
+			expect: nil,
+			set:    nil,
 		},
-		"with non-empty *HashSet and mix of nil, empty, and non-empty Sets containing single intersection": {
-			expect: Hash(123),
-			others: []Set[int]{
-				Singleton(-789),
-				nil,
-				Empty[int](),
-				(*HashSet[int])(nil),
-				Hash(-123, 0, 123),
-			},
-			set: Hash(123, 456, 789),
+		"with nil *EmptySet": {
+			expect: (*EmptySet[string])(nil),
+			set:    (*EmptySet[int])(nil),
 		},
-		"with non-empty *HashSet and mix of nil, empty, and non-empty Sets containing multiple intersections": {
-			expect: Hash(123, 456),
-			others: []Set[int]{
-				Singleton(0),
-				nil,
-				Empty[int](),
-				(*HashSet[int])(nil),
-				Hash(123, 456),
-			},
-			set: Hash(123, 456, 789),
+		"with nil *HashSet": {
+			expect: (*HashSet[string])(nil),
+			set:    (*HashSet[int])(nil),
 		},
-		"with non-empty *HashSet and mix of nil, empty, and non-empty Sets containing full intersection": {
-			expect: Hash(123, 456, 789),
-			others: []Set[int]{
-				Singleton(123),
-				nil,
-				Empty[int](),
-				(*HashSet[int])(nil),
-				Hash(456, 789),
-			},
-			set: Hash(123, 456, 789),
+		"with nil *MutableHashSet": {
+			expect: (*MutableHashSet[string])(nil),
+			set:    (*MutableHashSet[int])(nil),
 		},
-		"with non-empty *HashSet and non-empty Sets containing no intersections": {
-			expect: Hash[int](),
-			others: []Set[int]{
-				Singleton(-789),
-				Hash(-456, -123),
-			},
-			set: Hash(123, 456, 789),
+		"with nil *SingletonSet": {
+			expect: (*HashSet[string])(nil),
+			set:    (*SingletonSet[int])(nil),
 		},
-		"with non-empty *HashSet and non-empty Sets containing single intersection": {
-			expect: Hash(123),
-			others: []Set[int]{
-				Singleton(-789),
-				Hash(-123, 0, 123),
-			},
-			set: Hash(123, 456, 789),
+		"with nil *SyncHashSet": {
+			expect: (*SyncHashSet[string])(nil),
+			set:    (*SyncHashSet[int])(nil),
 		},
-		"with non-empty *HashSet and non-empty Sets containing multiple intersections": {
-			expect: Hash(123, 456),
-			others: []Set[int]{
-				Singleton(0),
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if mapped := FilterMap(tc.set, mapper); mapped != tc.expect {
+				t.Errorf("unexpected mapped Set; want %v, got %v", tc.expect, mapped)
+			}
+		})
+	}
+}
+
+func Test_FindDifferences(t *testing.T) {
+	testCases := map[string]struct {
+		candidates []Set[int]
+		expect     []int
+		reference  Set[int]
+	}{
+		"with non-empty *HashSet reference and mix of equal and unequal candidates": {
+			candidates: []Set[int]{
+				Hash(123, 456, 789),
 				Hash(123, 456),
+				MutableHash(789, 456, 123),
+				nil,
+				Hash(123, 456, 789, 0),
+				SyncHash(123, 456, 789),
+				Hash[int](),
 			},
-			set: Hash(123, 456, 789),
+			expect:    []int{1, 3, 4, 6},
+			reference: Hash(123, 456, 789),
 		},
-		"with non-empty *HashSet and non-empty Sets containing full intersection": {
-			expect: Hash(123, 456, 789),
-			others: []Set[int]{
-				Singleton(123),
-				Hash(456, 789),
+		"with non-empty *HashSet reference and only equal candidates": {
+			candidates: []Set[int]{
+				Hash(123, 456, 789),
+				MutableHash(123, 456, 789),
 			},
-			set: Hash(123, 456, 789),
+			expect:    nil,
+			reference: Hash(123, 456, 789),
 		},
-		"with non-empty *HashSet and mix of nil and empty Sets": {
-			expect: Hash[int](),
-			others: []Set[int]{
-				nil,
-				Empty[int](),
-				(*HashSet[int])(nil),
+		"with non-empty *HashSet reference and only unequal candidates": {
+			candidates: []Set[int]{
+				Hash(123),
+				Singleton(456),
 			},
-			set: Hash(123, 456, 789),
+			expect:    []int{0, 1},
+			reference: Hash(123, 456, 789),
 		},
-		"with non-empty *HashSet and empty Sets": {
-			expect: Hash[int](),
-			others: []Set[int]{
-				Empty[int](),
-				Hash[int](),
-			},
-			set: Hash(123, 456, 789),
+		"with non-empty *HashSet reference and no candidates": {
+			candidates: nil,
+			expect:     nil,
+			reference:  Hash(123, 456, 789),
 		},
-		"with non-empty *HashSet and nil Sets": {
-			expect: Hash[int](),
-			others: []Set[int]{
+		"with empty *HashSet reference and mix of empty, nil, and non-empty candidates": {
+			candidates: []Set[int]{
+				Hash[int](),
 				nil,
 				(*HashSet[int])(nil),
+				Singleton(0),
+				Empty[int](),
 			},
-			set: Hash(123, 456, 789),
+			expect:    []int{3},
+			reference: Hash[int](),
 		},
-		"with empty *HashSet and mix of nil, empty, and non-empty Sets": {
-			expect: Hash[int](),
-			others: []Set[int]{
-				Singleton(123),
+		"with nil Set reference and mix of empty, nil, and non-empty candidates": {
+			candidates: []Set[int]{
+				Hash[int](),
 				nil,
-				Empty[int](),
-				(*HashSet[int])(nil),
-				Hash(456, 789),
+				Hash(123),
 			},
-			set: Hash[int](),
+			expect:    []int{2},
+			reference: nil,
 		},
-		"with empty *HashSet and non-empty Sets": {
-			expect: Hash[int](),
-			others: []Set[int]{
-				Singleton(123),
-				Hash(456, 789),
-			},
-			set: Hash[int](),
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := FindDifferences(tc.reference, tc.candidates...)
+			if !cmp.Equal(tc.expect, result) {
+				t.Errorf("unexpected indices; got diff %v", cmp.Diff(tc.expect, result))
+			}
+		})
+	}
+}
+
+func Test_FlatMap(t *testing.T) {
+	neighbors := func(element int) []int { return []int{element - 1, element, element + 1} }
+	testCases := map[string]struct {
+		expect     Set[int]
+		expectKind SetKind
+		mapper     func(element int) []int
+		set        Set[int]
+	}{
+		"with *EmptySet": {
+			expect:     Empty[int](),
+			expectKind: EmptyKind,
+			mapper:     neighbors,
+			set:        Empty[int](),
 		},
-		"with empty *HashSet and empty Sets": {
-			expect: Hash[int](),
-			others: []Set[int]{
-				Empty[int](),
-				Hash[int](),
+		"with empty *HashSet": {
+			expect:     Hash[int](),
+			expectKind: HashKind,
+			mapper:     neighbors,
+			set:        Hash[int](),
+		},
+		"with non-empty *HashSet and overlapping values": {
+			expect:     Hash(0, 1, 2, 3, 4),
+			expectKind: HashKind,
+			mapper:     neighbors,
+			set:        Hash(1, 2, 3),
+		},
+		"with non-empty *HashSet and empty values": {
+			expect:     Hash[int](),
+			expectKind: HashKind,
+			mapper:     func(_ int) []int { return []int{} },
+			set:        Hash(1, 2, 3),
+		},
+		"with non-empty *HashSet and nil values": {
+			expect:     Hash[int](),
+			expectKind: HashKind,
+			mapper:     func(_ int) []int { return nil },
+			set:        Hash(1, 2, 3),
+		},
+		"with non-empty *HashSet and some empty values": {
+			expect:     Hash(2, 4),
+			expectKind: HashKind,
+			mapper: func(element int) []int {
+				if element%2 == 0 {
+					return []int{element, element}
+				}
+				return nil
 			},
-			set: Hash[int](),
+			set: Hash(1, 2, 3, 4),
 		},
-		"with empty *HashSet and nil Sets": {
-			expect: Hash[int](),
-			others: []Set[int]{
-				nil,
-				(*HashSet[int])(nil),
-			},
-			set: Hash[int](),
+		"with non-empty *MutableHashSet": {
+			expect:     MutableHash(0, 1, 2, 3, 4),
+			expectKind: MutableHashKind,
+			mapper:     neighbors,
+			set:        MutableHash(1, 3),
 		},
-		"with non-empty *MutableHashSet and mix of nil, empty, and non-empty Sets containing multiple intersections": {
-			expect: MutableHash(123, 456),
-			others: []Set[int]{
-				Singleton(0),
-				nil,
-				Empty[int](),
-				(*HashSet[int])(nil),
-				Hash(123, 456),
-			},
-			set: MutableHash(123, 456, 789),
+		"with *SingletonSet": {
+			expect:     Hash(122, 123, 124),
+			expectKind: HashKind,
+			mapper:     neighbors,
+			set:        Singleton(123),
 		},
-		"with empty *MutableHashSet and mix of nil, empty, and non-empty Sets": {
-			expect: MutableHash[int](),
-			others: []Set[int]{
-				Singleton(123),
-				nil,
-				Empty[int](),
-				(*HashSet[int])(nil),
-				Hash(456, 789),
-			},
-			set: MutableHash[int](),
+		"with non-empty *SyncHashSet": {
+			expect:     SyncHash(0, 1, 2, 3),
+			expectKind: SyncHashKind,
+			mapper:     neighbors,
+			set:        SyncHash(1, 2),
+		},
+		"with non-empty *SmallSet": {
+			expect:     MutableHash(0, 1, 2),
+			expectKind: MutableHashKind,
+			mapper:     neighbors,
+			set:        Small(Asc[int], 1),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			intersection := Intersection(tc.set, tc.others...)
-			if internal.IsNil(intersection) {
-				t.Error("unexpected nil Set")
+			mapped := FlatMap(tc.set, tc.mapper)
+			if internal.IsNil(mapped) {
+				t.Fatal("unexpected nil Set")
 			}
-			if !intersection.Equal(tc.expect) {
-				t.Errorf("unexpected intersection Set; want %v, got %v", tc.expect, intersection)
+			if kind := mapped.Kind(); kind != tc.expectKind {
+				t.Errorf("unexpected SetKind; want %v, got %v", tc.expectKind, kind)
 			}
-			if tc.expect.IsMutable() != intersection.IsMutable() {
-				t.Errorf("unexpected intersection Set mutability; want %v, got %v", tc.expect.IsMutable(), intersection.IsMutable())
+			if !mapped.Equal(tc.expect) {
+				t.Errorf("unexpected mapped Set; want %v, got %v", tc.expect, mapped)
 			}
 		})
 	}
 }
 
-func Test_Intersection_Nil(t *testing.T) {
+func Test_FlatMap_Nil(t *testing.T) {
+	mapper := func(element int) []string {
+		t.Errorf("unexpected call to mapper with %v", element)
+		return nil
+	}
 	testCases := map[string]struct {
-		others []Set[int]
+		expect Set[string]
 		set    Set[int]
 	}{
 		"with nil Set": {
-			others: []Set[int]{
-				Singleton(0),
-				nil,
-				Empty[int](),
-				(*HashSet[int])(nil),
-				Hash(123, 456),
+			expect: nil,
+			set:    nil,
+		},
+		"with nil *EmptySet": {
+			expect: (*EmptySet[string])(nil),
+			set:    (*EmptySet[int])(nil),
+		},
+		"with nil *HashSet": {
+			expect: (*HashSet[string])(nil),
+			set:    (*HashSet[int])(nil),
+		},
+		"with nil *MutableHashSet": {
+			expect: (*MutableHashSet[string])(nil),
+			set:    (*MutableHashSet[int])(nil),
+		},
+		"with nil *SingletonSet": {
+			expect: (*HashSet[string])(nil),
+			set:    (*SingletonSet[int])(nil),
+		},
+		"with nil *SyncHashSet": {
+			expect: (*SyncHashSet[string])(nil),
+			set:    (*SyncHashSet[int])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if mapped := FlatMap(tc.set, mapper); mapped != tc.expect {
+				t.Errorf("unexpected mapped Set; want %v, got %v", tc.expect, mapped)
+			}
+		})
+	}
+}
+
+func Test_Group(t *testing.T) {
+	testCases := map[string]struct {
+		expect      map[string]Set[int]
+		grouperFunc func(element int) string
+		set         Set[int]
+	}{
+		"with non-empty *HashSet with multi-group grouper": {
+			expect: map[string]Set[int]{
+				"negative": Hash(-789, -456, -123),
+				"positive": Hash(123, 456, 789),
+			},
+			grouperFunc: func(element int) string {
+				if element < 0 {
+					return "negative"
+				}
+				return "positive"
 			},
+			set: Hash(-789, -456, -123, 123, 456, 789),
+		},
+		"with non-empty *HashSet with single-group grouper": {
+			expect: map[string]Set[int]{
+				"positive": Hash(123, 456, 789),
+			},
+			grouperFunc: func(element int) string { return "positive" },
+			set:         Hash(123, 456, 789),
+		},
+		"with empty *HashSet": {
+			expect:      map[string]Set[int]{},
+			grouperFunc: func(element int) string { return "" },
+			set:         Hash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			groups := Group(tc.set, tc.grouperFunc)
+			if groups == nil {
+				t.Error("unexpected nil map")
+			}
+			opts := []cmp.Option{cmp.Transformer("Set", func(in Set[int]) []int {
+				return in.SortedSlice(Asc[int])
+			})}
+			if !cmp.Equal(groups, tc.expect, opts...) {
+				t.Errorf("unexpected map; got diff %v", cmp.Diff(tc.expect, groups, opts...))
+			}
+		})
+	}
+}
+
+func Test_Group_IntKey(t *testing.T) {
+	groups := Group[int](Hash(1, 5, 12, 17, 25, 29), func(element int) int { return element / 10 })
+	expect := map[int]Set[int]{
+		0: Hash(1, 5),
+		1: Hash(12, 17),
+		2: Hash(25, 29),
+	}
+	opts := []cmp.Option{cmp.Transformer("Set", func(in Set[int]) []int {
+		return in.SortedSlice(Asc[int])
+	})}
+	if !cmp.Equal(groups, expect, opts...) {
+		t.Errorf("unexpected map; got diff %v", cmp.Diff(expect, groups, opts...))
+	}
+}
+
+func Test_Group_StructKey(t *testing.T) {
+	type key struct {
+		even     bool
+		negative bool
+	}
+	groups := Group[int](Hash(-2, -1, 0, 1, 2, 3), func(element int) key {
+		return key{even: element%2 == 0, negative: element < 0}
+	})
+	expect := map[key]Set[int]{
+		{even: true, negative: true}:   Hash(-2),
+		{even: false, negative: true}:  Hash(-1),
+		{even: true, negative: false}:  Hash(0, 2),
+		{even: false, negative: false}: Hash(1, 3),
+	}
+	opts := []cmp.Option{cmp.Transformer("Set", func(in Set[int]) []int {
+		return in.SortedSlice(Asc[int])
+	})}
+	if !cmp.Equal(groups, expect, opts...) {
+		t.Errorf("unexpected map; got diff %v", cmp.Diff(expect, groups, opts...))
+	}
+}
+
+func Test_Group_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		set Set[int]
+	}{
+		"with nil Set": {
 			set: nil,
 		},
 		"with nil *HashSet": {
-			others: []Set[int]{
-				Singleton(123),
-				nil,
-				Empty[int](),
-				(*HashSet[int])(nil),
-				Hash(456, 789),
-			},
 			set: (*HashSet[int])(nil),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			diff := Intersection(tc.set, tc.others...)
-			if internal.IsNotNil(diff) {
-				t.Errorf("unexpected Set; want nil, got %v", diff)
+			var funcCallCount int
+			groups := Group(tc.set, func(element int) string {
+				return ""
+			})
+			if groups != nil {
+				t.Errorf("unexpected map; want nil, got %v", groups)
+			}
+			if funcCallCount != 0 {
+				t.Errorf("unexpected number of calls to grouper; want 0, got %v", funcCallCount)
+			}
+		})
+	}
+}
+
+func Test_GroupKeysOf(t *testing.T) {
+	testCases := map[string]struct {
+		expect []string
+		groups map[string]Set[int]
+	}{
+		"with groups from Group": {
+			expect: []string{"even", "odd"},
+			groups: Group[int](Hash(1, 2, 3, 4), func(element int) string {
+				if element%2 == 0 {
+					return "even"
+				}
+				return "odd"
+			}),
+		},
+		"with groups containing nil Set": {
+			expect: []string{"bar", "foo"},
+			groups: map[string]Set[int]{"foo": Hash(123), "bar": nil},
+		},
+		"with no groups": {
+			expect: []string{},
+			groups: map[string]Set[int]{},
+		},
+		"with nil map": {
+			expect: []string{},
+			groups: nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := GroupKeysOf(tc.groups)
+			if result == nil {
+				t.Fatal("unexpected Set; want non-nil, got nil")
+			}
+			if keys := result.SortedSlice(Asc[string]); !cmp.Equal(tc.expect, keys, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected keys; want %v, got %v", tc.expect, keys)
+			}
+		})
+	}
+}
+
+func Test_GroupMutable(t *testing.T) {
+	testCases := map[string]struct {
+		expect      map[string]Set[int]
+		grouperFunc func(element int) string
+		set         Set[int]
+	}{
+		"with non-empty *HashSet with multi-group grouper": {
+			expect: map[string]Set[int]{
+				"negative": Hash(-789, -456, -123),
+				"positive": Hash(123, 456, 789),
+			},
+			grouperFunc: func(element int) string {
+				if element < 0 {
+					return "negative"
+				}
+				return "positive"
+			},
+			set: Hash(-789, -456, -123, 123, 456, 789),
+		},
+		"with non-empty *MutableHashSet with single-group grouper": {
+			expect: map[string]Set[int]{
+				"positive": Hash(123, 456, 789),
+			},
+			grouperFunc: func(element int) string { return "positive" },
+			set:         MutableHash(123, 456, 789),
+		},
+		"with *SingletonSet": {
+			expect: map[string]Set[int]{
+				"positive": Hash(123),
+			},
+			grouperFunc: func(element int) string { return "positive" },
+			set:         Singleton(123),
+		},
+		"with empty *HashSet": {
+			expect:      map[string]Set[int]{},
+			grouperFunc: func(element int) string { return "" },
+			set:         Hash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			groups := GroupMutable(tc.set, tc.grouperFunc)
+			if groups == nil {
+				t.Fatal("unexpected nil map")
+			}
+			if len(groups) != len(tc.expect) {
+				t.Errorf("unexpected number of groups; want %v, got %v", len(tc.expect), len(groups))
+			}
+			for key, expect := range tc.expect {
+				group := groups[key]
+				if group == nil {
+					t.Errorf("unexpected nil group for %q", key)
+					continue
+				}
+				if !group.IsMutable() {
+					t.Errorf("unexpected immutable group for %q", key)
+				}
+				if !group.Equal(expect) {
+					t.Errorf("unexpected group for %q; want %v, got %v", key, expect, group)
+				}
+			}
+		})
+	}
+}
+
+func Test_GroupMutable_Independent(t *testing.T) {
+	set := MutableHash(1, 2, 3, 4)
+	groups := GroupMutable[int](set, func(element int) string {
+		if element%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	})
+
+	groups["even"].Put(6)
+	groups["odd"].Delete(1)
+	set.Put(5)
+
+	if expect := Hash(1, 2, 3, 4, 5); !set.Equal(expect) {
+		t.Errorf("unexpected Set; want %v, got %v", expect, set)
+	}
+	if expect := Hash(2, 4, 6); !groups["even"].Equal(expect) {
+		t.Errorf("unexpected even group; want %v, got %v", expect, groups["even"])
+	}
+	if expect := Hash(3); !groups["odd"].Equal(expect) {
+		t.Errorf("unexpected odd group; want %v, got %v", expect, groups["odd"])
+	}
+}
+
+func Test_GroupMutable_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		set Set[int]
+	}{
+		"with nil Set": {
+			set: nil,
+		},
+		"with nil *HashSet": {
+			set: (*HashSet[int])(nil),
+		},
+		"with nil *MutableHashSet": {
+			set: (*MutableHashSet[int])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var funcCallCount int
+			groups := GroupMutable(tc.set, func(element int) string {
+				funcCallCount++
+				return ""
+			})
+			if groups != nil {
+				t.Errorf("unexpected map; want nil, got %v", groups)
+			}
+			if funcCallCount != 0 {
+				t.Errorf("unexpected number of calls to grouper; want 0, got %v", funcCallCount)
+			}
+		})
+	}
+}
+
+func Test_GroupedSortedJoin(t *testing.T) {
+	classifyFunc := func(element int) string {
+		if element < 0 {
+			return "negative"
+		}
+		return "positive"
+	}
+	testCases := map[string]struct {
+		expect   string
+		lessFunc func(x, y int) bool
+		set      Set[int]
+	}{
+		"with *HashSet containing elements within multiple groups and ascending less": {
+			expect:   "-789,-456,-123 | 0,123,456,789",
+			lessFunc: Asc[int],
+			set:      Hash(789, -123, 456, 0, -789, 123, -456),
+		},
+		"with *HashSet containing elements within multiple groups and descending less": {
+			expect:   "-123,-456,-789 | 789,456,123,0",
+			lessFunc: Desc[int],
+			set:      Hash(789, -123, 456, 0, -789, 123, -456),
+		},
+		"with *HashSet containing elements within single group": {
+			expect:   "123,456,789",
+			lessFunc: Asc[int],
+			set:      Hash(789, 123, 456),
+		},
+		"with *HashSet containing single element": {
+			expect:   "123",
+			lessFunc: Asc[int],
+			set:      Hash(123),
+		},
+		"with *HashSet containing no elements": {
+			expect:   "",
+			lessFunc: Asc[int],
+			set:      Hash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := GroupedSortedJoin(tc.set, ",", " | ", getIntStringConverterWithDefaultOptions[int](), classifyFunc, tc.lessFunc)
+			if result != tc.expect {
+				t.Errorf("unexpected result; want %q, got %q", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_GroupedSortedJoin_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		set Set[int]
+	}{
+		"with nil Set": {
+			set: nil,
+		},
+		"with nil *HashSet": {
+			set: (*HashSet[int])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var funcCallCount int
+			result := GroupedSortedJoin(tc.set, ",", " | ", func(element int) string {
+				funcCallCount++
+				return ""
+			}, func(element int) string {
+				funcCallCount++
+				return ""
+			}, Asc[int])
+			if exp := ""; result != exp {
+				t.Errorf("unexpected result; want %q, got %q", exp, result)
+			}
+			if funcCallCount != 0 {
+				t.Errorf("unexpected number of calls to convert and classify; want 0, got %v", funcCallCount)
+			}
+		})
+	}
+}
+
+func Test_HasNilElement(t *testing.T) {
+	a, b := 123, 456
+	testCases := map[string]struct {
+		expect bool
+		set    Set[*int]
+	}{
+		"with HashSet containing nil and non-nil elements": {
+			expect: true,
+			set:    Hash(&a, nil, &b),
+		},
+		"with HashSet containing only nil element": {
+			expect: true,
+			set:    Hash[*int](nil),
+		},
+		"with HashSet containing no nil elements": {
+			expect: false,
+			set:    Hash(&a, &b),
+		},
+		"with SingletonSet containing nil element": {
+			expect: true,
+			set:    Singleton[*int](nil),
+		},
+		"with Set containing no elements": {
+			expect: false,
+			set:    Hash[*int](),
+		},
+		"with nil Set": {
+			expect: false,
+			set:    nil,
+		},
+		"with nil *HashSet": {
+			expect: false,
+			set:    (*HashSet[*int])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if result := HasNilElement(tc.set); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_IndexBy(t *testing.T) {
+	testCases := map[string]struct {
+		items  []string
+		expect map[int]*HashSet[string]
+	}{
+		"with nil slice": {
+			items:  nil,
+			expect: map[int]*HashSet[string]{},
+		},
+		"with slice containing no items": {
+			items:  []string{},
+			expect: map[int]*HashSet[string]{},
+		},
+		"with slice containing single item": {
+			items:  []string{"alpha"},
+			expect: map[int]*HashSet[string]{5: Hash("alpha")},
+		},
+		"with slice containing multiple items with unique keys": {
+			items:  []string{"a", "bb", "ccc"},
+			expect: map[int]*HashSet[string]{1: Hash("a"), 2: Hash("bb"), 3: Hash("ccc")},
+		},
+		"with slice containing multiple items with equal keys": {
+			items: []string{"alpha", "beta", "gamma", "delta", "pi", "mu", "beta"},
+			expect: map[int]*HashSet[string]{
+				2: Hash("pi", "mu"),
+				4: Hash("beta"),
+				5: Hash("alpha", "gamma", "delta"),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			index := IndexBy(tc.items, func(item string) int { return len(item) })
+			if index == nil {
+				t.Fatal("unexpected nil map")
+			}
+			if exp, act := len(tc.expect), len(index); act != exp {
+				t.Errorf("unexpected map length; want %v, got %v", exp, act)
+			}
+			for key, expect := range tc.expect {
+				set, ok := index[key]
+				if !ok {
+					t.Errorf("unexpected missing key: %v", key)
+					continue
+				}
+				if !set.Equal(expect) {
+					t.Errorf("unexpected Set for key %v; want %v, got %v", key, expect, set)
+				}
+			}
+		})
+	}
+}
+
+func Test_Intersection(t *testing.T) {
+	testCases := map[string]struct {
+		expect Set[int]
+		others []Set[int]
+		set    Set[int]
+	}{
+		"with non-empty *HashSet and mix of nil, empty, and non-empty Sets containing no intersections": {
+			expect: Hash[int](),
+			others: []Set[int]{
+				Singleton(-789),
+				nil,
+				Empty[int](),
+				(*HashSet[int])(nil),
+				Hash(-456, -123),
+			},
+			set: Hash(123, 456, 789),
+		},
+		"with non-empty *HashSet and mix of nil, empty, and non-empty Sets containing single intersection": {
+			expect: Hash(123),
+			others: []Set[int]{
+				Singleton(-789),
+				nil,
+				Empty[int](),
+				(*HashSet[int])(nil),
+				Hash(-123, 0, 123),
+			},
+			set: Hash(123, 456, 789),
+		},
+		"with non-empty *HashSet and mix of nil, empty, and non-empty Sets containing multiple intersections": {
+			expect: Hash(123, 456),
+			others: []Set[int]{
+				Singleton(0),
+				nil,
+				Empty[int](),
+				(*HashSet[int])(nil),
+				Hash(123, 456),
+			},
+			set: Hash(123, 456, 789),
+		},
+		"with non-empty *HashSet and mix of nil, empty, and non-empty Sets containing full intersection": {
+			expect: Hash(123, 456, 789),
+			others: []Set[int]{
+				Singleton(123),
+				nil,
+				Empty[int](),
+				(*HashSet[int])(nil),
+				Hash(456, 789),
+			},
+			set: Hash(123, 456, 789),
+		},
+		"with non-empty *HashSet and non-empty Sets containing no intersections": {
+			expect: Hash[int](),
+			others: []Set[int]{
+				Singleton(-789),
+				Hash(-456, -123),
+			},
+			set: Hash(123, 456, 789),
+		},
+		"with non-empty *HashSet and non-empty Sets containing single intersection": {
+			expect: Hash(123),
+			others: []Set[int]{
+				Singleton(-789),
+				Hash(-123, 0, 123),
+			},
+			set: Hash(123, 456, 789),
+		},
+		"with non-empty *HashSet and non-empty Sets containing multiple intersections": {
+			expect: Hash(123, 456),
+			others: []Set[int]{
+				Singleton(0),
+				Hash(123, 456),
+			},
+			set: Hash(123, 456, 789),
+		},
+		"with non-empty *HashSet and non-empty Sets containing full intersection": {
+			expect: Hash(123, 456, 789),
+			others: []Set[int]{
+				Singleton(123),
+				Hash(456, 789),
+			},
+			set: Hash(123, 456, 789),
+		},
+		"with non-empty *HashSet and mix of nil and empty Sets": {
+			expect: Hash[int](),
+			others: []Set[int]{
+				nil,
+				Empty[int](),
+				(*HashSet[int])(nil),
+			},
+			set: Hash(123, 456, 789),
+		},
+		"with non-empty *HashSet and empty Sets": {
+			expect: Hash[int](),
+			others: []Set[int]{
+				Empty[int](),
+				Hash[int](),
+			},
+			set: Hash(123, 456, 789),
+		},
+		"with non-empty *HashSet and nil Sets": {
+			expect: Hash[int](),
+			others: []Set[int]{
+				nil,
+				(*HashSet[int])(nil),
+			},
+			set: Hash(123, 456, 789),
+		},
+		"with empty *HashSet and mix of nil, empty, and non-empty Sets": {
+			expect: Hash[int](),
+			others: []Set[int]{
+				Singleton(123),
+				nil,
+				Empty[int](),
+				(*HashSet[int])(nil),
+				Hash(456, 789),
+			},
+			set: Hash[int](),
+		},
+		"with empty *HashSet and non-empty Sets": {
+			expect: Hash[int](),
+			others: []Set[int]{
+				Singleton(123),
+				Hash(456, 789),
+			},
+			set: Hash[int](),
+		},
+		"with empty *HashSet and empty Sets": {
+			expect: Hash[int](),
+			others: []Set[int]{
+				Empty[int](),
+				Hash[int](),
+			},
+			set: Hash[int](),
+		},
+		"with empty *HashSet and nil Sets": {
+			expect: Hash[int](),
+			others: []Set[int]{
+				nil,
+				(*HashSet[int])(nil),
+			},
+			set: Hash[int](),
+		},
+		"with non-empty *MutableHashSet and mix of nil, empty, and non-empty Sets containing multiple intersections": {
+			expect: MutableHash(123, 456),
+			others: []Set[int]{
+				Singleton(0),
+				nil,
+				Empty[int](),
+				(*HashSet[int])(nil),
+				Hash(123, 456),
+			},
+			set: MutableHash(123, 456, 789),
+		},
+		"with empty *MutableHashSet and mix of nil, empty, and non-empty Sets": {
+			expect: MutableHash[int](),
+			others: []Set[int]{
+				Singleton(123),
+				nil,
+				Empty[int](),
+				(*HashSet[int])(nil),
+				Hash(456, 789),
+			},
+			set: MutableHash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			intersection := Intersection(tc.set, tc.others...)
+			if internal.IsNil(intersection) {
+				t.Error("unexpected nil Set")
+			}
+			if !intersection.Equal(tc.expect) {
+				t.Errorf("unexpected intersection Set; want %v, got %v", tc.expect, intersection)
+			}
+			if tc.expect.IsMutable() != intersection.IsMutable() {
+				t.Errorf("unexpected intersection Set mutability; want %v, got %v", tc.expect.IsMutable(), intersection.IsMutable())
+			}
+		})
+	}
+}
+
+func Test_Intersection_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		others []Set[int]
+		set    Set[int]
+	}{
+		"with nil Set": {
+			others: []Set[int]{
+				Singleton(0),
+				nil,
+				Empty[int](),
+				(*HashSet[int])(nil),
+				Hash(123, 456),
+			},
+			set: nil,
+		},
+		"with nil *HashSet": {
+			others: []Set[int]{
+				Singleton(123),
+				nil,
+				Empty[int](),
+				(*HashSet[int])(nil),
+				Hash(456, 789),
+			},
+			set: (*HashSet[int])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diff := Intersection(tc.set, tc.others...)
+			if internal.IsNotNil(diff) {
+				t.Errorf("unexpected Set; want nil, got %v", diff)
+			}
+		})
+	}
+}
+
+func Test_IntersectionCardinality(t *testing.T) {
+	testCases := map[string]struct {
+		a      Set[int]
+		b      Set[int]
+		expect int
+	}{
+		"with overlapping Set": {
+			a:      Hash(1, 2, 3, 4),
+			b:      MutableHash(3, 4, 5),
+			expect: 2,
+		},
+		"with disjoint Set": {
+			a:      Hash(1, 2),
+			b:      Small(Asc[int], 3, 4, 5),
+			expect: 0,
+		},
+		"with equal Set": {
+			a:      SyncHash(1, 2, 3),
+			b:      Hash(3, 2, 1),
+			expect: 3,
+		},
+		"with empty Set": {
+			a:      Hash(1, 2),
+			b:      Empty[int](),
+			expect: 0,
+		},
+		"with nil Set": {
+			a:      nil,
+			b:      Hash(1, 2),
+			expect: 0,
+		},
+		"with nil *HashSet": {
+			a:      Hash(1, 2),
+			b:      (*HashSet[int])(nil),
+			expect: 0,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if result := IntersectionCardinality(tc.a, tc.b); result != tc.expect {
+				t.Errorf("unexpected cardinality; want %v, got %v", tc.expect, result)
+			}
+			if result := IntersectionCardinality(tc.b, tc.a); result != tc.expect {
+				t.Errorf("unexpected inverse cardinality; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_IntersectionCardinality_Same(t *testing.T) {
+	set := SyncHash(1, 2, 3)
+	if result := IntersectionCardinality[int](set, set); result != 3 {
+		t.Errorf("unexpected cardinality; want 3, got %v", result)
+	}
+}
+
+func Test_IntersectionChannel(t *testing.T) {
+	testCases := map[string]struct {
+		expect        Set[int]
+		expectMutable bool
+		sets          []Set[int]
+	}{
+		"with single Set": {
+			expect: Hash(123, 456, 789),
+			sets:   []Set[int]{Hash(123, 456, 789)},
+		},
+		"with overlapping Sets": {
+			expect: Hash(456),
+			sets:   []Set[int]{Hash(123, 456, 789), Hash(456, 789), Hash(0, 456)},
+		},
+		"with disjoint Sets": {
+			expect: Hash[int](),
+			sets:   []Set[int]{Hash(123), Hash(456), Hash(123, 456)},
+		},
+		"with nil Sets": {
+			expect: Hash(456, 789),
+			sets:   []Set[int]{nil, Hash(123, 456, 789), nil, Hash(456, 789, 999)},
+		},
+		"with mutable first Set": {
+			expect:        Hash(456),
+			expectMutable: true,
+			sets:          []Set[int]{MutableHash(123, 456), Hash(456)},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ch := make(chan Set[int])
+			go func() {
+				defer close(ch)
+				for _, set := range tc.sets {
+					ch <- set
+				}
+			}()
+			intersection := IntersectionChannel(ch)
+			if internal.IsNil(intersection) {
+				t.Fatal("unexpected nil Set")
+			}
+			if !intersection.Equal(tc.expect) {
+				t.Errorf("unexpected intersection Set; want %v, got %v", tc.expect, intersection)
+			}
+			if mutable := intersection.IsMutable(); mutable != tc.expectMutable {
+				t.Errorf("unexpected intersection Set mutability; want %v, got %v", tc.expectMutable, mutable)
+			}
+		})
+	}
+}
+
+func Test_IntersectionChannel_Nil(t *testing.T) {
+	ch := make(chan Set[int], 2)
+	ch <- nil
+	ch <- nil
+	close(ch)
+	if intersection := IntersectionChannel(ch); internal.IsNotNil(intersection) {
+		t.Errorf("unexpected intersection Set; want nil, got %v", intersection)
+	}
+}
+
+func Test_JaccardIndex(t *testing.T) {
+	testCases := map[string]struct {
+		a      Set[int]
+		b      Set[int]
+		expect float64
+	}{
+		"with overlapping Set": {
+			a:      Hash(1, 2, 3, 4),
+			b:      MutableHash(3, 4, 5, 6),
+			expect: 2.0 / 6.0,
+		},
+		"with disjoint Set": {
+			a:      Hash(1, 2),
+			b:      Small(Asc[int], 3, 4, 5),
+			expect: 0,
+		},
+		"with equal Set": {
+			a:      SyncHash(1, 2, 3),
+			b:      Hash(3, 2, 1),
+			expect: 1,
+		},
+		"with Set and empty Set": {
+			a:      Hash(1, 2),
+			b:      Empty[int](),
+			expect: 0,
+		},
+		"with empty Set": {
+			a:      Hash[int](),
+			b:      Empty[int](),
+			expect: 1,
+		},
+		"with nil Set": {
+			a:      nil,
+			b:      (*HashSet[int])(nil),
+			expect: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if result := JaccardIndex(tc.a, tc.b); result != tc.expect {
+				t.Errorf("unexpected index; want %v, got %v", tc.expect, result)
+			}
+			if result := JaccardIndex(tc.b, tc.a); result != tc.expect {
+				t.Errorf("unexpected inverse index; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_JoinBool(t *testing.T) {
+	testCases := map[string]struct {
+		expect []string
+		set    Set[bool]
+	}{
+		"with *HashSet containing multiple elements and no options": {
+			expect: []string{"false", "true"},
+			set:    Hash(false, true),
+		},
+		"with *HashSet containing single element and no options": {
+			expect: []string{"true"},
+			set:    Hash(true),
+		},
+		"with *HashSet containing no elements and no options": {
+			expect: []string{},
+			set:    Hash[bool](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sep := ","
+			assertSetJoin(t, JoinBool(tc.set, sep), sep, tc.expect)
+		})
+	}
+}
+
+func Test_JoinBool_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		set Set[bool]
+	}{
+		"with nil Set": {
+			set: nil,
+		},
+		"with nil *HashSet": {
+			set: (*HashSet[bool])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := JoinBool(tc.set, ",")
+			if exp := ""; result != exp {
+				t.Errorf("unexpected result; want %q, got %q", exp, result)
+			}
+		})
+	}
+}
+
+func Test_JoinByte(t *testing.T) {
+	testCases := map[string]struct {
+		expect []string
+		opts   []JoinUintOption
+		set    Set[byte]
+	}{
+		"with *HashSet containing multiple elements and no options": {
+			expect: []string{"0", "10", "255"},
+			set:    Hash[byte](0, 10, 255),
+		},
+		"with *HashSet containing multiple elements and WithUintBase option for base-16": {
+			expect: []string{"0", "a", "ff"},
+			opts:   []JoinUintOption{WithUintBase(16)},
+			set:    Hash[byte](0, 10, 255),
+		},
+		"with *HashSet containing multiple elements and WithUintBase option for base-2": {
+			expect: []string{"0", "1010", "11111111"},
+			opts:   []JoinUintOption{WithUintBase(2)},
+			set:    Hash[byte](0, 10, 255),
+		},
+		"with *HashSet containing single element and no options": {
+			expect: []string{"10"},
+			set:    Hash[byte](10),
+		},
+		"with *HashSet containing no elements and no options": {
+			expect: []string{},
+			set:    Hash[byte](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sep := ","
+			assertSetJoin(t, JoinByte(tc.set, sep, tc.opts...), sep, tc.expect)
+		})
+	}
+}
+
+func Test_JoinByte_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		set Set[byte]
+	}{
+		"with nil Set": {
+			set: nil,
+		},
+		"with nil *HashSet": {
+			set: (*HashSet[byte])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := JoinByte(tc.set, ",")
+			if exp := ""; result != exp {
+				t.Errorf("unexpected result; want %q, got %q", exp, result)
+			}
+		})
+	}
+}
+
+func Test_JoinBytes(t *testing.T) {
+	testCases := map[string]struct {
+		expect string
+		opts   []JoinUintOption
+		sep    string
+		set    Set[byte]
+	}{
+		"with *HashSet containing multiple elements and no options": {
+			expect: "000,010,255",
+			sep:    ",",
+			set:    Hash[byte](255, 0, 10),
+		},
+		"with *HashSet containing multiple elements and WithUintBase option for base-16": {
+			expect: "000aff",
+			opts:   []JoinUintOption{WithUintBase(16)},
+			sep:    "",
+			set:    Hash[byte](255, 0, 10),
+		},
+		"with *HashSet containing multiple elements and WithUintBase option for base-16 and separator": {
+			expect: "00:0a:ff",
+			opts:   []JoinUintOption{WithUintBase(16)},
+			sep:    ":",
+			set:    Hash[byte](255, 0, 10),
+		},
+		"with *HashSet containing multiple elements and WithUintBase option for base-2": {
+			expect: "00000001 10000000",
+			opts:   []JoinUintOption{WithUintBase(2)},
+			sep:    " ",
+			set:    Hash[byte](128, 1),
+		},
+		"with *HashSet containing multiple elements and WithUintSortingDesc option": {
+			expect: "ff0a00",
+			opts:   []JoinUintOption{WithUintBase(16), WithUintSortingDesc()},
+			sep:    "",
+			set:    Hash[byte](255, 0, 10),
+		},
+		"with *HashSet containing single element and no options": {
+			expect: "007",
+			sep:    ",",
+			set:    Hash[byte](7),
+		},
+		"with *HashSet containing no elements and no options": {
+			expect: "",
+			sep:    ",",
+			set:    Hash[byte](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := JoinBytes(tc.set, tc.sep, tc.opts...)
+			if result != tc.expect {
+				t.Errorf("unexpected result; want %q, got %q", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_JoinBytes_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		set Set[byte]
+	}{
+		"with nil Set": {
+			set: nil,
+		},
+		"with nil *HashSet": {
+			set: (*HashSet[byte])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := JoinBytes(tc.set, ",")
+			if exp := ""; result != exp {
+				t.Errorf("unexpected result; want %q, got %q", exp, result)
+			}
+		})
+	}
+}
+
+func Test_JoinComplex64(t *testing.T) {
+	testCases := map[string]struct {
+		expect []string
+		opts   []JoinComplexOption
+		set    Set[complex64]
+	}{
+		"with *HashSet containing multiple elements and no options": {
+			expect: []string{"(0+0i)", "(123.321+321.123i)", "(456.654+654.456i)", "(789.987+987.789i)"},
+			set: Hash(
+				c64(0, 0),
+				c64(123.321, 321.123),
+				c64(456.654, 654.456),
+				c64(789.987, 987.789),
+			),
+		},
+		"with *HashSet containing multiple elements and WithComplexFormat option": {
+			expect: []string{
+				"(0e+00+0e+00i)",
+				"(1.23321e+02+3.21123e+02i)",
+				"(4.56654e+02+6.54456e+02i)",
+				"(7.89987e+02+9.87789e+02i)",
+			},
+			opts: []JoinComplexOption{WithComplexFormat('e')},
+			set: Hash(
+				c64(0, 0),
+				c64(123.321, 321.123),
+				c64(456.654, 654.456),
+				c64(789.987, 987.789),
+			),
+		},
+		"with *HashSet containing multiple elements and WithComplexPrecision option": {
+			expect: []string{"(0.0+0.0i)", "(123.3+321.1i)", "(456.7+654.5i)", "(790.0+987.8i)"},
+			opts:   []JoinComplexOption{WithComplexPrecision(1)},
+			set: Hash(
+				c64(0, 0),
+				c64(123.321, 321.123),
+				c64(456.654, 654.456),
+				c64(789.987, 987.789),
+			),
+		},
+		"with *HashSet containing single element and no options": {
+			expect: []string{"(123.321+321.123i)"},
+			set:    Hash(c64(123.321, 321.123)),
+		},
+		"with *HashSet containing no elements and no options": {
+			expect: []string{},
+			set:    Hash[complex64](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sep := ","
+			assertSetJoin(t, JoinComplex64(tc.set, sep, tc.opts...), sep, tc.expect)
+		})
+	}
+}
+
+func Test_JoinComplex64_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		set Set[complex64]
+	}{
+		"with nil Set": {
+			set: nil,
+		},
+		"with nil *HashSet": {
+			set: (*HashSet[complex64])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := JoinComplex64(tc.set, ",")
+			if exp := ""; result != exp {
+				t.Errorf("unexpected result; want %q, got %q", exp, result)
+			}
+		})
+	}
+}
+
+func Test_JoinComplex128(t *testing.T) {
+	testCases := map[string]struct {
+		expect []string
+		opts   []JoinComplexOption
+		set    Set[complex128]
+	}{
+		"with *HashSet containing multiple elements and no options": {
+			expect: []string{"(0+0i)", "(123.321+321.123i)", "(456.654+654.456i)", "(789.987+987.789i)"},
+			set: Hash(
+				c128(0, 0),
+				c128(123.321, 321.123),
+				c128(456.654, 654.456),
+				c128(789.987, 987.789),
+			),
+		},
+		"with *HashSet containing multiple elements and WithComplexFormat option": {
+			expect: []string{
+				"(0e+00+0e+00i)",
+				"(1.23321e+02+3.21123e+02i)",
+				"(4.56654e+02+6.54456e+02i)",
+				"(7.89987e+02+9.87789e+02i)",
+			},
+			opts: []JoinComplexOption{WithComplexFormat('e')},
+			set: Hash(
+				c128(0, 0),
+				c128(123.321, 321.123),
+				c128(456.654, 654.456),
+				c128(789.987, 987.789),
+			),
+		},
+		"with *HashSet containing multiple elements and WithComplexPrecision option": {
+			expect: []string{"(0.0+0.0i)", "(123.3+321.1i)", "(456.7+654.5i)", "(790.0+987.8i)"},
+			opts:   []JoinComplexOption{WithComplexPrecision(1)},
+			set: Hash(
+				c128(0, 0),
+				c128(123.321, 321.123),
+				c128(456.654, 654.456),
+				c128(789.987, 987.789),
+			),
+		},
+		"with *HashSet containing single element and no options": {
+			expect: []string{"(123.321+321.123i)"},
+			set:    Hash(c128(123.321, 321.123)),
+		},
+		"with *HashSet containing no elements and no options": {
+			expect: []string{},
+			set:    Hash[complex128](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sep := ","
+			assertSetJoin(t, JoinComplex128(tc.set, sep, tc.opts...), sep, tc.expect)
+		})
+	}
+}
+
+func Test_JoinComplex128_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		set Set[complex128]
+	}{
+		"with nil Set": {
+			set: nil,
+		},
+		"with nil *HashSet": {
+			set: (*HashSet[complex128])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := JoinComplex128(tc.set, ",")
+			if exp := ""; result != exp {
+				t.Errorf("unexpected result; want %q, got %q", exp, result)
+			}
+		})
+	}
+}
+
+func Test_JoinFloat32(t *testing.T) {
+	testCases := map[string]struct {
+		expect []string
+		opts   []JoinFloatOption
+		set    Set[float32]
+	}{
+		"with *HashSet containing multiple elements and no options": {
+			expect: []string{"-789.987", "-456.654", "-123.321", "0", "123.321", "456.654", "789.987"},
+			set:    Hash[float32](-789.987, -456.654, -123.321, 0.0, 123.321, 456.654, 789.987),
+		},
+		"with *HashSet containing multiple elements and WithFloatFormat option": {
+			expect: []string{"0e+00", "1.23321e+02", "4.56654e+02", "7.89987e+02"},
+			opts:   []JoinFloatOption{WithFloatFormat('e')},
+			set:    Hash[float32](0.0, 123.321, 456.654, 789.987),
+		},
+		"with *HashSet containing multiple elements and WithFloatPrecision option": {
+			expect: []string{"0.0", "123.3", "456.7", "790.0"},
+			opts:   []JoinFloatOption{WithFloatPrecision(1)},
+			set:    Hash[float32](0.0, 123.321, 456.654, 789.987),
+		},
+		"with *HashSet containing single element and no options": {
+			expect: []string{"123.321"},
+			set:    Hash[float32](123.321),
+		},
+		"with *HashSet containing no elements and no options": {
+			expect: []string{},
+			set:    Hash[float32](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sep := ","
+			assertSetJoin(t, JoinFloat32(tc.set, sep, tc.opts...), sep, tc.expect)
+		})
+	}
+}
+
+func Test_JoinFloat32_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		set Set[float32]
+	}{
+		"with nil Set": {
+			set: nil,
+		},
+		"with nil *HashSet": {
+			set: (*HashSet[float32])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := JoinFloat32(tc.set, ",")
+			if exp := ""; result != exp {
+				t.Errorf("unexpected result; want %q, got %q", exp, result)
+			}
+		})
+	}
+}
+
+func Test_JoinFloat64(t *testing.T) {
+	testCases := map[string]struct {
+		expect []string
+		opts   []JoinFloatOption
+		set    Set[float64]
+	}{
+		"with *HashSet containing multiple elements and no options": {
+			expect: []string{"-789.987", "-456.654", "-123.321", "0", "123.321", "456.654", "789.987"},
+			set:    Hash(-789.987, -456.654, -123.321, 0.0, 123.321, 456.654, 789.987),
+		},
+		"with *HashSet containing multiple elements and WithFloatFormat option": {
+			expect: []string{"0e+00", "1.23321e+02", "4.56654e+02", "7.89987e+02"},
+			opts:   []JoinFloatOption{WithFloatFormat('e')},
+			set:    Hash(0.0, 123.321, 456.654, 789.987),
+		},
+		"with *HashSet containing multiple elements and WithFloatPrecision option": {
+			expect: []string{"0.0", "123.3", "456.7", "790.0"},
+			opts:   []JoinFloatOption{WithFloatPrecision(1)},
+			set:    Hash(0.0, 123.321, 456.654, 789.987),
+		},
+		"with *HashSet containing single element and no options": {
+			expect: []string{"123.321"},
+			set:    Hash(123.321),
+		},
+		"with *HashSet containing no elements and no options": {
+			expect: []string{},
+			set:    Hash[float64](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sep := ","
+			assertSetJoin(t, JoinFloat64(tc.set, sep, tc.opts...), sep, tc.expect)
+		})
+	}
+}
+
+func Test_JoinFloat64_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		set Set[float64]
+	}{
+		"with nil Set": {
+			set: nil,
+		},
+		"with nil *HashSet": {
+			set: (*HashSet[float64])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := JoinFloat64(tc.set, ",")
+			if exp := ""; result != exp {
+				t.Errorf("unexpected result; want %q, got %q", exp, result)
+			}
+		})
+	}
+}
+
+func Test_JoinInt(t *testing.T) {
+	testCases := map[string]struct {
+		expect []string
+		opts   []JoinIntOption
+		set    Set[int]
+	}{
+		"with *HashSet containing multiple elements and no options": {
+			expect: []string{"-789", "-456", "-123", "0", "123", "456", "789"},
+			set:    Hash(-789, -456, -123, 0, 123, 456, 789),
+		},
+		"with *HashSet containing multiple elements and WithIntBase option": {
+			expect: []string{"0", "1", "1010", "1100100"},
+			opts:   []JoinIntOption{WithIntBase(2)},
+			set:    Hash(0, 1, 10, 100),
+		},
+		"with *HashSet containing single element and no options": {
+			expect: []string{"123"},
+			set:    Hash(123),
+		},
+		"with *HashSet containing no elements and no options": {
+			expect: []string{},
+			set:    Hash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sep := ","
+			assertSetJoin(t, JoinInt(tc.set, sep, tc.opts...), sep, tc.expect)
+		})
+	}
+}
+
+func Test_JoinInt_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		set Set[int]
+	}{
+		"with nil Set": {
+			set: nil,
+		},
+		"with nil *HashSet": {
+			set: (*HashSet[int])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := JoinInt(tc.set, ",")
+			if exp := ""; result != exp {
+				t.Errorf("unexpected result; want %q, got %q", exp, result)
+			}
+		})
+	}
+}
+
+func Test_JoinInt_Sorting(t *testing.T) {
+	testCases := map[string]struct {
+		expect string
+		opts   []JoinIntOption
+		set    Set[int]
+	}{
+		"with *HashSet containing multiple elements and WithIntSorting option": {
+			expect: "-10,20,-30",
+			opts: []JoinIntOption{WithIntSorting(func(x, y int64) bool {
+				if x < 0 {
+					x = -x
+				}
+				if y < 0 {
+					y = -y
+				}
+				return x < y
+			})},
+			set: Hash(-30, 20, -10),
+		},
+		"with *HashSet containing multiple elements and WithIntSortingAsc option": {
+			expect: "-789,-123,0,456,789",
+			opts:   []JoinIntOption{WithIntSortingAsc()},
+			set:    Hash(-789, 456, 0, -123, 789),
+		},
+		"with *HashSet containing multiple elements and WithIntSortingDesc option": {
+			expect: "789,456,0,-123,-789",
+			opts:   []JoinIntOption{WithIntSortingDesc()},
+			set:    Hash(-789, 456, 0, -123, 789),
+		},
+		"with *HashSet containing multiple elements and WithIntBase and WithIntSortingDesc options": {
+			expect: "1100,1010,1",
+			opts:   []JoinIntOption{WithIntBase(2), WithIntSortingDesc()},
+			set:    Hash[int](1, 10, 12),
+		},
+		"with *HashSet containing no elements and WithIntSortingAsc option": {
+			expect: "",
+			opts:   []JoinIntOption{WithIntSortingAsc()},
+			set:    Hash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := JoinInt(tc.set, ",", tc.opts...)
+			if result != tc.expect {
+				t.Errorf("unexpected result; want %q, got %q", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_JoinNonZero(t *testing.T) {
+	testCases := map[string]struct {
+		expect []string
+		set    Set[int]
+	}{
+		"with Set containing zero and other elements": {
+			expect: []string{"123", "456"},
+			set:    Hash(0, 123, 456),
+		},
+		"with Set containing only zero": {
+			expect: []string{},
+			set:    Hash(0),
+		},
+		"with Set containing no zero": {
+			expect: []string{"-1", "123"},
+			set:    Hash(-1, 123),
+		},
+		"with Set containing no elements": {
+			expect: []string{},
+			set:    Hash[int](),
+		},
+		"with nil Set": {
+			expect: []string{},
+			set:    nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assertSetJoin(t, JoinNonZero(tc.set, ",", strconv.Itoa), ",", tc.expect)
+		})
+	}
+}
+
+func Test_JoinNonZero_String(t *testing.T) {
+	testCases := map[string]struct {
+		expect []string
+		set    Set[string]
+	}{
+		"with Set containing empty string and other elements": {
+			expect: []string{"foo", "bar"},
+			set:    Hash("", "foo", "bar"),
+		},
+		"with Set containing only empty string": {
+			expect: []string{},
+			set:    Singleton(""),
+		},
+		"with Set containing whitespace": {
+			expect: []string{" ", "foo"},
+			set:    Hash("", " ", "foo"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := JoinNonZero(tc.set, "|", func(element string) string { return element })
+			assertSetJoin(t, result, "|", tc.expect)
+			if !tc.set.Contains("") {
+				t.Error("unexpected removal of zero element from Set")
+			}
+		})
+	}
+}
+
+func Test_JoinRune(t *testing.T) {
+	testCases := map[string]struct {
+		expect []string
+		set    Set[rune]
+	}{
+		"with *HashSet containing multiple elements and no options": {
+			expect: []string{"a", "b", "c"},
+			set:    Hash('a', 'b', 'c'),
+		},
+		"with *HashSet containing single element and no options": {
+			expect: []string{"a"},
+			set:    Hash('a'),
+		},
+		"with *HashSet containing no elements and no options": {
+			expect: []string{},
+			set:    Hash[rune](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sep := ","
+			assertSetJoin(t, JoinRune(tc.set, sep), sep, tc.expect)
+		})
+	}
+}
+
+func Test_JoinRune_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		set Set[rune]
+	}{
+		"with nil Set": {
+			set: nil,
+		},
+		"with nil *HashSet": {
+			set: (*HashSet[rune])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := JoinRune(tc.set, ",")
+			if exp := ""; result != exp {
+				t.Errorf("unexpected result; want %q, got %q", exp, result)
+			}
+		})
+	}
+}
+
+func Test_JoinString(t *testing.T) {
+	testCases := map[string]struct {
+		expect []string
+		set    Set[string]
+	}{
+		"with *HashSet containing multiple elements and no options": {
+			expect: []string{"foo", "bar"},
+			set:    Hash("foo", "bar"),
+		},
+		"with *HashSet containing single element and no options": {
+			expect: []string{"foo"},
+			set:    Hash("foo"),
+		},
+		"with *HashSet containing no elements and no options": {
+			expect: []string{},
+			set:    Hash[string](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sep := ","
+			assertSetJoin(t, JoinString(tc.set, sep), sep, tc.expect)
+		})
+	}
+}
+
+func Test_JoinString_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		set Set[string]
+	}{
+		"with nil Set": {
+			set: nil,
+		},
+		"with nil *HashSet": {
+			set: (*HashSet[string])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := JoinString(tc.set, ",")
+			if exp := ""; result != exp {
+				t.Errorf("unexpected result; want %q, got %q", exp, result)
+			}
+		})
+	}
+}
+
+func Test_JoinStringer(t *testing.T) {
+	testCases := map[string]struct {
+		expect []string
+		set    Set[testStringer]
+	}{
+		"with *HashSet containing multiple elements": {
+			expect: []string{"<foo>", "<bar>"},
+			set:    Hash[testStringer]("foo", "bar"),
+		},
+		"with *HashSet containing single element": {
+			expect: []string{"<foo>"},
+			set:    Hash[testStringer]("foo"),
+		},
+		"with *HashSet containing no elements": {
+			expect: []string{},
+			set:    Hash[testStringer](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sep := ","
+			assertSetJoin(t, JoinStringer(tc.set, sep), sep, tc.expect)
+		})
+	}
+}
+
+func Test_JoinStringer_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		set Set[testStringer]
+	}{
+		"with nil Set": {
+			set: nil,
+		},
+		"with nil *HashSet": {
+			set: (*HashSet[testStringer])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := JoinStringer(tc.set, ",")
+			if exp := ""; result != exp {
+				t.Errorf("unexpected result; want %q, got %q", exp, result)
+			}
+		})
+	}
+}
+
+func Test_JoinUint(t *testing.T) {
+	testCases := map[string]struct {
+		expect []string
+		opts   []JoinUintOption
+		set    Set[uint]
+	}{
+		"with *HashSet containing multiple elements and no options": {
+			expect: []string{"0", "123", "456", "789"},
+			set:    Hash[uint](0, 123, 456, 789),
+		},
+		"with *HashSet containing multiple elements and WithUintBase option": {
+			expect: []string{"0", "1", "1010", "1100100"},
+			opts:   []JoinUintOption{WithUintBase(2)},
+			set:    Hash[uint](0, 1, 10, 100),
+		},
+		"with *HashSet containing single element and no options": {
+			expect: []string{"123"},
+			set:    Hash[uint](123),
+		},
+		"with *HashSet containing no elements and no options": {
+			expect: []string{},
+			set:    Hash[uint](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sep := ","
+			assertSetJoin(t, JoinUint(tc.set, sep, tc.opts...), sep, tc.expect)
+		})
+	}
+}
+
+func Test_JoinUint_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		set Set[uint]
+	}{
+		"with nil Set": {
+			set: nil,
+		},
+		"with nil *HashSet": {
+			set: (*HashSet[uint])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := JoinUint(tc.set, ",")
+			if exp := ""; result != exp {
+				t.Errorf("unexpected result; want %q, got %q", exp, result)
+			}
+		})
+	}
+}
+
+func Test_JoinUint_Sorting(t *testing.T) {
+	testCases := map[string]struct {
+		expect string
+		opts   []JoinUintOption
+		set    Set[uint]
+	}{
+		"with *HashSet containing multiple elements and WithUintSorting option": {
+			expect: "37,28,19",
+			opts: []JoinUintOption{WithUintSorting(func(x, y uint64) bool {
+				return x%10 < y%10
+			})},
+			set: Hash[uint](19, 28, 37),
+		},
+		"with *HashSet containing multiple elements and WithUintSortingAsc option": {
+			expect: "0,123,456,789",
+			opts:   []JoinUintOption{WithUintSortingAsc()},
+			set:    Hash[uint](789, 456, 0, 123),
+		},
+		"with *HashSet containing multiple elements and WithUintSortingDesc option": {
+			expect: "789,456,123,0",
+			opts:   []JoinUintOption{WithUintSortingDesc()},
+			set:    Hash[uint](789, 456, 0, 123),
+		},
+		"with *HashSet containing multiple elements and WithUintBase and WithUintSortingDesc options": {
+			expect: "1100,1010,1",
+			opts:   []JoinUintOption{WithUintBase(2), WithUintSortingDesc()},
+			set:    Hash[uint](1, 10, 12),
+		},
+		"with *HashSet containing no elements and WithUintSortingAsc option": {
+			expect: "",
+			opts:   []JoinUintOption{WithUintSortingAsc()},
+			set:    Hash[uint](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := JoinUint(tc.set, ",", tc.opts...)
+			if result != tc.expect {
+				t.Errorf("unexpected result; want %q, got %q", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_JoinWrap(t *testing.T) {
+	testCases := map[string]struct {
+		expect []string
+		set    Set[int]
+	}{
+		"with Set containing multiple elements": {
+			expect: []string{
+				"[123], [456], [789]",
+				"[123], [789], [456]",
+				"[456], [123], [789]",
+				"[456], [789], [123]",
+				"[789], [123], [456]",
+				"[789], [456], [123]",
+			},
+			set: Hash(123, 456, 789),
+		},
+		"with Set containing single element": {
+			expect: []string{"[123]"},
+			set:    Hash(123),
+		},
+		"with Set containing no elements": {
+			expect: []string{""},
+			set:    Hash[int](),
+		},
+		"with nil Set": {
+			expect: []string{""},
+			set:    nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := JoinWrap(tc.set, ", ", "[", "]", strconv.Itoa)
+			for _, expect := range tc.expect {
+				if result == expect {
+					return
+				}
+			}
+			t.Errorf("unexpected string; want any of %q, got %q", tc.expect, result)
+		})
+	}
+}
+
+func Test_MajorityUnion(t *testing.T) {
+	sets := []Set[int]{
+		Hash(1, 2, 3, 4),
+		MutableHash(2, 3, 5),
+		Singleton(3),
+	}
+	testCases := map[string]struct {
+		expect    Set[int]
+		sets      []Set[int]
+		threshold int
+	}{
+		"with threshold of 1": {
+			expect:    Hash(1, 2, 3, 4, 5),
+			sets:      sets,
+			threshold: 1,
+		},
+		"with threshold of 2": {
+			expect:    Hash(2, 3),
+			sets:      sets,
+			threshold: 2,
+		},
+		"with threshold of 3": {
+			expect:    Hash(3),
+			sets:      sets,
+			threshold: 3,
+		},
+		"with threshold less than 1": {
+			expect:    Union[int](sets[0], sets[1:]...),
+			sets:      sets,
+			threshold: -1,
+		},
+		"with threshold greater than number of Sets": {
+			expect:    Hash(3),
+			sets:      sets,
+			threshold: 4,
+		},
+		"with nil Set": {
+			expect:    Hash(2, 3),
+			sets:      []Set[int]{Hash(1, 2, 3), nil, Hash(2, 3, 4)},
+			threshold: 2,
+		},
+		"with nil Set and threshold equal to number of Sets": {
+			expect:    Hash[int](),
+			sets:      []Set[int]{Hash(1, 2, 3), nil, Hash(2, 3, 4)},
+			threshold: 3,
+		},
+		"with no Sets": {
+			expect:    Hash[int](),
+			sets:      nil,
+			threshold: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := MajorityUnion(tc.threshold, tc.sets...)
+			if internal.IsNil(result) {
+				t.Fatal("unexpected nil Set")
+			}
+			if !result.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_Map(t *testing.T) {
+	testCases := map[string]struct {
+		expect     Set[string]
+		mapperFunc func(element int) string
+		set        Set[int]
+	}{
+		"with *EmptySet": {
+			expect: Empty[string](),
+			set:    Empty[int](),
+		},
+		"with empty *HashSet": {
+			expect: Hash[string](),
+			set:    Hash[int](),
+		},
+		"with non-empty *HashSet": {
+			expect: Hash("123", "456", "789"),
+			set:    Hash(123, 456, 789),
+		},
+		"with empty *MutableHashSet": {
+			expect: MutableHash[string](),
+			set:    MutableHash[int](),
+		},
+		"with non-empty *MutableHashSet": {
+			expect: MutableHash("123", "456", "789"),
+			set:    MutableHash(123, 456, 789),
+		},
+		"with *SingletonSet": {
+			expect: Singleton("123"),
+			set:    Singleton(123),
+		},
+		"with empty *SyncHashSet": {
+			expect: SyncHash[string](),
+			set:    SyncHash[int](),
+		},
+		"with non-empty *SyncHashSet": {
+			expect: SyncHash("123", "456", "789"),
+			set:    SyncHash(123, 456, 789),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mapped := Map(tc.set, getIntStringConverterWithDefaultOptions[int]())
+			if internal.IsNil(mapped) {
+				t.Error("unexpected nil Set")
+			}
+			if !mapped.Equal(tc.expect) {
+				t.Errorf("unexpected mapped Set; want %v, got %v", tc.expect, mapped)
+			}
+		})
+	}
+}
+
+func Test_Map_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		set Set[int]
+	}{
+		"with nil Set": {
+			set: nil,
+		},
+		"with nil *EmptySet": {
+			set: (*EmptySet[int])(nil),
+		},
+		"with nil *HashSet": {
+			set: (*HashSet[int])(nil),
+		},
+		"with nil *MutableHashSet": {
+			set: (*MutableHashSet[int])(nil),
+		},
+		"with nil *SingletonSet": {
+			set: (*SingletonSet[int])(nil),
+		},
+		"with nil *SyncHashSet": {
+			set: (*SyncHashSet[int])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var funcCallCount int
+			mapped := Map(tc.set, func(element int) string {
+				funcCallCount++
+				return ""
+			})
+			if internal.IsNotNil(mapped) {
+				t.Errorf("unexpected mapped Set; want nil, got %v", mapped)
+			}
+			if funcCallCount != 0 {
+				t.Errorf("unexpected number of calls to mapper; want 0, got %v", funcCallCount)
+			}
+		})
+	}
+}
+
+func Test_MapSlice(t *testing.T) {
+	testCases := map[string]struct {
+		expect []int
+		mapper func(element int) int
+		set    Set[int]
+	}{
+		"with multiple elements mapped to equal values": {
+			expect: []int{0, 0, 1, 1},
+			mapper: func(element int) int { return element % 2 },
+			set:    Hash(1, 2, 3, 4),
+		},
+		"with all elements mapped to same value": {
+			expect: []int{7, 7, 7},
+			mapper: func(_ int) int { return 7 },
+			set:    MutableHash(123, 456, 789),
+		},
+		"with elements mapped to distinct values": {
+			expect: []int{246, 912, 1578},
+			mapper: func(element int) int { return element * 2 },
+			set:    SyncHash(123, 456, 789),
+		},
+		"with Set containing no elements": {
+			expect: []int{},
+			mapper: func(element int) int { return element },
+			set:    Hash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := MapSlice(tc.set, tc.mapper)
+			sort.Ints(result)
+			if !cmp.Equal(tc.expect, result, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected slice; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_MapSlice_NonComparable(t *testing.T) {
+	result := MapSlice[int](Hash(1, 2, 3), func(element int) []int {
+		return []int{element % 2}
+	})
+	if exp, act := 3, len(result); act != exp {
+		t.Errorf("unexpected slice length; want %v, got %v", exp, act)
+	}
+	var sum int
+	for _, values := range result {
+		sum += values[0]
+	}
+	if sum != 2 {
+		t.Errorf("unexpected sum of values; want 2, got %v", sum)
+	}
+}
+
+func Test_MapSlice_Nil(t *testing.T) {
+	if result := MapSlice[int, int](nil, func(element int) int { return element }); result != nil {
+		t.Errorf("unexpected slice; want nil, got %v", result)
+	}
+	if result := MapSlice[int, int]((*HashSet[int])(nil), func(element int) int { return element }); result != nil {
+		t.Errorf("unexpected slice; want nil, got %v", result)
+	}
+}
+
+func Test_MarshalJSONSorted(t *testing.T) {
+	testCases := map[string]struct {
+		set    Set[int]
+		less   func(x, y int) bool
+		expect string
+	}{
+		"with EmptySet": {
+			set:    Empty[int](),
+			less:   Asc[int],
+			expect: "[]",
+		},
+		"with empty HashSet": {
+			set:    Hash[int](),
+			less:   Asc[int],
+			expect: "[]",
+		},
+		"with SingletonSet": {
+			set:    Singleton(123),
+			less:   Asc[int],
+			expect: "[123]",
+		},
+		"with HashSet in ascending order": {
+			set:    Hash(789, 123, 456),
+			less:   Asc[int],
+			expect: "[123,456,789]",
+		},
+		"with HashSet in descending order": {
+			set:    Hash(789, 123, 456),
+			less:   Desc[int],
+			expect: "[789,456,123]",
+		},
+		"with MutableHashSet": {
+			set:    MutableHash(789, 123, 456),
+			less:   Asc[int],
+			expect: "[123,456,789]",
+		},
+		"with SyncHashSet": {
+			set:    SyncHash(789, 123, 456),
+			less:   Asc[int],
+			expect: "[123,456,789]",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			data, err := MarshalJSONSorted(tc.set, tc.less)
+			if err != nil {
+				t.Fatalf("unexpected error; want nil, got %q", err)
+			}
+			if actual := string(data); actual != tc.expect {
+				t.Errorf("unexpected JSON; want %q, got %q", tc.expect, actual)
+			}
+		})
+	}
+}
+
+func Test_MarshalJSONSorted_Nil(t *testing.T) {
+	data, err := MarshalJSONSorted[int](nil, Asc[int])
+	if err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	if expect, actual := "null", string(data); actual != expect {
+		t.Errorf("unexpected JSON; want %q, got %q", expect, actual)
+	}
+}
+
+func Test_MarshalJSONSorted_Stable(t *testing.T) {
+	elements := make([]int, 1000)
+	for i := range elements {
+		elements[i] = len(elements) - i
+	}
+	set := HashFromSlice(elements)
+	expect, err := MarshalJSONSorted[int](set, Asc[int])
+	if err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	for i := 0; i < 100; i++ {
+		actual, err := MarshalJSONSorted[int](set, Asc[int])
+		if err != nil {
+			t.Fatalf("unexpected error; want nil, got %q", err)
+		}
+		if !bytes.Equal(expect, actual) {
+			t.Fatalf("unexpected JSON on call %d; want %s, got %s", i+1, expect, actual)
+		}
+	}
+}
+
+func Test_MarshalSetText(t *testing.T) {
+	testCases := map[string]struct {
+		set    Set[string]
+		sep    string
+		expect string
+	}{
+		"with empty Set": {
+			set:    Hash[string](),
+			sep:    ",",
+			expect: "",
+		},
+		"with single element Set": {
+			set:    Hash("foo"),
+			sep:    ",",
+			expect: "foo",
+		},
+		"with multiple elements Set": {
+			set:    MutableHash("fizz", "buzz", "foo", "bar"),
+			sep:    ",",
+			expect: "bar,buzz,fizz,foo",
+		},
+		"with elements containing whitespace": {
+			set:    Hash(" foo", "bar "),
+			sep:    ",",
+			expect: " foo,bar ",
+		},
+		"with multi-character separator": {
+			set:    Hash("foo", "bar"),
+			sep:    "::",
+			expect: "bar::foo",
+		},
+		"with empty separator": {
+			set:    Hash("foo", "bar"),
+			sep:    "",
+			expect: "bar" + DefaultTextSeparator + "foo",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			text, err := MarshalSetText(tc.set, tc.sep)
+			if err != nil {
+				t.Fatalf("unexpected error; want nil, got %q", err)
+			}
+			if actual := string(text); actual != tc.expect {
+				t.Errorf("unexpected text; want %q, got %q", tc.expect, actual)
+			}
+		})
+	}
+}
+
+func Test_MarshalSetText_Error(t *testing.T) {
+	testCases := map[string]struct {
+		set Set[string]
+		sep string
+	}{
+		"with element containing separator": {
+			set: Hash("foo", "fizz,buzz"),
+			sep: ",",
+		},
+		"with element containing default separator": {
+			set: Hash("foo", "fizz,buzz"),
+			sep: "",
+		},
+		"with element containing multi-character separator": {
+			set: Hash("foo", "fizz::buzz"),
+			sep: "::",
+		},
+		"with empty element": {
+			set: Hash("foo", ""),
+			sep: ",",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			text, err := MarshalSetText(tc.set, tc.sep)
+			if !errors.Is(err, ErrTextElement) {
+				t.Errorf("unexpected error; want %q, got %q", ErrTextElement, err)
+			}
+			if text != nil {
+				t.Errorf("unexpected text; want nil, got %q", text)
+			}
+		})
+	}
+}
+
+func Test_MarshalSetText_Nil(t *testing.T) {
+	text, err := MarshalSetText[string](nil, ",")
+	if err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	if len(text) != 0 {
+		t.Errorf("unexpected text; want empty, got %q", text)
+	}
+}
+
+func Test_MarshalSetText_RoundTrip(t *testing.T) {
+	type tag string
+	testCases := map[string]struct {
+		set Set[tag]
+		sep string
+	}{
+		"with empty Set": {
+			set: Hash[tag](),
+			sep: ",",
+		},
+		"with single element Set": {
+			set: Hash[tag]("foo"),
+			sep: ",",
+		},
+		"with multiple elements Set": {
+			set: Hash[tag]("fizz", "buzz", "foo", "bar"),
+			sep: ",",
+		},
+		"with elements containing whitespace": {
+			set: Hash[tag](" foo", "bar "),
+			sep: ",",
+		},
+		"with elements containing a different separator": {
+			set: Hash[tag]("fizz,buzz", "foo,bar"),
+			sep: ";",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			text, err := MarshalSetText(tc.set, tc.sep)
+			if err != nil {
+				t.Fatalf("unexpected error; want nil, got %q", err)
+			}
+			if set := UnmarshalSetText[tag](text, tc.sep); !set.Equal(tc.set) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.set, set)
+			}
+		})
+	}
+}
+
+func Test_Max(t *testing.T) {
+	testCases := map[string]struct {
+		expectElement int
+		expectOK      bool
+		set           Set[int]
+	}{
+		"with *HashSet containing multiple elements": {
+			expectElement: 789,
+			expectOK:      true,
+			set:           Hash(-789, -456, -123, 0, 123, 456, 789),
+		},
+		"with *HashSet containing single element": {
+			expectElement: 123,
+			expectOK:      true,
+			set:           Hash(123),
+		},
+		"with *HashSet containing no elements": {
+			expectElement: 0,
+			expectOK:      false,
+			set:           Hash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			element, ok := Max(tc.set)
+			if ok != tc.expectOK {
+				t.Errorf("unexpected bool result; want %v, got %v", tc.expectOK, ok)
+			}
+			if element != tc.expectElement {
+				t.Errorf("unexpected element result; want %v, got %v", tc.expectElement, element)
+			}
+		})
+	}
+}
+
+func Test_Max_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		set Set[int]
+	}{
+		"with nil Set": {
+			set: nil,
+		},
+		"with nil *HashSet": {
+			set: (*HashSet[int])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			element, ok := Max(tc.set)
+			if ok {
+				t.Error("unexpected bool result; want false, got true")
+			}
+			if element != 0 {
+				t.Errorf("unexpected non-zero value for element result; want 0, got %v", element)
+			}
+		})
+	}
+}
+
+func Test_MaxBy(t *testing.T) {
+	testCases := map[string]struct {
+		expectElement string
+		expectOK      bool
+		set           Set[string]
+	}{
+		"with *HashSet containing multiple elements with distinct key": {
+			expectElement: "gamma",
+			expectOK:      true,
+			set:           Hash("alpha", "gamma", "pi", "beta"),
+		},
+		"with *HashSet containing single element": {
+			expectElement: "alpha",
+			expectOK:      true,
+			set:           Hash("alpha"),
+		},
+		"with *HashSet containing no elements": {
+			expectElement: "",
+			expectOK:      false,
+			set:           Hash[string](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			element, ok := MaxBy(tc.set, func(element string) int {
+				if element == "gamma" {
+					// Ensure that the longest element is distinct
+					return len(element) + 1
+				}
+				return len(element)
+			})
+			if ok != tc.expectOK {
+				t.Errorf("unexpected bool result; want %v, got %v", tc.expectOK, ok)
+			}
+			if element != tc.expectElement {
+				t.Errorf("unexpected element result; want %q, got %q", tc.expectElement, element)
+			}
+		})
+	}
+}
+
+func Test_MaxBy_Tie(t *testing.T) {
+	set := Hash("alpha", "gamma", "pi", "nu")
+	element, ok := MaxBy[string](set, func(element string) int { return len(element) })
+	if !ok {
+		t.Error("unexpected bool result; want true, got false")
+	}
+	if candidates := Hash("alpha", "gamma", "pi", "nu").Filter(func(e string) bool {
+		return len(e) == len(element)
+	}); !candidates.Contains(element) {
+		t.Errorf("unexpected element result; want any of %v, got %q", candidates, element)
+	}
+}
+
+func Test_MaxBy_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		set Set[string]
+	}{
+		"with nil Set": {
+			set: nil,
+		},
+		"with nil *HashSet": {
+			set: (*HashSet[string])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var funcCallCount int
+			element, ok := MaxBy(tc.set, func(element string) int {
+				funcCallCount++
+				return len(element)
+			})
+			if ok {
+				t.Error("unexpected bool result; want false, got true")
+			}
+			if element != "" {
+				t.Errorf("unexpected non-zero value for element result; want %q, got %q", "", element)
+			}
+			if funcCallCount != 0 {
+				t.Errorf("unexpected number of calls to key; want 0, got %v", funcCallCount)
 			}
 		})
 	}
 }
 
-func Test_JoinBool(t *testing.T) {
+func Test_Min(t *testing.T) {
 	testCases := map[string]struct {
-		expect []string
-		set    Set[bool]
+		expectElement int
+		expectOK      bool
+		set           Set[int]
 	}{
-		"with *HashSet containing multiple elements and no options": {
-			expect: []string{"false", "true"},
-			set:    Hash(false, true),
+		"with *HashSet containing multiple elements": {
+			expectElement: -789,
+			expectOK:      true,
+			set:           Hash(-789, -456, -123, 0, 123, 456, 789),
 		},
-		"with *HashSet containing single element and no options": {
-			expect: []string{"true"},
-			set:    Hash(true),
+		"with *HashSet containing single element": {
+			expectElement: 123,
+			expectOK:      true,
+			set:           Hash(123),
 		},
-		"with *HashSet containing no elements and no options": {
-			expect: []string{},
-			set:    Hash[bool](),
+		"with *HashSet containing no elements": {
+			expectElement: 0,
+			expectOK:      false,
+			set:           Hash[int](),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			sep := ","
-			assertSetJoin(t, JoinBool(tc.set, sep), sep, tc.expect)
+			element, ok := Min(tc.set)
+			if ok != tc.expectOK {
+				t.Errorf("unexpected bool result; want %v, got %v", tc.expectOK, ok)
+			}
+			if element != tc.expectElement {
+				t.Errorf("unexpected element result; want %v, got %v", tc.expectElement, element)
+			}
 		})
 	}
 }
 
-func Test_JoinBool_Nil(t *testing.T) {
+func Test_Min_Nil(t *testing.T) {
 	testCases := map[string]struct {
-		set Set[bool]
+		set Set[int]
 	}{
 		"with nil Set": {
 			set: nil,
 		},
 		"with nil *HashSet": {
-			set: (*HashSet[bool])(nil),
+			set: (*HashSet[int])(nil),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			result := JoinBool(tc.set, ",")
-			if exp := ""; result != exp {
-				t.Errorf("unexpected result; want %q, got %q", exp, result)
+			element, ok := Min(tc.set)
+			if ok {
+				t.Error("unexpected bool result; want false, got true")
+			}
+			if element != 0 {
+				t.Errorf("unexpected non-zero value for element result; want 0, got %v", element)
 			}
 		})
 	}
 }
 
-func Test_JoinComplex64(t *testing.T) {
+func Test_MinBy(t *testing.T) {
 	testCases := map[string]struct {
-		expect []string
-		opts   []JoinComplexOption
-		set    Set[complex64]
+		expectElement string
+		expectOK      bool
+		set           Set[string]
 	}{
-		"with *HashSet containing multiple elements and no options": {
-			expect: []string{"(0+0i)", "(123.321+321.123i)", "(456.654+654.456i)", "(789.987+987.789i)"},
-			set: Hash(
-				c64(0, 0),
-				c64(123.321, 321.123),
-				c64(456.654, 654.456),
-				c64(789.987, 987.789),
-			),
-		},
-		"with *HashSet containing multiple elements and WithComplexFormat option": {
-			expect: []string{
-				"(0e+00+0e+00i)",
-				"(1.23321e+02+3.21123e+02i)",
-				"(4.56654e+02+6.54456e+02i)",
-				"(7.89987e+02+9.87789e+02i)",
-			},
-			opts: []JoinComplexOption{WithComplexFormat('e')},
-			set: Hash(
-				c64(0, 0),
-				c64(123.321, 321.123),
-				c64(456.654, 654.456),
-				c64(789.987, 987.789),
-			),
-		},
-		"with *HashSet containing multiple elements and WithComplexPrecision option": {
-			expect: []string{"(0.0+0.0i)", "(123.3+321.1i)", "(456.7+654.5i)", "(790.0+987.8i)"},
-			opts:   []JoinComplexOption{WithComplexPrecision(1)},
-			set: Hash(
-				c64(0, 0),
-				c64(123.321, 321.123),
-				c64(456.654, 654.456),
-				c64(789.987, 987.789),
-			),
+		"with *HashSet containing multiple elements with distinct key": {
+			expectElement: "pi",
+			expectOK:      true,
+			set:           Hash("alpha", "gamma", "pi", "beta"),
 		},
-		"with *HashSet containing single element and no options": {
-			expect: []string{"(123.321+321.123i)"},
-			set:    Hash(c64(123.321, 321.123)),
+		"with *HashSet containing single element": {
+			expectElement: "alpha",
+			expectOK:      true,
+			set:           Hash("alpha"),
 		},
-		"with *HashSet containing no elements and no options": {
-			expect: []string{},
-			set:    Hash[complex64](),
+		"with *HashSet containing no elements": {
+			expectElement: "",
+			expectOK:      false,
+			set:           Hash[string](),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			sep := ","
-			assertSetJoin(t, JoinComplex64(tc.set, sep, tc.opts...), sep, tc.expect)
+			element, ok := MinBy(tc.set, func(element string) int { return len(element) })
+			if ok != tc.expectOK {
+				t.Errorf("unexpected bool result; want %v, got %v", tc.expectOK, ok)
+			}
+			if element != tc.expectElement {
+				t.Errorf("unexpected element result; want %q, got %q", tc.expectElement, element)
+			}
 		})
 	}
 }
 
-func Test_JoinComplex64_Nil(t *testing.T) {
+func Test_MinBy_Tie(t *testing.T) {
+	set := Hash("alpha", "gamma", "pi", "nu")
+	element, ok := MinBy[string](set, func(element string) int { return len(element) })
+	if !ok {
+		t.Error("unexpected bool result; want true, got false")
+	}
+	if candidates := Hash("alpha", "gamma", "pi", "nu").Filter(func(e string) bool {
+		return len(e) == len(element)
+	}); !candidates.Contains(element) {
+		t.Errorf("unexpected element result; want any of %v, got %q", candidates, element)
+	}
+}
+
+func Test_MinBy_Nil(t *testing.T) {
 	testCases := map[string]struct {
-		set Set[complex64]
+		set Set[string]
 	}{
 		"with nil Set": {
 			set: nil,
 		},
 		"with nil *HashSet": {
-			set: (*HashSet[complex64])(nil),
+			set: (*HashSet[string])(nil),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			result := JoinComplex64(tc.set, ",")
-			if exp := ""; result != exp {
-				t.Errorf("unexpected result; want %q, got %q", exp, result)
+			var funcCallCount int
+			element, ok := MinBy(tc.set, func(element string) int {
+				funcCallCount++
+				return len(element)
+			})
+			if ok {
+				t.Error("unexpected bool result; want false, got true")
+			}
+			if element != "" {
+				t.Errorf("unexpected non-zero value for element result; want %q, got %q", "", element)
+			}
+			if funcCallCount != 0 {
+				t.Errorf("unexpected number of calls to key; want 0, got %v", funcCallCount)
 			}
 		})
 	}
 }
 
-func Test_JoinComplex128(t *testing.T) {
+func Test_PowerSet(t *testing.T) {
 	testCases := map[string]struct {
 		expect []string
-		opts   []JoinComplexOption
-		set    Set[complex128]
+		set    Set[int]
 	}{
-		"with *HashSet containing multiple elements and no options": {
-			expect: []string{"(0+0i)", "(123.321+321.123i)", "(456.654+654.456i)", "(789.987+987.789i)"},
-			set: Hash(
-				c128(0, 0),
-				c128(123.321, 321.123),
-				c128(456.654, 654.456),
-				c128(789.987, 987.789),
-			),
-		},
-		"with *HashSet containing multiple elements and WithComplexFormat option": {
-			expect: []string{
-				"(0e+00+0e+00i)",
-				"(1.23321e+02+3.21123e+02i)",
-				"(4.56654e+02+6.54456e+02i)",
-				"(7.89987e+02+9.87789e+02i)",
-			},
-			opts: []JoinComplexOption{WithComplexFormat('e')},
-			set: Hash(
-				c128(0, 0),
-				c128(123.321, 321.123),
-				c128(456.654, 654.456),
-				c128(789.987, 987.789),
-			),
+		"with nil Set": {
+			expect: nil,
+			set:    nil,
 		},
-		"with *HashSet containing multiple elements and WithComplexPrecision option": {
-			expect: []string{"(0.0+0.0i)", "(123.3+321.1i)", "(456.7+654.5i)", "(790.0+987.8i)"},
-			opts:   []JoinComplexOption{WithComplexPrecision(1)},
-			set: Hash(
-				c128(0, 0),
-				c128(123.321, 321.123),
-				c128(456.654, 654.456),
-				c128(789.987, 987.789),
-			),
+		"with Set containing no elements": {
+			expect: []string{""},
+			set:    Hash[int](),
 		},
-		"with *HashSet containing single element and no options": {
-			expect: []string{"(123.321+321.123i)"},
-			set:    Hash(c128(123.321, 321.123)),
+		"with Set containing single element": {
+			expect: []string{"", "123"},
+			set:    Singleton(123),
 		},
-		"with *HashSet containing no elements and no options": {
-			expect: []string{},
-			set:    Hash[complex128](),
+		"with Set containing multiple elements": {
+			expect: []string{"", "123", "123,456", "123,456,789", "123,789", "456", "456,789", "789"},
+			set:    Hash(123, 456, 789),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			sep := ","
-			assertSetJoin(t, JoinComplex128(tc.set, sep, tc.opts...), sep, tc.expect)
+			subsets := PowerSet(tc.set)
+			if tc.expect == nil {
+				if subsets != nil {
+					t.Errorf("unexpected subsets; want nil, got %v", subsets)
+				}
+				return
+			}
+			result := make([]string, len(subsets))
+			for i, subset := range subsets {
+				result[i] = subset.SortedJoin(",", strconv.Itoa, Asc[int])
+			}
+			sort.Strings(result)
+			if diff := cmp.Diff(tc.expect, result); diff != "" {
+				t.Errorf("unexpected subsets (-want +got):\n%s", diff)
+			}
 		})
 	}
 }
 
-func Test_JoinComplex128_Nil(t *testing.T) {
+func Test_PowerSet_NaN(t *testing.T) {
+	nan := math.NaN()
 	testCases := map[string]struct {
-		set Set[complex128]
+		expect []string
+		set    Set[float64]
 	}{
-		"with nil Set": {
-			set: nil,
+		"with *FloatHashSet containing no elements": {
+			expect: []string{""},
+			set:    HashFloat[float64](),
 		},
-		"with nil *HashSet": {
-			set: (*HashSet[complex128])(nil),
+		"with *FloatHashSet containing single element": {
+			expect: []string{"", "NaN"},
+			set:    HashFloat(nan),
+		},
+		"with *FloatHashSet containing multiple elements": {
+			expect: []string{"", "1", "1,2", "1,2,NaN", "1,NaN", "2", "2,NaN", "NaN"},
+			set:    HashFloat(1, 2, nan, nan),
+		},
+		"with *TreeSet containing no elements": {
+			expect: []string{""},
+			set:    Tree[float64](),
+		},
+		"with *TreeSet containing single element": {
+			expect: []string{"", "NaN"},
+			set:    Tree(nan),
+		},
+		"with *TreeSet containing multiple elements": {
+			expect: []string{"", "1", "1,2", "1,2,NaN", "1,NaN", "2", "2,NaN", "NaN"},
+			set:    Tree(1, 2, nan, nan),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			result := JoinComplex128(tc.set, ",")
-			if exp := ""; result != exp {
-				t.Errorf("unexpected result; want %q, got %q", exp, result)
+			subsets := PowerSet(tc.set)
+			result := make([]string, len(subsets))
+			for i, subset := range subsets {
+				elements := MapSlice(subset, func(element float64) string {
+					return strconv.FormatFloat(element, 'g', -1, 64)
+				})
+				sort.Strings(elements)
+				result[i] = strings.Join(elements, ",")
+			}
+			sort.Strings(result)
+			if diff := cmp.Diff(tc.expect, result); diff != "" {
+				t.Errorf("unexpected subsets (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func Test_JoinFloat32(t *testing.T) {
+func Test_PowerSet_Mutability(t *testing.T) {
 	testCases := map[string]struct {
-		expect []string
-		opts   []JoinFloatOption
-		set    Set[float32]
+		expectKind SetKind
+		set        Set[int]
 	}{
-		"with *HashSet containing multiple elements and no options": {
-			expect: []string{"-789.987", "-456.654", "-123.321", "0", "123.321", "456.654", "789.987"},
-			set:    Hash[float32](-789.987, -456.654, -123.321, 0.0, 123.321, 456.654, 789.987),
-		},
-		"with *HashSet containing multiple elements and WithFloatFormat option": {
-			expect: []string{"0e+00", "1.23321e+02", "4.56654e+02", "7.89987e+02"},
-			opts:   []JoinFloatOption{WithFloatFormat('e')},
-			set:    Hash[float32](0.0, 123.321, 456.654, 789.987),
+		"with *HashSet": {
+			expectKind: HashKind,
+			set:        Hash(123, 456, 789),
 		},
-		"with *HashSet containing multiple elements and WithFloatPrecision option": {
-			expect: []string{"0.0", "123.3", "456.7", "790.0"},
-			opts:   []JoinFloatOption{WithFloatPrecision(1)},
-			set:    Hash[float32](0.0, 123.321, 456.654, 789.987),
-		},
-		"with *HashSet containing single element and no options": {
-			expect: []string{"123.321"},
-			set:    Hash[float32](123.321),
+		"with *MutableHashSet": {
+			expectKind: MutableHashKind,
+			set:        MutableHash(123, 456, 789),
 		},
-		"with *HashSet containing no elements and no options": {
-			expect: []string{},
-			set:    Hash[float32](),
+		"with *SmallSet": {
+			expectKind: SmallKind,
+			set:        Small(Asc[int], 123, 456, 789),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			sep := ","
-			assertSetJoin(t, JoinFloat32(tc.set, sep, tc.opts...), sep, tc.expect)
+			for _, subset := range PowerSet(tc.set) {
+				if kind := subset.Kind(); kind != tc.expectKind {
+					t.Errorf("unexpected kind; want %v, got %v", tc.expectKind, kind)
+				}
+				if subset.IsMutable() != tc.set.IsMutable() {
+					t.Errorf("unexpected Set mutability; want %v, got %v", tc.set.IsMutable(), subset.IsMutable())
+				}
+			}
 		})
 	}
 }
 
-func Test_JoinFloat32_Nil(t *testing.T) {
+func Test_PowerSet_Overflow(t *testing.T) {
+	set := MutableHash[int]()
+	for i := 0; i <= maxPowerSetLen; i++ {
+		set.Put(i)
+	}
+	defer func() {
+		err, _ := recover().(error)
+		if !errors.Is(err, ErrPowerSetLen) {
+			t.Errorf("unexpected panic; want %v, got %v", ErrPowerSetLen, err)
+		}
+	}()
+	PowerSet[int](set)
+	t.Error("expected panic")
+}
+
+func Test_Product(t *testing.T) {
 	testCases := map[string]struct {
-		set Set[float32]
+		expect int
+		set    Set[int]
 	}{
+		"with multiple elements": {
+			expect: 24,
+			set:    Hash(1, 2, 3, 4),
+		},
+		"with negative elements": {
+			expect: -6,
+			set:    MutableHash(-1, 2, 3),
+		},
+		"with zero element": {
+			expect: 0,
+			set:    SyncHash(0, 123, 456),
+		},
+		"with single element": {
+			expect: 123,
+			set:    Singleton(123),
+		},
+		"with Set containing no elements": {
+			expect: 1,
+			set:    Hash[int](),
+		},
 		"with nil Set": {
-			set: nil,
+			expect: 1,
+			set:    nil,
 		},
 		"with nil *HashSet": {
-			set: (*HashSet[float32])(nil),
+			expect: 1,
+			set:    (*HashSet[int])(nil),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			result := JoinFloat32(tc.set, ",")
-			if exp := ""; result != exp {
-				t.Errorf("unexpected result; want %q, got %q", exp, result)
+			if result := Product(tc.set); result != tc.expect {
+				t.Errorf("unexpected product; want %v, got %v", tc.expect, result)
 			}
 		})
 	}
 }
 
-func Test_JoinFloat64(t *testing.T) {
+func Test_Product_Float(t *testing.T) {
+	if result := Product[float64](Hash(0.5, 4.0, 1.5)); result != 3 {
+		t.Errorf("unexpected product; want 3, got %v", result)
+	}
+	if result := Product[float32](Hash[float32]()); result != 1 {
+		t.Errorf("unexpected product; want 1, got %v", result)
+	}
+}
+
+func Test_Reduce(t *testing.T) {
 	testCases := map[string]struct {
-		expect []string
-		opts   []JoinFloatOption
-		set    Set[float64]
+		expect      uint
+		initValue   []uint
+		reducerFunc func(acc uint, element int) uint
+		set         Set[int]
 	}{
-		"with *HashSet containing multiple elements and no options": {
-			expect: []string{"-789.987", "-456.654", "-123.321", "0", "123.321", "456.654", "789.987"},
-			set:    Hash(-789.987, -456.654, -123.321, 0.0, 123.321, 456.654, 789.987),
-		},
-		"with *HashSet containing multiple elements and WithFloatFormat option": {
-			expect: []string{"0e+00", "1.23321e+02", "4.56654e+02", "7.89987e+02"},
-			opts:   []JoinFloatOption{WithFloatFormat('e')},
-			set:    Hash(0.0, 123.321, 456.654, 789.987),
+		"with non-empty *HashSet and additive reducer and initial value": {
+			expect:    123 + 456 + 789 + 100,
+			initValue: []uint{100},
+			reducerFunc: func(acc uint, element int) uint {
+				return acc + uint(element)
+			},
+			set: Hash(123, 456, 789),
 		},
-		"with *HashSet containing multiple elements and WithFloatPrecision option": {
-			expect: []string{"0.0", "123.3", "456.7", "790.0"},
-			opts:   []JoinFloatOption{WithFloatPrecision(1)},
-			set:    Hash(0.0, 123.321, 456.654, 789.987),
+		"with non-empty *HashSet and additive reducer and no initial value": {
+			expect: 123 + 456 + 789,
+			reducerFunc: func(acc uint, element int) uint {
+				return acc + uint(element)
+			},
+			set: Hash(123, 456, 789),
 		},
-		"with *HashSet containing single element and no options": {
-			expect: []string{"123.321"},
-			set:    Hash(123.321),
+		"with empty *HashSet and initial value": {
+			expect:    100,
+			initValue: []uint{100},
+			reducerFunc: func(acc uint, element int) uint {
+				return acc + uint(element)
+			},
+			set: Hash[int](),
 		},
-		"with *HashSet containing no elements and no options": {
-			expect: []string{},
-			set:    Hash[float64](),
+		"with empty *HashSet and no initial value": {
+			expect: 0,
+			reducerFunc: func(acc uint, element int) uint {
+				return acc + uint(element)
+			},
+			set: Hash[int](),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			sep := ","
-			assertSetJoin(t, JoinFloat64(tc.set, sep, tc.opts...), sep, tc.expect)
+			result := Reduce(tc.set, tc.reducerFunc, tc.initValue...)
+			if result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
 		})
 	}
 }
 
-func Test_JoinFloat64_Nil(t *testing.T) {
+func Test_Reduce_Nil(t *testing.T) {
 	testCases := map[string]struct {
-		set Set[float64]
+		expect    uint
+		initValue []uint
+		set       Set[int]
 	}{
-		"with nil Set": {
-			set: nil,
+		"with nil Set and initial value": {
+			expect:    100,
+			initValue: []uint{100},
+			set:       nil,
 		},
-		"with nil *HashSet": {
-			set: (*HashSet[float64])(nil),
+		"with nil Set and no initial value": {
+			expect: 0,
+			set:    nil,
+		},
+		"with nil *HashSet and initial value": {
+			expect:    100,
+			initValue: []uint{100},
+			set:       (*HashSet[int])(nil),
+		},
+		"with nil *HashSet and no initial value": {
+			expect: 0,
+			set:    (*HashSet[int])(nil),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			result := JoinFloat64(tc.set, ",")
-			if exp := ""; result != exp {
-				t.Errorf("unexpected result; want %q, got %q", exp, result)
+			var funcCallCount int
+			result := Reduce[int, uint](tc.set, func(acc uint, element int) uint {
+				funcCallCount++
+				return 123
+			}, tc.initValue...)
+			if result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+			if funcCallCount != 0 {
+				t.Errorf("unexpected number of calls to reducer; want 0, got %v", funcCallCount)
 			}
 		})
 	}
 }
 
-func Test_JoinInt(t *testing.T) {
+func Test_Relate(t *testing.T) {
 	testCases := map[string]struct {
-		expect []string
-		opts   []JoinIntOption
-		set    Set[int]
+		expect      map[int]Set[int]
+		relatedFunc func(x, y int) bool
+		set         Set[int]
 	}{
-		"with *HashSet containing multiple elements and no options": {
-			expect: []string{"-789", "-456", "-123", "0", "123", "456", "789"},
-			set:    Hash(-789, -456, -123, 0, 123, 456, 789),
-		},
-		"with *HashSet containing multiple elements and WithIntBase option": {
-			expect: []string{"0", "1", "1010", "1100100"},
-			opts:   []JoinIntOption{WithIntBase(2)},
-			set:    Hash(0, 1, 10, 100),
+		"with non-empty *HashSet with symmetric relation": {
+			expect: map[int]Set[int]{
+				1: Hash(3, 5),
+				2: Hash(4),
+				3: Hash(1, 5),
+				4: Hash(2),
+				5: Hash(1, 3),
+			},
+			relatedFunc: func(x, y int) bool { return (x+y)%2 == 0 },
+			set:         Hash(1, 2, 3, 4, 5),
+		},
+		"with non-empty *HashSet with asymmetric relation": {
+			expect: map[int]Set[int]{
+				1: Hash(2, 3),
+				2: Hash(3),
+				3: Hash[int](),
+			},
+			relatedFunc: func(x, y int) bool { return x < y },
+			set:         Hash(1, 2, 3),
 		},
-		"with *HashSet containing single element and no options": {
-			expect: []string{"123"},
-			set:    Hash(123),
+		"with non-empty *HashSet with always-matching relation": {
+			expect: map[int]Set[int]{
+				1: Hash(2),
+				2: Hash(1),
+			},
+			relatedFunc: func(_, _ int) bool { return true },
+			set:         Hash(1, 2),
 		},
-		"with *HashSet containing no elements and no options": {
-			expect: []string{},
-			set:    Hash[int](),
+		"with empty *HashSet": {
+			expect:      map[int]Set[int]{},
+			relatedFunc: func(_, _ int) bool { return true },
+			set:         Hash[int](),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			sep := ","
-			assertSetJoin(t, JoinInt(tc.set, sep, tc.opts...), sep, tc.expect)
+			relations := Relate(tc.set, tc.relatedFunc)
+			if relations == nil {
+				t.Error("unexpected nil map")
+			}
+			opts := []cmp.Option{cmp.Transformer("Set", func(in Set[int]) []int {
+				return in.SortedSlice(Asc[int])
+			})}
+			if !cmp.Equal(relations, tc.expect, opts...) {
+				t.Errorf("unexpected map; got diff %v", cmp.Diff(tc.expect, relations, opts...))
+			}
 		})
 	}
 }
 
-func Test_JoinInt_Nil(t *testing.T) {
+func Test_Relate_Nil(t *testing.T) {
 	testCases := map[string]struct {
 		set Set[int]
 	}{
@@ -1384,56 +4780,94 @@ func Test_JoinInt_Nil(t *testing.T) {
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			result := JoinInt(tc.set, ",")
-			if exp := ""; result != exp {
-				t.Errorf("unexpected result; want %q, got %q", exp, result)
+			var funcCallCount int
+			relations := Relate(tc.set, func(_, _ int) bool {
+				funcCallCount++
+				return true
+			})
+			if relations != nil {
+				t.Errorf("unexpected map; want nil, got %v", relations)
+			}
+			if funcCallCount != 0 {
+				t.Errorf("unexpected number of calls to related; want 0, got %v", funcCallCount)
 			}
 		})
 	}
 }
 
-func Test_JoinRune(t *testing.T) {
+func Test_SortedJoin(t *testing.T) {
 	testCases := map[string]struct {
-		expect []string
-		set    Set[rune]
+		expect   string
+		lessFunc func(x, y int) bool
+		set      Set[int]
 	}{
-		"with *HashSet containing multiple elements and no options": {
-			expect: []string{"a", "b", "c"},
-			set:    Hash('a', 'b', 'c'),
+		"with *HashSet containing multiple elements and no less function": {
+			expect: "-789,-456,-123,0,123,456,789",
+			set:    Hash(0, 123, -123, 456, -456, 789, -789),
 		},
-		"with *HashSet containing single element and no options": {
-			expect: []string{"a"},
-			set:    Hash('a'),
+		"with *HashSet containing multiple elements and ascending less function": {
+			expect:   "-789,-456,-123,0,123,456,789",
+			lessFunc: Asc[int],
+			set:      Hash(0, 123, -123, 456, -456, 789, -789),
 		},
-		"with *HashSet containing no elements and no options": {
-			expect: []string{},
-			set:    Hash[rune](),
+		"with *HashSet containing multiple elements and descending less function": {
+			expect:   "789,456,123,0,-123,-456,-789",
+			lessFunc: Desc[int],
+			set:      Hash(0, 123, -123, 456, -456, 789, -789),
+		},
+		"with *HashSet containing single element and no less function": {
+			expect: "123",
+			set:    Hash(123),
+		},
+		"with *HashSet containing no elements and no less function": {
+			expect: "",
+			set:    Hash[int](),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			sep := ","
-			assertSetJoin(t, JoinRune(tc.set, sep), sep, tc.expect)
+			convert := getIntStringConverterWithDefaultOptions[int]()
+			result := SortedJoin(tc.set, ",", convert, wrapLess(tc.lessFunc)...)
+			if result != tc.expect {
+				t.Errorf("unexpected result; want %q, got %q", tc.expect, result)
+			}
+			if tc.lessFunc == nil {
+				if exp := tc.set.SortedJoin(",", convert, Asc[int]); result != exp {
+					t.Errorf("unexpected result compared to Set.SortedJoin with Asc; want %q, got %q", exp, result)
+				}
+			}
 		})
 	}
 }
 
-func Test_JoinRune_Nil(t *testing.T) {
+func Test_SortedJoin_String(t *testing.T) {
+	set := Hash("gamma", "alpha", "beta")
+	convert := func(element string) string { return element }
+	result := SortedJoin[string](set, ",", convert)
+	if exp := "alpha,beta,gamma"; result != exp {
+		t.Errorf("unexpected result; want %q, got %q", exp, result)
+	}
+	if exp := set.SortedJoin(",", convert, Asc[string]); result != exp {
+		t.Errorf("unexpected result compared to Set.SortedJoin with Asc; want %q, got %q", exp, result)
+	}
+}
+
+func Test_SortedJoin_Nil(t *testing.T) {
 	testCases := map[string]struct {
-		set Set[rune]
+		set Set[int]
 	}{
 		"with nil Set": {
 			set: nil,
 		},
 		"with nil *HashSet": {
-			set: (*HashSet[rune])(nil),
+			set: (*HashSet[int])(nil),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			result := JoinRune(tc.set, ",")
+			result := SortedJoin(tc.set, ",", getIntStringConverterWithDefaultOptions[int]())
 			if exp := ""; result != exp {
 				t.Errorf("unexpected result; want %q, got %q", exp, result)
 			}
@@ -1441,48 +4875,76 @@ func Test_JoinRune_Nil(t *testing.T) {
 	}
 }
 
-func Test_JoinString(t *testing.T) {
+func Test_SortedJoinFloat32(t *testing.T) {
 	testCases := map[string]struct {
-		expect []string
-		set    Set[string]
+		expect string
+		opts   []JoinFloatOption
+		set    Set[float32]
 	}{
 		"with *HashSet containing multiple elements and no options": {
-			expect: []string{"foo", "bar"},
-			set:    Hash("foo", "bar"),
+			expect: "-789.987,-456.654,-123.321,0,123.321,456.654,789.987",
+			set:    Hash[float32](-789.987, -456.654, -123.321, 0.0, 123.321, 456.654, 789.987),
+		},
+		"with *HashSet containing multiple elements and WithFloatFormat option": {
+			expect: "0e+00,1.23321e+02,4.56654e+02,7.89987e+02",
+			opts:   []JoinFloatOption{WithFloatFormat('e')},
+			set:    Hash[float32](0.0, 123.321, 456.654, 789.987),
+		},
+		"with *HashSet containing multiple elements and WithFloatPrecision option": {
+			expect: "0.0,123.3,456.7,790.0",
+			opts:   []JoinFloatOption{WithFloatPrecision(1)},
+			set:    Hash[float32](0.0, 123.321, 456.654, 789.987),
+		},
+		"with *HashSet containing multiple elements and WithFloatSorting option": {
+			expect: "789.987,456.654,123.321,0,-123.321,-456.654,-789.987",
+			opts:   []JoinFloatOption{WithFloatSorting(Desc[float64])},
+			set:    Hash[float32](-789.987, -456.654, -123.321, 0.0, 123.321, 456.654, 789.987),
+		},
+		"with *HashSet containing multiple elements and WithFloatSortingAsc option": {
+			expect: "-789.987,-456.654,-123.321,0,123.321,456.654,789.987",
+			opts:   []JoinFloatOption{WithFloatSortingAsc()},
+			set:    Hash[float32](-789.987, -456.654, -123.321, 0.0, 123.321, 456.654, 789.987),
+		},
+		"with *HashSet containing multiple elements and WithFloatSortingDesc option": {
+			expect: "789.987,456.654,123.321,0,-123.321,-456.654,-789.987",
+			opts:   []JoinFloatOption{WithFloatSortingDesc()},
+			set:    Hash[float32](-789.987, -456.654, -123.321, 0.0, 123.321, 456.654, 789.987),
 		},
 		"with *HashSet containing single element and no options": {
-			expect: []string{"foo"},
-			set:    Hash("foo"),
+			expect: "123.321",
+			set:    Hash[float32](123.321),
 		},
 		"with *HashSet containing no elements and no options": {
-			expect: []string{},
-			set:    Hash[string](),
+			expect: "",
+			set:    Hash[float32](),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			sep := ","
-			assertSetJoin(t, JoinString(tc.set, sep), sep, tc.expect)
+			result := SortedJoinFloat32(tc.set, ",", tc.opts...)
+			if result != tc.expect {
+				t.Errorf("unexpected result; want %q, got %q", tc.expect, result)
+			}
 		})
 	}
 }
 
-func Test_JoinString_Nil(t *testing.T) {
+func Test_SortedJoinFloat32_Nil(t *testing.T) {
 	testCases := map[string]struct {
-		set Set[string]
+		set Set[float32]
 	}{
 		"with nil Set": {
 			set: nil,
 		},
 		"with nil *HashSet": {
-			set: (*HashSet[string])(nil),
+			set: (*HashSet[float32])(nil),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			result := JoinString(tc.set, ",")
+			result := SortedJoinFloat32(tc.set, ",")
 			if exp := ""; result != exp {
 				t.Errorf("unexpected result; want %q, got %q", exp, result)
 			}
@@ -1490,54 +4952,76 @@ func Test_JoinString_Nil(t *testing.T) {
 	}
 }
 
-func Test_JoinUint(t *testing.T) {
-	testCases := map[string]struct {
-		expect []string
-		opts   []JoinUintOption
-		set    Set[uint]
+func Test_SortedJoinFloat64(t *testing.T) {
+	testCases := map[string]struct {
+		expect string
+		opts   []JoinFloatOption
+		set    Set[float64]
 	}{
 		"with *HashSet containing multiple elements and no options": {
-			expect: []string{"0", "123", "456", "789"},
-			set:    Hash[uint](0, 123, 456, 789),
+			expect: "-789.987,-456.654,-123.321,0,123.321,456.654,789.987",
+			set:    Hash(-789.987, -456.654, -123.321, 0.0, 123.321, 456.654, 789.987),
 		},
-		"with *HashSet containing multiple elements and WithUintBase option": {
-			expect: []string{"0", "1", "1010", "1100100"},
-			opts:   []JoinUintOption{WithUintBase(2)},
-			set:    Hash[uint](0, 1, 10, 100),
+		"with *HashSet containing multiple elements and WithFloatFormat option": {
+			expect: "0e+00,1.23321e+02,4.56654e+02,7.89987e+02",
+			opts:   []JoinFloatOption{WithFloatFormat('e')},
+			set:    Hash(0.0, 123.321, 456.654, 789.987),
+		},
+		"with *HashSet containing multiple elements and WithFloatPrecision option": {
+			expect: "0.0,123.3,456.7,790.0",
+			opts:   []JoinFloatOption{WithFloatPrecision(1)},
+			set:    Hash(0.0, 123.321, 456.654, 789.987),
+		},
+		"with *HashSet containing multiple elements and WithFloatSorting option": {
+			expect: "789.987,456.654,123.321,0,-123.321,-456.654,-789.987",
+			opts:   []JoinFloatOption{WithFloatSorting(Desc[float64])},
+			set:    Hash(-789.987, -456.654, -123.321, 0.0, 123.321, 456.654, 789.987),
+		},
+		"with *HashSet containing multiple elements and WithFloatSortingAsc option": {
+			expect: "-789.987,-456.654,-123.321,0,123.321,456.654,789.987",
+			opts:   []JoinFloatOption{WithFloatSortingAsc()},
+			set:    Hash(-789.987, -456.654, -123.321, 0.0, 123.321, 456.654, 789.987),
+		},
+		"with *HashSet containing multiple elements and WithFloatSortingDesc option": {
+			expect: "789.987,456.654,123.321,0,-123.321,-456.654,-789.987",
+			opts:   []JoinFloatOption{WithFloatSortingDesc()},
+			set:    Hash(-789.987, -456.654, -123.321, 0.0, 123.321, 456.654, 789.987),
 		},
 		"with *HashSet containing single element and no options": {
-			expect: []string{"123"},
-			set:    Hash[uint](123),
+			expect: "123.321",
+			set:    Hash(123.321),
 		},
 		"with *HashSet containing no elements and no options": {
-			expect: []string{},
-			set:    Hash[uint](),
+			expect: "",
+			set:    Hash[float64](),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			sep := ","
-			assertSetJoin(t, JoinUint(tc.set, sep, tc.opts...), sep, tc.expect)
+			result := SortedJoinFloat64(tc.set, ",", tc.opts...)
+			if result != tc.expect {
+				t.Errorf("unexpected result; want %q, got %q", tc.expect, result)
+			}
 		})
 	}
 }
 
-func Test_JoinUint_Nil(t *testing.T) {
+func Test_SortedJoinFloat64_Nil(t *testing.T) {
 	testCases := map[string]struct {
-		set Set[uint]
+		set Set[float64]
 	}{
 		"with nil Set": {
 			set: nil,
 		},
 		"with nil *HashSet": {
-			set: (*HashSet[uint])(nil),
+			set: (*HashSet[float64])(nil),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			result := JoinUint(tc.set, ",")
+			result := SortedJoinFloat64(tc.set, ",")
 			if exp := ""; result != exp {
 				t.Errorf("unexpected result; want %q, got %q", exp, result)
 			}
@@ -1545,362 +5029,330 @@ func Test_JoinUint_Nil(t *testing.T) {
 	}
 }
 
-func Test_Map(t *testing.T) {
+func Test_SortedJoinInt(t *testing.T) {
 	testCases := map[string]struct {
-		expect     Set[string]
-		mapperFunc func(element int) string
-		set        Set[int]
+		expect string
+		opts   []JoinIntOption
+		set    Set[int]
 	}{
-		"with *EmptySet": {
-			expect: Empty[string](),
-			set:    Empty[int](),
+		"with *HashSet containing multiple elements and no options": {
+			expect: "-789,-456,-123,0,123,456,789",
+			set:    Hash(-789, -456, -123, 0, 123, 456, 789),
 		},
-		"with empty *HashSet": {
-			expect: Hash[string](),
-			set:    Hash[int](),
+		"with *HashSet containing multiple elements and WithIntBase option": {
+			expect: "0,1,1010,1100100",
+			opts:   []JoinIntOption{WithIntBase(2)},
+			set:    Hash(0, 1, 10, 100),
 		},
-		"with non-empty *HashSet": {
-			expect: Hash("123", "456", "789"),
-			set:    Hash(123, 456, 789),
+		"with *HashSet containing multiple elements and WithIntSorting option": {
+			expect: "789,456,123,0,-123,-456,-789",
+			opts:   []JoinIntOption{WithIntSorting(Desc[int64])},
+			set:    Hash(-789, -456, -123, 0, 123, 456, 789),
 		},
-		"with empty *MutableHashSet": {
-			expect: MutableHash[string](),
-			set:    MutableHash[int](),
+		"with *HashSet containing multiple elements and WithIntSortingAsc option": {
+			expect: "-789,-456,-123,0,123,456,789",
+			opts:   []JoinIntOption{WithIntSortingAsc()},
+			set:    Hash(-789, -456, -123, 0, 123, 456, 789),
 		},
-		"with non-empty *MutableHashSet": {
-			expect: MutableHash("123", "456", "789"),
-			set:    MutableHash(123, 456, 789),
+		"with *HashSet containing multiple elements and WithIntSortingDesc option": {
+			expect: "789,456,123,0,-123,-456,-789",
+			opts:   []JoinIntOption{WithIntSortingDesc()},
+			set:    Hash(-789, -456, -123, 0, 123, 456, 789),
 		},
-		"with *SingletonSet": {
-			expect: Singleton("123"),
-			set:    Singleton(123),
+		"with *HashSet containing negative elements and WithIntBase option for base-16": {
+			expect: "-ff,-a,0,a,ff",
+			opts:   []JoinIntOption{WithIntBase(16)},
+			set:    Hash(255, -10, 0, 10, -255),
 		},
-		"with empty *SyncHashSet": {
-			expect: SyncHash[string](),
-			set:    SyncHash[int](),
+		"with *HashSet containing negative elements and WithIntBase and WithIntSortingDesc options": {
+			expect: "ff,a,0,-a,-ff",
+			opts:   []JoinIntOption{WithIntBase(16), WithIntSortingDesc()},
+			set:    Hash(255, -10, 0, 10, -255),
 		},
-		"with non-empty *SyncHashSet": {
-			expect: SyncHash("123", "456", "789"),
-			set:    SyncHash(123, 456, 789),
+		"with *HashSet containing single element and no options": {
+			expect: "123",
+			set:    Hash(123),
+		},
+		"with *HashSet containing no elements and no options": {
+			expect: "",
+			set:    Hash[int](),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			mapped := Map(tc.set, getIntStringConverterWithDefaultOptions[int]())
-			if internal.IsNil(mapped) {
-				t.Error("unexpected nil Set")
-			}
-			if !mapped.Equal(tc.expect) {
-				t.Errorf("unexpected mapped Set; want %v, got %v", tc.expect, mapped)
+			result := SortedJoinInt(tc.set, ",", tc.opts...)
+			if result != tc.expect {
+				t.Errorf("unexpected result; want %q, got %q", tc.expect, result)
 			}
 		})
 	}
 }
 
-func Test_Map_Nil(t *testing.T) {
+func Test_SortedJoinInt_Nil(t *testing.T) {
 	testCases := map[string]struct {
 		set Set[int]
 	}{
 		"with nil Set": {
 			set: nil,
 		},
-		"with nil *EmptySet": {
-			set: (*EmptySet[int])(nil),
-		},
 		"with nil *HashSet": {
 			set: (*HashSet[int])(nil),
 		},
-		"with nil *MutableHashSet": {
-			set: (*MutableHashSet[int])(nil),
-		},
-		"with nil *SingletonSet": {
-			set: (*SingletonSet[int])(nil),
-		},
-		"with nil *SyncHashSet": {
-			set: (*SyncHashSet[int])(nil),
-		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			var funcCallCount int
-			mapped := Map(tc.set, func(element int) string {
-				funcCallCount++
-				return ""
-			})
-			if internal.IsNotNil(mapped) {
-				t.Errorf("unexpected mapped Set; want nil, got %v", mapped)
-			}
-			if funcCallCount != 0 {
-				t.Errorf("unexpected number of calls to mapper; want 0, got %v", funcCallCount)
+			result := SortedJoinInt(tc.set, ",")
+			if exp := ""; result != exp {
+				t.Errorf("unexpected result; want %q, got %q", exp, result)
 			}
 		})
 	}
 }
 
-func Test_Max(t *testing.T) {
+func Test_SortedJoinRune(t *testing.T) {
 	testCases := map[string]struct {
-		expectElement int
-		expectOK      bool
-		set           Set[int]
+		expect string
+		opts   []SortedJoinRuneOption
+		set    Set[rune]
 	}{
-		"with *HashSet containing multiple elements": {
-			expectElement: 789,
-			expectOK:      true,
-			set:           Hash(-789, -456, -123, 0, 123, 456, 789),
+		"with *HashSet containing multiple elements and no options": {
+			expect: "a,b,c",
+			set:    Hash('a', 'b', 'c'),
 		},
-		"with *HashSet containing single element": {
-			expectElement: 123,
-			expectOK:      true,
-			set:           Hash(123),
+		"with *HashSet containing multiple elements and WithRuneSorting option": {
+			expect: "c,b,a",
+			opts:   []SortedJoinRuneOption{WithRuneSorting(Desc[rune])},
+			set:    Hash('a', 'b', 'c'),
 		},
-		"with *HashSet containing no elements": {
-			expectElement: 0,
-			expectOK:      false,
-			set:           Hash[int](),
+		"with *HashSet containing multiple elements and WithRuneSortingAsc option": {
+			expect: "a,b,c",
+			opts:   []SortedJoinRuneOption{WithRuneSortingAsc()},
+			set:    Hash('a', 'b', 'c'),
+		},
+		"with *HashSet containing multiple elements and WithRuneSortingDesc option": {
+			expect: "c,b,a",
+			opts:   []SortedJoinRuneOption{WithRuneSortingDesc()},
+			set:    Hash('a', 'b', 'c'),
+		},
+		"with *HashSet containing single element and no options": {
+			expect: "a",
+			set:    Hash('a'),
+		},
+		"with *HashSet containing no elements and no options": {
+			expect: "",
+			set:    Hash[rune](),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			element, ok := Max(tc.set)
-			if ok != tc.expectOK {
-				t.Errorf("unexpected bool result; want %v, got %v", tc.expectOK, ok)
-			}
-			if element != tc.expectElement {
-				t.Errorf("unexpected element result; want %v, got %v", tc.expectElement, element)
+			result := SortedJoinRune(tc.set, ",", tc.opts...)
+			if result != tc.expect {
+				t.Errorf("unexpected result; want %q, got %q", tc.expect, result)
 			}
 		})
 	}
 }
 
-func Test_Max_Nil(t *testing.T) {
+func Test_SortedJoinRune_Nil(t *testing.T) {
 	testCases := map[string]struct {
-		set Set[int]
+		set Set[rune]
 	}{
 		"with nil Set": {
 			set: nil,
 		},
 		"with nil *HashSet": {
-			set: (*HashSet[int])(nil),
+			set: (*HashSet[rune])(nil),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			element, ok := Max(tc.set)
-			if ok {
-				t.Error("unexpected bool result; want false, got true")
-			}
-			if element != 0 {
-				t.Errorf("unexpected non-zero value for element result; want 0, got %v", element)
+			result := SortedJoinRune(tc.set, ",")
+			if exp := ""; result != exp {
+				t.Errorf("unexpected result; want %q, got %q", exp, result)
 			}
 		})
 	}
 }
 
-func Test_Min(t *testing.T) {
+func Test_SortedJoinString(t *testing.T) {
 	testCases := map[string]struct {
-		expectElement int
-		expectOK      bool
-		set           Set[int]
+		expect string
+		opts   []SortedJoinStringOption
+		set    Set[string]
 	}{
-		"with *HashSet containing multiple elements": {
-			expectElement: -789,
-			expectOK:      true,
-			set:           Hash(-789, -456, -123, 0, 123, 456, 789),
+		"with *HashSet containing multiple elements and no options": {
+			expect: "bar,foo",
+			set:    Hash("foo", "bar"),
 		},
-		"with *HashSet containing single element": {
-			expectElement: 123,
-			expectOK:      true,
-			set:           Hash(123),
+		"with *HashSet containing multiple elements and WithStringSorting option": {
+			expect: "foo,bar",
+			opts:   []SortedJoinStringOption{WithStringSorting(Desc[string])},
+			set:    Hash("foo", "bar"),
+		},
+		"with *HashSet containing multiple elements and WithStringSortingAsc option": {
+			expect: "bar,foo",
+			opts:   []SortedJoinStringOption{WithStringSortingAsc()},
+			set:    Hash("foo", "bar"),
+		},
+		"with *HashSet containing multiple elements and WithStringSortingDesc option": {
+			expect: "foo,bar",
+			opts:   []SortedJoinStringOption{WithStringSortingDesc()},
+			set:    Hash("foo", "bar"),
+		},
+		"with *HashSet containing single element and no options": {
+			expect: "foo",
+			set:    Hash("foo"),
 		},
-		"with *HashSet containing no elements": {
-			expectElement: 0,
-			expectOK:      false,
-			set:           Hash[int](),
+		"with *HashSet containing no elements and no options": {
+			expect: "",
+			set:    Hash[string](),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			element, ok := Min(tc.set)
-			if ok != tc.expectOK {
-				t.Errorf("unexpected bool result; want %v, got %v", tc.expectOK, ok)
-			}
-			if element != tc.expectElement {
-				t.Errorf("unexpected element result; want %v, got %v", tc.expectElement, element)
+			result := SortedJoinString(tc.set, ",", tc.opts...)
+			if result != tc.expect {
+				t.Errorf("unexpected result; want %q, got %q", tc.expect, result)
 			}
 		})
 	}
 }
 
-func Test_Min_Nil(t *testing.T) {
+func Test_SortedJoinString_Nil(t *testing.T) {
 	testCases := map[string]struct {
-		set Set[int]
+		set Set[string]
 	}{
 		"with nil Set": {
 			set: nil,
 		},
 		"with nil *HashSet": {
-			set: (*HashSet[int])(nil),
+			set: (*HashSet[string])(nil),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			element, ok := Min(tc.set)
-			if ok {
-				t.Error("unexpected bool result; want false, got true")
-			}
-			if element != 0 {
-				t.Errorf("unexpected non-zero value for element result; want 0, got %v", element)
+			result := SortedJoinString(tc.set, ",")
+			if exp := ""; result != exp {
+				t.Errorf("unexpected result; want %q, got %q", exp, result)
 			}
 		})
 	}
 }
 
-func Test_Reduce(t *testing.T) {
+func Test_SortedJoinStringer(t *testing.T) {
 	testCases := map[string]struct {
-		expect      uint
-		initValue   []uint
-		reducerFunc func(acc uint, element int) uint
-		set         Set[int]
+		expect string
+		less   func(x, y testStringer) bool
+		set    Set[testStringer]
 	}{
-		"with non-empty *HashSet and additive reducer and initial value": {
-			expect:    123 + 456 + 789 + 100,
-			initValue: []uint{100},
-			reducerFunc: func(acc uint, element int) uint {
-				return acc + uint(element)
-			},
-			set: Hash(123, 456, 789),
+		"with *HashSet containing multiple elements and ascending less function": {
+			expect: "<bar>,<fizz>,<foo>",
+			less:   Asc[testStringer],
+			set:    Hash[testStringer]("foo", "bar", "fizz"),
 		},
-		"with non-empty *HashSet and additive reducer and no initial value": {
-			expect: 123 + 456 + 789,
-			reducerFunc: func(acc uint, element int) uint {
-				return acc + uint(element)
-			},
-			set: Hash(123, 456, 789),
+		"with *HashSet containing multiple elements and descending less function": {
+			expect: "<foo>,<fizz>,<bar>",
+			less:   Desc[testStringer],
+			set:    Hash[testStringer]("foo", "bar", "fizz"),
 		},
-		"with empty *HashSet and initial value": {
-			expect:    100,
-			initValue: []uint{100},
-			reducerFunc: func(acc uint, element int) uint {
-				return acc + uint(element)
-			},
-			set: Hash[int](),
+		"with *HashSet containing single element": {
+			expect: "<foo>",
+			less:   Asc[testStringer],
+			set:    Hash[testStringer]("foo"),
 		},
-		"with empty *HashSet and no initial value": {
-			expect: 0,
-			reducerFunc: func(acc uint, element int) uint {
-				return acc + uint(element)
-			},
-			set: Hash[int](),
+		"with *HashSet containing no elements": {
+			expect: "",
+			less:   Asc[testStringer],
+			set:    Hash[testStringer](),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			result := Reduce(tc.set, tc.reducerFunc, tc.initValue...)
+			result := SortedJoinStringer(tc.set, ",", tc.less)
 			if result != tc.expect {
-				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+				t.Errorf("unexpected result; want %q, got %q", tc.expect, result)
 			}
 		})
 	}
 }
 
-func Test_Reduce_Nil(t *testing.T) {
+func Test_SortedJoinStringer_Nil(t *testing.T) {
 	testCases := map[string]struct {
-		expect    uint
-		initValue []uint
-		set       Set[int]
+		set Set[testStringer]
 	}{
-		"with nil Set and initial value": {
-			expect:    100,
-			initValue: []uint{100},
-			set:       nil,
-		},
-		"with nil Set and no initial value": {
-			expect: 0,
-			set:    nil,
-		},
-		"with nil *HashSet and initial value": {
-			expect:    100,
-			initValue: []uint{100},
-			set:       (*HashSet[int])(nil),
+		"with nil Set": {
+			set: nil,
 		},
-		"with nil *HashSet and no initial value": {
-			expect: 0,
-			set:    (*HashSet[int])(nil),
+		"with nil *HashSet": {
+			set: (*HashSet[testStringer])(nil),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			var funcCallCount int
-			result := Reduce[int, uint](tc.set, func(acc uint, element int) uint {
-				funcCallCount++
-				return 123
-			}, tc.initValue...)
-			if result != tc.expect {
-				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
-			}
-			if funcCallCount != 0 {
-				t.Errorf("unexpected number of calls to reducer; want 0, got %v", funcCallCount)
+			result := SortedJoinStringer(tc.set, ",", Asc[testStringer])
+			if exp := ""; result != exp {
+				t.Errorf("unexpected result; want %q, got %q", exp, result)
 			}
 		})
 	}
 }
 
-func Test_SortedJoinFloat32(t *testing.T) {
+func Test_SortedJoinUint(t *testing.T) {
 	testCases := map[string]struct {
 		expect string
-		opts   []JoinFloatOption
-		set    Set[float32]
+		opts   []JoinUintOption
+		set    Set[uint]
 	}{
 		"with *HashSet containing multiple elements and no options": {
-			expect: "-789.987,-456.654,-123.321,0,123.321,456.654,789.987",
-			set:    Hash[float32](-789.987, -456.654, -123.321, 0.0, 123.321, 456.654, 789.987),
+			expect: "0,123,456,789",
+			set:    Hash[uint](0, 123, 456, 789),
 		},
-		"with *HashSet containing multiple elements and WithFloatFormat option": {
-			expect: "0e+00,1.23321e+02,4.56654e+02,7.89987e+02",
-			opts:   []JoinFloatOption{WithFloatFormat('e')},
-			set:    Hash[float32](0.0, 123.321, 456.654, 789.987),
+		"with *HashSet containing multiple elements and WithUintBase option": {
+			expect: "0,1,1010,1100100",
+			opts:   []JoinUintOption{WithUintBase(2)},
+			set:    Hash[uint](0, 1, 10, 100),
 		},
-		"with *HashSet containing multiple elements and WithFloatPrecision option": {
-			expect: "0.0,123.3,456.7,790.0",
-			opts:   []JoinFloatOption{WithFloatPrecision(1)},
-			set:    Hash[float32](0.0, 123.321, 456.654, 789.987),
+		"with *HashSet containing multiple elements and WithUintSorting option": {
+			expect: "789,456,123,0",
+			opts:   []JoinUintOption{WithUintSorting(Desc[uint64])},
+			set:    Hash[uint](0, 123, 456, 789),
 		},
-		"with *HashSet containing multiple elements and WithFloatSorting option": {
-			expect: "789.987,456.654,123.321,0,-123.321,-456.654,-789.987",
-			opts:   []JoinFloatOption{WithFloatSorting(Desc[float64])},
-			set:    Hash[float32](-789.987, -456.654, -123.321, 0.0, 123.321, 456.654, 789.987),
+		"with *HashSet containing multiple elements and WithUintSortingAsc option": {
+			expect: "0,123,456,789",
+			opts:   []JoinUintOption{WithUintSortingAsc()},
+			set:    Hash[uint](0, 123, 456, 789),
 		},
-		"with *HashSet containing multiple elements and WithFloatSortingAsc option": {
-			expect: "-789.987,-456.654,-123.321,0,123.321,456.654,789.987",
-			opts:   []JoinFloatOption{WithFloatSortingAsc()},
-			set:    Hash[float32](-789.987, -456.654, -123.321, 0.0, 123.321, 456.654, 789.987),
+		"with *HashSet containing multiple elements and WithUintSortingDesc option": {
+			expect: "789,456,123,0",
+			opts:   []JoinUintOption{WithUintSortingDesc()},
+			set:    Hash[uint](0, 123, 456, 789),
 		},
-		"with *HashSet containing multiple elements and WithFloatSortingDesc option": {
-			expect: "789.987,456.654,123.321,0,-123.321,-456.654,-789.987",
-			opts:   []JoinFloatOption{WithFloatSortingDesc()},
-			set:    Hash[float32](-789.987, -456.654, -123.321, 0.0, 123.321, 456.654, 789.987),
+		"with *HashSet containing multiple elements and WithUintBase option for base-16": {
+			expect: "0,a,ff,100",
+			opts:   []JoinUintOption{WithUintBase(16)},
+			set:    Hash[uint](256, 10, 0, 255),
 		},
 		"with *HashSet containing single element and no options": {
-			expect: "123.321",
-			set:    Hash[float32](123.321),
+			expect: "123",
+			set:    Hash[uint](123),
 		},
 		"with *HashSet containing no elements and no options": {
 			expect: "",
-			set:    Hash[float32](),
+			set:    Hash[uint](),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			result := SortedJoinFloat32(tc.set, ",", tc.opts...)
+			result := SortedJoinUint(tc.set, ",", tc.opts...)
 			if result != tc.expect {
 				t.Errorf("unexpected result; want %q, got %q", tc.expect, result)
 			}
@@ -1908,21 +5360,21 @@ func Test_SortedJoinFloat32(t *testing.T) {
 	}
 }
 
-func Test_SortedJoinFloat32_Nil(t *testing.T) {
+func Test_SortedJoinUint_Nil(t *testing.T) {
 	testCases := map[string]struct {
-		set Set[float32]
+		set Set[uint]
 	}{
 		"with nil Set": {
 			set: nil,
 		},
 		"with nil *HashSet": {
-			set: (*HashSet[float32])(nil),
+			set: (*HashSet[uint])(nil),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			result := SortedJoinFloat32(tc.set, ",")
+			result := SortedJoinUint(tc.set, ",")
 			if exp := ""; result != exp {
 				t.Errorf("unexpected result; want %q, got %q", exp, result)
 			}
@@ -1930,403 +5382,700 @@ func Test_SortedJoinFloat32_Nil(t *testing.T) {
 	}
 }
 
-func Test_SortedJoinFloat64(t *testing.T) {
+func Test_SortedJoinWrap(t *testing.T) {
 	testCases := map[string]struct {
 		expect string
-		opts   []JoinFloatOption
-		set    Set[float64]
+		set    Set[string]
 	}{
-		"with *HashSet containing multiple elements and no options": {
-			expect: "-789.987,-456.654,-123.321,0,123.321,456.654,789.987",
-			set:    Hash(-789.987, -456.654, -123.321, 0.0, 123.321, 456.654, 789.987),
+		"with Set containing multiple elements": {
+			expect: `"alpha","beta","gamma"`,
+			set:    Hash("gamma", "alpha", "beta"),
 		},
-		"with *HashSet containing multiple elements and WithFloatFormat option": {
-			expect: "0e+00,1.23321e+02,4.56654e+02,7.89987e+02",
-			opts:   []JoinFloatOption{WithFloatFormat('e')},
-			set:    Hash(0.0, 123.321, 456.654, 789.987),
+		"with Set containing single element": {
+			expect: `"alpha"`,
+			set:    Hash("alpha"),
 		},
-		"with *HashSet containing multiple elements and WithFloatPrecision option": {
-			expect: "0.0,123.3,456.7,790.0",
-			opts:   []JoinFloatOption{WithFloatPrecision(1)},
-			set:    Hash(0.0, 123.321, 456.654, 789.987),
+		"with Set containing no elements": {
+			expect: "",
+			set:    Hash[string](),
 		},
-		"with *HashSet containing multiple elements and WithFloatSorting option": {
-			expect: "789.987,456.654,123.321,0,-123.321,-456.654,-789.987",
-			opts:   []JoinFloatOption{WithFloatSorting(Desc[float64])},
-			set:    Hash(-789.987, -456.654, -123.321, 0.0, 123.321, 456.654, 789.987),
+		"with nil Set": {
+			expect: "",
+			set:    nil,
 		},
-		"with *HashSet containing multiple elements and WithFloatSortingAsc option": {
-			expect: "-789.987,-456.654,-123.321,0,123.321,456.654,789.987",
-			opts:   []JoinFloatOption{WithFloatSortingAsc()},
-			set:    Hash(-789.987, -456.654, -123.321, 0.0, 123.321, 456.654, 789.987),
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := SortedJoinWrap(tc.set, ",", `"`, `"`, func(element string) string { return element }, Asc[string])
+			if result != tc.expect {
+				t.Errorf("unexpected string; want %q, got %q", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_SortedMerge(t *testing.T) {
+	testCases := map[string]struct {
+		expect []int
+		sets   []Set[int]
+	}{
+		"with overlapping Sets": {
+			expect: []int{0, 123, 456, 789, 999},
+			sets:   []Set[int]{Hash(789, 123, 456), MutableHash(456, 999), SyncHash(0, 123)},
 		},
-		"with *HashSet containing multiple elements and WithFloatSortingDesc option": {
-			expect: "789.987,456.654,123.321,0,-123.321,-456.654,-789.987",
-			opts:   []JoinFloatOption{WithFloatSortingDesc()},
-			set:    Hash(-789.987, -456.654, -123.321, 0.0, 123.321, 456.654, 789.987),
+		"with single Set": {
+			expect: []int{123, 456, 789},
+			sets:   []Set[int]{Hash(789, 456, 123)},
 		},
-		"with *HashSet containing single element and no options": {
-			expect: "123.321",
-			set:    Hash(123.321),
+		"with nil and empty Sets": {
+			expect: []int{123, 456},
+			sets:   []Set[int]{nil, Hash(456), Hash[int](), (*HashSet[int])(nil), Hash(123)},
 		},
-		"with *HashSet containing no elements and no options": {
-			expect: "",
-			set:    Hash[float64](),
+		"with no Sets": {
+			expect: []int{},
+			sets:   nil,
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			result := SortedJoinFloat64(tc.set, ",", tc.opts...)
-			if result != tc.expect {
-				t.Errorf("unexpected result; want %q, got %q", tc.expect, result)
+			if diff := cmp.Diff(tc.expect, SortedMerge(Asc[int], tc.sets...)); diff != "" {
+				t.Errorf("unexpected elements (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func Test_SortedJoinFloat64_Nil(t *testing.T) {
+func Test_SortedSlice(t *testing.T) {
 	testCases := map[string]struct {
-		set Set[float64]
+		expect []int
+		less   func(x, y int) bool
+		set    Set[int]
 	}{
-		"with nil Set": {
-			set: nil,
+		"with non-empty *HashSet and default (ascending) sorting": {
+			expect: []int{123, 456, 789},
+			set:    Hash(123, 456, 789),
 		},
-		"with nil *HashSet": {
-			set: (*HashSet[float64])(nil),
+		"with non-empty *HashSet and custom (descending) sorting": {
+			expect: []int{789, 456, 123},
+			less:   Desc[int],
+			set:    Hash(123, 456, 789),
+		},
+		"with empty *HashSet": {
+			expect: []int{},
+			set:    Hash[int](),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			result := SortedJoinFloat64(tc.set, ",")
-			if exp := ""; result != exp {
-				t.Errorf("unexpected result; want %q, got %q", exp, result)
+			elements := SortedSlice[int](tc.set, wrapLess(tc.less)...)
+			if elements == nil {
+				t.Error("unexpected nil slice")
+			}
+			if !cmp.Equal(tc.expect, elements) {
+				t.Errorf("unexpected slice; got diff %v", cmp.Diff(tc.expect, elements))
 			}
 		})
 	}
 }
 
-func Test_SortedJoinInt(t *testing.T) {
+func Test_SortedSlice_Nil(t *testing.T) {
+	var set *HashSet[int]
+	elements := SortedSlice[int](set)
+	if elements != nil {
+		t.Errorf("unexpected slice; want nil, got %v", elements)
+	}
+}
+
+func Test_Split(t *testing.T) {
 	testCases := map[string]struct {
-		expect string
-		opts   []JoinIntOption
-		set    Set[int]
+		expectMutable bool
+		expectSizes   []int
+		n             int
+		set           Set[int]
 	}{
-		"with *HashSet containing multiple elements and no options": {
-			expect: "-789,-456,-123,0,123,456,789",
-			set:    Hash(-789, -456, -123, 0, 123, 456, 789),
-		},
-		"with *HashSet containing multiple elements and WithIntBase option": {
-			expect: "0,1,1010,1100100",
-			opts:   []JoinIntOption{WithIntBase(2)},
-			set:    Hash(0, 1, 10, 100),
-		},
-		"with *HashSet containing multiple elements and WithIntSorting option": {
-			expect: "789,456,123,0,-123,-456,-789",
-			opts:   []JoinIntOption{WithIntSorting(Desc[int64])},
-			set:    Hash(-789, -456, -123, 0, 123, 456, 789),
-		},
-		"with *HashSet containing multiple elements and WithIntSortingAsc option": {
-			expect: "-789,-456,-123,0,123,456,789",
-			opts:   []JoinIntOption{WithIntSortingAsc()},
-			set:    Hash(-789, -456, -123, 0, 123, 456, 789),
-		},
-		"with *HashSet containing multiple elements and WithIntSortingDesc option": {
-			expect: "789,456,123,0,-123,-456,-789",
-			opts:   []JoinIntOption{WithIntSortingDesc()},
-			set:    Hash(-789, -456, -123, 0, 123, 456, 789),
+		"with n of 1": {
+			expectSizes: []int{5},
+			n:           1,
+			set:         Hash(1, 2, 3, 4, 5),
+		},
+		"with n dividing elements evenly": {
+			expectSizes: []int{3, 3},
+			n:           2,
+			set:         Hash(1, 2, 3, 4, 5, 6),
+		},
+		"with n not dividing elements evenly": {
+			expectSizes: []int{3, 2, 2},
+			n:           3,
+			set:         Hash(1, 2, 3, 4, 5, 6, 7),
+		},
+		"with n equal to number of elements": {
+			expectSizes: []int{1, 1, 1},
+			n:           3,
+			set:         Hash(1, 2, 3),
+		},
+		"with n greater than number of elements": {
+			expectSizes: []int{1, 1, 0, 0},
+			n:           4,
+			set:         Hash(1, 2),
+		},
+		"with empty Set": {
+			expectSizes: []int{0, 0},
+			n:           2,
+			set:         Hash[int](),
 		},
-		"with *HashSet containing single element and no options": {
-			expect: "123",
-			set:    Hash(123),
+		"with mutable Set": {
+			expectMutable: true,
+			expectSizes:   []int{2, 1},
+			n:             2,
+			set:           MutableHash(1, 2, 3),
 		},
-		"with *HashSet containing no elements and no options": {
-			expect: "",
-			set:    Hash[int](),
+		"with synchronized Set": {
+			expectMutable: true,
+			expectSizes:   []int{2, 1},
+			n:             2,
+			set:           SyncHash(1, 2, 3),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			result := SortedJoinInt(tc.set, ",", tc.opts...)
-			if result != tc.expect {
-				t.Errorf("unexpected result; want %q, got %q", tc.expect, result)
+			partitions := Split(tc.set, tc.n)
+			if exp, act := tc.n, len(partitions); act != exp {
+				t.Fatalf("unexpected number of partitions; want %v, got %v", exp, act)
+			}
+			union := MutableHash[int]()
+			var total int
+			for i, partition := range partitions {
+				if internal.IsNil(partition) {
+					t.Fatalf("unexpected nil partition at index %v", i)
+				}
+				if exp, act := tc.expectSizes[i], partition.Len(); act != exp {
+					t.Errorf("unexpected length of partition at index %v; want %v, got %v", i, exp, act)
+				}
+				if mutable := partition.IsMutable(); mutable != tc.expectMutable {
+					t.Errorf("unexpected partition mutability; want %v, got %v", tc.expectMutable, mutable)
+				}
+				total += partition.Len()
+				union.PutAll(partition)
+			}
+			if total != union.Len() {
+				t.Errorf("unexpected overlap between partitions; want %v elements, got %v", union.Len(), total)
+			}
+			if !union.Equal(tc.set) {
+				t.Errorf("unexpected union of partitions; want %v, got %v", tc.set, union)
+			}
+			if _, ok := tc.set.(*SyncHashSet[int]); ok {
+				if _, ok = partitions[0].(*SyncHashSet[int]); !ok {
+					t.Errorf("unexpected partition type; want *SyncHashSet[int], got %T", partitions[0])
+				}
 			}
 		})
 	}
 }
 
-func Test_SortedJoinInt_Nil(t *testing.T) {
+func Test_Split_Nil(t *testing.T) {
 	testCases := map[string]struct {
+		n   int
 		set Set[int]
 	}{
 		"with nil Set": {
+			n:   2,
 			set: nil,
 		},
-		"with nil *HashSet": {
-			set: (*HashSet[int])(nil),
+		"with zero n": {
+			n:   0,
+			set: Hash(1, 2, 3),
+		},
+		"with negative n": {
+			n:   -1,
+			set: Hash(1, 2, 3),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			result := SortedJoinInt(tc.set, ",")
-			if exp := ""; result != exp {
-				t.Errorf("unexpected result; want %q, got %q", exp, result)
+			if partitions := Split(tc.set, tc.n); partitions != nil {
+				t.Errorf("unexpected partitions; want nil, got %v", partitions)
 			}
 		})
 	}
 }
 
-func Test_SortedJoinRune(t *testing.T) {
+func Test_StreamDedup(t *testing.T) {
 	testCases := map[string]struct {
-		expect string
-		opts   []SortedJoinRuneOption
-		set    Set[rune]
+		elements []int
+		expect   []int
+		window   int
 	}{
-		"with *HashSet containing multiple elements and no options": {
-			expect: "a,b,c",
-			set:    Hash('a', 'b', 'c'),
-		},
-		"with *HashSet containing multiple elements and WithRuneSorting option": {
-			expect: "c,b,a",
-			opts:   []SortedJoinRuneOption{WithRuneSorting(Desc[rune])},
-			set:    Hash('a', 'b', 'c'),
-		},
-		"with *HashSet containing multiple elements and WithRuneSortingAsc option": {
-			expect: "a,b,c",
-			opts:   []SortedJoinRuneOption{WithRuneSortingAsc()},
-			set:    Hash('a', 'b', 'c'),
-		},
-		"with *HashSet containing multiple elements and WithRuneSortingDesc option": {
-			expect: "c,b,a",
-			opts:   []SortedJoinRuneOption{WithRuneSortingDesc()},
-			set:    Hash('a', 'b', 'c'),
-		},
-		"with *HashSet containing single element and no options": {
-			expect: "a",
-			set:    Hash('a'),
-		},
-		"with *HashSet containing no elements and no options": {
-			expect: "",
-			set:    Hash[rune](),
+		"with no elements": {
+			elements: nil,
+			expect:   nil,
+			window:   2,
+		},
+		"with unique elements": {
+			elements: []int{1, 2, 3, 4},
+			expect:   []int{1, 2, 3, 4},
+			window:   2,
+		},
+		"with repeated elements within window": {
+			elements: []int{1, 1, 2, 1, 2, 2},
+			expect:   []int{1, 2},
+			window:   2,
+		},
+		"with repeated elements after eviction": {
+			elements: []int{1, 2, 3, 1, 3, 2},
+			expect:   []int{1, 2, 3, 1, 2},
+			window:   2,
+		},
+		"with window of one": {
+			elements: []int{1, 1, 2, 1, 1},
+			expect:   []int{1, 2, 1},
+			window:   1,
+		},
+		"with zero window": {
+			elements: []int{1, 2, 3, 1, 2, 3, 4},
+			expect:   []int{1, 2, 3, 4},
+			window:   0,
+		},
+		"with negative window": {
+			elements: []int{1, 2, 1, 2},
+			expect:   []int{1, 2},
+			window:   -1,
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			result := SortedJoinRune(tc.set, ",", tc.opts...)
-			if result != tc.expect {
-				t.Errorf("unexpected result; want %q, got %q", tc.expect, result)
+			in := make(chan int)
+			go func() {
+				defer close(in)
+				for _, element := range tc.elements {
+					in <- element
+				}
+			}()
+			var elements []int
+			for element := range StreamDedup(context.Background(), in, tc.window) {
+				elements = append(elements, element)
+			}
+			if diff := cmp.Diff(tc.expect, elements); diff != "" {
+				t.Errorf("unexpected forwarded elements (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func Test_SortedJoinRune_Nil(t *testing.T) {
+func Test_StreamDedup_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan int)
+	out := StreamDedup(ctx, in, 0)
+	in <- 123
+	cancel()
+	for range out {
+	}
+	select {
+	case in <- 456:
+		t.Error("unexpected receive from in channel after context was canceled")
+	default:
+	}
+}
+
+func Test_Sum(t *testing.T) {
 	testCases := map[string]struct {
-		set Set[rune]
+		expect int
+		set    Set[int]
 	}{
+		"with multiple elements": {
+			expect: 1368,
+			set:    Hash(123, 456, 789),
+		},
+		"with negative elements": {
+			expect: -912,
+			set:    MutableHash(-123, -456, -789, 456),
+		},
+		"with single element": {
+			expect: 123,
+			set:    Singleton(123),
+		},
+		"with Set containing no elements": {
+			expect: 0,
+			set:    Hash[int](),
+		},
 		"with nil Set": {
-			set: nil,
+			expect: 0,
+			set:    nil,
 		},
 		"with nil *HashSet": {
-			set: (*HashSet[rune])(nil),
+			expect: 0,
+			set:    (*HashSet[int])(nil),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			result := SortedJoinRune(tc.set, ",")
-			if exp := ""; result != exp {
-				t.Errorf("unexpected result; want %q, got %q", exp, result)
+			if result := Sum(tc.set); result != tc.expect {
+				t.Errorf("unexpected sum; want %v, got %v", tc.expect, result)
 			}
 		})
 	}
 }
 
-func Test_SortedJoinString(t *testing.T) {
+func Test_Sum_Float(t *testing.T) {
+	if result := Sum[float64](Hash(0.5, 1.25, 2.25)); result != 4 {
+		t.Errorf("unexpected sum; want 4, got %v", result)
+	}
+	if result := Sum[float32](Hash[float32]()); result != 0 {
+		t.Errorf("unexpected sum; want 0, got %v", result)
+	}
+}
+
+func Test_Sum_Uint(t *testing.T) {
+	if result := Sum[uint8](Hash[uint8](100, 200)); result != 44 {
+		t.Errorf("unexpected sum; want 44, got %v", result)
+	}
+}
+
+func Test_SymmetricParts(t *testing.T) {
 	testCases := map[string]struct {
-		expect string
-		opts   []SortedJoinStringOption
-		set    Set[string]
+		a             Set[int]
+		b             Set[int]
+		expectAMinusB Set[int]
+		expectBMinusA Set[int]
 	}{
-		"with *HashSet containing multiple elements and no options": {
-			expect: "bar,foo",
-			set:    Hash("foo", "bar"),
-		},
-		"with *HashSet containing multiple elements and WithStringSorting option": {
-			expect: "foo,bar",
-			opts:   []SortedJoinStringOption{WithStringSorting(Desc[string])},
-			set:    Hash("foo", "bar"),
-		},
-		"with *HashSet containing multiple elements and WithStringSortingAsc option": {
-			expect: "bar,foo",
-			opts:   []SortedJoinStringOption{WithStringSortingAsc()},
-			set:    Hash("foo", "bar"),
-		},
-		"with *HashSet containing multiple elements and WithStringSortingDesc option": {
-			expect: "foo,bar",
-			opts:   []SortedJoinStringOption{WithStringSortingDesc()},
-			set:    Hash("foo", "bar"),
-		},
-		"with *HashSet containing single element and no options": {
-			expect: "foo",
-			set:    Hash("foo"),
-		},
-		"with *HashSet containing no elements and no options": {
-			expect: "",
-			set:    Hash[string](),
+		"with overlapping non-empty *HashSet and *HashSet": {
+			a:             Hash(0, 123, 456),
+			b:             Hash(456, 789),
+			expectAMinusB: Hash(0, 123),
+			expectBMinusA: Hash(789),
+		},
+		"with disjoint non-empty *HashSet and *HashSet": {
+			a:             Hash(123),
+			b:             Hash(456),
+			expectAMinusB: Hash(123),
+			expectBMinusA: Hash(456),
+		},
+		"with equal non-empty *HashSet and *HashSet": {
+			a:             Hash(123, 456),
+			b:             Hash(123, 456),
+			expectAMinusB: Hash[int](),
+			expectBMinusA: Hash[int](),
+		},
+		"with non-empty *MutableHashSet and empty *HashSet": {
+			a:             MutableHash(123, 456),
+			b:             Hash[int](),
+			expectAMinusB: MutableHash(123, 456),
+			expectBMinusA: Hash[int](),
+		},
+		"with empty *HashSet and non-empty *SyncHashSet": {
+			a:             Hash[int](),
+			b:             SyncHash(123, 456),
+			expectAMinusB: Hash[int](),
+			expectBMinusA: SyncHash(123, 456),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			result := SortedJoinString(tc.set, ",", tc.opts...)
-			if result != tc.expect {
-				t.Errorf("unexpected result; want %q, got %q", tc.expect, result)
+			aMinusB, bMinusA := SymmetricParts(tc.a, tc.b)
+			if !tc.expectAMinusB.Equal(aMinusB) {
+				t.Errorf("unexpected a minus b Set; want %v, got %v", tc.expectAMinusB, aMinusB)
+			}
+			if tc.expectAMinusB.IsMutable() != aMinusB.IsMutable() {
+				t.Errorf("unexpected a minus b Set mutability; want %v, got %v", tc.expectAMinusB.IsMutable(), aMinusB.IsMutable())
+			}
+			if !tc.expectBMinusA.Equal(bMinusA) {
+				t.Errorf("unexpected b minus a Set; want %v, got %v", tc.expectBMinusA, bMinusA)
+			}
+			if tc.expectBMinusA.IsMutable() != bMinusA.IsMutable() {
+				t.Errorf("unexpected b minus a Set mutability; want %v, got %v", tc.expectBMinusA.IsMutable(), bMinusA.IsMutable())
+			}
+			if diff := DiffSymmetric(tc.a, tc.b); !aMinusB.Union(bMinusA).Equal(diff) {
+				t.Errorf("unexpected union of parts; want %v, got %v", diff, aMinusB.Union(bMinusA))
+			}
+			if intersection := aMinusB.Intersection(bMinusA); !intersection.IsEmpty() {
+				t.Errorf("unexpected intersection of parts; want [], got %v", intersection)
 			}
 		})
 	}
 }
 
-func Test_SortedJoinString_Nil(t *testing.T) {
+func Test_SymmetricParts_Nil(t *testing.T) {
+	aMinusB, bMinusA := SymmetricParts[int](nil, Hash(123))
+	if internal.IsNotNil(aMinusB) {
+		t.Errorf("unexpected a minus b Set; want nil, got %v", aMinusB)
+	}
+	if expect := Hash(123); !expect.Equal(bMinusA) {
+		t.Errorf("unexpected b minus a Set; want %v, got %v", expect, bMinusA)
+	}
+}
+
+func Test_Sync(t *testing.T) {
 	testCases := map[string]struct {
-		set Set[string]
+		current       MutableSet[int]
+		expectAdded   int
+		expectRemoved int
+		target        Set[int]
 	}{
-		"with nil Set": {
-			set: nil,
-		},
-		"with nil *HashSet": {
-			set: (*HashSet[string])(nil),
+		"with MutableHashSet and overlapping target": {
+			current:       MutableHash(123, 456, 789),
+			expectAdded:   2,
+			expectRemoved: 1,
+			target:        Hash(456, 789, -123, -456),
+		},
+		"with MutableHashSet and equal target": {
+			current:       MutableHash(123, 456, 789),
+			expectAdded:   0,
+			expectRemoved: 0,
+			target:        Hash(123, 456, 789),
+		},
+		"with MutableHashSet and disjoint target": {
+			current:       MutableHash(123, 456),
+			expectAdded:   3,
+			expectRemoved: 2,
+			target:        Hash(-123, -456, -789),
+		},
+		"with MutableHashSet and empty target": {
+			current:       MutableHash(123, 456, 789),
+			expectAdded:   0,
+			expectRemoved: 3,
+			target:        Hash[int](),
+		},
+		"with MutableHashSet and nil target": {
+			current:       MutableHash(123, 456, 789),
+			expectAdded:   0,
+			expectRemoved: 3,
+			target:        nil,
+		},
+		"with empty MutableHashSet": {
+			current:       MutableHash[int](),
+			expectAdded:   3,
+			expectRemoved: 0,
+			target:        Hash(123, 456, 789),
+		},
+		"with SyncHashSet and overlapping target": {
+			current:       SyncHash(123, 456, 789),
+			expectAdded:   2,
+			expectRemoved: 1,
+			target:        Hash(456, 789, -123, -456),
+		},
+		"with SyncHashSet and SyncHashSet target": {
+			current:       SyncHash(123, 456, 789),
+			expectAdded:   1,
+			expectRemoved: 2,
+			target:        SyncHash(789, -789),
+		},
+		"with SyncHashSet and empty target": {
+			current:       SyncHash(123, 456, 789),
+			expectAdded:   0,
+			expectRemoved: 3,
+			target:        Hash[int](),
+		},
+		"with SyncHashSet and nil target": {
+			current:       SyncHash(123, 456, 789),
+			expectAdded:   0,
+			expectRemoved: 3,
+			target:        nil,
+		},
+		"with SmallSet and overlapping target": {
+			current:       Small(Asc[int], 123, 456, 789),
+			expectAdded:   2,
+			expectRemoved: 1,
+			target:        Hash(456, 789, -123, -456),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			result := SortedJoinString(tc.set, ",")
-			if exp := ""; result != exp {
-				t.Errorf("unexpected result; want %q, got %q", exp, result)
+			added, removed := Sync(tc.current, tc.target)
+			if added != tc.expectAdded {
+				t.Errorf("unexpected number of added elements; want %v, got %v", tc.expectAdded, added)
+			}
+			if removed != tc.expectRemoved {
+				t.Errorf("unexpected number of removed elements; want %v, got %v", tc.expectRemoved, removed)
+			}
+			if !tc.current.Equal(tc.target) {
+				t.Errorf("unexpected elements; want %v, got %v", tc.target, tc.current)
 			}
 		})
 	}
 }
 
-func Test_SortedJoinUint(t *testing.T) {
+func Test_Sync_Concurrent(t *testing.T) {
+	target := SyncHash(123, -456)
+	testConcurrently(func(set *SyncHashSet[int], i int) {
+		Sync[int](set, target)
+		Sync[int](target, set)
+	})
+}
+
+func Test_Sync_Nil(t *testing.T) {
+	added, removed := Sync[int](nil, Hash(123))
+	if added != 0 || removed != 0 {
+		t.Errorf("unexpected number of changed elements; want 0 and 0, got %v and %v", added, removed)
+	}
+	var set *MutableHashSet[int]
+	added, removed = Sync[int](set, Hash(123))
+	if added != 0 || removed != 0 {
+		t.Errorf("unexpected number of changed elements; want 0 and 0, got %v and %v", added, removed)
+	}
+}
+
+func Test_Sync_Self(t *testing.T) {
+	set := SyncHash(123, 456)
+	added, removed := Sync[int](set, set)
+	if added != 0 || removed != 0 {
+		t.Errorf("unexpected number of changed elements; want 0 and 0, got %v and %v", added, removed)
+	}
+}
+
+func Test_TaggedUnion(t *testing.T) {
 	testCases := map[string]struct {
-		expect string
-		opts   []JoinUintOption
-		set    Set[uint]
+		a      Set[int]
+		b      Set[int]
+		expect []Tagged[int]
 	}{
-		"with *HashSet containing multiple elements and no options": {
-			expect: "0,123,456,789",
-			set:    Hash[uint](0, 123, 456, 789),
-		},
-		"with *HashSet containing multiple elements and WithUintBase option": {
-			expect: "0,1,1010,1100100",
-			opts:   []JoinUintOption{WithUintBase(2)},
-			set:    Hash[uint](0, 1, 10, 100),
+		"with overlapping Sets": {
+			a: Hash(123, 456),
+			b: Hash(456, 789),
+			expect: []Tagged[int]{
+				{Value: 123, InA: true},
+				{Value: 456, InA: true, InB: true},
+				{Value: 789, InB: true},
+			},
 		},
-		"with *HashSet containing multiple elements and WithUintSorting option": {
-			expect: "789,456,123,0",
-			opts:   []JoinUintOption{WithUintSorting(Desc[uint64])},
-			set:    Hash[uint](0, 123, 456, 789),
+		"with disjoint Sets": {
+			a: Hash(123),
+			b: Hash(456),
+			expect: []Tagged[int]{
+				{Value: 123, InA: true},
+				{Value: 456, InB: true},
+			},
 		},
-		"with *HashSet containing multiple elements and WithUintSortingAsc option": {
-			expect: "0,123,456,789",
-			opts:   []JoinUintOption{WithUintSortingAsc()},
-			set:    Hash[uint](0, 123, 456, 789),
+		"with equal Sets": {
+			a: Hash(123, 456),
+			b: MutableHash(456, 123),
+			expect: []Tagged[int]{
+				{Value: 123, InA: true, InB: true},
+				{Value: 456, InA: true, InB: true},
+			},
 		},
-		"with *HashSet containing multiple elements and WithUintSortingDesc option": {
-			expect: "789,456,123,0",
-			opts:   []JoinUintOption{WithUintSortingDesc()},
-			set:    Hash[uint](0, 123, 456, 789),
+		"with nil Set a": {
+			a:      nil,
+			b:      Hash(123),
+			expect: []Tagged[int]{{Value: 123, InB: true}},
 		},
-		"with *HashSet containing single element and no options": {
-			expect: "123",
-			set:    Hash[uint](123),
+		"with nil Set b": {
+			a:      Hash(123),
+			b:      nil,
+			expect: []Tagged[int]{{Value: 123, InA: true}},
 		},
-		"with *HashSet containing no elements and no options": {
-			expect: "",
-			set:    Hash[uint](),
+		"with nil Sets": {
+			a:      nil,
+			b:      nil,
+			expect: []Tagged[int]{},
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			result := SortedJoinUint(tc.set, ",", tc.opts...)
-			if result != tc.expect {
-				t.Errorf("unexpected result; want %q, got %q", tc.expect, result)
+			union := TaggedUnion(tc.a, tc.b)
+			if union.IsMutable() {
+				t.Error("unexpected Set mutability; want false, got true")
+			}
+			elements := union.SortedSlice(func(x, y Tagged[int]) bool { return x.Value < y.Value })
+			if diff := cmp.Diff(tc.expect, elements); diff != "" {
+				t.Errorf("unexpected elements (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func Test_SortedJoinUint_Nil(t *testing.T) {
+func Test_ToDelimited(t *testing.T) {
 	testCases := map[string]struct {
-		set Set[uint]
+		expect []string
+		set    Set[int]
+		sorted bool
 	}{
-		"with nil Set": {
-			set: nil,
+		"with Set containing multiple elements": {
+			expect: []string{"123", "456", "789"},
+			set:    Hash(789, 123, 456),
+			sorted: false,
 		},
-		"with nil *HashSet": {
-			set: (*HashSet[uint])(nil),
+		"with Set containing multiple elements and sorted": {
+			expect: []string{"123", "456", "789"},
+			set:    Hash(789, 123, 456),
+			sorted: true,
+		},
+		"with Set containing no elements": {
+			expect: []string{},
+			set:    Hash[int](),
+			sorted: true,
+		},
+		"with nil Set": {
+			expect: []string{},
+			set:    nil,
+			sorted: true,
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			result := SortedJoinUint(tc.set, ",")
-			if exp := ""; result != exp {
-				t.Errorf("unexpected result; want %q, got %q", exp, result)
+			result := ToDelimited(tc.set, ",", strconv.Itoa, tc.sorted)
+			if tc.sorted {
+				if expect := strings.Join(tc.expect, ","); result != expect {
+					t.Errorf("unexpected string; want %q, got %q", expect, result)
+				}
+			} else {
+				assertSetJoin(t, result, ",", tc.expect)
 			}
 		})
 	}
 }
 
-func Test_SortedSlice(t *testing.T) {
+func Test_ToDelimited_RoundTrip(t *testing.T) {
 	testCases := map[string]struct {
-		expect []int
-		less   func(x, y int) bool
 		set    Set[int]
+		sorted bool
 	}{
-		"with non-empty *HashSet and default (ascending) sorting": {
-			expect: []int{123, 456, 789},
-			set:    Hash(123, 456, 789),
-		},
-		"with non-empty *HashSet and custom (descending) sorting": {
-			expect: []int{789, 456, 123},
-			less:   Desc[int],
-			set:    Hash(123, 456, 789),
+		"with Set containing multiple elements": {
+			set:    Hash(-1, 0, 123, 456, 789),
+			sorted: false,
 		},
-		"with empty *HashSet": {
-			expect: []int{},
+		"with Set containing multiple elements and sorted": {
+			set:    Hash(-1, 0, 123, 456, 789),
+			sorted: true,
+		},
+		"with Set containing single element": {
+			set:    Singleton(123),
+			sorted: true,
+		},
+		"with Set containing no elements": {
 			set:    Hash[int](),
+			sorted: false,
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			elements := SortedSlice[int](tc.set, wrapLess(tc.less)...)
-			if elements == nil {
-				t.Error("unexpected nil slice")
+			delimited := ToDelimited(tc.set, ", ", strconv.Itoa, tc.sorted)
+			set, err := HashFromDelimited(delimited, ",", strconv.Atoi)
+			if err != nil {
+				t.Fatalf("unexpected error; want nil, got %q", err)
 			}
-			if !cmp.Equal(tc.expect, elements) {
-				t.Errorf("unexpected slice; got diff %v", cmp.Diff(tc.expect, elements))
+			if !set.Equal(tc.set) {
+				t.Errorf("unexpected Set parsed from %q; want %v, got %v", delimited, tc.set, set)
+			}
+			if tc.sorted {
+				if again := ToDelimited[int](set, ", ", strconv.Itoa, true); again != delimited {
+					t.Errorf("unexpected string for parsed Set; want %q, got %q", delimited, again)
+				}
 			}
 		})
 	}
 }
 
-func Test_SortedSlice_Nil(t *testing.T) {
-	var set *HashSet[int]
-	elements := SortedSlice[int](set)
-	if elements != nil {
-		t.Errorf("unexpected slice; want nil, got %v", elements)
-	}
-}
-
 func Test_TryMap(t *testing.T) {
 	testErr := errors.New("test")
 	testCases := map[string]struct {
@@ -2541,6 +6290,27 @@ func Test_TryReduce(t *testing.T) {
 	}
 }
 
+func Test_TryReduce_FailOnSecondElement(t *testing.T) {
+	testErr := errors.New("test")
+	var count int
+	result, err := TryReduce[int, int](Tree(123, 456, 789), func(acc int, element int) (int, error) {
+		count++
+		if count == 2 {
+			return -1, testErr
+		}
+		return acc + element, nil
+	}, 100)
+	if !errors.Is(err, testErr) {
+		t.Errorf("unexpected error; want %q, got %q", testErr, err)
+	}
+	if expect := 100 + 123; result != expect {
+		t.Errorf("unexpected result; want %v, got %v", expect, result)
+	}
+	if count != 2 {
+		t.Errorf("unexpected number of reducer function calls; want 2, got %v", count)
+	}
+}
+
 func Test_TryReduce_Nil(t *testing.T) {
 	testCases := map[string]struct {
 		expect    uint
@@ -2587,6 +6357,78 @@ func Test_TryReduce_Nil(t *testing.T) {
 	}
 }
 
+func Test_Ungroup(t *testing.T) {
+	testCases := map[string]struct {
+		expect []int
+		groups map[string]Set[int]
+	}{
+		"with groups containing elements": {
+			expect: []int{123, 456, 789},
+			groups: map[string]Set[int]{"foo": Hash(123, 456), "bar": Singleton(789)},
+		},
+		"with groups containing overlapping elements": {
+			expect: []int{123, 456, 789},
+			groups: map[string]Set[int]{"foo": Hash(123, 456), "bar": MutableHash(456, 789)},
+		},
+		"with groups containing nil Set": {
+			expect: []int{123},
+			groups: map[string]Set[int]{"foo": Hash(123), "bar": nil, "baz": (*HashSet[int])(nil)},
+		},
+		"with no groups": {
+			expect: []int{},
+			groups: map[string]Set[int]{},
+		},
+		"with nil map": {
+			expect: []int{},
+			groups: nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := Ungroup(tc.groups)
+			if result == nil {
+				t.Fatal("unexpected Set; want non-nil, got nil")
+			}
+			if elements := result.SortedSlice(Asc[int]); !cmp.Equal(tc.expect, elements, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected elements; want %v, got %v", tc.expect, elements)
+			}
+		})
+	}
+}
+
+func Test_Ungroup_Group(t *testing.T) {
+	testCases := map[string]struct {
+		grouper func(element int) int
+		set     Set[int]
+	}{
+		"with multiple groups": {
+			grouper: func(element int) int { return element % 3 },
+			set:     Hash(-789, -456, -123, 0, 123, 456, 789),
+		},
+		"with single group": {
+			grouper: func(_ int) int { return 0 },
+			set:     MutableHash(123, 456, 789),
+		},
+		"with group per element": {
+			grouper: func(element int) int { return element },
+			set:     SyncHash(123, 456, 789),
+		},
+		"with Set containing no elements": {
+			grouper: func(element int) int { return element },
+			set:     Hash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if result := Ungroup(Group(tc.set, tc.grouper)); !result.Equal(tc.set) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.set, result)
+			}
+		})
+	}
+}
+
 func Test_Union(t *testing.T) {
 	testCases := map[string]struct {
 		expect Set[int]
@@ -2865,6 +6707,456 @@ func Test_Union_Nil(t *testing.T) {
 	}
 }
 
+func Test_UnionCardinality(t *testing.T) {
+	testCases := map[string]struct {
+		a      Set[int]
+		b      Set[int]
+		expect int
+	}{
+		"with overlapping Set": {
+			a:      Hash(1, 2, 3, 4),
+			b:      MutableHash(3, 4, 5),
+			expect: 5,
+		},
+		"with disjoint Set": {
+			a:      Hash(1, 2),
+			b:      Small(Asc[int], 3, 4, 5),
+			expect: 5,
+		},
+		"with equal Set": {
+			a:      SyncHash(1, 2, 3),
+			b:      Hash(3, 2, 1),
+			expect: 3,
+		},
+		"with empty Set": {
+			a:      Hash(1, 2),
+			b:      Empty[int](),
+			expect: 2,
+		},
+		"with nil Set": {
+			a:      nil,
+			b:      Hash(1, 2),
+			expect: 2,
+		},
+		"with nil *HashSet": {
+			a:      (*HashSet[int])(nil),
+			b:      nil,
+			expect: 0,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if result := UnionCardinality(tc.a, tc.b); result != tc.expect {
+				t.Errorf("unexpected cardinality; want %v, got %v", tc.expect, result)
+			}
+			if result := UnionCardinality(tc.b, tc.a); result != tc.expect {
+				t.Errorf("unexpected inverse cardinality; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_UnionChannel(t *testing.T) {
+	testCases := map[string]struct {
+		expect        Set[int]
+		expectMutable bool
+		sets          []Set[int]
+	}{
+		"with single Set": {
+			expect: Hash(123, 456, 789),
+			sets:   []Set[int]{Hash(123, 456, 789)},
+		},
+		"with overlapping Sets": {
+			expect: Hash(0, 123, 456, 789),
+			sets:   []Set[int]{Hash(123, 456, 789), Hash(456, 789), Hash(0, 456)},
+		},
+		"with nil Sets": {
+			expect: Hash(123, 456, 789, 999),
+			sets:   []Set[int]{nil, Hash(123, 456, 789), nil, Hash(456, 789, 999)},
+		},
+		"with mutable Set": {
+			expect:        Hash(123, 456),
+			expectMutable: true,
+			sets:          []Set[int]{Hash(123), MutableHash(456)},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ch := make(chan Set[int])
+			var wg sync.WaitGroup
+			wg.Add(len(tc.sets))
+			for _, set := range tc.sets {
+				go func(set Set[int]) {
+					defer wg.Done()
+					ch <- set
+				}(set)
+			}
+			go func() {
+				wg.Wait()
+				close(ch)
+			}()
+			union := UnionChannel(ch)
+			if internal.IsNil(union) {
+				t.Fatal("unexpected nil Set")
+			}
+			if !union.Equal(tc.expect) {
+				t.Errorf("unexpected union Set; want %v, got %v", tc.expect, union)
+			}
+			if mutable := union.IsMutable(); mutable != tc.expectMutable {
+				t.Errorf("unexpected union Set mutability; want %v, got %v", tc.expectMutable, mutable)
+			}
+		})
+	}
+}
+
+func Test_UnionChannel_Nil(t *testing.T) {
+	ch := make(chan Set[int], 2)
+	ch <- nil
+	ch <- nil
+	close(ch)
+	if union := UnionChannel(ch); internal.IsNotNil(union) {
+		t.Errorf("unexpected union Set; want nil, got %v", union)
+	}
+}
+
+func Test_UnionWith(t *testing.T) {
+	testCases := map[string]struct {
+		a             Set[int]
+		b             Set[int]
+		expect        Set[int]
+		expectMutable bool
+	}{
+		"with overlapping Sets": {
+			a:      Hash(123, 456, 789),
+			b:      Hash(456, 789, 999),
+			expect: Hash(123, 456, 789, 999),
+		},
+		"with disjoint Sets": {
+			a:      Hash(123, 456),
+			b:      Hash(789, 999),
+			expect: Hash(123, 456, 789, 999),
+		},
+		"with equal Sets": {
+			a:      Hash(123, 456),
+			b:      Hash(456, 123),
+			expect: Hash(123, 456),
+		},
+		"with nil Set a": {
+			a:      nil,
+			b:      Hash(123, 456),
+			expect: Hash(123, 456),
+		},
+		"with nil Set b": {
+			a:      Hash(123, 456),
+			b:      nil,
+			expect: Hash(123, 456),
+		},
+		"with mutable Set": {
+			a:             MutableHash(123, 456),
+			b:             Hash(456, 789),
+			expect:        Hash(123, 456, 789),
+			expectMutable: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			collisions := MutableHash[int]()
+			var callCount int
+			union := UnionWith(tc.a, tc.b, func(element int) {
+				callCount++
+				collisions.Put(element)
+			})
+			if !union.Equal(tc.expect) {
+				t.Errorf("unexpected union Set; want %v, got %v", tc.expect, union)
+			}
+			if mutable := union.IsMutable(); mutable != tc.expectMutable {
+				t.Errorf("unexpected union Set mutability; want %v, got %v", tc.expectMutable, mutable)
+			}
+			expectCollisions := Intersection(tc.a, tc.b)
+			if exp := expectCollisions.Len(); callCount != exp {
+				t.Errorf("unexpected number of calls to onCollision; want %v, got %v", exp, callCount)
+			}
+			if !collisions.Equal(expectCollisions) {
+				t.Errorf("unexpected collisions; want %v, got %v", expectCollisions, collisions)
+			}
+		})
+	}
+}
+
+func Test_UnionWith_Nil(t *testing.T) {
+	if union := UnionWith[int](nil, nil, nil); internal.IsNotNil(union) {
+		t.Errorf("unexpected union Set; want nil, got %v", union)
+	}
+	union := UnionWith[int](Hash(123, 456), Hash(456, 789), nil)
+	if expect := Hash(123, 456, 789); !union.Equal(expect) {
+		t.Errorf("unexpected union Set; want %v, got %v", expect, union)
+	}
+}
+
+func Test_UnmarshalSetText(t *testing.T) {
+	testCases := map[string]struct {
+		text   string
+		sep    string
+		expect Set[string]
+	}{
+		"with empty text": {
+			text:   "",
+			sep:    ",",
+			expect: Hash[string](),
+		},
+		"with text containing only separators": {
+			text:   ",,",
+			sep:    ",",
+			expect: Hash[string](),
+		},
+		"with text containing single element": {
+			text:   "foo",
+			sep:    ",",
+			expect: Hash("foo"),
+		},
+		"with text containing multiple elements": {
+			text:   "foo,bar,foo,fizz",
+			sep:    ",",
+			expect: Hash("foo", "bar", "fizz"),
+		},
+		"with text containing empty elements": {
+			text:   ",foo,,bar,",
+			sep:    ",",
+			expect: Hash("foo", "bar"),
+		},
+		"with text containing whitespace": {
+			text:   " foo,bar ",
+			sep:    ",",
+			expect: Hash(" foo", "bar "),
+		},
+		"with multi-character separator": {
+			text:   "foo::bar",
+			sep:    "::",
+			expect: Hash("foo", "bar"),
+		},
+		"with empty separator": {
+			text:   "foo" + DefaultTextSeparator + "bar",
+			sep:    "",
+			expect: Hash("foo", "bar"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := UnmarshalSetText[string]([]byte(tc.text), tc.sep)
+			if internal.IsNil(set) {
+				t.Fatal("unexpected nil Set")
+			}
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+			if set.IsMutable() != false {
+				t.Error("unexpected Set mutability; want false, got true")
+			}
+		})
+	}
+}
+
+func Test_ValidateSubset(t *testing.T) {
+	testCases := map[string]struct {
+		allowed  Set[string]
+		expect   Set[string]
+		expectOK bool
+		set      Set[string]
+	}{
+		"with *HashSet containing elements outside of allowed *HashSet": {
+			allowed:  Hash("alpha", "beta", "gamma"),
+			expect:   Hash("delta", "epsilon"),
+			expectOK: false,
+			set:      Hash("alpha", "delta", "epsilon"),
+		},
+		"with *HashSet containing only elements within allowed *HashSet": {
+			allowed:  Hash("alpha", "beta", "gamma"),
+			expect:   Hash[string](),
+			expectOK: true,
+			set:      Hash("alpha", "gamma"),
+		},
+		"with *HashSet equal to allowed *HashSet": {
+			allowed:  Hash("alpha", "beta", "gamma"),
+			expect:   Hash[string](),
+			expectOK: true,
+			set:      Hash("alpha", "beta", "gamma"),
+		},
+		"with empty *HashSet": {
+			allowed:  Hash("alpha", "beta", "gamma"),
+			expect:   Hash[string](),
+			expectOK: true,
+			set:      Hash[string](),
+		},
+		"with non-empty *HashSet and empty allowed *HashSet": {
+			allowed:  Hash[string](),
+			expect:   Hash("alpha"),
+			expectOK: false,
+			set:      Hash("alpha"),
+		},
+		"with non-empty *HashSet and nil allowed Set": {
+			allowed:  nil,
+			expect:   Hash("alpha"),
+			expectOK: false,
+			set:      Hash("alpha"),
+		},
+		"with *MutableHashSet containing elements outside of allowed *HashSet": {
+			allowed:  Hash("alpha", "beta", "gamma"),
+			expect:   MutableHash("delta"),
+			expectOK: false,
+			set:      MutableHash("alpha", "delta"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			invalid, ok := ValidateSubset(tc.set, tc.allowed)
+			if internal.IsNil(invalid) {
+				t.Error("unexpected nil Set")
+			}
+			if ok != tc.expectOK {
+				t.Errorf("unexpected ok; want %v, got %v", tc.expectOK, ok)
+			}
+			if !tc.expect.Equal(invalid) {
+				t.Errorf("unexpected invalid Set; want %v, got %v", tc.expect, invalid)
+			}
+			if tc.expect.IsMutable() != invalid.IsMutable() {
+				t.Errorf("unexpected invalid Set mutability; want %v, got %v", tc.expect.IsMutable(), invalid.IsMutable())
+			}
+		})
+	}
+}
+
+func Test_ValidateSubset_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		set Set[string]
+	}{
+		"with nil Set": {
+			set: nil,
+		},
+		"with nil *HashSet": {
+			set: (*HashSet[string])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			invalid, ok := ValidateSubset[string](tc.set, Hash("alpha"))
+			if internal.IsNotNil(invalid) {
+				t.Errorf("unexpected invalid Set; want nil, got %v", invalid)
+			}
+			if !ok {
+				t.Error("unexpected ok; want true, got false")
+			}
+		})
+	}
+}
+
+func Test_WriteJoin(t *testing.T) {
+	testCases := map[string]struct {
+		expect string
+		sep    string
+		set    Set[int]
+	}{
+		"with EmptySet": {
+			expect: "",
+			sep:    ",",
+			set:    Empty[int](),
+		},
+		"with SingletonSet": {
+			expect: "123",
+			sep:    ",",
+			set:    Singleton(123),
+		},
+		"with TreeSet containing multiple elements": {
+			expect: "123,456,789",
+			sep:    ",",
+			set:    Tree(789, 123, 456),
+		},
+		"with TreeSet and multi-character separator": {
+			expect: "123, 456, 789",
+			sep:    ", ",
+			set:    Tree(789, 123, 456),
+		},
+		"with TreeSet and empty separator": {
+			expect: "123456789",
+			sep:    "",
+			set:    Tree(789, 123, 456),
+		},
+		"with SyncHashSet containing single element": {
+			expect: "123",
+			sep:    ",",
+			set:    SyncHash(123),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			n, err := WriteJoin(&buf, tc.set, tc.sep, strconv.Itoa)
+			if err != nil {
+				t.Fatalf("unexpected error; want nil, got %q", err)
+			}
+			if actual := buf.String(); actual != tc.expect {
+				t.Errorf("unexpected written string; want %q, got %q", tc.expect, actual)
+			}
+			if n != int64(len(tc.expect)) {
+				t.Errorf("unexpected number of bytes written; want %v, got %v", len(tc.expect), n)
+			}
+		})
+	}
+}
+
+func Test_WriteJoin_Error(t *testing.T) {
+	testCases := map[string]struct {
+		expect string
+		limit  int
+	}{
+		"with writer failing on first element": {
+			expect: "",
+			limit:  0,
+		},
+		"with writer failing part way through element": {
+			expect: "123,4",
+			limit:  5,
+		},
+		"with writer failing on separator": {
+			expect: "123",
+			limit:  3,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			w := &limitedWriter{limit: tc.limit}
+			n, err := WriteJoin[int](w, Tree(123, 456, 789), ",", strconv.Itoa)
+			if !errors.Is(err, errLimitedWriter) {
+				t.Errorf("unexpected error; want %q, got %q", errLimitedWriter, err)
+			}
+			if actual := w.buf.String(); actual != tc.expect {
+				t.Errorf("unexpected written string; want %q, got %q", tc.expect, actual)
+			}
+			if n != int64(len(tc.expect)) {
+				t.Errorf("unexpected number of bytes written; want %v, got %v", len(tc.expect), n)
+			}
+		})
+	}
+}
+
+func Test_WriteJoin_Nil(t *testing.T) {
+	var buf bytes.Buffer
+	n, err := WriteJoin[int](&buf, nil, ",", strconv.Itoa)
+	if err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	if n != 0 || buf.Len() != 0 {
+		t.Errorf("unexpected written string; want %q, got %q", "", buf.String())
+	}
+}
+
 func assertSetJoin(t *testing.T, result, sep string, expect []string) {
 	if len(result) == 0 {
 		if len(expect) > 0 {
@@ -2919,3 +7211,27 @@ func wrapLess[E constraints.Ordered](less func(x, y E) bool) []func(x, y E) bool
 	}
 	return []func(x, y E) bool{less}
 }
+
+// errLimitedWriter is returned by limitedWriter once its limit has been reached.
+var errLimitedWriter = errors.New("limit reached")
+
+// limitedWriter is an io.Writer that writes to a buffer until its limit is reached, after which it fails.
+type limitedWriter struct {
+	buf   bytes.Buffer
+	limit int
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if remaining := w.limit - w.buf.Len(); len(p) > remaining {
+		n, _ := w.buf.Write(p[:remaining])
+		return n, errLimitedWriter
+	}
+	return w.buf.Write(p)
+}
+
+// testStringer is a string type implementing fmt.Stringer, wrapping its value in angle brackets.
+type testStringer string
+
+func (s testStringer) String() string {
+	return "<" + string(s) + ">"
+}
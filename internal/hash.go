@@ -21,6 +21,8 @@
 package internal
 
 import (
+	"bytes"
+	"encoding/gob"
 	"encoding/json"
 	"fmt"
 	"sort"
@@ -33,6 +35,14 @@ type Hash[E comparable] map[E]struct{}
 // NilString is a string representation of the elements within a nil Hash.
 const NilString = "[]"
 
+// AppendSlice appends all elements of the Hash to the dst slice and returns the extended slice.
+func AppendSlice[E comparable](dst []E, hash Hash[E]) []E {
+	for element := range hash {
+		dst = append(dst, element)
+	}
+	return dst
+}
+
 // Clone returns a clone of the Hash.
 func Clone[E comparable](hash Hash[E]) Hash[E] {
 	cloned := make(Hash[E])
@@ -73,6 +83,28 @@ func DeleteAll[E comparable](hash Hash[E], elements Collection[E]) {
 	}
 }
 
+// DeleteAnyOf removes all elements from the Hash that exist within any of the specified Collection. Any nil Collection
+// is skipped.
+func DeleteAnyOf[E comparable](hash Hash[E], cols []Collection[E]) {
+	for _, col := range cols {
+		if IsNotNil(col) {
+			DeleteAll(hash, col)
+		}
+	}
+}
+
+// DeleteNth removes the element at index i from the Hash, were its elements sorted using the provided less function,
+// and returns the removed element as well as an indication of whether i was in range.
+func DeleteNth[E comparable](hash Hash[E], less func(x, y E) bool, i int) (E, bool) {
+	if i < 0 || i >= len(hash) {
+		var zero E
+		return zero, false
+	}
+	element := SortedSlice(hash, less)[i]
+	delete(hash, element)
+	return element, true
+}
+
 // DeleteSlice removes all elements in the specified slice from the Hash.
 func DeleteSlice[E comparable](hash Hash[E], elements []E) {
 	for _, element := range elements {
@@ -214,6 +246,31 @@ func DiffSymmetricAll[E comparable, C Collection[E]](
 	return factory(diff, flags)
 }
 
+// DrainWhere removes all elements that match the predicate function from the Hash and returns them within a new Hash.
+func DrainWhere[E comparable](hash Hash[E], predicate func(element E) bool) Hash[E] {
+	drained := make(Hash[E])
+	for element := range hash {
+		if predicate(element) {
+			drained[element] = struct{}{}
+			delete(hash, element)
+		}
+	}
+	return drained
+}
+
+// Equal returns whether the Hash contains the exact same elements as the other Hash.
+func Equal[E comparable](hash, other Hash[E]) bool {
+	if len(hash) != len(other) {
+		return false
+	}
+	for element := range other {
+		if _, ok := hash[element]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // Every returns whether the Hash contains elements that all match the predicate function.
 func Every[E comparable](hash Hash[E], predicate func(element E) bool) bool {
 	if len(hash) == 0 {
@@ -259,6 +316,52 @@ func FromSlice[E comparable](elements []E) Hash[E] {
 	return hash
 }
 
+// FromSliceFilter returns a Hash containing each unique element from the slice provided for which the keep function
+// returns true. If keep is nil, all elements are kept.
+//
+// As the number of elements that will be kept cannot be known in advance, the Hash is conservatively pre-sized for half
+// of the elements, which avoids most early growth without over-allocating when few elements are kept.
+func FromSliceFilter[E comparable](elements []E, keep func(element E) bool) Hash[E] {
+	if keep == nil {
+		return FromSlice(elements)
+	}
+	hash := make(Hash[E], len(elements)/2)
+	for _, element := range elements {
+		if keep(element) {
+			hash[element] = struct{}{}
+		}
+	}
+	return hash
+}
+
+// FromSliceWithCapacity returns a Hash containing each unique element from the slice provided that has been pre-sized
+// to hold at least capacity elements. If capacity is less than the length of the slice, the Hash is instead pre-sized
+// to hold all elements within the slice.
+func FromSliceWithCapacity[E comparable](capacity int, elements []E) Hash[E] {
+	if capacity < len(elements) {
+		capacity = len(elements)
+	}
+	hash := make(Hash[E], capacity)
+	for _, element := range elements {
+		hash[element] = struct{}{}
+	}
+	return hash
+}
+
+// Grow returns a Hash containing the same elements as the Hash provided that has been pre-sized to hold at least n
+// additional elements. As the capacity of a map cannot be inspected, a new Hash is always allocated when n is positive,
+// otherwise the Hash provided is returned as-is.
+func Grow[E comparable](hash Hash[E], n int) Hash[E] {
+	if n <= 0 {
+		return hash
+	}
+	grown := make(Hash[E], len(hash)+n)
+	for element := range hash {
+		grown[element] = struct{}{}
+	}
+	return grown
+}
+
 // Intersection returns a Hash containing only elements of the Hash that also exist in the Collection provided.
 func Intersection[E comparable](hash Hash[E], elements Collection[E]) Hash[E] {
 	intersection := make(Hash[E])
@@ -337,6 +440,20 @@ func Map[E comparable, T comparable](elements Collection[E], mapper func(element
 	return mapped
 }
 
+// MarshalGob returns a gob serialization of the elements within the Hash as a slice.
+func MarshalGob[E comparable](hash Hash[E]) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(Slice(hash)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalGobNil returns a gob serialization of an empty slice used to represent a nil Hash.
+func MarshalGobNil[E comparable]() ([]byte, error) {
+	return MarshalGob[E](nil)
+}
+
 // MarshalJSON returns the elements of the Hash serialized as a JSON array.
 func MarshalJSON[E comparable](hash Hash[E]) ([]byte, error) {
 	return json.Marshal(Slice(hash))
@@ -385,6 +502,26 @@ func None[E comparable](hash Hash[E], predicate func(element E) bool) bool {
 	return true
 }
 
+// Pop removes any element from the Hash and returns the element as well as an indication of whether the Hash contained
+// any elements.
+func Pop[E comparable](hash Hash[E]) (E, bool) {
+	element, ok := TakeOne(hash)
+	if ok {
+		delete(hash, element)
+	}
+	return element, ok
+}
+
+// Project returns a Hash containing each unique value returned by the field function for each item in the slice
+// provided.
+func Project[T any, K comparable](items []T, field func(item T) K) Hash[K] {
+	hash := make(Hash[K], len(items))
+	for _, item := range items {
+		hash[field(item)] = struct{}{}
+	}
+	return hash
+}
+
 // Put adds the element to the Hash as well as any additional elements specified. Nothing changes for elements that
 // already exist within the Hash.
 func Put[E comparable](hash Hash[E], element E, elements []E) {
@@ -425,6 +562,18 @@ func Range[E comparable](hash Hash[E], iter func(element E) bool) {
 	}
 }
 
+// ReplaceElement removes the old element from the Hash and adds the new element, but only if the old element is
+// present, and returns whether it was present. Nothing changes if the new element already exists within the Hash,
+// other than the removal of the old element.
+func ReplaceElement[E comparable](hash Hash[E], oldElement, newElement E) bool {
+	if _, ok := hash[oldElement]; !ok {
+		return false
+	}
+	delete(hash, oldElement)
+	hash[newElement] = struct{}{}
+	return true
+}
+
 // Retaining returns a Hash containing only the specified element(s) if they exist in the Hash.
 func Retaining[E comparable](hash Hash[E], element E, elements []E) Hash[E] {
 	retained := make(Hash[E])
@@ -453,6 +602,25 @@ func RetainingAll[E comparable](hash Hash[E], elements Collection[E]) Hash[E] {
 	return retained
 }
 
+// RetainingAllOf returns a Hash containing only elements of the Hash that exist within all the specified Collection.
+// Any nil Collection is treated as having no elements. If no Collection is specified, a clone of the Hash is returned.
+func RetainingAllOf[E comparable](hash Hash[E], cols []Collection[E]) Hash[E] {
+	retained := make(Hash[E])
+	for element := range hash {
+		all := true
+		for _, col := range cols {
+			if IsNil(col) || !col.Contains(element) {
+				all = false
+				break
+			}
+		}
+		if all {
+			retained[element] = struct{}{}
+		}
+	}
+	return retained
+}
+
 // RetainingSlice returns a Hash containing only elements the specified slice if they exist in the Hash.
 func RetainingSlice[E comparable](hash Hash[E], elements []E) Hash[E] {
 	retained := make(Hash[E])
@@ -527,6 +695,16 @@ func String[E comparable](hash Hash[E]) string {
 	return fmt.Sprintf("%v", Slice(hash))
 }
 
+// Take removes the element from the Hash and returns the element as well as an indication of whether it was present.
+func Take[E comparable](hash Hash[E], element E) (E, bool) {
+	if _, ok := hash[element]; !ok {
+		var zero E
+		return zero, false
+	}
+	delete(hash, element)
+	return element, true
+}
+
 // TakeOne returns any element within the Hash as well as an indication of whether the Hash contains any elements.
 func TakeOne[E comparable](hash Hash[E]) (element E, ok bool) {
 	for element = range hash {
@@ -638,6 +816,15 @@ func UnionAll[E comparable, C Collection[E]](
 	return factory(hash, flags)
 }
 
+// UnmarshalGob returns a Hash containing each unique element deserialized from the gob-encoded slice provided.
+func UnmarshalGob[E comparable](data []byte) (Hash[E], error) {
+	var elements []E
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&elements); err != nil {
+		return nil, err
+	}
+	return FromSlice(elements), nil
+}
+
 // UnmarshalJSON deserializes the given JSON data as a JSON array and returns a Hash containing each unique element.
 func UnmarshalJSON[E comparable](data []byte) (Hash[E], error) {
 	var elements []E
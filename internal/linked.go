@@ -0,0 +1,205 @@
+// Copyright (C) 2023 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package internal
+
+// Linked contains only unique elements while also maintaining the order in which they were inserted using a doubly
+// linked list, where each element is indexed to its node for constant time lookups and removal.
+//
+// The zero value of Linked is ready for use.
+type Linked[E comparable] struct {
+	head  *linkedNode[E]
+	index map[E]*linkedNode[E]
+	tail  *linkedNode[E]
+}
+
+// linkedNode is a node within the doubly linked list of a Linked.
+type linkedNode[E comparable] struct {
+	element E
+	next    *linkedNode[E]
+	prev    *linkedNode[E]
+}
+
+// LinkedFromSlice returns a Linked containing each unique element from the slice provided in the order in which they
+// first occur.
+func LinkedFromSlice[E comparable](elements []E) *Linked[E] {
+	l := &Linked[E]{}
+	for _, element := range elements {
+		l.Put(element)
+	}
+	return l
+}
+
+// Clear removes all elements from the Linked.
+func (l *Linked[E]) Clear() {
+	l.head, l.index, l.tail = nil, nil, nil
+}
+
+// Clone returns a clone of the Linked, maintaining the order of its elements.
+func (l *Linked[E]) Clone() *Linked[E] {
+	cloned := &Linked[E]{index: make(map[E]*linkedNode[E], len(l.index))}
+	for node := l.head; node != nil; node = node.next {
+		cloned.Put(node.element)
+	}
+	return cloned
+}
+
+// Contains returns whether the Linked contains the element.
+func (l *Linked[E]) Contains(element E) bool {
+	_, ok := l.index[element]
+	return ok
+}
+
+// Delete removes the element from the Linked and returns whether it was present.
+func (l *Linked[E]) Delete(element E) bool {
+	node, ok := l.index[element]
+	if !ok {
+		return false
+	}
+	delete(l.index, element)
+	l.unlink(node)
+	return true
+}
+
+// DeleteWhere removes all elements that match the predicate function from the Linked without affecting the order of
+// the remaining elements.
+func (l *Linked[E]) DeleteWhere(predicate func(element E) bool) {
+	for node := l.head; node != nil; {
+		next := node.next
+		if predicate(node.element) {
+			delete(l.index, node.element)
+			l.unlink(node)
+		}
+		node = next
+	}
+}
+
+// Filter returns a new Linked containing only elements of the Linked that match the filter function, maintaining their
+// order.
+func (l *Linked[E]) Filter(filter func(element E) bool) *Linked[E] {
+	filtered := &Linked[E]{}
+	for node := l.head; node != nil; node = node.next {
+		if filter(node.element) {
+			filtered.Put(node.element)
+		}
+	}
+	return filtered
+}
+
+// Grow pre-sizes the index of the Linked to hold at least n additional elements. If n is not positive, Grow is a no-op.
+func (l *Linked[E]) Grow(n int) {
+	if n <= 0 {
+		return
+	}
+	index := make(map[E]*linkedNode[E], len(l.index)+n)
+	for element, node := range l.index {
+		index[element] = node
+	}
+	l.index = index
+}
+
+// Len returns the number of elements within the Linked.
+func (l *Linked[E]) Len() int {
+	return len(l.index)
+}
+
+// Pop removes the most recently inserted element from the Linked and returns it as well as an indication of whether
+// the Linked contained any elements.
+func (l *Linked[E]) Pop() (E, bool) {
+	if l.tail == nil {
+		var zero E
+		return zero, false
+	}
+	node := l.tail
+	delete(l.index, node.element)
+	l.unlink(node)
+	return node.element, true
+}
+
+// Put appends the element to the end of the Linked, if not already present, and returns whether it was added.
+func (l *Linked[E]) Put(element E) bool {
+	if _, ok := l.index[element]; ok {
+		return false
+	}
+	if l.index == nil {
+		l.index = make(map[E]*linkedNode[E])
+	}
+	node := &linkedNode[E]{element: element, prev: l.tail}
+	if l.tail == nil {
+		l.head = node
+	} else {
+		l.tail.next = node
+	}
+	l.tail = node
+	l.index[element] = node
+	return true
+}
+
+// Range calls the iter function with each element within the Linked, in the order in which they were inserted, but
+// will stop early whenever the iter function returns true.
+func (l *Linked[E]) Range(iter func(element E) bool) {
+	for node := l.head; node != nil; node = node.next {
+		if iter(node.element) {
+			break
+		}
+	}
+}
+
+// Replace replaces the old element within the Linked with the new element, taking its position, but only if the old
+// element is present, and returns whether it was present. If the new element is already present, the old element is
+// simply removed.
+func (l *Linked[E]) Replace(oldElement, newElement E) bool {
+	node, ok := l.index[oldElement]
+	if !ok {
+		return false
+	}
+	delete(l.index, oldElement)
+	if _, ok = l.index[newElement]; ok {
+		l.unlink(node)
+	} else {
+		node.element = newElement
+		l.index[newElement] = node
+	}
+	return true
+}
+
+// Slice returns a slice containing all elements of the Linked in the order in which they were inserted.
+func (l *Linked[E]) Slice() []E {
+	elements := make([]E, 0, len(l.index))
+	for node := l.head; node != nil; node = node.next {
+		elements = append(elements, node.element)
+	}
+	return elements
+}
+
+// unlink removes the node from the doubly linked list of the Linked without updating its index.
+func (l *Linked[E]) unlink(node *linkedNode[E]) {
+	if node.prev == nil {
+		l.head = node.next
+	} else {
+		node.prev.next = node.next
+	}
+	if node.next == nil {
+		l.tail = node.prev
+	} else {
+		node.next.prev = node.prev
+	}
+	node.next, node.prev = nil, nil
+}
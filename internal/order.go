@@ -0,0 +1,130 @@
+// Copyright (C) 2023 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package internal
+
+// Order maintains the order in which elements were put into a Hash, once tracking has been opted into.
+//
+// All methods are safe to call on a nil Order, in which case they are no-ops, so callers need not check whether
+// tracking is enabled before recording changes. An Order is not safe for concurrent modification.
+type Order[E comparable] struct {
+	elements Linked[E]
+}
+
+// NewOrder returns an Order containing all elements within the Hash. As a Hash has no order of its own, those elements
+// are ordered arbitrarily, while any elements put afterwards are ordered after them in the order they are put.
+func NewOrder[E comparable](hash Hash[E]) *Order[E] {
+	o := &Order[E]{}
+	o.elements.Grow(len(hash))
+	for element := range hash {
+		o.elements.Put(element)
+	}
+	return o
+}
+
+// Delete removes the element from the Order as well as any additional elements specified.
+func (o *Order[E]) Delete(element E, elements []E) {
+	if o == nil {
+		return
+	}
+	o.elements.Delete(element)
+	for _, _element := range elements {
+		o.elements.Delete(_element)
+	}
+}
+
+// Len returns the number of elements within the Order.
+func (o *Order[E]) Len() int {
+	if o == nil {
+		return 0
+	}
+	return o.elements.Len()
+}
+
+// Prune removes all elements from the Order that are no longer within the Hash. As every element within the Order is
+// expected to be within the Hash, this is a no-op when they contain the same number of elements.
+func (o *Order[E]) Prune(hash Hash[E]) {
+	if o == nil || o.elements.Len() == len(hash) {
+		return
+	}
+	o.elements.DeleteWhere(func(element E) bool {
+		_, ok := hash[element]
+		return !ok
+	})
+}
+
+// Put puts the element into the Order as well as any additional elements specified, ordering each after all others
+// unless it is already ordered.
+func (o *Order[E]) Put(element E, elements []E) {
+	if o == nil {
+		return
+	}
+	o.elements.Put(element)
+	for _, _element := range elements {
+		o.elements.Put(_element)
+	}
+}
+
+// PutSlice puts all elements in the slice into the Order, ordering each after all others unless it is already ordered.
+func (o *Order[E]) PutSlice(elements []E) {
+	if o == nil {
+		return
+	}
+	for _, element := range elements {
+		o.elements.Put(element)
+	}
+}
+
+// Replace replaces the old element with the new element within the Order, taking its position, unless the new element
+// is already ordered, in which case the old element is simply removed.
+func (o *Order[E]) Replace(oldElement, newElement E) {
+	if o == nil {
+		return
+	}
+	o.elements.Replace(oldElement, newElement)
+}
+
+// Snapshot returns a slice containing all elements within the Hash in the order maintained by the Order.
+//
+// The Order is never modified, so any element within the Order that is no longer within the Hash is skipped, while any
+// element within the Hash that was never put into the Order is appended in an arbitrary order.
+func (o *Order[E]) Snapshot(hash Hash[E]) []E {
+	snapshot := make([]E, 0, len(hash))
+	if o != nil {
+		o.elements.Range(func(element E) bool {
+			if _, ok := hash[element]; ok {
+				snapshot = append(snapshot, element)
+			}
+			return false
+		})
+	}
+	if len(snapshot) < len(hash) {
+		ordered := make(Hash[E], len(snapshot))
+		for _, element := range snapshot {
+			ordered[element] = struct{}{}
+		}
+		for element := range hash {
+			if _, ok := ordered[element]; !ok {
+				snapshot = append(snapshot, element)
+			}
+		}
+	}
+	return snapshot
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2023 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package internal
+
+import "sort"
+
+// SortedDelete removes the element from the sorted slice, if present, and returns the resulting slice.
+func SortedDelete[E comparable](elements []E, less func(x, y E) bool, element E) []E {
+	if i, ok := SortedSearch(elements, less, element); ok {
+		copy(elements[i:], elements[i+1:])
+		var zero E
+		elements[len(elements)-1] = zero
+		elements = elements[:len(elements)-1]
+	}
+	return elements
+}
+
+// SortedFromSlice returns a slice containing each unique element from the slice provided sorted using the provided less
+// function.
+//
+// The slice provided is never modified.
+func SortedFromSlice[E comparable](elements []E, less func(x, y E) bool) []E {
+	sorted := make([]E, len(elements))
+	copy(sorted, elements)
+	sort.Slice(sorted, func(i, j int) bool { return less(sorted[i], sorted[j]) })
+	return SortedUnique(sorted)
+}
+
+// SortedInsert adds the element to the sorted slice, if not already present, and returns the resulting slice.
+func SortedInsert[E comparable](elements []E, less func(x, y E) bool, element E) []E {
+	i, ok := SortedSearch(elements, less, element)
+	if ok {
+		return elements
+	}
+	var zero E
+	elements = append(elements, zero)
+	copy(elements[i+1:], elements[i:])
+	elements[i] = element
+	return elements
+}
+
+// SortedSearch uses binary search to find the index of the element within the sorted slice as well as an indication of
+// whether the element was found. If the element was not found, the index at which it would be inserted is returned.
+func SortedSearch[E comparable](elements []E, less func(x, y E) bool, element E) (int, bool) {
+	i, j := 0, len(elements)
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if less(elements[h], element) {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	return i, i < len(elements) && elements[i] == element
+}
+
+// SortedUnique removes adjacent duplicate elements from the sorted slice and returns the resulting slice.
+func SortedUnique[E comparable](elements []E) []E {
+	if len(elements) < 2 {
+		return elements
+	}
+	j := 1
+	for i := 1; i < len(elements); i++ {
+		if elements[i] != elements[j-1] {
+			elements[j] = elements[i]
+			j++
+		}
+	}
+	return elements[:j]
+}
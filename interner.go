@@ -0,0 +1,128 @@
+// Copyright (C) 2023 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sets
+
+import (
+	"github.com/neocotic/go-sets/internal"
+	"sync"
+)
+
+// Interner hands out canonical copies of elements so that equal elements held by multiple sets can share a single
+// copy, reducing memory when holding many large sets with overlapping elements (e.g. thousands of sets of strings).
+// The first copy of an element passed to Interner.Intern becomes the canonical copy returned for all equal elements
+// thereafter.
+//
+// The zero value of Interner is ready for use. Interner is safe for concurrent use by multiple goroutines, so may be
+// shared between sets that are used by different goroutines.
+//
+// An Interner only ever grows as it retains every canonical copy handed out, even after all sets using it no longer
+// contain the element, so it is best suited to element domains with a bounded number of distinct elements.
+type Interner[E comparable] struct {
+	mu     sync.Mutex
+	values map[E]E
+}
+
+// Intern returns the canonical copy of the value, which is the value itself if no equal value has been interned
+// previously.
+//
+// If the Interner is nil, Interner.Intern returns the value.
+func (i *Interner[E]) Intern(value E) E {
+	if i == nil {
+		return value
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.intern(value)
+}
+
+// Len returns the number of canonical copies held by the Interner.
+//
+// If the Interner is nil, Interner.Len returns zero.
+func (i *Interner[E]) Len() int {
+	if i == nil {
+		return 0
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return len(i.values)
+}
+
+// intern returns the canonical copy of the value, the same as Interner.Intern, but without locking the Interner, which
+// must already be locked by the caller.
+func (i *Interner[E]) intern(value E) E {
+	if canonical, ok := i.values[value]; ok {
+		return canonical
+	}
+	if i.values == nil {
+		i.values = make(map[E]E)
+	}
+	i.values[value] = value
+	return value
+}
+
+// put adds the canonical copy of the element to the internal.Hash.
+func (i *Interner[E]) put(hash internal.Hash[E], element E) {
+	hash[i.Intern(element)] = struct{}{}
+}
+
+// putHash adds the canonical copy of each element in the specified internal.Hash to the internal.Hash, locking the
+// Interner only once for all elements.
+func (i *Interner[E]) putHash(hash internal.Hash[E], elements internal.Hash[E]) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for element := range elements {
+		hash[i.intern(element)] = struct{}{}
+	}
+}
+
+// putSlice adds the canonical copy of each element in the specified slice to the internal.Hash, locking the Interner
+// only once for all elements.
+func (i *Interner[E]) putSlice(hash internal.Hash[E], elements []E) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for _, element := range elements {
+		hash[i.intern(element)] = struct{}{}
+	}
+}
+
+// InternedHash returns a MutableHashSet struct that implements MutableSet containing the canonical copy of each unique
+// element provided, as returned by the Interner. Any elements added to the MutableHashSet thereafter, including those
+// added by MutableHashSet.Put, MutableHashSet.PutAll, MutableHashSet.PutSlice, MutableHashSet.ReplaceElement, and
+// json.Unmarshal, are also routed through the Interner. Any MutableHashSet derived from the MutableHashSet (e.g. by
+// MutableHashSet.Clone, MutableHashSet.Filter, or MutableHashSet.Union) shares the same Interner, with any elements
+// taken from another Set also being routed through it. Sharing an Interner between multiple sets ensures that equal
+// elements within them share a single copy.
+//
+// Membership is unaffected as elements are still compared using equality. That is; MutableHashSet.Contains returns
+// true for any element equal to an interned element, regardless of whether it is the canonical copy.
+//
+// If the Interner is nil, InternedHash behaves the same as MutableHash.
+//
+// As InternedHash returns a mutable struct it is not safe for concurrent use by multiple goroutines, although the
+// Interner itself can be safely shared with sets used by other goroutines.
+func InternedHash[E comparable](interner *Interner[E], elements ...E) *MutableHashSet[E] {
+	if interner == nil {
+		return MutableHash(elements...)
+	}
+	set := &MutableHashSet[E]{elements: make(internal.Hash[E], len(elements)), interner: interner}
+	interner.putSlice(set.elements, elements)
+	return set
+}
@@ -0,0 +1,253 @@
+// Copyright (C) 2023 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sets
+
+import (
+	"encoding/json"
+	"github.com/google/go-cmp/cmp"
+	"strings"
+	"sync"
+	"testing"
+	"unsafe"
+)
+
+func sameString(x, y string) bool {
+	return unsafe.StringData(x) == unsafe.StringData(y)
+}
+
+func Test_Interner_Intern(t *testing.T) {
+	var interner Interner[string]
+	first, second := strings.Clone("foo"), strings.Clone("foo")
+	if sameString(first, second) {
+		t.Fatal("unexpected shared storage for test strings")
+	}
+	if result := interner.Intern(first); !sameString(result, first) {
+		t.Error("unexpected canonical copy; want first string")
+	}
+	if result := interner.Intern(second); !sameString(result, first) {
+		t.Error("unexpected canonical copy; want first string")
+	}
+	if result := interner.Intern(strings.Clone("bar")); result != "bar" {
+		t.Errorf("unexpected value; want %q, got %q", "bar", result)
+	}
+	if exp, act := 2, interner.Len(); act != exp {
+		t.Errorf("unexpected Interner length; want %v, got %v", exp, act)
+	}
+}
+
+func Test_Interner_Intern_Concurrent(t *testing.T) {
+	var interner Interner[string]
+	var wg sync.WaitGroup
+	results := make([]string, 100)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = interner.Intern(strings.Clone("foo"))
+		}(i)
+	}
+	wg.Wait()
+	for _, result := range results {
+		if !sameString(result, results[0]) {
+			t.Fatal("unexpected canonical copy; want shared storage")
+		}
+	}
+	if exp, act := 1, interner.Len(); act != exp {
+		t.Errorf("unexpected Interner length; want %v, got %v", exp, act)
+	}
+}
+
+func Test_Interner_Nil(t *testing.T) {
+	var interner *Interner[string]
+	value := strings.Clone("foo")
+	if result := interner.Intern(value); !sameString(result, value) {
+		t.Error("unexpected value; want same string")
+	}
+	if act := interner.Len(); act != 0 {
+		t.Errorf("unexpected Interner length; want 0, got %v", act)
+	}
+}
+
+func Test_InternedHash(t *testing.T) {
+	var interner Interner[string]
+	a := InternedHash(&interner, strings.Clone("foo"), strings.Clone("bar"))
+	b := InternedHash(&interner, strings.Clone("foo"), strings.Clone("baz"))
+	if exp, act := []string{"bar", "foo"}, a.SortedSlice(Asc[string]); !cmp.Equal(exp, act) {
+		t.Errorf("unexpected elements; want %v, got %v", exp, act)
+	}
+	if exp, act := []string{"baz", "foo"}, b.SortedSlice(Asc[string]); !cmp.Equal(exp, act) {
+		t.Errorf("unexpected elements; want %v, got %v", exp, act)
+	}
+	assertInternedElement(t, a, b, "foo")
+	if exp, act := 3, interner.Len(); act != exp {
+		t.Errorf("unexpected Interner length; want %v, got %v", exp, act)
+	}
+	if !a.Contains(strings.Clone("foo")) {
+		t.Error("unexpected Set containment of foo; want true, got false")
+	}
+	if a.Contains("baz") {
+		t.Error("unexpected Set containment of baz; want false, got true")
+	}
+}
+
+func Test_InternedHash_Mutations(t *testing.T) {
+	var interner Interner[string]
+	reference := InternedHash(&interner, strings.Clone("foo"), strings.Clone("bar"), strings.Clone("baz"), strings.Clone("qux"))
+
+	testCases := map[string]struct {
+		element string
+		mutate  func(set *MutableHashSet[string])
+	}{
+		"with Put": {
+			element: "foo",
+			mutate: func(set *MutableHashSet[string]) {
+				set.Put(strings.Clone("foo"))
+			},
+		},
+		"with PutAll": {
+			element: "bar",
+			mutate: func(set *MutableHashSet[string]) {
+				set.PutAll(Hash(strings.Clone("bar")))
+			},
+		},
+		"with PutSlice": {
+			element: "baz",
+			mutate: func(set *MutableHashSet[string]) {
+				set.PutSlice([]string{strings.Clone("baz")})
+			},
+		},
+		"with ReplaceElement": {
+			element: "qux",
+			mutate: func(set *MutableHashSet[string]) {
+				set.ReplaceElement("quux", strings.Clone("qux"))
+			},
+		},
+		"with Put on Clone": {
+			element: "foo",
+			mutate: func(set *MutableHashSet[string]) {
+				clone := set.Clone().(*MutableHashSet[string])
+				clone.Put(strings.Clone("foo"))
+				set.PutAll(clone)
+			},
+		},
+		"with json.Unmarshal": {
+			element: "bar",
+			mutate: func(set *MutableHashSet[string]) {
+				if err := json.Unmarshal([]byte(`["bar"]`), set); err != nil {
+					t.Fatalf("unexpected error; want nil, got %v", err)
+				}
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := InternedHash(&interner, strings.Clone("quux"))
+			tc.mutate(set)
+			assertInternedElement(t, reference, set, tc.element)
+		})
+	}
+	if exp, act := 5, interner.Len(); act != exp {
+		t.Errorf("unexpected Interner length; want %v, got %v", exp, act)
+	}
+}
+
+func Test_InternedHash_Derived(t *testing.T) {
+	var interner Interner[string]
+	reference := InternedHash(&interner, strings.Clone("foo"), strings.Clone("bar"))
+
+	testCases := map[string]struct {
+		derive  func(set *MutableHashSet[string]) Set[string]
+		element string
+	}{
+		"with Diff": {
+			derive: func(set *MutableHashSet[string]) Set[string] {
+				return set.Diff(Hash("quux"))
+			},
+			element: "foo",
+		},
+		"with DiffSymmetric": {
+			derive: func(set *MutableHashSet[string]) Set[string] {
+				return set.DiffSymmetric(Hash(strings.Clone("bar")))
+			},
+			element: "bar",
+		},
+		"with DrainWhere": {
+			derive: func(set *MutableHashSet[string]) Set[string] {
+				return set.DrainWhere(func(_ string) bool { return true })
+			},
+			element: "foo",
+		},
+		"with Filter": {
+			derive: func(set *MutableHashSet[string]) Set[string] {
+				return set.Filter(func(_ string) bool { return true })
+			},
+			element: "foo",
+		},
+		"with Intersection": {
+			derive: func(set *MutableHashSet[string]) Set[string] {
+				return set.Intersection(Hash("foo"))
+			},
+			element: "foo",
+		},
+		"with Union": {
+			derive: func(set *MutableHashSet[string]) Set[string] {
+				return set.Union(Hash(strings.Clone("bar")))
+			},
+			element: "bar",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := InternedHash(&interner, strings.Clone("foo"), strings.Clone("quux"))
+			derived := tc.derive(set).(*MutableHashSet[string])
+			assertInternedElement(t, reference, derived, tc.element)
+			derived.Put(strings.Clone("bar"))
+			assertInternedElement(t, reference, derived, "bar")
+		})
+	}
+}
+
+func Test_InternedHash_NilInterner(t *testing.T) {
+	set := InternedHash[string](nil, "foo", "bar")
+	if exp, act := []string{"bar", "foo"}, set.SortedSlice(Asc[string]); !cmp.Equal(exp, act) {
+		t.Errorf("unexpected elements; want %v, got %v", exp, act)
+	}
+	set.Put("baz")
+	if !set.Contains("baz") {
+		t.Error("unexpected Set containment of baz; want true, got false")
+	}
+}
+
+func assertInternedElement(t *testing.T, a, b Set[string], element string) {
+	x, ok := a.Find(func(e string) bool { return e == element })
+	if !ok {
+		t.Fatalf("unexpected Set containment of %q; want true, got false", element)
+	}
+	y, ok := b.Find(func(e string) bool { return e == element })
+	if !ok {
+		t.Fatalf("unexpected Set containment of %q; want true, got false", element)
+	}
+	if !sameString(x, y) {
+		t.Errorf("unexpected storage of %q; want shared, got distinct", element)
+	}
+}
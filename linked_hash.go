@@ -0,0 +1,958 @@
+// Copyright (C) 2023 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/neocotic/go-sets/internal"
+	"math/rand"
+	"sort"
+)
+
+// LinkedHashSet is an implementation of MutableSet that contains a unique data set while remembering the order in which
+// elements were first put into it.
+//
+// LinkedHashSet.Range, LinkedHashSet.Slice, LinkedHashSet.All, and LinkedHashSet.String all emit elements in insertion
+// order, which can be useful for building stable output. Putting an element that already exists within the
+// LinkedHashSet does not change its position, however, deleting an element and then putting it again moves it to the
+// end.
+//
+// Membership is checked in constant time using a map, while the order is maintained using a doubly linked list. As
+// such, LinkedHashSet requires noticeably more memory than MutableHashSet, as each element is also stored within a list
+// node along with two pointers, and every node is a separate allocation. MutableHashSet should be used instead for such
+// cases where iteration order is not important.
+//
+// The zero value of LinkedHashSet is an empty set ready for use.
+//
+// As LinkedHashSet is mutable it is not safe for concurrent use by multiple goroutines.
+type LinkedHashSet[E comparable] struct {
+	elements internal.Linked[E]
+}
+
+var (
+	_ MutableSet[any]  = (*LinkedHashSet[any])(nil)
+	_ fmt.Stringer     = (*LinkedHashSet[any])(nil)
+	_ json.Marshaler   = (*LinkedHashSet[any])(nil)
+	_ json.Unmarshaler = (*LinkedHashSet[any])(nil)
+)
+
+// All returns an iterator over each element within the LinkedHashSet, which is compatible with iter.Seq so can be used
+// with range-over-func (e.g. `for element := range set.All()`) from Go 1.23 onwards.
+//
+// Elements are yielded in insertion order.
+//
+// If the LinkedHashSet is nil, the iterator returned by LinkedHashSet.All yields no elements.
+func (s *LinkedHashSet[E]) All() func(yield func(element E) bool) {
+	return seq[E](s.Range)
+}
+
+// AppendSlice appends all elements of the LinkedHashSet to the dst slice and returns the extended slice, following the
+// same convention as the built-in append function. This allows a buffer to be reused across calls, avoiding the
+// allocation made by LinkedHashSet.Slice each time.
+//
+// Elements are appended in insertion order.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.AppendSlice returns dst unchanged.
+func (s *LinkedHashSet[E]) AppendSlice(dst []E) []E {
+	if s == nil {
+		return dst
+	}
+	s.elements.Range(func(element E) bool {
+		dst = append(dst, element)
+		return false
+	})
+	return dst
+}
+
+// Canonical returns a minimal byte representation of the LinkedHashSet that is stable for its elements, which can be
+// useful for hashing, cache keys, or as a shortcut for equality checks. See Set.Canonical for the escaping and
+// separator used.
+//
+// As the representation is stable for the elements, it is not affected by insertion order.
+//
+// If the LinkedHashSet is nil it is treated as having no elements and so LinkedHashSet.Canonical returns an empty
+// slice.
+func (s *LinkedHashSet[E]) Canonical(convert func(element E) string) []byte {
+	return canonical[E](s, convert)
+}
+
+// Clear removes all elements from the LinkedHashSet.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Clear is a no-op.
+//
+// A reference to the LinkedHashSet is returned for method chaining.
+func (s *LinkedHashSet[E]) Clear() MutableSet[E] {
+	if s == nil {
+		var ns *LinkedHashSet[E]
+		return ns
+	}
+	s.elements.Clear()
+	return s
+}
+
+// Clone returns a clone of the LinkedHashSet, maintaining the order of its elements.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Clone returns nil.
+func (s *LinkedHashSet[E]) Clone() Set[E] {
+	if s == nil {
+		var ns *LinkedHashSet[E]
+		return ns
+	}
+	return s.with(s.elements.Clone())
+}
+
+// Contains returns whether the LinkedHashSet contains the element.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Contains returns false.
+func (s *LinkedHashSet[E]) Contains(element E) bool {
+	if s == nil {
+		return false
+	}
+	return s.elements.Contains(element)
+}
+
+// ContainsAll returns whether the LinkedHashSet contains the element as well as all additional elements specified,
+// stopping as soon as any element is found not to be contained.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.ContainsAll returns false.
+func (s *LinkedHashSet[E]) ContainsAll(element E, elements ...E) bool {
+	return containsAll[E](element, elements, s.Contains)
+}
+
+// ContainsAny returns whether the LinkedHashSet contains the element or any of the additional elements specified,
+// stopping as soon as any element is found to be contained.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.ContainsAny returns false.
+func (s *LinkedHashSet[E]) ContainsAny(element E, elements ...E) bool {
+	return containsAny[E](element, elements, s.Contains)
+}
+
+// ContainsEach returns a slice containing whether the LinkedHashSet contains each of the elements provided, where each
+// result is at the same index as its element.
+//
+// The returned slice always has the same length as the slice of elements provided.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.ContainsEach returns a slice of false values.
+func (s *LinkedHashSet[E]) ContainsEach(elements []E) []bool {
+	return containsEach[E](elements, s.Contains)
+}
+
+// Count returns the number of elements within the LinkedHashSet that match the predicate function.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Count returns zero.
+func (s *LinkedHashSet[E]) Count(predicate func(element E) bool) int {
+	return count[E](s.Range, predicate)
+}
+
+// Covers returns whether the LinkedHashSet is a superset of every other Set. That is; whether the LinkedHashSet
+// contains all elements within each other Set. Any other Set containing more elements than the LinkedHashSet is never
+// covered and so is rejected before its elements are checked.
+//
+// Any nil other Set is treated as having no elements and so is always covered. If no other Set is provided,
+// LinkedHashSet.Covers returns true.
+//
+// If the LinkedHashSet is nil it is treated as having no elements.
+func (s *LinkedHashSet[E]) Covers(others ...Set[E]) bool {
+	return covers[E](s.Len(), s.Contains, others)
+}
+
+// Delete removes the element from the LinkedHashSet as well as any additional elements specified.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Delete is a no-op.
+//
+// A reference to the LinkedHashSet is returned for method chaining.
+func (s *LinkedHashSet[E]) Delete(element E, elements ...E) MutableSet[E] {
+	if s == nil {
+		var ns *LinkedHashSet[E]
+		return ns
+	}
+	s.elements.Delete(element)
+	for _, _element := range elements {
+		s.elements.Delete(_element)
+	}
+	return s
+}
+
+// DeleteAll removes all elements in the specified Set from the LinkedHashSet.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.DeleteAll is a no-op.
+//
+// A reference to the LinkedHashSet is returned for method chaining.
+func (s *LinkedHashSet[E]) DeleteAll(elements Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *LinkedHashSet[E]
+		return ns
+	}
+	if elements != nil {
+		elements.Range(func(element E) bool {
+			s.elements.Delete(element)
+			return false
+		})
+	}
+	return s
+}
+
+// DeleteAnyOf removes all elements from the LinkedHashSet that exist within any of the specified Set. Any nil Set is
+// skipped.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.DeleteAnyOf is a no-op.
+//
+// A reference to the LinkedHashSet is returned for method chaining.
+func (s *LinkedHashSet[E]) DeleteAnyOf(sets ...Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *LinkedHashSet[E]
+		return ns
+	}
+	s.elements.DeleteWhere(func(element E) bool { return containedByAny(sets, element) })
+	return s
+}
+
+// DeleteExisting removes the element from the LinkedHashSet as well as any additional elements specified, and returns
+// the number of those elements that were present and therefore removed. Unlike LinkedHashSet.Delete, this allows the
+// caller to learn whether anything was actually removed.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.DeleteExisting is a no-op and returns zero.
+func (s *LinkedHashSet[E]) DeleteExisting(element E, elements ...E) int {
+	if s == nil {
+		return 0
+	}
+	return deleteExisting[E](s.Take, element, elements)
+}
+
+// DeleteNth removes the element at index i from the LinkedHashSet, were its elements sorted using the provided less
+// function, and returns the removed element as well as an indication of whether an element was removed.
+//
+// If i is out of range (i.e. negative or not less than LinkedHashSet.Len), LinkedHashSet.DeleteNth is a no-op and
+// returns the zero value for E and false.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.DeleteNth is a no-op and returns the zero value for E and false.
+func (s *LinkedHashSet[E]) DeleteNth(less func(x, y E) bool, i int) (E, bool) {
+	if s == nil || i < 0 || i >= s.elements.Len() {
+		var zero E
+		return zero, false
+	}
+	element := s.SortedSlice(less)[i]
+	s.elements.Delete(element)
+	return element, true
+}
+
+// DeleteSlice removes all elements in the specified slice from the LinkedHashSet.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.DeleteSlice is a no-op.
+//
+// A reference to the LinkedHashSet is returned for method chaining.
+func (s *LinkedHashSet[E]) DeleteSlice(elements []E) MutableSet[E] {
+	if s == nil {
+		var ns *LinkedHashSet[E]
+		return ns
+	}
+	for _, element := range elements {
+		s.elements.Delete(element)
+	}
+	return s
+}
+
+// DeleteWhere removes all elements that match the predicate function from the LinkedHashSet.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.DeleteWhere is a no-op.
+//
+// A reference to the LinkedHashSet is returned for method chaining.
+func (s *LinkedHashSet[E]) DeleteWhere(predicate func(element E) bool) MutableSet[E] {
+	if s == nil {
+		var ns *LinkedHashSet[E]
+		return ns
+	}
+	s.elements.DeleteWhere(predicate)
+	return s
+}
+
+// Diff returns a new LinkedHashSet struct containing only elements of the LinkedHashSet that do not exist in another
+// Set, maintaining their order.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Diff returns nil.
+func (s *LinkedHashSet[E]) Diff(other Set[E]) Set[E] {
+	if s == nil {
+		var ns *LinkedHashSet[E]
+		return ns
+	}
+	if other == nil {
+		return s.Clone()
+	}
+	return s.with(s.elements.Filter(func(element E) bool { return !other.Contains(element) }))
+}
+
+// DiffSymmetric returns a new LinkedHashSet struct containing elements that exist within the LinkedHashSet or another
+// Set, but not both. Elements of the LinkedHashSet maintain their order and are followed by those of the other Set.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.DiffSymmetric returns nil.
+func (s *LinkedHashSet[E]) DiffSymmetric(other Set[E]) Set[E] {
+	if s == nil {
+		var ns *LinkedHashSet[E]
+		return ns
+	}
+	if other == nil {
+		return s.Clone()
+	}
+	elements := s.elements.Filter(func(element E) bool { return !other.Contains(element) })
+	other.Range(func(element E) bool {
+		if !s.elements.Contains(element) {
+			elements.Put(element)
+		}
+		return false
+	})
+	return s.with(elements)
+}
+
+// DrainWhere removes all elements that match the predicate function from the LinkedHashSet and returns them within a
+// new LinkedHashSet struct. Unlike LinkedHashSet.DeleteWhere, this allows the caller to inspect the elements that were
+// removed. Together, the LinkedHashSet and the returned LinkedHashSet contain exactly the elements that the
+// LinkedHashSet contained beforehand.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.DrainWhere returns nil.
+func (s *LinkedHashSet[E]) DrainWhere(predicate func(element E) bool) Set[E] {
+	if s == nil {
+		var ns *LinkedHashSet[E]
+		return ns
+	}
+	drained := s.Filter(predicate)
+	s.DeleteAll(drained)
+	return drained
+}
+
+// Equal returns whether the LinkedHashSet contains the exact same elements as another Set. Insertion order is ignored.
+//
+// If the LinkedHashSet is nil it is treated as having no elements and the same logic applies to the other Set. To
+// clarify; this means that a nil Set is equal to a non-nil Set that contains no elements.
+func (s *LinkedHashSet[E]) Equal(other Set[E]) bool {
+	if s == nil {
+		return other == nil || other.IsEmpty()
+	} else if other == nil {
+		return s.IsEmpty()
+	}
+	if s.elements.Len() != other.Len() {
+		return false
+	}
+	equal := true
+	s.elements.Range(func(element E) bool {
+		equal = other.Contains(element)
+		return !equal
+	})
+	return equal
+}
+
+// Every returns whether the LinkedHashSet contains elements that all match the predicate function.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Every returns false.
+func (s *LinkedHashSet[E]) Every(predicate func(element E) bool) bool {
+	if s == nil || s.elements.Len() == 0 {
+		return false
+	}
+	_, ok := s.Find(func(element E) bool { return !predicate(element) })
+	return !ok
+}
+
+// Filter returns a new LinkedHashSet struct containing only elements of the LinkedHashSet that match the filter
+// function, maintaining their order.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Filter returns nil.
+func (s *LinkedHashSet[E]) Filter(filter func(element E) bool) Set[E] {
+	if s == nil {
+		var ns *LinkedHashSet[E]
+		return ns
+	}
+	return s.with(s.elements.Filter(filter))
+}
+
+// Find returns an element within the LinkedHashSet that matches the search function as well as an indication of
+// whether a match was found.
+//
+// Elements are searched in insertion order.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Find returns the zero value for E and false.
+func (s *LinkedHashSet[E]) Find(search func(element E) bool) (E, bool) {
+	var (
+		found E
+		ok    bool
+	)
+	s.Range(func(element E) bool {
+		if search(element) {
+			found, ok = element, true
+		}
+		return ok
+	})
+	return found, ok
+}
+
+// Grow increases the capacity of the LinkedHashSet, if necessary, to guarantee space for another n elements. After
+// Grow(n), at least n elements can be added to the LinkedHashSet without it needing to allocate further. Only the index
+// of the LinkedHashSet is pre-sized as each element is still allocated its own node when added.
+//
+// If n is not positive, LinkedHashSet.Grow is a no-op.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Grow is a no-op.
+//
+// A reference to the LinkedHashSet is returned for method chaining.
+func (s *LinkedHashSet[E]) Grow(n int) MutableSet[E] {
+	if s == nil {
+		var ns *LinkedHashSet[E]
+		return ns
+	}
+	s.elements.Grow(n)
+	return s
+}
+
+// Immutable returns an immutable clone of the LinkedHashSet.
+//
+// As HashSet is returned, insertion order is not maintained by the clone.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Immutable returns nil.
+func (s *LinkedHashSet[E]) Immutable() Set[E] {
+	if s == nil {
+		var ns *HashSet[E]
+		return ns
+	}
+	return &HashSet[E]{elements: internal.FromSlice(s.elements.Slice())}
+}
+
+// Intersection returns a new LinkedHashSet struct containing only elements of the LinkedHashSet that also exist in
+// another Set, maintaining their order.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Intersection returns nil.
+func (s *LinkedHashSet[E]) Intersection(other Set[E]) Set[E] {
+	if s == nil {
+		var ns *LinkedHashSet[E]
+		return ns
+	}
+	if other == nil {
+		return &LinkedHashSet[E]{}
+	}
+	return s.with(s.elements.Filter(other.Contains))
+}
+
+// IsDisjoint returns whether the LinkedHashSet has no elements in common with another Set. Only the elements within
+// the smaller of the two are iterated, with each being checked for within the larger.
+//
+// Any nil other Set, or other Set containing no elements, is disjoint from the LinkedHashSet.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.IsDisjoint returns true.
+func (s *LinkedHashSet[E]) IsDisjoint(other Set[E]) bool {
+	return disjoint[E](s.Len(), s.Contains, s.Range, other)
+}
+
+// IsEmpty returns whether the LinkedHashSet contains no elements.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.IsEmpty returns true.
+func (s *LinkedHashSet[E]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+// IsMutable always returns true to conform with Set.IsMutable.
+func (s *LinkedHashSet[E]) IsMutable() bool {
+	return true
+}
+
+// Join converts the elements within the LinkedHashSet to strings which are then concatenated to create a single
+// string, placing sep between the converted elements in the resulting string.
+//
+// Elements are joined in insertion order.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Join returns an empty string.
+func (s *LinkedHashSet[E]) Join(sep string, convert func(element E) string) string {
+	if s == nil {
+		return ""
+	}
+	return joinSlice(s.elements.Slice(), sep, convert)
+}
+
+// Kind always returns LinkedHashKind to conform with Set.Kind.
+func (s *LinkedHashSet[E]) Kind() SetKind {
+	return LinkedHashKind
+}
+
+// Len returns the number of elements within the LinkedHashSet.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Len returns zero.
+func (s *LinkedHashSet[E]) Len() int {
+	if s == nil {
+		return 0
+	}
+	return s.elements.Len()
+}
+
+// Max returns the maximum element within the LinkedHashSet using the provided less function.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Max returns the zero value for E and false.
+func (s *LinkedHashSet[E]) Max(less func(x, y E) bool) (E, bool) {
+	var (
+		max E
+		ok  bool
+	)
+	s.Range(func(element E) bool {
+		if !ok || less(max, element) {
+			max, ok = element, true
+		}
+		return false
+	})
+	return max, ok
+}
+
+// Min returns the minimum element within the LinkedHashSet using the provided less function.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Min returns the zero value for E and false.
+func (s *LinkedHashSet[E]) Min(less func(x, y E) bool) (E, bool) {
+	var (
+		min E
+		ok  bool
+	)
+	s.Range(func(element E) bool {
+		if !ok || less(element, min) {
+			min, ok = element, true
+		}
+		return false
+	})
+	return min, ok
+}
+
+// Mutable returns a reference to itself to conform with Set.Mutable.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Mutable returns nil.
+func (s *LinkedHashSet[E]) Mutable() MutableSet[E] {
+	if s == nil {
+		var ns *LinkedHashSet[E]
+		return ns
+	}
+	return s
+}
+
+// None returns whether the LinkedHashSet contains no elements that match the predicate function.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.None returns true.
+func (s *LinkedHashSet[E]) None(predicate func(element E) bool) bool {
+	return !s.Some(predicate)
+}
+
+// Pop removes an element from the LinkedHashSet and returns it as well as an indication of whether the LinkedHashSet
+// contained any elements. The element removed is always the last in insertion order.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Pop is a no-op and returns the zero value for E and false.
+func (s *LinkedHashSet[E]) Pop() (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	return s.elements.Pop()
+}
+
+// Put adds the element to the end of the LinkedHashSet as well as any additional elements specified, in the order
+// provided. Nothing changes for elements that already exist within the LinkedHashSet, including their position.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Put is a no-op.
+//
+// A reference to the LinkedHashSet is returned for method chaining.
+func (s *LinkedHashSet[E]) Put(element E, elements ...E) MutableSet[E] {
+	if s == nil {
+		var ns *LinkedHashSet[E]
+		return ns
+	}
+	s.elements.Put(element)
+	for _, _element := range elements {
+		s.elements.Put(_element)
+	}
+	return s
+}
+
+// PutAll adds all elements in the specified Set to the end of the LinkedHashSet, in the iteration order of the Set.
+// Nothing changes for elements that already exist within the LinkedHashSet, including their position.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.PutAll is a no-op.
+//
+// A reference to the LinkedHashSet is returned for method chaining.
+func (s *LinkedHashSet[E]) PutAll(elements Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *LinkedHashSet[E]
+		return ns
+	}
+	if elements != nil {
+		elements.Range(func(element E) bool {
+			s.elements.Put(element)
+			return false
+		})
+	}
+	return s
+}
+
+// PutSlice adds all elements in the specified slice to the end of the LinkedHashSet, in the order provided. Nothing
+// changes for elements that already exist within the LinkedHashSet, including their position.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.PutSlice is a no-op.
+//
+// A reference to the LinkedHashSet is returned for method chaining.
+func (s *LinkedHashSet[E]) PutSlice(elements []E) MutableSet[E] {
+	if s == nil {
+		var ns *LinkedHashSet[E]
+		return ns
+	}
+	for _, element := range elements {
+		s.elements.Put(element)
+	}
+	return s
+}
+
+// Range calls the iter function with each element within the LinkedHashSet but will stop early whenever the iter
+// function returns true.
+//
+// Elements are iterated in insertion order.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Range is a no-op.
+func (s *LinkedHashSet[E]) Range(iter func(element E) bool) {
+	if s != nil {
+		s.elements.Range(iter)
+	}
+}
+
+// RangeContext calls the iter function with each element within the LinkedHashSet, the same as LinkedHashSet.Range,
+// except that the context is checked before each element. If the context is done, iteration stops and the error of the
+// context is returned.
+//
+// Elements are iterated in insertion order.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.RangeContext is a no-op and returns nil.
+func (s *LinkedHashSet[E]) RangeContext(ctx context.Context, iter func(element E) bool) error {
+	if s == nil {
+		return nil
+	}
+	return rangeContext[E](ctx, s.Range, iter)
+}
+
+// ReplaceElement removes the old element from the LinkedHashSet and adds the new element in its place, taking its
+// position, but only if the old element is present, and returns whether it was present. If the new element already
+// exists within the LinkedHashSet, the old element is simply removed.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.ReplaceElement is a no-op and returns false.
+func (s *LinkedHashSet[E]) ReplaceElement(oldElement, newElement E) bool {
+	if s == nil {
+		return false
+	}
+	return s.elements.Replace(oldElement, newElement)
+}
+
+// Retain removes all elements from the LinkedHashSet except the element(s) specified.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Retain is a no-op.
+//
+// A reference to the LinkedHashSet is returned for method chaining.
+func (s *LinkedHashSet[E]) Retain(element E, elements ...E) MutableSet[E] {
+	if s == nil {
+		var ns *LinkedHashSet[E]
+		return ns
+	}
+	retained := internal.FromSlice(elements)
+	retained[element] = struct{}{}
+	s.elements.DeleteWhere(func(element E) bool {
+		_, ok := retained[element]
+		return !ok
+	})
+	return s
+}
+
+// RetainAll removes all elements from the LinkedHashSet except those in the specified Set.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.RetainAll is a no-op.
+//
+// A reference to the LinkedHashSet is returned for method chaining.
+func (s *LinkedHashSet[E]) RetainAll(elements Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *LinkedHashSet[E]
+		return ns
+	}
+	if elements == nil {
+		s.elements.Clear()
+	} else {
+		s.elements.DeleteWhere(func(element E) bool { return !elements.Contains(element) })
+	}
+	return s
+}
+
+// RetainAllOf removes all elements from the LinkedHashSet except those that exist within all the specified Set. That
+// is; the LinkedHashSet is intersected with each Set in place. As with LinkedHashSet.RetainAll, any nil Set is treated
+// as having no elements. If no Set is specified, nothing is removed.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.RetainAllOf is a no-op.
+//
+// A reference to the LinkedHashSet is returned for method chaining.
+func (s *LinkedHashSet[E]) RetainAllOf(sets ...Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *LinkedHashSet[E]
+		return ns
+	}
+	s.elements.DeleteWhere(func(element E) bool { return !containedByAll(sets, element) })
+	return s
+}
+
+// RetainSlice removes all elements from the LinkedHashSet except those in the specified slice.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.RetainSlice is a no-op.
+//
+// A reference to the LinkedHashSet is returned for method chaining.
+func (s *LinkedHashSet[E]) RetainSlice(elements []E) MutableSet[E] {
+	if s == nil {
+		var ns *LinkedHashSet[E]
+		return ns
+	}
+	retained := internal.FromSlice(elements)
+	s.elements.DeleteWhere(func(element E) bool {
+		_, ok := retained[element]
+		return !ok
+	})
+	return s
+}
+
+// RetainWhere removes all elements except those that match the predicate function from the LinkedHashSet.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.RetainWhere is a no-op.
+//
+// A reference to the LinkedHashSet is returned for method chaining.
+func (s *LinkedHashSet[E]) RetainWhere(predicate func(element E) bool) MutableSet[E] {
+	if s == nil {
+		var ns *LinkedHashSet[E]
+		return ns
+	}
+	s.elements.DeleteWhere(func(element E) bool { return !predicate(element) })
+	return s
+}
+
+// Sample returns a pseudo-random element within the LinkedHashSet as well as an indication of whether the LinkedHashSet
+// contained any elements.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Sample returns the zero value for E and false.
+func (s *LinkedHashSet[E]) Sample() (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	return sample[E](s.Range, s.Len())
+}
+
+// SampleN returns a new LinkedHashSet struct containing up to n distinct elements of the LinkedHashSet chosen
+// pseudo-randomly. All elements are included if n is greater than or equal to the number of elements within the
+// LinkedHashSet, while none are included if n is not positive.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.SampleN returns nil.
+func (s *LinkedHashSet[E]) SampleN(n int) Set[E] {
+	return s.SampleNWithRand(n, nil)
+}
+
+// SampleNWithRand returns a new LinkedHashSet struct containing up to n distinct elements of the LinkedHashSet chosen
+// pseudo-randomly using the provided source, the same as LinkedHashSet.SampleN otherwise. If the source is nil, the
+// default source is used.
+//
+// Elements are chosen in insertion order, so repeated calls on an equal LinkedHashSet using sources that are seeded
+// identically always choose the same elements.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.SampleNWithRand returns nil.
+func (s *LinkedHashSet[E]) SampleNWithRand(n int, r *rand.Rand) Set[E] {
+	if s == nil {
+		var ns *LinkedHashSet[E]
+		return ns
+	}
+	return sampleFilter[E](s, n, r)
+}
+
+// Slice returns a slice containing all elements of the LinkedHashSet.
+//
+// Elements within the resulting slice are in insertion order.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Slice returns nil.
+func (s *LinkedHashSet[E]) Slice() []E {
+	if s == nil {
+		return nil
+	}
+	return s.elements.Slice()
+}
+
+// Some returns whether the LinkedHashSet contains any element that matches the predicate function.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Some returns false.
+func (s *LinkedHashSet[E]) Some(predicate func(element E) bool) bool {
+	_, ok := s.Find(predicate)
+	return ok
+}
+
+// SortedAppendSlice appends all elements of the LinkedHashSet to the dst slice, sorted using the provided less
+// function, and returns the extended slice. Only the appended elements are sorted so any elements already within dst
+// are left untouched. This allows a buffer to be reused across calls, avoiding the allocation made by
+// LinkedHashSet.SortedSlice each time.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.SortedAppendSlice returns dst unchanged.
+func (s *LinkedHashSet[E]) SortedAppendSlice(dst []E, less func(x, y E) bool) []E {
+	if s == nil {
+		return dst
+	}
+	return sortedAppendSlice[E](s, dst, less)
+}
+
+// SortedJoin sorts the elements within the LinkedHashSet using the provided less function and then converts those
+// elements into strings which are then joined using the specified separator to create the resulting string.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.SortedJoin returns an empty string.
+func (s *LinkedHashSet[E]) SortedJoin(sep string, convert func(element E) string, less func(x, y E) bool) string {
+	if s == nil {
+		return ""
+	}
+	return joinSlice(s.SortedSlice(less), sep, convert)
+}
+
+// SortedRange calls the iter function with each element within the LinkedHashSet, sorted using the provided less
+// function, along with the zero-based index of that element within the sorted order, but will stop early whenever the
+// iter function returns true.
+//
+// Unlike LinkedHashSet.Range, iteration order is deterministic.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.SortedRange is a no-op.
+func (s *LinkedHashSet[E]) SortedRange(less func(x, y E) bool, iter func(index int, element E) bool) {
+	if s == nil {
+		return
+	}
+	sortedRange[E](s, less, iter)
+}
+
+// SortedSlice returns a slice containing all elements of the LinkedHashSet sorted using the provided less function.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.SortedSlice returns nil.
+func (s *LinkedHashSet[E]) SortedSlice(less func(x, y E) bool) []E {
+	if s == nil {
+		return nil
+	}
+	elements := s.elements.Slice()
+	sort.SliceStable(elements, func(i, j int) bool { return less(elements[i], elements[j]) })
+	return elements
+}
+
+// Take removes the element from the LinkedHashSet and returns the element that was stored within the LinkedHashSet as
+// well as an indication of whether it was present. As elements are compared using equality, the returned element is
+// always equal to the element provided when present.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.Take is a no-op and returns the zero value for E and false.
+func (s *LinkedHashSet[E]) Take(element E) (E, bool) {
+	if s == nil || !s.elements.Delete(element) {
+		var zero E
+		return zero, false
+	}
+	return element, true
+}
+
+// ToMap returns a map containing all elements of the LinkedHashSet as keys, which can be useful when integrating with
+// APIs that expect a map rather than a Set or slice.
+//
+// The returned map is always a copy so can be modified freely without affecting the LinkedHashSet.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.ToMap returns nil.
+func (s *LinkedHashSet[E]) ToMap() map[E]struct{} {
+	if s == nil {
+		return nil
+	}
+	return internal.FromSlice(s.elements.Slice())
+}
+
+// TryRange calls the iter function with each element within the LinkedHashSet but will stop early whenever the iter
+// function returns an error.
+//
+// Elements are iterated in insertion order.
+//
+// If the LinkedHashSet is nil, LinkedHashSet.TryRange is a no-op.
+func (s *LinkedHashSet[E]) TryRange(iter func(element E) error) error {
+	var err error
+	s.Range(func(element E) bool {
+		err = iter(element)
+		return err != nil
+	})
+	return err
+}
+
+// Union returns a new LinkedHashSet containing a union of the LinkedHashSet with another Set. Elements of the
+// LinkedHashSet maintain their order and are followed by those of the other Set.
+//
+// If the LinkedHashSet and the other Set are both nil, LinkedHashSet.Union returns nil.
+func (s *LinkedHashSet[E]) Union(other Set[E]) Set[E] {
+	if s == nil {
+		if other == nil {
+			var ns *LinkedHashSet[E]
+			return ns
+		}
+		return &LinkedHashSet[E]{elements: *internal.LinkedFromSlice(other.Slice())}
+	}
+	elements := s.elements.Clone()
+	if other != nil {
+		other.Range(func(element E) bool {
+			elements.Put(element)
+			return false
+		})
+	}
+	return s.with(elements)
+}
+
+func (s *LinkedHashSet[E]) String() string {
+	if s == nil {
+		return internal.NilString
+	}
+	return fmt.Sprintf("%v", s.elements.Slice())
+}
+
+func (s *LinkedHashSet[E]) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return internal.MarshalJSONNil()
+	}
+	return json.Marshal(s.elements.Slice())
+}
+
+// UnmarshalJSON deserializes the given JSON data as a JSON array into the LinkedHashSet, replacing any existing
+// elements with those within the array in the order in which they first occur.
+func (s *LinkedHashSet[E]) UnmarshalJSON(data []byte) error {
+	var elements []E
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	s.elements = *internal.LinkedFromSlice(elements)
+	return nil
+}
+
+// with returns a new LinkedHashSet containing the linked elements provided.
+func (s *LinkedHashSet[E]) with(elements *internal.Linked[E]) *LinkedHashSet[E] {
+	return &LinkedHashSet[E]{elements: *elements}
+}
+
+// Linked returns a LinkedHashSet struct that implements MutableSet containing each unique element provided in the
+// order in which they first occur.
+//
+// As Linked returns a mutable struct it is not safe for concurrent use by multiple goroutines.
+func Linked[E comparable](elements ...E) *LinkedHashSet[E] {
+	return &LinkedHashSet[E]{elements: *internal.LinkedFromSlice(elements)}
+}
+
+// LinkedFromSlice returns a LinkedHashSet struct that implements MutableSet containing each unique element from the
+// slice provided in the order in which they first occur.
+//
+// As LinkedFromSlice returns a mutable struct it is not safe for concurrent use by multiple goroutines.
+func LinkedFromSlice[E comparable](elements []E) *LinkedHashSet[E] {
+	return &LinkedHashSet[E]{elements: *internal.LinkedFromSlice(elements)}
+}
@@ -21,9 +21,17 @@
 package sets
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/gob"
 	"encoding/json"
 	"fmt"
 	"github.com/neocotic/go-sets/internal"
+	"golang.org/x/exp/constraints"
+	"io"
+	"math/rand"
+	"sync/atomic"
 )
 
 // MutableHashSet is an implementation of MutableSet that contains a unique data set.
@@ -32,15 +40,57 @@ import (
 // instead for such cases where mutability is required, otherwise HashSet for a simple immutable Set.
 type MutableHashSet[E comparable] struct {
 	elements internal.Hash[E]
+	capacity int
+	interner *Interner[E]
+	order    atomic.Pointer[internal.Order[E]]
 }
 
 var (
 	_ MutableSet[any]  = (*MutableHashSet[any])(nil)
 	_ fmt.Stringer     = (*MutableHashSet[any])(nil)
+	_ driver.Valuer    = (*MutableHashSet[any])(nil)
+	_ gob.GobDecoder   = (*MutableHashSet[any])(nil)
+	_ gob.GobEncoder   = (*MutableHashSet[any])(nil)
 	_ json.Marshaler   = (*MutableHashSet[any])(nil)
 	_ json.Unmarshaler = (*MutableHashSet[any])(nil)
+	_ sql.Scanner      = (*MutableHashSet[any])(nil)
 )
 
+// All returns an iterator over each element within the MutableHashSet, which is compatible with iter.Seq so can be used
+// with range-over-func (e.g. `for element := range set.All()`) from Go 1.23 onwards.
+//
+// Iteration order is not guaranteed to be consistent.
+//
+// If the MutableHashSet is nil, the iterator returned by MutableHashSet.All yields no elements.
+func (s *MutableHashSet[E]) All() func(yield func(element E) bool) {
+	return seq[E](s.Range)
+}
+
+// AppendSlice appends all elements of the MutableHashSet to the dst slice and returns the extended slice, following the
+// same convention as the built-in append function. This allows a buffer to be reused across calls, avoiding the
+// allocation made by MutableHashSet.Slice each time.
+//
+// The order in which elements are appended is not guaranteed to be consistent. MutableHashSet.SortedSlice should be
+// used instead for such cases where consistent ordering is required.
+//
+// If the MutableHashSet is nil, MutableHashSet.AppendSlice returns dst unchanged.
+func (s *MutableHashSet[E]) AppendSlice(dst []E) []E {
+	if s == nil {
+		return dst
+	}
+	return internal.AppendSlice(dst, s.elements)
+}
+
+// Canonical returns a minimal byte representation of the MutableHashSet that is stable for its elements, which can be
+// useful for hashing, cache keys, or as a shortcut for equality checks. See Set.Canonical for the escaping and
+// separator used.
+//
+// If the MutableHashSet is nil it is treated as having no elements and so MutableHashSet.Canonical returns an empty
+// slice.
+func (s *MutableHashSet[E]) Canonical(convert func(element E) string) []byte {
+	return canonical[E](s, convert)
+}
+
 // Clear removes all elements from the MutableHashSet.
 //
 // If the MutableHashSet is nil, MutableHashSet.Clear is a no-op.
@@ -52,6 +102,8 @@ func (s *MutableHashSet[E]) Clear() MutableSet[E] {
 		return ns
 	}
 	s.elements = make(internal.Hash[E])
+	s.capacity = 0
+	s.order.Store(nil)
 	return s
 }
 
@@ -63,7 +115,7 @@ func (s *MutableHashSet[E]) Clone() Set[E] {
 		var ns *MutableHashSet[E]
 		return ns
 	}
-	return &MutableHashSet[E]{internal.Clone[E](s.elements)}
+	return s.with(internal.Clone[E](s.elements))
 }
 
 // Contains returns whether the MutableHashSet contains the element.
@@ -77,6 +129,51 @@ func (s *MutableHashSet[E]) Contains(element E) bool {
 	return ok
 }
 
+// ContainsAll returns whether the MutableHashSet contains the element as well as all additional elements specified,
+// stopping as soon as any element is found not to be contained.
+//
+// If the MutableHashSet is nil, MutableHashSet.ContainsAll returns false.
+func (s *MutableHashSet[E]) ContainsAll(element E, elements ...E) bool {
+	return containsAll[E](element, elements, s.Contains)
+}
+
+// ContainsAny returns whether the MutableHashSet contains the element or any of the additional elements specified,
+// stopping as soon as any element is found to be contained.
+//
+// If the MutableHashSet is nil, MutableHashSet.ContainsAny returns false.
+func (s *MutableHashSet[E]) ContainsAny(element E, elements ...E) bool {
+	return containsAny[E](element, elements, s.Contains)
+}
+
+// ContainsEach returns a slice containing whether the MutableHashSet contains each of the elements provided, where each
+// result is at the same index as its element.
+//
+// The returned slice always has the same length as the slice of elements provided.
+//
+// If the MutableHashSet is nil, MutableHashSet.ContainsEach returns a slice of false values.
+func (s *MutableHashSet[E]) ContainsEach(elements []E) []bool {
+	return containsEach[E](elements, s.Contains)
+}
+
+// Count returns the number of elements within the MutableHashSet that match the predicate function.
+//
+// If the MutableHashSet is nil, MutableHashSet.Count returns zero.
+func (s *MutableHashSet[E]) Count(predicate func(element E) bool) int {
+	return count[E](s.Range, predicate)
+}
+
+// Covers returns whether the MutableHashSet is a superset of every other Set. That is; whether the MutableHashSet
+// contains all elements within each other Set. Any other Set containing more elements than the MutableHashSet is never
+// covered and so is rejected before its elements are checked.
+//
+// Any nil other Set is treated as having no elements and so is always covered. If no other Set is provided,
+// MutableHashSet.Covers returns true.
+//
+// If the MutableHashSet is nil it is treated as having no elements.
+func (s *MutableHashSet[E]) Covers(others ...Set[E]) bool {
+	return covers[E](s.Len(), s.Contains, others)
+}
+
 // Delete removes the element from the MutableHashSet as well as any additional elements specified.
 //
 // If the MutableHashSet is nil, MutableHashSet.Delete is a no-op.
@@ -88,6 +185,7 @@ func (s *MutableHashSet[E]) Delete(element E, elements ...E) MutableSet[E] {
 		return ns
 	}
 	internal.Delete[E](s.elements, element, elements)
+	s.order.Load().Delete(element, elements)
 	return s
 }
 
@@ -102,9 +200,61 @@ func (s *MutableHashSet[E]) DeleteAll(elements Set[E]) MutableSet[E] {
 		return ns
 	}
 	internal.DeleteAll[E](s.elements, elements)
+	s.order.Load().Prune(s.elements)
 	return s
 }
 
+// DeleteAnyOf removes all elements from the MutableHashSet that exist within any of the specified Set. Any nil Set is
+// skipped.
+//
+// If the MutableHashSet is nil, MutableHashSet.DeleteAnyOf is a no-op.
+//
+// A reference to the MutableHashSet is returned for method chaining.
+func (s *MutableHashSet[E]) DeleteAnyOf(sets ...Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *MutableHashSet[E]
+		return ns
+	}
+	internal.DeleteAnyOf[E](s.elements, asCollections(sets))
+	s.order.Load().Prune(s.elements)
+	return s
+}
+
+// DeleteExisting removes the element from the MutableHashSet as well as any additional elements specified, and returns
+// the number of those elements that were present and therefore removed. Unlike MutableHashSet.Delete, this allows the
+// caller to learn whether anything was actually removed.
+//
+// If the MutableHashSet is nil, MutableHashSet.DeleteExisting is a no-op and returns zero.
+func (s *MutableHashSet[E]) DeleteExisting(element E, elements ...E) int {
+	if s == nil {
+		return 0
+	}
+	deleted := deleteExisting[E](func(element E) (E, bool) {
+		return internal.Take[E](s.elements, element)
+	}, element, elements)
+	s.order.Load().Prune(s.elements)
+	return deleted
+}
+
+// DeleteNth removes the element at index i from the MutableHashSet, were its elements sorted using the provided less
+// function, and returns the removed element as well as an indication of whether an element was removed.
+//
+// If i is out of range (i.e. negative or not less than MutableHashSet.Len), MutableHashSet.DeleteNth is a no-op and
+// returns the zero value for E and false.
+//
+// If the MutableHashSet is nil, MutableHashSet.DeleteNth is a no-op and returns the zero value for E and false.
+func (s *MutableHashSet[E]) DeleteNth(less func(x, y E) bool, i int) (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	element, ok := internal.DeleteNth[E](s.elements, less, i)
+	if ok {
+		s.order.Load().Delete(element, nil)
+	}
+	return element, ok
+}
+
 // DeleteSlice removes all elements in the specified slice from the MutableHashSet.
 //
 // If the MutableHashSet is nil, MutableHashSet.DeleteSlice is a no-op.
@@ -116,6 +266,7 @@ func (s *MutableHashSet[E]) DeleteSlice(elements []E) MutableSet[E] {
 		return ns
 	}
 	internal.DeleteSlice[E](s.elements, elements)
+	s.order.Load().Prune(s.elements)
 	return s
 }
 
@@ -130,6 +281,7 @@ func (s *MutableHashSet[E]) DeleteWhere(predicate func(element E) bool) MutableS
 		return ns
 	}
 	internal.DeleteWhere[E](s.elements, predicate)
+	s.order.Load().Prune(s.elements)
 	return s
 }
 
@@ -142,7 +294,7 @@ func (s *MutableHashSet[E]) Diff(other Set[E]) Set[E] {
 		var ns *MutableHashSet[E]
 		return ns
 	}
-	return &MutableHashSet[E]{internal.Diff[E](s.elements, other)}
+	return s.with(internal.Diff[E](s.elements, other))
 }
 
 // DiffSymmetric returns a new MutableHashSet struct containing elements that exist within the MutableHashSet or another
@@ -154,11 +306,29 @@ func (s *MutableHashSet[E]) DiffSymmetric(other Set[E]) Set[E] {
 		var ns *MutableHashSet[E]
 		return ns
 	}
-	return &MutableHashSet[E]{internal.DiffSymmetric[E](s.elements, other)}
+	return s.with(s.interned(internal.DiffSymmetric[E](s.elements, other)))
+}
+
+// DrainWhere removes all elements that match the predicate function from the MutableHashSet and returns them within a
+// new MutableHashSet struct. Unlike MutableHashSet.DeleteWhere, this allows the caller to inspect the elements that
+// were removed. Together, the MutableHashSet and the returned MutableHashSet contain exactly the elements that the
+// MutableHashSet contained beforehand.
+//
+// If the MutableHashSet is nil, MutableHashSet.DrainWhere returns nil.
+func (s *MutableHashSet[E]) DrainWhere(predicate func(element E) bool) Set[E] {
+	if s == nil {
+		var ns *MutableHashSet[E]
+		return ns
+	}
+	drained := internal.DrainWhere[E](s.elements, predicate)
+	s.order.Load().Prune(s.elements)
+	return s.with(drained)
 }
 
 // Equal returns whether the MutableHashSet contains the exact same elements as another Set.
 //
+// If the other Set is a HashSet or MutableHashSet, their elements are compared directly without any allocation.
+//
 // If the MutableHashSet is nil it is treated as having no elements and the same logic applies to the other Set. To
 // clarify; this means that a nil Set is equal to a non-nil Set that contains no elements.
 func (s *MutableHashSet[E]) Equal(other Set[E]) bool {
@@ -167,6 +337,9 @@ func (s *MutableHashSet[E]) Equal(other Set[E]) bool {
 	} else if other == nil {
 		return s.IsEmpty()
 	}
+	if elements, ok := hashOf[E](other); ok {
+		return internal.Equal[E](s.elements, elements)
+	}
 	return internal.ContainsOnly[E](s.elements, other.Slice())
 }
 
@@ -189,7 +362,7 @@ func (s *MutableHashSet[E]) Filter(filter func(element E) bool) Set[E] {
 		var ns *MutableHashSet[E]
 		return ns
 	}
-	return &MutableHashSet[E]{internal.Filter[E](s.elements, filter)}
+	return s.with(internal.Filter[E](s.elements, filter))
 }
 
 // Find returns an element within the MutableHashSet that matches the search function as well as an indication of
@@ -206,6 +379,30 @@ func (s *MutableHashSet[E]) Find(search func(element E) bool) (E, bool) {
 	return internal.Find[E](s.elements, search)
 }
 
+// Grow increases the capacity of the MutableHashSet, if necessary, to guarantee space for another n elements. After
+// Grow(n), at least n elements can be added to the MutableHashSet without it needing to allocate further. As the
+// capacity of a map cannot be inspected, the capacity that the backing map was last sized for is recorded instead, and
+// the backing map is only rebuilt when that capacity cannot hold another n elements. Only the capacity reserved by Grow
+// (or MutableHashWithCapacity) is recorded, so the backing map may be rebuilt when it already has enough capacity
+// (e.g. having grown as elements were added).
+//
+// If n is not positive, MutableHashSet.Grow is a no-op.
+//
+// If the MutableHashSet is nil, MutableHashSet.Grow is a no-op.
+//
+// A reference to the MutableHashSet is returned for method chaining.
+func (s *MutableHashSet[E]) Grow(n int) MutableSet[E] {
+	if s == nil {
+		var ns *MutableHashSet[E]
+		return ns
+	}
+	if n > 0 && len(s.elements)+n > s.capacity {
+		s.capacity = len(s.elements) + n
+		s.elements = internal.Grow(s.elements, n)
+	}
+	return s
+}
+
 // Immutable returns an immutable clone of the MutableHashSet.
 //
 // If the MutableHashSet is nil, MutableHashSet.Immutable returns nil.
@@ -214,7 +411,7 @@ func (s *MutableHashSet[E]) Immutable() Set[E] {
 		var ns *HashSet[E]
 		return ns
 	}
-	return &HashSet[E]{internal.Clone[E](s.elements)}
+	return &HashSet[E]{elements: internal.Clone[E](s.elements)}
 }
 
 // Intersection returns a new MutableHashSet struct containing only elements of the MutableHashSet that also exist in
@@ -226,7 +423,17 @@ func (s *MutableHashSet[E]) Intersection(other Set[E]) Set[E] {
 		var ns *MutableHashSet[E]
 		return ns
 	}
-	return &MutableHashSet[E]{internal.Intersection[E](s.elements, other)}
+	return s.with(s.interned(internal.Intersection[E](s.elements, other)))
+}
+
+// IsDisjoint returns whether the MutableHashSet has no elements in common with another Set. Only the elements within
+// the smaller of the two are iterated, with each being checked for within the larger.
+//
+// Any nil other Set, or other Set containing no elements, is disjoint from the MutableHashSet.
+//
+// If the MutableHashSet is nil, MutableHashSet.IsDisjoint returns true.
+func (s *MutableHashSet[E]) IsDisjoint(other Set[E]) bool {
+	return disjoint[E](s.Len(), s.Contains, s.Range, other)
 }
 
 // IsEmpty returns whether the MutableHashSet contains no elements.
@@ -258,6 +465,24 @@ func (s *MutableHashSet[E]) Join(sep string, convert func(element E) string) str
 	return internal.Join[E](s.elements, sep, convert)
 }
 
+// Keys returns a map containing all elements of the MutableHashSet as keys, which can be useful when integrating with
+// APIs that expect a map rather than a slice.
+//
+// The returned map is always a copy so can be modified freely without affecting the MutableHashSet.
+//
+// If the MutableHashSet is nil, MutableHashSet.Keys returns nil.
+func (s *MutableHashSet[E]) Keys() map[E]struct{} {
+	if s == nil {
+		return nil
+	}
+	return internal.Clone[E](s.elements)
+}
+
+// Kind always returns MutableHashKind to conform with Set.Kind.
+func (s *MutableHashSet[E]) Kind() SetKind {
+	return MutableHashKind
+}
+
 // Len returns the number of elements within the MutableHashSet.
 //
 // If the MutableHashSet is nil, MutableHashSet.Len returns zero.
@@ -311,6 +536,22 @@ func (s *MutableHashSet[E]) None(predicate func(element E) bool) bool {
 	return internal.None[E](s.elements, predicate)
 }
 
+// Pop removes an arbitrary element from the MutableHashSet and returns it as well as an indication of whether the
+// MutableHashSet contained any elements.
+//
+// If the MutableHashSet is nil, MutableHashSet.Pop is a no-op and returns the zero value for E and false.
+func (s *MutableHashSet[E]) Pop() (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	element, ok := internal.Pop[E](s.elements)
+	if ok {
+		s.order.Load().Delete(element, nil)
+	}
+	return element, ok
+}
+
 // Put adds the element to the MutableHashSet as well as any additional elements specified. Nothing changes for elements
 // that already exist within the MutableHashSet.
 //
@@ -322,7 +563,13 @@ func (s *MutableHashSet[E]) Put(element E, elements ...E) MutableSet[E] {
 		var ns *MutableHashSet[E]
 		return ns
 	}
-	internal.Put[E](s.elements, element, elements)
+	if s.interner != nil {
+		s.interner.put(s.elements, element)
+		s.interner.putSlice(s.elements, elements)
+	} else {
+		internal.Put[E](s.elements, element, elements)
+	}
+	s.order.Load().Put(element, elements)
 	return s
 }
 
@@ -337,7 +584,19 @@ func (s *MutableHashSet[E]) PutAll(elements Set[E]) MutableSet[E] {
 		var ns *MutableHashSet[E]
 		return ns
 	}
-	internal.PutAll[E](s.elements, elements)
+	if s.interner != nil {
+		if elements != nil {
+			s.interner.putSlice(s.elements, elements.Slice())
+		}
+	} else {
+		internal.PutAll[E](s.elements, elements)
+	}
+	if o := s.order.Load(); o != nil && elements != nil {
+		elements.Range(func(element E) bool {
+			o.Put(element, nil)
+			return false
+		})
+	}
 	return s
 }
 
@@ -352,7 +611,12 @@ func (s *MutableHashSet[E]) PutSlice(elements []E) MutableSet[E] {
 		var ns *MutableHashSet[E]
 		return ns
 	}
-	internal.PutSlice[E](s.elements, elements)
+	if s.interner != nil {
+		s.interner.putSlice(s.elements, elements)
+	} else {
+		internal.PutSlice[E](s.elements, elements)
+	}
+	s.order.Load().PutSlice(elements)
 	return s
 }
 
@@ -368,6 +632,39 @@ func (s *MutableHashSet[E]) Range(iter func(element E) bool) {
 	}
 }
 
+// RangeContext calls the iter function with each element within the MutableHashSet, the same as MutableHashSet.Range,
+// except that the context is checked before each element. If the context is done, iteration stops and the error of the
+// context is returned.
+//
+// Iteration order is not guaranteed to be consistent.
+//
+// If the MutableHashSet is nil, MutableHashSet.RangeContext is a no-op and returns nil.
+func (s *MutableHashSet[E]) RangeContext(ctx context.Context, iter func(element E) bool) error {
+	if s == nil {
+		return nil
+	}
+	return rangeContext[E](ctx, s.Range, iter)
+}
+
+// ReplaceElement removes the old element from the MutableHashSet and adds the new element in its place, but only if the
+// old element is present, and returns whether it was present. If the new element already exists within the
+// MutableHashSet, the old element is simply removed.
+//
+// If the MutableHashSet is nil, MutableHashSet.ReplaceElement is a no-op and returns false.
+func (s *MutableHashSet[E]) ReplaceElement(oldElement, newElement E) bool {
+	if s == nil {
+		return false
+	}
+	if _, ok := s.elements[oldElement]; ok && s.interner != nil {
+		newElement = s.interner.Intern(newElement)
+	}
+	replaced := internal.ReplaceElement[E](s.elements, oldElement, newElement)
+	if replaced {
+		s.order.Load().Replace(oldElement, newElement)
+	}
+	return replaced
+}
+
 // Retain removes all elements from the MutableHashSet except the element(s) specified.
 //
 // If the MutableHashSet is nil, MutableHashSet.Retain is a no-op.
@@ -379,6 +676,8 @@ func (s *MutableHashSet[E]) Retain(element E, elements ...E) MutableSet[E] {
 		return ns
 	}
 	s.elements = internal.Retaining[E](s.elements, element, elements)
+	s.capacity = 0
+	s.order.Load().Prune(s.elements)
 	return s
 }
 
@@ -393,6 +692,26 @@ func (s *MutableHashSet[E]) RetainAll(elements Set[E]) MutableSet[E] {
 		return ns
 	}
 	s.elements = internal.RetainingAll[E](s.elements, elements)
+	s.capacity = 0
+	s.order.Load().Prune(s.elements)
+	return s
+}
+
+// RetainAllOf removes all elements from the MutableHashSet except those that exist within all the specified Set. That
+// is; the MutableHashSet is intersected with each Set in place. As with MutableHashSet.RetainAll, any nil Set is
+// treated as having no elements. If no Set is specified, nothing is removed.
+//
+// If the MutableHashSet is nil, MutableHashSet.RetainAllOf is a no-op.
+//
+// A reference to the MutableHashSet is returned for method chaining.
+func (s *MutableHashSet[E]) RetainAllOf(sets ...Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *MutableHashSet[E]
+		return ns
+	}
+	s.elements = internal.RetainingAllOf[E](s.elements, asCollections(sets))
+	s.capacity = 0
+	s.order.Load().Prune(s.elements)
 	return s
 }
 
@@ -407,6 +726,8 @@ func (s *MutableHashSet[E]) RetainSlice(elements []E) MutableSet[E] {
 		return ns
 	}
 	s.elements = internal.RetainingSlice[E](s.elements, elements)
+	s.capacity = 0
+	s.order.Load().Prune(s.elements)
 	return s
 }
 
@@ -421,9 +742,51 @@ func (s *MutableHashSet[E]) RetainWhere(predicate func(element E) bool) MutableS
 		return ns
 	}
 	s.elements = internal.RetainingWhere[E](s.elements, predicate)
+	s.capacity = 0
+	s.order.Load().Prune(s.elements)
 	return s
 }
 
+// Sample returns a pseudo-random element within the MutableHashSet as well as an indication of whether the
+// MutableHashSet contained any elements.
+//
+// If the MutableHashSet is nil, MutableHashSet.Sample returns the zero value for E and false.
+func (s *MutableHashSet[E]) Sample() (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	return sample[E](s.Range, s.Len())
+}
+
+// SampleN returns a new MutableHashSet struct containing up to n distinct elements of the MutableHashSet chosen
+// pseudo-randomly. All elements are included if n is greater than or equal to the number of elements within the
+// MutableHashSet, while none are included if n is not positive.
+//
+// If the MutableHashSet is nil, MutableHashSet.SampleN returns nil.
+func (s *MutableHashSet[E]) SampleN(n int) Set[E] {
+	return s.SampleNWithRand(n, nil)
+}
+
+// SampleNWithRand returns a new MutableHashSet struct containing up to n distinct elements of the MutableHashSet chosen
+// pseudo-randomly using the provided source, the same as MutableHashSet.SampleN otherwise. If the source is nil, the
+// default source is used.
+//
+// Elements are chosen in an arbitrary order unless MutableHashSet.StableRange has already been called on the
+// MutableHashSet, in which case the order it maintains is used instead. MutableHashSet.SampleNWithRand never starts
+// maintaining that order itself. Therefore, repeated calls on the same MutableHashSet using sources that are seeded
+// identically are only guaranteed to choose the same elements once MutableHashSet.StableRange has been called, and only
+// for as long as the MutableHashSet is not modified.
+//
+// If the MutableHashSet is nil, MutableHashSet.SampleNWithRand returns nil.
+func (s *MutableHashSet[E]) SampleNWithRand(n int, r *rand.Rand) Set[E] {
+	if s == nil {
+		var ns *MutableHashSet[E]
+		return ns
+	}
+	return s.with(sampleN[E](s.order.Load().Snapshot(s.elements), n, r))
+}
+
 // Slice returns a slice containing all elements of the MutableHashSet.
 //
 // The order of elements within the resulting slice is not guaranteed to be consistent. MutableHashSet.SortedSlice
@@ -447,6 +810,19 @@ func (s *MutableHashSet[E]) Some(predicate func(element E) bool) bool {
 	return internal.Some[E](s.elements, predicate)
 }
 
+// SortedAppendSlice appends all elements of the MutableHashSet to the dst slice, sorted using the provided less
+// function, and returns the extended slice. Only the appended elements are sorted so any elements already within dst
+// are left untouched. This allows a buffer to be reused across calls, avoiding the allocation made by
+// MutableHashSet.SortedSlice each time.
+//
+// If the MutableHashSet is nil, MutableHashSet.SortedAppendSlice returns dst unchanged.
+func (s *MutableHashSet[E]) SortedAppendSlice(dst []E, less func(x, y E) bool) []E {
+	if s == nil {
+		return dst
+	}
+	return sortedAppendSlice[E](s, dst, less)
+}
+
 // SortedJoin sorts the elements within the MutableHashSet using the provided less function and then converts those
 // elements into strings which are then joined using the specified separator to create the resulting string.
 //
@@ -458,6 +834,20 @@ func (s *MutableHashSet[E]) SortedJoin(sep string, convert func(element E) strin
 	return internal.SortedJoin[E](s.elements, sep, convert, less)
 }
 
+// SortedRange calls the iter function with each element within the MutableHashSet, sorted using the provided less
+// function, along with the zero-based index of that element within the sorted order, but will stop early whenever the
+// iter function returns true.
+//
+// Unlike MutableHashSet.Range, iteration order is deterministic.
+//
+// If the MutableHashSet is nil, MutableHashSet.SortedRange is a no-op.
+func (s *MutableHashSet[E]) SortedRange(less func(x, y E) bool, iter func(index int, element E) bool) {
+	if s == nil {
+		return
+	}
+	sortedRange[E](s, less, iter)
+}
+
 // SortedSlice returns a slice containing all elements of the MutableHashSet sorted using the provided less function.
 //
 // If the MutableHashSet is nil, MutableHashSet.SortedSlice returns nil.
@@ -468,6 +858,57 @@ func (s *MutableHashSet[E]) SortedSlice(less func(x, y E) bool) []E {
 	return internal.SortedSlice[E](s.elements, less)
 }
 
+// StableRange calls the iter function with each element within the MutableHashSet but will stop early whenever the iter
+// function returns true.
+//
+// Unlike MutableHashSet.Range, iteration order is stable across repeated calls on the same MutableHashSet, which can be
+// useful for reproducibility (e.g. within tests). No less function is required, unlike MutableHashSet.SortedSlice.
+// Instead, the order of elements is only tracked from the first call to MutableHashSet.StableRange, so the cost of
+// doing so is only incurred when needed. Until then, the only cost is an atomic load whenever the MutableHashSet is
+// modified to check whether tracking has been enabled. Elements already within the MutableHashSet at that point are
+// ordered arbitrarily, while elements added afterwards are iterated after them in the order they were added. Elements
+// that are deleted from the MutableHashSet never affect the order of the remaining elements. Tracking stops, and its
+// order is discarded, whenever the MutableHashSet is cleared, decoded, or unmarshalled into.
+//
+// If the MutableHashSet is nil, MutableHashSet.StableRange is a no-op.
+func (s *MutableHashSet[E]) StableRange(iter func(element E) bool) {
+	if s == nil {
+		return
+	}
+	for _, element := range s.stableOrder().Snapshot(s.elements) {
+		if iter(element) {
+			break
+		}
+	}
+}
+
+// Take removes the element from the MutableHashSet and returns the element that was stored within the MutableHashSet as
+// well as an indication of whether it was present. As elements are compared using equality, the returned element is
+// always equal to the element provided when present.
+//
+// If the MutableHashSet is nil, MutableHashSet.Take is a no-op and returns the zero value for E and false.
+func (s *MutableHashSet[E]) Take(element E) (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	element, ok := internal.Take[E](s.elements, element)
+	if ok {
+		s.order.Load().Delete(element, nil)
+	}
+	return element, ok
+}
+
+// ToMap returns a map containing all elements of the MutableHashSet as keys, which is equivalent to
+// MutableHashSet.Keys.
+//
+// The returned map is always a copy so can be modified freely without affecting the MutableHashSet.
+//
+// If the MutableHashSet is nil, MutableHashSet.ToMap returns nil.
+func (s *MutableHashSet[E]) ToMap() map[E]struct{} {
+	return s.Keys()
+}
+
 // TryRange calls the iter function with each element within the MutableHashSet but will stop early whenever the iter
 // function returns an error.
 //
@@ -486,7 +927,10 @@ func (s *MutableHashSet[E]) TryRange(iter func(element E) error) error {
 // If the MutableHashSet and the other Set are both nil, MutableHashSet.Union returns nil.
 func (s *MutableHashSet[E]) Union(other Set[E]) Set[E] {
 	if elements := internal.Union[E](s, other); elements != nil {
-		return &MutableHashSet[E]{elements}
+		if s == nil {
+			return &MutableHashSet[E]{elements: elements}
+		}
+		return s.with(s.interned(elements))
 	}
 	var ns *MutableHashSet[E]
 	return ns
@@ -499,6 +943,30 @@ func (s *MutableHashSet[E]) String() string {
 	return internal.String[E](s.elements)
 }
 
+func (s *MutableHashSet[E]) GobEncode() ([]byte, error) {
+	if s == nil {
+		return internal.MarshalGobNil[E]()
+	}
+	return internal.MarshalGob[E](s.elements)
+}
+
+func (s *MutableHashSet[E]) GobDecode(data []byte) error {
+	if elements, err := internal.UnmarshalGob[E](data); err != nil {
+		return err
+	} else if s.interner != nil {
+		s.elements = make(internal.Hash[E], len(elements))
+		s.capacity = 0
+		s.interner.putHash(s.elements, elements)
+		s.order.Store(nil)
+		return nil
+	} else {
+		s.elements = elements
+		s.capacity = 0
+		s.order.Store(nil)
+		return nil
+	}
+}
+
 func (s *MutableHashSet[E]) MarshalJSON() ([]byte, error) {
 	if s == nil {
 		return internal.MarshalJSONNil()
@@ -509,18 +977,99 @@ func (s *MutableHashSet[E]) MarshalJSON() ([]byte, error) {
 func (s *MutableHashSet[E]) UnmarshalJSON(data []byte) error {
 	if elements, err := internal.UnmarshalJSON[E](data); err != nil {
 		return err
+	} else if s.interner != nil {
+		s.elements = make(internal.Hash[E], len(elements))
+		s.capacity = 0
+		s.interner.putHash(s.elements, elements)
+		s.order.Store(nil)
+		return nil
 	} else {
 		s.elements = elements
+		s.capacity = 0
+		s.order.Store(nil)
 		return nil
 	}
 }
 
+func (s *MutableHashSet[E]) Scan(src any) error {
+	data, err := scanJSON(src)
+	if err != nil {
+		return err
+	}
+	return s.UnmarshalJSON(data)
+}
+
+func (s *MutableHashSet[E]) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	data, err := s.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// interned returns the internal.Hash with each element replaced by its canonical copy, as returned by the Interner of
+// the MutableHashSet. If the MutableHashSet has no Interner, the internal.Hash is returned unchanged.
+func (s *MutableHashSet[E]) interned(elements internal.Hash[E]) internal.Hash[E] {
+	if s.interner == nil {
+		return elements
+	}
+	hash := make(internal.Hash[E], len(elements))
+	s.interner.putHash(hash, elements)
+	return hash
+}
+
+// stableOrder returns the internal.Order used to maintain a stable iteration order for MutableHashSet.StableRange,
+// creating it from the elements currently within the MutableHashSet if it does not already exist.
+//
+// Once created, the internal.Order must be kept in sync with every change made to the elements within the
+// MutableHashSet.
+func (s *MutableHashSet[E]) stableOrder() *internal.Order[E] {
+	if o := s.order.Load(); o != nil {
+		return o
+	}
+	s.order.CompareAndSwap(nil, internal.NewOrder[E](s.elements))
+	return s.order.Load()
+}
+
+// with returns a new MutableHashSet containing the elements provided, sharing the Interner of the MutableHashSet, if
+// any. Any elements that may not have been routed through that Interner must be passed through
+// MutableHashSet.interned beforehand.
+func (s *MutableHashSet[E]) with(elements internal.Hash[E]) *MutableHashSet[E] {
+	return &MutableHashSet[E]{elements: elements, interner: s.interner}
+}
+
 // MutableHash returns a MutableHashSet struct that implements MutableSet containing each unique element provided.
 //
 // As MutableHash returns a mutable struct it is not safe for concurrent use by multiple goroutines. SyncHash should be
 // used instead for such cases where mutability is required, otherwise Hash for a simple immutable Set.
 func MutableHash[E comparable](elements ...E) *MutableHashSet[E] {
-	return &MutableHashSet[E]{internal.FromSlice[E](elements)}
+	return &MutableHashSet[E]{elements: internal.FromSlice[E](elements)}
+}
+
+// MutableHashFromDelimited returns a MutableHashSet struct that implements MutableSet containing each unique element
+// parsed from the delimited string provided, where the string is split into tokens separated by sep and each token is
+// converted into an element using the parse function. This is useful for parsing configuration (e.g. an allowlist
+// within an environment variable or CLI flag) such as "a, b, c".
+//
+// Whitespace surrounding each token is trimmed and any token that is empty as a result is skipped, so a string that is
+// empty, or only contains separators and whitespace, produces an empty MutableHashSet. If sep is empty, the string is
+// split after each UTF-8 sequence, as with strings.Split.
+//
+// If the parse function returns an error for any token, parsing stops and an ErrDelimitedToken is returned, including
+// the offending token and wrapping the error.
+//
+// As MutableHashFromDelimited returns a mutable struct it is not safe for concurrent use by multiple goroutines.
+// SyncHashFromDelimited should be used instead for such cases where mutability is required, otherwise
+// HashFromDelimited for a simple immutable Set.
+func MutableHashFromDelimited[E comparable](s, sep string, parse func(token string) (E, error)) (*MutableHashSet[E], error) {
+	elements, err := parseDelimited[E](s, sep, parse)
+	if err != nil {
+		return nil, err
+	}
+	return &MutableHashSet[E]{elements: elements}, nil
 }
 
 // MutableHashFromJSON returns a MutableHashSet struct that implements MutableSet containing each unique element parsed
@@ -537,6 +1086,39 @@ func MutableHashFromJSON[E comparable](data []byte) (*MutableHashSet[E], error)
 	return set, nil
 }
 
+// MutableHashFromJSONInteger returns a MutableHashSet struct that implements MutableSet containing each unique integer
+// element parsed from the JSON-encoded data provided.
+//
+// Unlike MutableHashFromJSON, each number is first decoded as a json.Number so that an ErrJSONInteger is returned for
+// any number that cannot be represented exactly by E (e.g. 1.5, or 256 when E is uint8) instead of it being silently
+// truncated. Numbers using exponents are accepted so long as they represent integers (e.g. 1e3).
+func MutableHashFromJSONInteger[E constraints.Integer](data []byte) (*MutableHashSet[E], error) {
+	elements, err := unmarshalJSONInteger[E](data)
+	if err != nil {
+		return nil, err
+	}
+	return &MutableHashSet[E]{elements: elements}, nil
+}
+
+// MutableHashFromJSONReader returns a MutableHashSet struct that implements MutableSet containing each unique element
+// decoded from the JSON array read from the io.Reader provided.
+//
+// Unlike MutableHashFromJSON, elements are decoded and added one at a time so that the full array is never held in
+// memory, which is better suited to large arrays. A top-level JSON null produces an empty MutableHashSet, while an
+// ErrJSONArray is returned for any other value that is not an array. Any data following the array may be read from the
+// io.Reader into a buffer and lost.
+//
+// As MutableHashFromJSONReader returns a mutable struct it is not safe for concurrent use by multiple goroutines.
+// SyncHashFromJSONReader should be used instead for such cases where mutability is required, otherwise
+// HashFromJSONReader for a simple immutable Set.
+func MutableHashFromJSONReader[E comparable](r io.Reader) (*MutableHashSet[E], error) {
+	elements, err := unmarshalJSONReader[E](r)
+	if err != nil {
+		return nil, err
+	}
+	return &MutableHashSet[E]{elements: elements}, nil
+}
+
 // MutableHashFromSlice returns a MutableHashSet struct that implements MutableSet containing each unique element from
 // the slice provided.
 //
@@ -544,5 +1126,48 @@ func MutableHashFromJSON[E comparable](data []byte) (*MutableHashSet[E], error)
 // SyncHashFromSlice should be used instead for such cases where mutability is required, otherwise HashFromSlice for a
 // simple immutable Set.
 func MutableHashFromSlice[E comparable](elements []E) *MutableHashSet[E] {
-	return &MutableHashSet[E]{internal.FromSlice[E](elements)}
+	return &MutableHashSet[E]{elements: internal.FromSlice[E](elements)}
+}
+
+// MutableHashFromSliceFilter returns a MutableHashSet struct that implements MutableSet containing each unique element
+// from the slice provided for which the keep function returns true. This avoids building a MutableHashSet only to then
+// filter it.
+//
+// If keep is nil, all elements within the slice are kept, making MutableHashFromSliceFilter equivalent to
+// MutableHashFromSlice.
+//
+// As MutableHashFromSliceFilter returns a mutable struct it is not safe for concurrent use by multiple goroutines.
+// SyncHashFromSliceFilter should be used instead for such cases where mutability is required, otherwise
+// HashFromSliceFilter for a simple immutable Set.
+func MutableHashFromSliceFilter[E comparable](elements []E, keep func(element E) bool) *MutableHashSet[E] {
+	return &MutableHashSet[E]{elements: internal.FromSliceFilter[E](elements, keep)}
+}
+
+// MutableHashWithCapacity returns a MutableHashSet struct that implements MutableSet containing each unique element
+// provided, pre-sized to hold at least capacity elements. This avoids the cost of the underlying map being grown
+// repeatedly while it is being populated (e.g. via MutableHashSet.Put) when the number of elements is known, or can be
+// estimated, in advance.
+//
+// If capacity is less than the number of elements provided, the MutableHashSet is instead sized to hold all of them.
+//
+// As MutableHashWithCapacity returns a mutable struct it is not safe for concurrent use by multiple goroutines.
+// SyncHashWithCapacity should be used instead for such cases where mutability is required, otherwise HashWithCapacity
+// for a simple immutable Set.
+func MutableHashWithCapacity[E comparable](capacity int, elements ...E) *MutableHashSet[E] {
+	if capacity < len(elements) {
+		capacity = len(elements)
+	}
+	return &MutableHashSet[E]{elements: internal.FromSliceWithCapacity[E](capacity, elements), capacity: capacity}
+}
+
+// MutableProject returns a MutableHashSet struct that implements MutableSet containing each unique value returned by
+// the field function for each item in the slice provided. For example; this can be used to collect the IDs of a slice
+// of users.
+//
+// If items is nil, an empty MutableHashSet is returned.
+//
+// As MutableProject returns a mutable struct it is not safe for concurrent use by multiple goroutines. SyncProject
+// should be used instead for such cases where mutability is required, otherwise Project for a simple immutable Set.
+func MutableProject[T any, K comparable](items []T, field func(item T) K) *MutableHashSet[K] {
+	return &MutableHashSet[K]{elements: internal.Project(items, field)}
 }
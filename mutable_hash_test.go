@@ -21,11 +21,20 @@
 package sets
 
 import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"encoding/gob"
 	"encoding/json"
 	"errors"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/neocotic/go-sets/internal"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -57,6 +66,81 @@ func Test_MutableHash(t *testing.T) {
 	}
 }
 
+func Test_MutableHashFromDelimited(t *testing.T) {
+	testCases := map[string]struct {
+		expectElements []int
+		s              string
+		sep            string
+	}{
+		"with comma-separated string": {
+			expectElements: []int{123, 456, 789},
+			s:              "123,456,789",
+			sep:            ",",
+		},
+		"with whitespace surrounding tokens": {
+			expectElements: []int{123, 456, 789},
+			s:              " 123 ,\t456,789\n",
+			sep:            ",",
+		},
+		"with empty tokens": {
+			expectElements: []int{123, 456},
+			s:              ",123,, ,456,",
+			sep:            ",",
+		},
+		"with duplicated tokens": {
+			expectElements: []int{123, 456},
+			s:              "123,456,123",
+			sep:            ",",
+		},
+		"with multi-character separator": {
+			expectElements: []int{123, 456},
+			s:              "123 | 456",
+			sep:            " | ",
+		},
+		"with empty string": {
+			expectElements: []int{},
+			s:              "",
+			sep:            ",",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set, err := MutableHashFromDelimited(tc.s, tc.sep, strconv.Atoi)
+			if err != nil {
+				t.Errorf("unexpected error; want nil, got %q", err)
+			} else if set == nil {
+				t.Error("unexpected nil Set")
+			} else {
+				if set.IsMutable() != true {
+					t.Errorf("unexpected Set mutability; want %v, got %v", true, set.IsMutable())
+				}
+
+				opts := []cmp.Option{cmpopts.SortSlices(Asc[int])}
+				if diff := cmp.Diff(tc.expectElements, set.Slice(), opts...); diff != "" {
+					t.Errorf("unexpected parsed elements (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func Test_MutableHashFromDelimited_Error(t *testing.T) {
+	set, err := MutableHashFromDelimited("123, abc ,456", ",", strconv.Atoi)
+	if set != nil {
+		t.Errorf("unexpected Set; want nil, got %v", set)
+	}
+	if !errors.Is(err, ErrDelimitedToken) {
+		t.Errorf("unexpected error; want %q, got %q", ErrDelimitedToken, err)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("unexpected wrapped error; want %q, got %q", strconv.ErrSyntax, err)
+	}
+	if err != nil && !strings.Contains(err.Error(), `"abc"`) {
+		t.Errorf("unexpected error message; want offending token %q, got %q", "abc", err)
+	}
+}
+
 func Test_MutableHashFromJSON(t *testing.T) {
 	testCases := map[string]struct {
 		expectElements []int
@@ -109,6 +193,209 @@ func Test_MutableHashFromJSON(t *testing.T) {
 	}
 }
 
+func Test_MutableHashFromJSONInteger(t *testing.T) {
+	testCases := map[string]struct {
+		expectElements []int64
+		json           string
+	}{
+		"with JSON string for array containing multiple elements": {
+			expectElements: []int64{-123, 456, 789},
+			json:           "[-123,456,789]",
+		},
+		"with JSON string for array containing elements using exponents": {
+			expectElements: []int64{1000, 120},
+			json:           "[1e3,1.2E2,1000]",
+		},
+		"with JSON string for array containing elements using zero fractional parts": {
+			expectElements: []int64{123},
+			json:           "[123.0]",
+		},
+		"with JSON string for array containing duplicated elements": {
+			expectElements: []int64{123, 456},
+			json:           "[123,456,123]",
+		},
+		"with JSON string for array containing null element": {
+			expectElements: []int64{0},
+			json:           "[null]",
+		},
+		"with JSON string for array containing large elements": {
+			expectElements: []int64{9223372036854775807, -9223372036854775808},
+			json:           "[9223372036854775807,-9223372036854775808]",
+		},
+		"with JSON string for empty array": {
+			expectElements: []int64{},
+			json:           "[]",
+		},
+		"with JSON string for null": {
+			expectElements: []int64{},
+			json:           "null",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set, err := MutableHashFromJSONInteger[int64]([]byte(tc.json))
+			if err != nil {
+				t.Errorf("unexpected error; want nil, got %q", err)
+			} else if set == nil {
+				t.Error("unexpected nil Set")
+			} else {
+				if !set.IsMutable() {
+					t.Error("unexpected Set mutability; want true, got false")
+				}
+
+				opts := []cmp.Option{cmpopts.SortSlices(Asc[int64])}
+				if actualElements := set.Slice(); !cmp.Equal(tc.expectElements, actualElements, opts...) {
+					t.Errorf("unexpected unmarshalled elements; got diff %v", cmp.Diff(tc.expectElements, actualElements, opts...))
+				}
+			}
+		})
+	}
+}
+
+func Test_MutableHashFromJSONInteger_Error(t *testing.T) {
+	testCases := map[string]struct {
+		expectErr error
+		json      string
+	}{
+		"with JSON string for array containing element with fractional part": {
+			expectErr: ErrJSONInteger,
+			json:      "[123,1.5]",
+		},
+		"with JSON string for array containing element using exponent with fractional part": {
+			expectErr: ErrJSONInteger,
+			json:      "[1e-3]",
+		},
+		"with JSON string for array containing element overflowing int8": {
+			expectErr: ErrJSONInteger,
+			json:      "[128]",
+		},
+		"with JSON string for array containing element underflowing int8": {
+			expectErr: ErrJSONInteger,
+			json:      "[-129]",
+		},
+		"with JSON string for array containing string element": {
+			json: "[\"abc\"]",
+		},
+		"with JSON string for object": {
+			json: "{}",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set, err := MutableHashFromJSONInteger[int8]([]byte(tc.json))
+			if err == nil {
+				t.Error("unexpected nil error")
+			} else if tc.expectErr != nil && !errors.Is(err, tc.expectErr) {
+				t.Errorf("unexpected error; want %q, got %q", tc.expectErr, err)
+			}
+			if set != nil {
+				t.Errorf("unexpected Set; want nil, got %v", set)
+			}
+		})
+	}
+}
+
+func Test_MutableHashFromJSONReader(t *testing.T) {
+	testCases := map[string]struct {
+		expectElements []int
+		json           string
+	}{
+		"with JSON string for array containing multiple elements": {
+			expectElements: []int{123, 456, 789},
+			json:           "[123,456,789]",
+		},
+		"with JSON string for array containing single element": {
+			expectElements: []int{123},
+			json:           "[123]",
+		},
+		"with JSON string for array containing duplicated elements": {
+			expectElements: []int{123, 456, 789},
+			json:           "[123,456,789,456,123]",
+		},
+		"with JSON string for array containing null element": {
+			expectElements: []int{0},
+			json:           "[null]",
+		},
+		"with JSON string for array containing whitespace": {
+			expectElements: []int{123, 456},
+			json:           " [ 123 ,\n 456 ] ",
+		},
+		"with JSON string for empty array": {
+			expectElements: []int{},
+			json:           "[]",
+		},
+		"with JSON string for null": {
+			expectElements: []int{},
+			json:           "null",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set, err := MutableHashFromJSONReader[int](strings.NewReader(tc.json))
+			if err != nil {
+				t.Errorf("unexpected error; want nil, got %q", err)
+			} else if set == nil {
+				t.Error("unexpected nil Set")
+			} else {
+				if !set.IsMutable() {
+					t.Error("unexpected Set mutability; want true, got false")
+				}
+
+				opts := []cmp.Option{cmpopts.SortSlices(Asc[int])}
+				if actualElements := set.Slice(); !cmp.Equal(tc.expectElements, actualElements, opts...) {
+					t.Errorf("unexpected unmarshalled elements; got diff %v", cmp.Diff(tc.expectElements, actualElements, opts...))
+				}
+			}
+		})
+	}
+}
+
+func Test_MutableHashFromJSONReader_Error(t *testing.T) {
+	testCases := map[string]struct {
+		expectErr error
+		json      string
+	}{
+		"with JSON string for object": {
+			expectErr: ErrJSONArray,
+			json:      "{\"foo\":123}",
+		},
+		"with JSON string for string": {
+			expectErr: ErrJSONArray,
+			json:      "\"abc\"",
+		},
+		"with JSON string for number": {
+			expectErr: ErrJSONArray,
+			json:      "123",
+		},
+		"with JSON string for array containing string element": {
+			json: "[123,\"abc\"]",
+		},
+		"with JSON string for unterminated array": {
+			json: "[123,456",
+		},
+		"with empty JSON string": {
+			json: "",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set, err := MutableHashFromJSONReader[int](strings.NewReader(tc.json))
+			if err == nil {
+				t.Error("unexpected nil error")
+			} else if tc.expectErr != nil && !errors.Is(err, tc.expectErr) {
+				t.Errorf("unexpected error; want %q, got %q", tc.expectErr, err)
+			}
+			if set != nil {
+				t.Errorf("unexpected Set; want nil, got %v", set)
+			}
+		})
+	}
+}
+
 func Test_MutableHashFromSlice(t *testing.T) {
 	testCases := map[string]struct {
 		elements []int
@@ -137,126 +424,633 @@ func Test_MutableHashFromSlice(t *testing.T) {
 	}
 }
 
+func Test_MutableHashSet_All(t *testing.T) {
+	var elements []int
+	MutableHash(123, 456, 789).All()(func(element int) bool {
+		elements = append(elements, element)
+		return true
+	})
+	if diff := cmp.Diff([]int{123, 456, 789}, elements, cmpopts.SortSlices(Asc[int])); diff != "" {
+		t.Errorf("unexpected yielded elements (-want +got):\n%s", diff)
+	}
+}
+
+func Test_MutableHashSet_All_Break(t *testing.T) {
+	set := MutableHash(123, 456, 789)
+	var callCount int
+	set.All()(func(element int) bool {
+		callCount++
+		if !set.Contains(element) {
+			t.Errorf("unexpected yielded element; got %v", element)
+		}
+		return callCount < 2
+	})
+	if callCount != 2 {
+		t.Errorf("unexpected number of calls to yield; want 2, got %v", callCount)
+	}
+}
+
+func Test_MutableHashSet_All_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	var callCount int
+	set.All()(func(_ int) bool {
+		callCount++
+		return true
+	})
+	if callCount != 0 {
+		t.Errorf("unexpected number of calls to yield; want 0, got %v", callCount)
+	}
+}
+
+func Test_MutableHashSet_AppendSlice(t *testing.T) {
+	testCases := map[string]struct {
+		set *MutableHashSet[int]
+		dst []int
+	}{
+		"with nil dst on non-empty *MutableHashSet": {
+			set: MutableHash(123, 456, 789),
+			dst: nil,
+		},
+		"with non-empty dst on non-empty *MutableHashSet": {
+			set: MutableHash(123, 456, 789),
+			dst: []int{1, 2},
+		},
+		"with dst having spare capacity on non-empty *MutableHashSet": {
+			set: MutableHash(123, 456, 789),
+			dst: make([]int, 0, 8),
+		},
+		"with nil dst on empty *MutableHashSet": {
+			set: MutableHash[int](),
+			dst: nil,
+		},
+		"with non-empty dst on empty *MutableHashSet": {
+			set: MutableHash[int](),
+			dst: []int{1, 2},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dst := append([]int(nil), tc.dst...)
+			ret := tc.set.AppendSlice(tc.dst)
+
+			if expectLen := len(tc.dst) + tc.set.Len(); len(ret) != expectLen {
+				t.Fatalf("unexpected slice length; want %v, got %v", expectLen, len(ret))
+			}
+			if !cmp.Equal(dst, ret[:len(tc.dst)], cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected leading elements; want %v, got %v", dst, ret[:len(tc.dst)])
+			}
+			if tail := ret[len(tc.dst):]; !HashFromSlice(tail).Equal(tc.set) {
+				t.Errorf("unexpected appended elements; want %v, got %v", tc.set, tail)
+			}
+			if cap(tc.dst) >= len(ret) && len(ret) > 0 && &tc.dst[:1][0] != &ret[0] {
+				t.Error("unexpected slice allocation; want dst to be reused")
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_AppendSlice_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	dst := []int{1, 2}
+	ret := set.AppendSlice(dst)
+
+	if !cmp.Equal(dst, ret) {
+		t.Errorf("unexpected slice; want %v, got %v", dst, ret)
+	}
+}
+
+func Test_MutableHashSet_Canonical(t *testing.T) {
+	testCases := map[string]struct {
+		expect string
+		set    *MutableHashSet[string]
+	}{
+		"with no elements": {
+			expect: "",
+			set:    MutableHash[string](),
+		},
+		"with elements": {
+			expect: "bar,baz,foo",
+			set:    MutableHash[string]("foo", "bar", "baz"),
+		},
+		"with elements requiring escaping": {
+			expect: `a\,,b,c\\`,
+			set:    MutableHash[string]("b", `c\`, "a,"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			canonical := string(tc.set.Canonical(func(element string) string { return element }))
+			if canonical != tc.expect {
+				t.Errorf("unexpected canonical; want %q, got %q", tc.expect, canonical)
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_Canonical_Nil(t *testing.T) {
+	var set *MutableHashSet[string]
+	canonical := set.Canonical(func(element string) string { return element })
+	if canonical == nil || len(canonical) != 0 {
+		t.Errorf("unexpected canonical; want %#v, got %#v", []byte{}, canonical)
+	}
+}
+
 func Test_MutableHashSet_Clear(t *testing.T) {
 	testCases := map[string]struct {
 		set *MutableHashSet[int]
 	}{
-		"on non-empty *MutableHashSet": {
-			set: MutableHash(123, 456, 789),
+		"on non-empty *MutableHashSet": {
+			set: MutableHash(123, 456, 789),
+		},
+		"on empty *MutableHashSet": {
+			set: MutableHash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ret := tc.set.Clear()
+
+			if internal.IsNil(ret) {
+				t.Error("unexpected nil MutableSet")
+			}
+			if tc.set != ret {
+				t.Errorf("unexpected MutableSet; want %v, got %v", tc.set, ret)
+			}
+			if !tc.set.IsEmpty() {
+				t.Error("unexpected MutableSet emptiness; want true, got false")
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_Clear_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	ret := set.Clear()
+
+	if internal.IsNotNil(ret) {
+		t.Errorf("unexpected MutableSet; want nil, got %v", ret)
+	}
+	if !set.IsEmpty() {
+		t.Error("unexpected MutableSet emptiness; want true, got false")
+	}
+}
+
+func Test_MutableHashFromSliceFilter(t *testing.T) {
+	testCases := map[string]struct {
+		elements []int
+		keep     func(element int) bool
+		expect   Set[int]
+	}{
+		"with nil slice": {
+			elements: nil,
+			keep:     func(element int) bool { return element > 0 },
+			expect:   Hash[int](),
+		},
+		"with slice containing no elements": {
+			elements: []int{},
+			keep:     func(element int) bool { return element > 0 },
+			expect:   Hash[int](),
+		},
+		"with slice containing elements all kept": {
+			elements: []int{123, 456, 789},
+			keep:     func(element int) bool { return element > 0 },
+			expect:   Hash(123, 456, 789),
+		},
+		"with slice containing elements some excluded": {
+			elements: []int{-123, 123, 456, -456, 456, 789},
+			keep:     func(element int) bool { return element > 0 },
+			expect:   Hash(123, 456, 789),
+		},
+		"with slice containing elements all excluded": {
+			elements: []int{-123, -456, -789},
+			keep:     func(element int) bool { return element > 0 },
+			expect:   Hash[int](),
+		},
+		"with nil keep": {
+			elements: []int{-123, 123, 456, 456},
+			keep:     nil,
+			expect:   Hash(-123, 123, 456),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := MutableHashFromSliceFilter(tc.elements, tc.keep)
+			if internal.IsNil(set) {
+				t.Fatal("unexpected nil Set")
+			}
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+			if set.IsMutable() != true {
+				t.Error("unexpected Set mutability; want true, got false")
+			}
+		})
+	}
+}
+
+func Test_MutableHashWithCapacity(t *testing.T) {
+	testCases := map[string]struct {
+		capacity int
+		elements []int
+		expect   Set[int]
+	}{
+		"with zero capacity and no elements": {
+			capacity: 0,
+			elements: nil,
+			expect:   Hash[int](),
+		},
+		"with negative capacity and no elements": {
+			capacity: -1,
+			elements: nil,
+			expect:   Hash[int](),
+		},
+		"with capacity and no elements": {
+			capacity: 16,
+			elements: nil,
+			expect:   Hash[int](),
+		},
+		"with capacity greater than number of elements": {
+			capacity: 16,
+			elements: []int{123, 456, 789},
+			expect:   Hash(123, 456, 789),
+		},
+		"with capacity less than number of elements": {
+			capacity: 1,
+			elements: []int{123, 456, 456, 789},
+			expect:   Hash(123, 456, 789),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := MutableHashWithCapacity(tc.capacity, tc.elements...)
+			if internal.IsNil(set) {
+				t.Fatal("unexpected nil Set")
+			}
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+			if set.IsMutable() != true {
+				t.Error("unexpected Set mutability; want true, got false")
+			}
+		})
+	}
+}
+
+func Test_MutableProject(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	testCases := map[string]struct {
+		items  []user
+		expect Set[int]
+	}{
+		"with nil slice": {
+			items:  nil,
+			expect: Hash[int](),
+		},
+		"with slice containing no items": {
+			items:  []user{},
+			expect: Hash[int](),
+		},
+		"with slice containing single item": {
+			items:  []user{{id: 123, name: "alpha"}},
+			expect: Hash(123),
+		},
+		"with slice containing multiple items with unique field values": {
+			items:  []user{{id: 123, name: "alpha"}, {id: 456, name: "beta"}, {id: 789, name: "gamma"}},
+			expect: Hash(123, 456, 789),
+		},
+		"with slice containing multiple items with duplicate field values": {
+			items:  []user{{id: 123, name: "alpha"}, {id: 456, name: "beta"}, {id: 123, name: "gamma"}},
+			expect: Hash(123, 456),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := MutableProject(tc.items, func(item user) int { return item.id })
+			if internal.IsNil(set) {
+				t.Fatal("unexpected nil Set")
+			}
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+			if set.IsMutable() != true {
+				t.Error("unexpected Set mutability; want true, got false")
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_Clone(t *testing.T) {
+	set := MutableHash(123, 456, 789)
+	clone := set.Clone()
+	if internal.IsNil(clone) {
+		t.Error("unexpected nil Set")
+	}
+	if l := clone.Len(); l != 3 {
+		t.Errorf("unexpected cloned Set length; want 3, got %v", l)
+	}
+	if !clone.Equal(set) {
+		t.Errorf("unexpected cloned Set; want %v, got %v", set, clone)
+	}
+	if !clone.IsMutable() {
+		t.Error("unexpected cloned Set mutability; want true, got false")
+	}
+}
+
+func Test_MutableHashSet_Clone_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	clone := set.Clone()
+	if clone == nil {
+		t.Error("unexpected nil Set")
+	}
+	if internal.IsNotNil(clone) {
+		t.Errorf("unexpected cloned Set; want nil, got %#v", clone)
+	}
+	if !clone.IsEmpty() {
+		t.Error("unexpected cloned Set emptiness; want true, got false")
+	}
+	if !clone.IsMutable() {
+		t.Error("unexpected cloned Set mutability; want true, got false")
+	}
+}
+
+func Test_MutableHashSet_Contains(t *testing.T) {
+	testCases := map[string]struct {
+		element int
+		expect  bool
+	}{
+		"with matching element": {
+			element: 123,
+			expect:  true,
+		},
+		"with non-matching zero value for element": {
+			element: 0,
+			expect:  false,
+		},
+		"with non-matching non-zero value for element": {
+			element: 1,
+			expect:  false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := MutableHash(123, 456, 789)
+			result := set.Contains(tc.element)
+			if result != tc.expect {
+				t.Errorf("unexpected element contained within Set: %q; want %v, got %v", tc.element, tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_ContainsAll(t *testing.T) {
+	testCases := map[string]struct {
+		element  int
+		elements []int
+		expect   bool
+	}{
+		"with single contained element": {
+			element: 123,
+			expect:  true,
+		},
+		"with single element not contained": {
+			element: -123,
+			expect:  false,
+		},
+		"with all elements contained": {
+			element:  123,
+			elements: []int{456, 789},
+			expect:   true,
+		},
+		"with duplicate contained elements": {
+			element:  123,
+			elements: []int{123, 123},
+			expect:   true,
+		},
+		"with first element not contained": {
+			element:  -123,
+			elements: []int{456, 789},
+			expect:   false,
+		},
+		"with last element not contained": {
+			element:  123,
+			elements: []int{456, -789},
+			expect:   false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := MutableHash(123, 456, 789)
+			if result := set.ContainsAll(tc.element, tc.elements...); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_ContainsAll_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	if set.ContainsAll(123, 456) {
+		t.Error("unexpected result; want false, got true")
+	}
+}
+
+func Test_MutableHashSet_ContainsAny(t *testing.T) {
+	testCases := map[string]struct {
+		element  int
+		elements []int
+		expect   bool
+	}{
+		"with single contained element": {
+			element: 123,
+			expect:  true,
+		},
+		"with single element not contained": {
+			element: -123,
+			expect:  false,
+		},
+		"with first element contained": {
+			element:  123,
+			elements: []int{-456, -789},
+			expect:   true,
+		},
+		"with last element contained": {
+			element:  -123,
+			elements: []int{-456, 789},
+			expect:   true,
+		},
+		"with no elements contained": {
+			element:  -123,
+			elements: []int{-456, -789},
+			expect:   false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := MutableHash(123, 456, 789)
+			if result := set.ContainsAny(tc.element, tc.elements...); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_ContainsAny_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	if set.ContainsAny(123, 456) {
+		t.Error("unexpected result; want false, got true")
+	}
+}
+
+func Test_MutableHashSet_Contains_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		element int
+	}{
+		"with non-matching zero value for element":     {0},
+		"with non-matching non-zero value for element": {1},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var set *MutableHashSet[int]
+			if set.Contains(tc.element) {
+				t.Errorf("unexpected element contained within Set: %q; want false, got true", tc.element)
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_ContainsEach(t *testing.T) {
+	testCases := map[string]struct {
+		elements []int
+		expect   []bool
+	}{
+		"with no elements": {
+			elements: nil,
+			expect:   []bool{},
 		},
-		"on empty *MutableHashSet": {
-			set: MutableHash[int](),
+		"with mix of present and absent elements": {
+			elements: []int{999, 123, 0, 789, 456, -1},
+			expect:   []bool{false, true, false, true, true, false},
+		},
+		"with duplicate elements": {
+			elements: []int{456, 999, 456},
+			expect:   []bool{true, false, true},
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			ret := tc.set.Clear()
-
-			if internal.IsNil(ret) {
-				t.Error("unexpected nil MutableSet")
-			}
-			if tc.set != ret {
-				t.Errorf("unexpected MutableSet; want %v, got %v", tc.set, ret)
-			}
-			if !tc.set.IsEmpty() {
-				t.Error("unexpected MutableSet emptiness; want true, got false")
+			set := MutableHash(123, 456, 789)
+			if diff := cmp.Diff(tc.expect, set.ContainsEach(tc.elements)); diff != "" {
+				t.Errorf("unexpected results (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func Test_MutableHashSet_Clear_Nil(t *testing.T) {
+func Test_MutableHashSet_ContainsEach_Nil(t *testing.T) {
 	var set *MutableHashSet[int]
-	ret := set.Clear()
-
-	if internal.IsNotNil(ret) {
-		t.Errorf("unexpected MutableSet; want nil, got %v", ret)
-	}
-	if !set.IsEmpty() {
-		t.Error("unexpected MutableSet emptiness; want true, got false")
+	if diff := cmp.Diff([]bool{false, false}, set.ContainsEach([]int{123, 0})); diff != "" {
+		t.Errorf("unexpected results (-want +got):\n%s", diff)
 	}
 }
 
-func Test_MutableHashSet_Clone(t *testing.T) {
-	set := MutableHash(123, 456, 789)
-	clone := set.Clone()
-	if internal.IsNil(clone) {
-		t.Error("unexpected nil Set")
-	}
-	if l := clone.Len(); l != 3 {
-		t.Errorf("unexpected cloned Set length; want 3, got %v", l)
-	}
-	if !clone.Equal(set) {
-		t.Errorf("unexpected cloned Set; want %v, got %v", set, clone)
+func Test_MutableHashSet_Count(t *testing.T) {
+	testCases := map[string]struct {
+		expect    int
+		predicate func(element int) bool
+	}{
+		"with predicate matching all elements": {
+			expect:    5,
+			predicate: func(_ int) bool { return true },
+		},
+		"with predicate matching some elements": {
+			expect:    3,
+			predicate: func(element int) bool { return element > 0 },
+		},
+		"with predicate matching single element": {
+			expect:    1,
+			predicate: func(element int) bool { return element == 0 },
+		},
+		"with predicate matching no elements": {
+			expect:    0,
+			predicate: func(_ int) bool { return false },
+		},
 	}
-	if !clone.IsMutable() {
-		t.Error("unexpected cloned Set mutability; want true, got false")
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := MutableHash(-123, 0, 123, 456, 789)
+			if result := set.Count(tc.predicate); result != tc.expect {
+				t.Errorf("unexpected count; want %v, got %v", tc.expect, result)
+			}
+		})
 	}
 }
 
-func Test_MutableHashSet_Clone_Nil(t *testing.T) {
+func Test_MutableHashSet_Count_Nil(t *testing.T) {
 	var set *MutableHashSet[int]
-	clone := set.Clone()
-	if clone == nil {
-		t.Error("unexpected nil Set")
-	}
-	if internal.IsNotNil(clone) {
-		t.Errorf("unexpected cloned Set; want nil, got %#v", clone)
-	}
-	if !clone.IsEmpty() {
-		t.Error("unexpected cloned Set emptiness; want true, got false")
-	}
-	if !clone.IsMutable() {
-		t.Error("unexpected cloned Set mutability; want true, got false")
+	if result := set.Count(func(_ int) bool { return true }); result != 0 {
+		t.Errorf("unexpected count; want 0, got %v", result)
 	}
 }
 
-func Test_MutableHashSet_Contains(t *testing.T) {
+func Test_MutableHashSet_Covers(t *testing.T) {
 	testCases := map[string]struct {
-		element int
-		expect  bool
+		expect bool
+		others []Set[int]
 	}{
-		"with matching element": {
-			element: 123,
-			expect:  true,
+		"with no other Sets": {
+			expect: true,
+			others: nil,
 		},
-		"with non-matching zero value for element": {
-			element: 0,
-			expect:  false,
+		"with covered Sets": {
+			expect: true,
+			others: []Set[int]{Hash(123), Hash(456, 789), Hash(123, 456, 789)},
 		},
-		"with non-matching non-zero value for element": {
-			element: 1,
-			expect:  false,
+		"with empty and nil Sets": {
+			expect: true,
+			others: []Set[int]{Hash[int](), nil, (*HashSet[int])(nil)},
+		},
+		"with mix of covered and not covered Sets": {
+			expect: false,
+			others: []Set[int]{Hash(123, 456), Hash(456, 999), Hash(789)},
+		},
+		"with Set containing more elements": {
+			expect: false,
+			others: []Set[int]{Hash(0, 123, 456, 789)},
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			set := MutableHash(123, 456, 789)
-			result := set.Contains(tc.element)
-			if result != tc.expect {
-				t.Errorf("unexpected element contained within Set: %q; want %v, got %v", tc.element, tc.expect, result)
+			if covers := set.Covers(tc.others...); covers != tc.expect {
+				t.Errorf("unexpected covers; want %v, got %v", tc.expect, covers)
 			}
 		})
 	}
 }
 
-func Test_MutableHashSet_Contains_Nil(t *testing.T) {
-	testCases := map[string]struct {
-		element int
-	}{
-		"with non-matching zero value for element":     {0},
-		"with non-matching non-zero value for element": {1},
+func Test_MutableHashSet_Covers_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	if !set.Covers(Hash[int](), nil) {
+		t.Error("unexpected covers of empty Set; want true, got false")
 	}
-
-	for name, tc := range testCases {
-		t.Run(name, func(t *testing.T) {
-			var set *MutableHashSet[int]
-			if set.Contains(tc.element) {
-				t.Errorf("unexpected element contained within Set: %q; want false, got true", tc.element)
-			}
-		})
+	if set.Covers(Hash(123)) {
+		t.Error("unexpected covers of non-empty Set; want false, got true")
 	}
 }
 
@@ -325,6 +1119,59 @@ func Test_MutableHashSet_Delete(t *testing.T) {
 	}
 }
 
+func Test_MutableHashSet_DeleteExisting(t *testing.T) {
+	testCases := map[string]struct {
+		element        int
+		elements       []int
+		expect         int
+		expectElements []int
+	}{
+		"with no elements present": {
+			element:        999,
+			elements:       []int{-123},
+			expect:         0,
+			expectElements: []int{123, 456, 789},
+		},
+		"with some elements present": {
+			element:        456,
+			elements:       []int{999, 789},
+			expect:         2,
+			expectElements: []int{123},
+		},
+		"with all elements present": {
+			element:        123,
+			elements:       []int{456, 789},
+			expect:         3,
+			expectElements: []int{},
+		},
+		"with duplicate elements present": {
+			element:        456,
+			elements:       []int{456},
+			expect:         1,
+			expectElements: []int{123, 789},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := MutableHash(123, 456, 789)
+			if deleted := set.DeleteExisting(tc.element, tc.elements...); deleted != tc.expect {
+				t.Errorf("unexpected number of deleted elements; want %v, got %v", tc.expect, deleted)
+			}
+			if expect := HashFromSlice(tc.expectElements); !set.Equal(expect) {
+				t.Errorf("unexpected Set; want %v, got %v", expect, set)
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_DeleteExisting_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	if deleted := set.DeleteExisting(123, 456); deleted != 0 {
+		t.Errorf("unexpected number of deleted elements; want 0, got %v", deleted)
+	}
+}
+
 func Test_MutableHashSet_Delete_Nil(t *testing.T) {
 	testCases := map[string]struct {
 		element  int
@@ -451,6 +1298,148 @@ func Test_MutableHashSet_DeleteAll_Nil(t *testing.T) {
 	}
 }
 
+func Test_MutableHashSet_DeleteAnyOf(t *testing.T) {
+	testCases := map[string]struct {
+		expect Set[int]
+		sets   []Set[int]
+	}{
+		"with no Sets": {
+			expect: Hash(123, 456, 789),
+			sets:   nil,
+		},
+		"with single Set": {
+			expect: Hash(123, 789),
+			sets:   []Set[int]{Hash(456, 999)},
+		},
+		"with multiple Sets": {
+			expect: Hash(789),
+			sets:   []Set[int]{Hash(456, 999), Hash(123), Hash[int]()},
+		},
+		"with nil Sets": {
+			expect: Hash(123, 789),
+			sets:   []Set[int]{nil, Hash(456), (*HashSet[int])(nil)},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := MutableHash(123, 456, 789)
+			composed := MutableHash(123, 456, 789)
+			for _, other := range tc.sets {
+				composed.DeleteAll(other)
+			}
+			set.DeleteAnyOf(tc.sets...)
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+			if !set.Equal(composed) {
+				t.Errorf("unexpected Set compared to composing DeleteAll; want %v, got %v", composed, set)
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_DeleteAnyOf_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	if result := set.DeleteAnyOf(Hash(123)); internal.IsNotNil(result) {
+		t.Errorf("unexpected MutableSet; want nil, got %v", result)
+	}
+}
+
+func Test_MutableHashSet_DeleteNth(t *testing.T) {
+	testCases := map[string]struct {
+		expect        Set[int]
+		expectElement int
+		expectOK      bool
+		i             int
+		lessFunc      func(x, y int) bool
+		set           *MutableHashSet[int]
+	}{
+		"with first index and ascending less on non-empty *MutableHashSet": {
+			expect:        Hash(0, 123, 456, 789),
+			expectElement: -123,
+			expectOK:      true,
+			i:             0,
+			lessFunc:      Asc[int],
+			set:           MutableHash(-123, 0, 123, 456, 789),
+		},
+		"with last index and ascending less on non-empty *MutableHashSet": {
+			expect:        Hash(-123, 0, 123, 456),
+			expectElement: 789,
+			expectOK:      true,
+			i:             4,
+			lessFunc:      Asc[int],
+			set:           MutableHash(-123, 0, 123, 456, 789),
+		},
+		"with middle index and ascending less on non-empty *MutableHashSet": {
+			expect:        Hash(-123, 0, 456, 789),
+			expectElement: 123,
+			expectOK:      true,
+			i:             2,
+			lessFunc:      Asc[int],
+			set:           MutableHash(-123, 0, 123, 456, 789),
+		},
+		"with first index and descending less on non-empty *MutableHashSet": {
+			expect:        Hash(-123, 0, 123, 456),
+			expectElement: 789,
+			expectOK:      true,
+			i:             0,
+			lessFunc:      Desc[int],
+			set:           MutableHash(-123, 0, 123, 456, 789),
+		},
+		"with negative index on non-empty *MutableHashSet": {
+			expect:        Hash(-123, 0, 123, 456, 789),
+			expectElement: 0,
+			expectOK:      false,
+			i:             -1,
+			lessFunc:      Asc[int],
+			set:           MutableHash(-123, 0, 123, 456, 789),
+		},
+		"with out of range index on non-empty *MutableHashSet": {
+			expect:        Hash(-123, 0, 123, 456, 789),
+			expectElement: 0,
+			expectOK:      false,
+			i:             5,
+			lessFunc:      Asc[int],
+			set:           MutableHash(-123, 0, 123, 456, 789),
+		},
+		"with first index on empty *MutableHashSet": {
+			expect:        Hash[int](),
+			expectElement: 0,
+			expectOK:      false,
+			i:             0,
+			lessFunc:      Asc[int],
+			set:           MutableHash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			element, ok := tc.set.DeleteNth(tc.lessFunc, tc.i)
+			if ok != tc.expectOK {
+				t.Errorf("unexpected bool result; want %v, got %v", tc.expectOK, ok)
+			}
+			if element != tc.expectElement {
+				t.Errorf("unexpected element result; want %v, got %v", tc.expectElement, element)
+			}
+			if !tc.expect.Equal(tc.set) {
+				t.Errorf("unexpected MutableSet; want %v, got %v", tc.expect, tc.set)
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_DeleteNth_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	element, ok := set.DeleteNth(Asc[int], 0)
+	if ok {
+		t.Error("unexpected bool result; want false, got true")
+	}
+	if element != 0 {
+		t.Errorf("unexpected non-zero value for element result; want 0, got %v", element)
+	}
+}
+
 func Test_MutableHashSet_DeleteSlice(t *testing.T) {
 	testCases := map[string]struct {
 		elements []int
@@ -824,6 +1813,60 @@ func Test_MutableHashSet_DiffSymmetric_Nil(t *testing.T) {
 	}
 }
 
+func Test_MutableHashSet_DrainWhere(t *testing.T) {
+	testCases := map[string]struct {
+		expectDrained  []int
+		expectElements []int
+		predicate      func(element int) bool
+	}{
+		"with some elements matching": {
+			expectDrained:  []int{2, 4},
+			expectElements: []int{1, 3, 5},
+			predicate:      func(element int) bool { return element%2 == 0 },
+		},
+		"with no elements matching": {
+			expectDrained:  []int{},
+			expectElements: []int{1, 2, 3, 4, 5},
+			predicate:      func(element int) bool { return element > 5 },
+		},
+		"with all elements matching": {
+			expectDrained:  []int{1, 2, 3, 4, 5},
+			expectElements: []int{},
+			predicate:      func(element int) bool { return element > 0 },
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := MutableHash(1, 2, 3, 4, 5)
+			drained := set.DrainWhere(tc.predicate)
+			if internal.IsNil(drained) {
+				t.Fatal("unexpected nil Set")
+			}
+			if kind := drained.Kind(); kind != MutableHashKind {
+				t.Errorf("unexpected SetKind; want %v, got %v", MutableHashKind, kind)
+			}
+			if expect := HashFromSlice(tc.expectDrained); !drained.Equal(expect) {
+				t.Errorf("unexpected drained Set; want %v, got %v", expect, drained)
+			}
+			if expect := HashFromSlice(tc.expectElements); !set.Equal(expect) {
+				t.Errorf("unexpected Set; want %v, got %v", expect, set)
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_DrainWhere_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	drained := set.DrainWhere(func(element int) bool {
+		t.Errorf("unexpected call to predicate with %v", element)
+		return true
+	})
+	if drained != (*MutableHashSet[int])(nil) {
+		t.Errorf("unexpected drained Set; want nil, got %v", drained)
+	}
+}
+
 func Test_MutableHashSet_Equal(t *testing.T) {
 	testCases := map[string]struct {
 		expect bool
@@ -1357,18 +2400,94 @@ func Test_MutableHashSet_Find_Nil(t *testing.T) {
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			var set *MutableHashSet[int]
-			element, ok := set.Find(tc.searchFunc)
-			if ok {
-				t.Error("unexpected bool result; want false, got true")
+			var set *MutableHashSet[int]
+			element, ok := set.Find(tc.searchFunc)
+			if ok {
+				t.Error("unexpected bool result; want false, got true")
+			}
+			if element != 0 {
+				t.Errorf("unexpected non-zero value for element result; want 0, got %v", element)
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_Grow(t *testing.T) {
+	testCases := map[string]struct {
+		set    *MutableHashSet[int]
+		n      int
+		expect Set[int]
+	}{
+		"with positive n on non-empty *MutableHashSet": {
+			set:    MutableHash(123, 456, 789),
+			n:      100,
+			expect: Hash(123, 456, 789),
+		},
+		"with positive n on empty *MutableHashSet": {
+			set:    MutableHash[int](),
+			n:      100,
+			expect: Hash[int](),
+		},
+		"with zero n on non-empty *MutableHashSet": {
+			set:    MutableHash(123, 456, 789),
+			n:      0,
+			expect: Hash(123, 456, 789),
+		},
+		"with negative n on non-empty *MutableHashSet": {
+			set:    MutableHash(123, 456, 789),
+			n:      -1,
+			expect: Hash(123, 456, 789),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ret := tc.set.Grow(tc.n)
+
+			if internal.IsNil(ret) {
+				t.Error("unexpected nil MutableSet")
 			}
-			if element != 0 {
-				t.Errorf("unexpected non-zero value for element result; want 0, got %v", element)
+			if tc.set != ret {
+				t.Errorf("unexpected MutableSet; want %v, got %v", tc.set, ret)
+			}
+			if !tc.set.Equal(tc.expect) {
+				t.Errorf("unexpected MutableSet; want %v, got %v", tc.expect, tc.set)
+			}
+
+			tc.set.Put(1000)
+			if !tc.set.Contains(1000) {
+				t.Error("unexpected MutableSet element presence after Grow; want true, got false")
 			}
 		})
 	}
 }
 
+func Test_MutableHashSet_Grow_Repeated(t *testing.T) {
+	set := MutableHash(123, 456, 789)
+	set.Grow(10)
+	elements := set.elements
+	set.Grow(5).Put(0).Grow(9)
+	if reflect.ValueOf(set.elements).Pointer() != reflect.ValueOf(elements).Pointer() {
+		t.Error("unexpected rebuild of backing map; want reused, got rebuilt")
+	}
+	set.Grow(10)
+	if reflect.ValueOf(set.elements).Pointer() == reflect.ValueOf(elements).Pointer() {
+		t.Error("unexpected reuse of backing map; want rebuilt, got reused")
+	}
+}
+
+func Test_MutableHashSet_Grow_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	ret := set.Grow(100)
+
+	if internal.IsNotNil(ret) {
+		t.Errorf("unexpected MutableSet; want nil, got %v", ret)
+	}
+	if !set.IsEmpty() {
+		t.Error("unexpected MutableSet emptiness; want true, got false")
+	}
+}
+
 func Test_MutableHashSet_Immutable(t *testing.T) {
 	testCases := map[string]struct {
 		set *MutableHashSet[int]
@@ -1508,6 +2627,73 @@ func Test_MutableHashSet_Intersection_Nil(t *testing.T) {
 	}
 }
 
+func Test_MutableHashSet_IsDisjoint(t *testing.T) {
+	testCases := map[string]struct {
+		expect bool
+		other  Set[int]
+	}{
+		"with disjoint Set": {
+			expect: true,
+			other:  Hash(-789, -456, -123),
+		},
+		"with smaller disjoint Set": {
+			expect: true,
+			other:  Singleton(0),
+		},
+		"with larger disjoint Set": {
+			expect: true,
+			other:  Hash(-789, -456, -123, 0, 1),
+		},
+		"with overlapping Set": {
+			expect: false,
+			other:  Hash(-123, 0, 456),
+		},
+		"with smaller overlapping Set": {
+			expect: false,
+			other:  Singleton(789),
+		},
+		"with larger overlapping Set": {
+			expect: false,
+			other:  Hash(-789, -456, -123, 0, 123),
+		},
+		"with equal Set": {
+			expect: false,
+			other:  Hash(123, 456, 789),
+		},
+		"with empty Set": {
+			expect: true,
+			other:  Hash[int](),
+		},
+		"with nil Set": {
+			expect: true,
+			other:  nil,
+		},
+		"with nil *HashSet": {
+			expect: true,
+			other:  (*HashSet[int])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := MutableHash(123, 456, 789)
+			if result := set.IsDisjoint(tc.other); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_IsDisjoint_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	if !set.IsDisjoint(Hash(123)) {
+		t.Error("unexpected result; want true, got false")
+	}
+	if !set.IsDisjoint(nil) {
+		t.Error("unexpected result; want true, got false")
+	}
+}
+
 func Test_MutableHashSet_IsEmpty(t *testing.T) {
 	testCases := map[string]struct {
 		expect bool
@@ -1588,6 +2774,59 @@ func Test_MutableHashSet_Join_Nil(t *testing.T) {
 	assertSetJoin(t, set.Join(sep, getIntStringConverterWithDefaultOptions[int]()), sep, []string{})
 }
 
+func Test_MutableHashSet_Keys(t *testing.T) {
+	testCases := map[string]struct {
+		set *MutableHashSet[int]
+	}{
+		"on *MutableHashSet containing multiple elements": {
+			set: MutableHash(123, 456, 789),
+		},
+		"on *MutableHashSet containing single element": {
+			set: MutableHash(123),
+		},
+		"on *MutableHashSet containing no elements": {
+			set: MutableHash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			keys := tc.set.Keys()
+			if keys == nil {
+				t.Error("unexpected nil map")
+			}
+			if !internal.ContainsOnly[int](keys, tc.set.Slice()) {
+				t.Errorf("unexpected map keys; want %v, got %v", tc.set.Slice(), keys)
+			}
+			keys[0] = struct{}{}
+			if tc.set.Contains(0) {
+				t.Error("unexpected element within MutableHashSet following modification of map; want false, got true")
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_Keys_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	if keys := set.Keys(); keys != nil {
+		t.Errorf("unexpected map; want nil, got %v", keys)
+	}
+}
+
+func Test_MutableHashSet_Kind(t *testing.T) {
+	set := MutableHash(123, 456, 789)
+	if kind := set.Kind(); kind != MutableHashKind {
+		t.Errorf("unexpected kind; want %v, got %v", MutableHashKind, kind)
+	}
+}
+
+func Test_MutableHashSet_Kind_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	if kind := set.Kind(); kind != MutableHashKind {
+		t.Errorf("unexpected kind; want %v, got %v", MutableHashKind, kind)
+	}
+}
+
 func Test_MutableHashSet_Len(t *testing.T) {
 	testCases := map[string]struct {
 		expect int
@@ -1827,6 +3066,41 @@ func Test_MutableHashSet_None_Nil(t *testing.T) {
 	}
 }
 
+func Test_MutableHashSet_Pop(t *testing.T) {
+	set := MutableHash(123, 456, 789)
+	var popped []int
+	for i := 0; i < 3; i++ {
+		element, ok := set.Pop()
+		if !ok {
+			t.Fatalf("unexpected pop result; want true, got false")
+		}
+		if set.Contains(element) {
+			t.Errorf("unexpected Set containment of %v; want false, got true", element)
+		}
+		if exp, act := 2-i, set.Len(); act != exp {
+			t.Errorf("unexpected Set length; want %v, got %v", exp, act)
+		}
+		popped = append(popped, element)
+	}
+	sort.Ints(popped)
+	if exp := []int{123, 456, 789}; !cmp.Equal(exp, popped) {
+		t.Errorf("unexpected popped elements; want %v, got %v", exp, popped)
+	}
+	if element, ok := set.Pop(); ok || element != 0 {
+		t.Errorf("unexpected pop result; want 0 and false, got %v and %v", element, ok)
+	}
+	if element, ok := MutableHash[int]().Pop(); ok || element != 0 {
+		t.Errorf("unexpected pop result; want 0 and false, got %v and %v", element, ok)
+	}
+}
+
+func Test_MutableHashSet_Pop_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	if element, ok := set.Pop(); ok || element != 0 {
+		t.Errorf("unexpected pop result; want 0 and false, got %v and %v", element, ok)
+	}
+}
+
 func Test_MutableHashSet_Put(t *testing.T) {
 	testCases := map[string]struct {
 		element  int
@@ -2155,6 +3429,73 @@ func Test_MutableHashSet_Range(t *testing.T) {
 	}
 }
 
+func Test_MutableHashSet_RangeContext(t *testing.T) {
+	set := MutableHash(123, 456, 789)
+	var elements []int
+	err := set.RangeContext(context.Background(), func(element int) bool {
+		elements = append(elements, element)
+		return false
+	})
+	if err != nil {
+		t.Errorf("unexpected error; want nil, got %q", err)
+	}
+	if expect := set.Len(); len(elements) != expect {
+		t.Errorf("unexpected number of iter function calls; want %v, got %v", expect, len(elements))
+	}
+	if actual := HashFromSlice(elements); !actual.Equal(set) {
+		t.Errorf("unexpected iterated elements; want %v, got %v", set, actual)
+	}
+}
+
+func Test_MutableHashSet_RangeContext_Cancel(t *testing.T) {
+	set := MutableHash(123, 456, 789)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var count int
+	err := set.RangeContext(ctx, func(_ int) bool {
+		count++
+		cancel()
+		return false
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error; want %q, got %q", context.Canceled, err)
+	}
+	if count != 1 {
+		t.Errorf("unexpected number of iter function calls; want 1, got %v", count)
+	}
+}
+
+func Test_MutableHashSet_RangeContext_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var called bool
+	err := MutableHash(123, 456, 789).RangeContext(ctx, func(_ int) bool {
+		called = true
+		return false
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error; want %q, got %q", context.Canceled, err)
+	}
+	if called {
+		t.Error("unexpected iter function call")
+	}
+}
+
+func Test_MutableHashSet_RangeContext_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	var called bool
+	err := set.RangeContext(context.Background(), func(_ int) bool {
+		called = true
+		return false
+	})
+	if err != nil {
+		t.Errorf("unexpected error; want nil, got %q", err)
+	}
+	if called {
+		t.Error("unexpected iter function call")
+	}
+}
+
 func Test_MutableHashSet_Range_Nil(t *testing.T) {
 	var funcCallCount int
 	var set *MutableHashSet[int]
@@ -2167,6 +3508,59 @@ func Test_MutableHashSet_Range_Nil(t *testing.T) {
 	}
 }
 
+func Test_MutableHashSet_ReplaceElement(t *testing.T) {
+	testCases := map[string]struct {
+		expect     Set[int]
+		expectOk   bool
+		newElement int
+		oldElement int
+	}{
+		"with old element absent": {
+			expect:     Hash(123, 456, 789),
+			expectOk:   false,
+			newElement: 999,
+			oldElement: 0,
+		},
+		"with old element present and new element absent": {
+			expect:     Hash(123, 789, 999),
+			expectOk:   true,
+			newElement: 999,
+			oldElement: 456,
+		},
+		"with old element present and new element already present": {
+			expect:     Hash(123, 789),
+			expectOk:   true,
+			newElement: 789,
+			oldElement: 456,
+		},
+		"with old element equal to new element": {
+			expect:     Hash(123, 456, 789),
+			expectOk:   true,
+			newElement: 456,
+			oldElement: 456,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := MutableHash(123, 456, 789)
+			if ok := set.ReplaceElement(tc.oldElement, tc.newElement); ok != tc.expectOk {
+				t.Errorf("unexpected replacement; want %v, got %v", tc.expectOk, ok)
+			}
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_ReplaceElement_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	if set.ReplaceElement(123, 456) {
+		t.Error("unexpected replacement; want false, got true")
+	}
+}
+
 func Test_MutableHashSet_Retain(t *testing.T) {
 	testCases := map[string]struct {
 		element  int
@@ -2355,6 +3749,58 @@ func Test_MutableHashSet_RetainAll_Nil(t *testing.T) {
 	}
 }
 
+func Test_MutableHashSet_RetainAllOf(t *testing.T) {
+	testCases := map[string]struct {
+		expect Set[int]
+		sets   []Set[int]
+	}{
+		"with no Sets": {
+			expect: Hash(123, 456, 789),
+			sets:   nil,
+		},
+		"with single Set": {
+			expect: Hash(456),
+			sets:   []Set[int]{Hash(456, 999)},
+		},
+		"with multiple Sets": {
+			expect: Hash(456),
+			sets:   []Set[int]{Hash(123, 456, 999), Hash(456, 789)},
+		},
+		"with disjoint Sets": {
+			expect: Hash[int](),
+			sets:   []Set[int]{Hash(123), Hash(456)},
+		},
+		"with nil Set": {
+			expect: Hash[int](),
+			sets:   []Set[int]{Hash(123, 456), nil},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := MutableHash(123, 456, 789)
+			composed := MutableHash(123, 456, 789)
+			for _, other := range tc.sets {
+				composed.RetainAll(other)
+			}
+			set.RetainAllOf(tc.sets...)
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+			if !set.Equal(composed) {
+				t.Errorf("unexpected Set compared to composing RetainAll; want %v, got %v", composed, set)
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_RetainAllOf_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	if result := set.RetainAllOf(Hash(123)); internal.IsNotNil(result) {
+		t.Errorf("unexpected MutableSet; want nil, got %v", result)
+	}
+}
+
 func Test_MutableHashSet_RetainSlice(t *testing.T) {
 	testCases := map[string]struct {
 		elements []int
@@ -2529,14 +3975,121 @@ func Test_MutableHashSet_RetainWhere_Nil(t *testing.T) {
 		},
 	}
 
-	for name, tc := range testCases {
-		t.Run(name, func(t *testing.T) {
-			var set *MutableHashSet[int]
-			set.RetainWhere(tc.predicateFunc)
-			if !set.IsEmpty() {
-				t.Error("unexpected MutableSet emptiness; want true, got false")
-			}
-		})
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var set *MutableHashSet[int]
+			set.RetainWhere(tc.predicateFunc)
+			if !set.IsEmpty() {
+				t.Error("unexpected MutableSet emptiness; want true, got false")
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_Sample(t *testing.T) {
+	set := MutableHash(123, 456, 789)
+	for i := 0; i < 10; i++ {
+		element, ok := set.Sample()
+		if !ok {
+			t.Fatal("unexpected indication of no elements; want true, got false")
+		}
+		if !set.Contains(element) {
+			t.Errorf("unexpected element; want element of %v, got %v", set, element)
+		}
+	}
+}
+
+func Test_MutableHashSet_Sample_Empty(t *testing.T) {
+	set := MutableHash[int]()
+	if element, ok := set.Sample(); ok {
+		t.Errorf("unexpected element; want none, got %v", element)
+	}
+}
+
+func Test_MutableHashSet_Sample_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	if element, ok := set.Sample(); ok {
+		t.Errorf("unexpected element; want none, got %v", element)
+	}
+}
+
+func Test_MutableHashSet_SampleN(t *testing.T) {
+	testCases := map[string]struct {
+		expectLen int
+		n         int
+		set       *MutableHashSet[int]
+	}{
+		"with n less than length": {
+			expectLen: 2,
+			n:         2,
+			set:       MutableHash(123, 456, 789),
+		},
+		"with n equal to length": {
+			expectLen: 3,
+			n:         3,
+			set:       MutableHash(123, 456, 789),
+		},
+		"with n greater than length": {
+			expectLen: 3,
+			n:         10,
+			set:       MutableHash(123, 456, 789),
+		},
+		"with zero n": {
+			expectLen: 0,
+			n:         0,
+			set:       MutableHash(123, 456, 789),
+		},
+		"with negative n": {
+			expectLen: 0,
+			n:         -1,
+			set:       MutableHash(123, 456, 789),
+		},
+		"with empty *MutableHashSet[int]": {
+			expectLen: 0,
+			n:         2,
+			set:       MutableHash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sampled := tc.set.SampleN(tc.n)
+			if internal.IsNil(sampled) {
+				t.Fatal("unexpected nil Set")
+			}
+			if kind, expectKind := sampled.Kind(), tc.set.Kind(); kind != expectKind {
+				t.Errorf("unexpected SetKind; want %v, got %v", expectKind, kind)
+			}
+			if l := sampled.Len(); l != tc.expectLen {
+				t.Errorf("unexpected Set length; want %v, got %v", tc.expectLen, l)
+			}
+			if !tc.set.Covers(sampled) {
+				t.Errorf("unexpected sampled Set; want subset of %v, got %v", tc.set, sampled)
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_SampleN_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	if sampled := set.SampleN(2); sampled != (*MutableHashSet[int])(nil) {
+		t.Errorf("unexpected sampled Set; want nil, got %v", sampled)
+	}
+}
+
+func Test_MutableHashSet_SampleNWithRand(t *testing.T) {
+	set := MutableHash(123, 456, 789)
+	set.SampleNWithRand(2, rand.New(rand.NewSource(42)))
+	if set.order.Load() != nil {
+		t.Fatal("unexpected order tracked by SampleNWithRand")
+	}
+
+	set.StableRange(func(_ int) bool { return false })
+	expect := set.SampleNWithRand(2, rand.New(rand.NewSource(42)))
+	for i := 0; i < 10; i++ {
+		if sampled := set.SampleNWithRand(2, rand.New(rand.NewSource(42))); !sampled.Equal(expect) {
+			t.Errorf("unexpected sampled Set; want %v, got %v", expect, sampled)
+		}
 	}
 }
 
@@ -2658,6 +4211,54 @@ func Test_MutableHashSet_Some_Nil(t *testing.T) {
 	}
 }
 
+func Test_MutableHashSet_SortedAppendSlice(t *testing.T) {
+	testCases := map[string]struct {
+		set    *MutableHashSet[int]
+		dst    []int
+		expect []int
+	}{
+		"with nil dst on non-empty *MutableHashSet": {
+			set:    MutableHash(456, 123, 789),
+			dst:    nil,
+			expect: []int{789, 456, 123},
+		},
+		"with non-empty dst on non-empty *MutableHashSet": {
+			set:    MutableHash(456, 123, 789),
+			dst:    []int{1, 999},
+			expect: []int{1, 999, 789, 456, 123},
+		},
+		"with nil dst on empty *MutableHashSet": {
+			set:    MutableHash[int](),
+			dst:    nil,
+			expect: nil,
+		},
+		"with non-empty dst on empty *MutableHashSet": {
+			set:    MutableHash[int](),
+			dst:    []int{1, 999},
+			expect: []int{1, 999},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ret := tc.set.SortedAppendSlice(tc.dst, Desc[int])
+			if !cmp.Equal(tc.expect, ret, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected slice; want %v, got %v", tc.expect, ret)
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_SortedAppendSlice_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	dst := []int{1, 999}
+	ret := set.SortedAppendSlice(dst, Desc[int])
+
+	if !cmp.Equal(dst, ret) {
+		t.Errorf("unexpected slice; want %v, got %v", dst, ret)
+	}
+}
+
 func Test_MutableHashSet_SortedJoin(t *testing.T) {
 	testCases := map[string]struct {
 		expect string
@@ -2695,6 +4296,54 @@ func Test_MutableHashSet_SortedJoin_Nil(t *testing.T) {
 	}
 }
 
+func Test_MutableHashSet_SortedRange(t *testing.T) {
+	testCases := map[string]struct {
+		expect []int
+		set    *MutableHashSet[int]
+		stopAt int
+	}{
+		"with non-empty *MutableHashSet[int]": {
+			expect: []int{789, 456, 345, 123, 12},
+			set:    MutableHash(456, 123, 789, 12, 345),
+			stopAt: -1,
+		},
+		"with non-empty *MutableHashSet[int] and early stop": {
+			expect: []int{789, 456, 345},
+			set:    MutableHash(456, 123, 789, 12, 345),
+			stopAt: 2,
+		},
+		"with empty *MutableHashSet[int]": {
+			expect: nil,
+			set:    MutableHash[int](),
+			stopAt: -1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var elements []int
+			tc.set.SortedRange(Desc[int], func(index int, element int) bool {
+				if index != len(elements) {
+					t.Errorf("unexpected index; want %v, got %v", len(elements), index)
+				}
+				elements = append(elements, element)
+				return index == tc.stopAt
+			})
+			if !cmp.Equal(tc.expect, elements, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected elements; want %v, got %v", tc.expect, elements)
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_SortedRange_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	set.SortedRange(Desc[int], func(index int, element int) bool {
+		t.Errorf("unexpected call to iter with %v at index %v", element, index)
+		return false
+	})
+}
+
 func Test_MutableHashSet_SortedSlice(t *testing.T) {
 	testCases := map[string]struct {
 		expect []int
@@ -2731,6 +4380,175 @@ func Test_MutableHashSet_SortedSlice_Nil(t *testing.T) {
 	}
 }
 
+func Test_MutableHashSet_StableRange(t *testing.T) {
+	set := MutableHash(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	collect := func() []int {
+		var elements []int
+		set.StableRange(func(element int) bool {
+			elements = append(elements, element)
+			return false
+		})
+		return elements
+	}
+
+	first := collect()
+	for i := 0; i < 10; i++ {
+		if diff := cmp.Diff(first, collect()); diff != "" {
+			t.Fatalf("unexpected order of iterated elements (-want +got):\n%s", diff)
+		}
+	}
+
+	set.Delete(first[0], first[5]).Put(11, 12)
+	var expectRetained []int
+	for _, element := range first {
+		if element != first[0] && element != first[5] {
+			expectRetained = append(expectRetained, element)
+		}
+	}
+	elements := collect()
+	if diff := cmp.Diff(expectRetained, elements[:len(expectRetained)]); diff != "" {
+		t.Errorf("unexpected order of remaining elements (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]int{11, 12}, elements[len(expectRetained):]); diff != "" {
+		t.Errorf("unexpected order of added elements (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(elements, collect()); diff != "" {
+		t.Errorf("unexpected order of iterated elements (-want +got):\n%s", diff)
+	}
+
+	set.PutSlice([]int{15, 13}).PutAll(Hash(14)).Put(12)
+	elements = collect()
+	if diff := cmp.Diff([]int{11, 12, 15, 13, 14}, elements[len(elements)-5:]); diff != "" {
+		t.Errorf("unexpected order of added elements (-want +got):\n%s", diff)
+	}
+
+	set.Clear().Put(123)
+	if diff := cmp.Diff([]int{123}, collect()); diff != "" {
+		t.Errorf("unexpected iterated elements after Clear (-want +got):\n%s", diff)
+	}
+}
+
+func Test_MutableHashSet_StableRange_Tracking(t *testing.T) {
+	set := MutableHash(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	if set.order.Load() != nil {
+		t.Fatal("unexpected order tracked before StableRange")
+	}
+
+	set.StableRange(func(_ int) bool { return false })
+	set.Delete(1)
+	set.DeleteSlice([]int{2, 3})
+	set.Pop()
+	set.Take(10)
+	set.RetainWhere(func(element int) bool { return element%2 == 0 })
+	if got, want := set.order.Load().Len(), set.Len(); got != want {
+		t.Errorf("unexpected number of tracked elements; want %v, got %v", want, got)
+	}
+
+	set.Clear()
+	if set.order.Load() != nil {
+		t.Error("unexpected order tracked after Clear")
+	}
+}
+
+func Test_MutableHashSet_StableRange_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	var callCount int
+	set.StableRange(func(_ int) bool {
+		callCount++
+		return false
+	})
+	if callCount != 0 {
+		t.Errorf("unexpected number of calls to iter; want 0, got %v", callCount)
+	}
+}
+
+func Test_MutableHashSet_Take(t *testing.T) {
+	testCases := map[string]struct {
+		element        int
+		expectElement  int
+		expectElements []int
+		expectOk       bool
+	}{
+		"with element present": {
+			element:        456,
+			expectElement:  456,
+			expectElements: []int{123, 789},
+			expectOk:       true,
+		},
+		"with element not present": {
+			element:        999,
+			expectElement:  0,
+			expectElements: []int{123, 456, 789},
+			expectOk:       false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := MutableHash(123, 456, 789)
+			element, ok := set.Take(tc.element)
+			if element != tc.expectElement || ok != tc.expectOk {
+				t.Errorf(
+					"unexpected taken element; want %v and %v, got %v and %v",
+					tc.expectElement,
+					tc.expectOk,
+					element,
+					ok,
+				)
+			}
+			if expect := HashFromSlice(tc.expectElements); !set.Equal(expect) {
+				t.Errorf("unexpected Set; want %v, got %v", expect, set)
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_Take_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	if element, ok := set.Take(123); ok {
+		t.Errorf("unexpected taken element; want 0 and false, got %v and %v", element, ok)
+	}
+}
+
+func Test_MutableHashSet_ToMap(t *testing.T) {
+	testCases := map[string]struct {
+		expect map[int]struct{}
+		set    *MutableHashSet[int]
+	}{
+		"with non-empty *MutableHashSet": {
+			expect: map[int]struct{}{123: {}, 456: {}, 789: {}},
+			set:    MutableHash(123, 456, 789),
+		},
+		"with empty *MutableHashSet": {
+			expect: map[int]struct{}{},
+			set:    MutableHash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := tc.set.ToMap()
+			if m == nil {
+				t.Fatal("unexpected map; want non-nil, got nil")
+			}
+			if !cmp.Equal(tc.expect, m) {
+				t.Errorf("unexpected map; want %v, got %v", tc.expect, m)
+			}
+			m[-1] = struct{}{}
+			if tc.set.Contains(-1) {
+				t.Error("unexpected Set containment of -1; want false, got true")
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_ToMap_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	if m := set.ToMap(); m != nil {
+		t.Errorf("unexpected map; want nil, got %v", m)
+	}
+}
+
 func Test_MutableHashSet_TryRange(t *testing.T) {
 	testError := errors.New("test")
 	testCases := map[string]struct {
@@ -3119,6 +4937,77 @@ func Test_MutableHashSet_String_Nil(t *testing.T) {
 	assertSetString(t, set.String(), []string{})
 }
 
+func Test_MutableHashSet_GobEncode(t *testing.T) {
+	testCases := map[string]struct {
+		set *MutableHashSet[int]
+	}{
+		"with empty *MutableHashSet": {
+			set: MutableHash[int](),
+		},
+		"with single element *MutableHashSet": {
+			set: MutableHash(123),
+		},
+		"with multiple elements *MutableHashSet": {
+			set: MutableHash(123, 456, 789),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := gob.NewEncoder(&buf).Encode(tc.set); err != nil {
+				t.Fatalf("unexpected error; want nil, got %q", err)
+			}
+			set := &MutableHashSet[int]{}
+			if err := gob.NewDecoder(&buf).Decode(set); err != nil {
+				t.Fatalf("unexpected error; want nil, got %q", err)
+			}
+			if !set.Equal(tc.set) {
+				t.Errorf("unexpected decoded Set; want %v, got %v", tc.set, set)
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_GobEncode_Field(t *testing.T) {
+	type wrapper struct {
+		Set *MutableHashSet[string]
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(wrapper{Set: MutableHash("foo", "bar")}); err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	var decoded wrapper
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	if expect := Hash("foo", "bar"); !expect.Equal(decoded.Set) {
+		t.Errorf("unexpected decoded Set; want %v, got %v", expect, decoded.Set)
+	}
+}
+
+func Test_MutableHashSet_GobEncode_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	data, err := set.GobEncode()
+	if err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	decoded := MutableHash(123)
+	if err = decoded.GobDecode(data); err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	if !decoded.IsEmpty() {
+		t.Errorf("unexpected decoded Set; want [], got %v", decoded)
+	}
+}
+
+func Test_MutableHashSet_GobDecode_Error(t *testing.T) {
+	set := MutableHash(123)
+	if err := set.GobDecode([]byte("invalid")); err == nil {
+		t.Error("unexpected error; want non-nil, got nil")
+	}
+}
+
 func Test_MutableHashSet_MarshalJSON(t *testing.T) {
 	testCases := map[string]struct {
 		expect []string
@@ -3205,3 +5094,181 @@ func Test_MutableHashSet_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func Test_MutableHashSet_UnmarshalJSON_Replace(t *testing.T) {
+	set := &MutableHashSet[int]{}
+	if err := json.Unmarshal([]byte("[1,2,3]"), set); err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	if err := json.Unmarshal([]byte("[4,5]"), set); err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	if expect := Hash(4, 5); !set.Equal(expect) {
+		t.Errorf("unexpected unmarshalled Set; want %v, got %v", expect, set)
+	}
+}
+
+func Test_MutableHashSet_Scan(t *testing.T) {
+	testCases := map[string]struct {
+		expect Set[int]
+		src    any
+	}{
+		"with string source": {
+			expect: Hash(1, 2, 3),
+			src:    "[1,2,3]",
+		},
+		"with []byte source": {
+			expect: Hash(1, 2, 3),
+			src:    []byte("[1,2,3]"),
+		},
+		"with string source for empty array": {
+			expect: Hash[int](),
+			src:    "[]",
+		},
+		"with string source for null": {
+			expect: Hash[int](),
+			src:    "null",
+		},
+		"with NULL source": {
+			expect: Hash[int](),
+			src:    nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := MutableHash(4, 5)
+			if err := set.Scan(tc.src); err != nil {
+				t.Fatalf("unexpected error; want nil, got %q", err)
+			}
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected scanned Set; want %v, got %v", tc.expect, set)
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_Scan_Error(t *testing.T) {
+	testCases := map[string]struct {
+		expectErr error
+		src       any
+	}{
+		"with unsupported source": {
+			expectErr: ErrScanSource,
+			src:       int64(123),
+		},
+		"with string source for invalid JSON": {
+			src: "[1,2",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := MutableHash(4, 5).Scan(tc.src)
+			if err == nil {
+				t.Error("unexpected nil error")
+			} else if tc.expectErr != nil && !errors.Is(err, tc.expectErr) {
+				t.Errorf("unexpected error; want %q, got %q", tc.expectErr, err)
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_Value(t *testing.T) {
+	testCases := map[string]struct {
+		set *MutableHashSet[int]
+	}{
+		"with empty *MutableHashSet": {
+			set: MutableHash[int](),
+		},
+		"with single element *MutableHashSet": {
+			set: MutableHash(123),
+		},
+		"with multiple elements *MutableHashSet": {
+			set: MutableHash(123, 456, 789),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			value, err := tc.set.Value()
+			if err != nil {
+				t.Fatalf("unexpected error; want nil, got %q", err)
+			}
+			if !driver.IsValue(value) {
+				t.Fatalf("unexpected driver.Value; got %T", value)
+			}
+			set := &MutableHashSet[int]{}
+			if err = set.Scan(value); err != nil {
+				t.Fatalf("unexpected error; want nil, got %q", err)
+			}
+			if !set.Equal(tc.set) {
+				t.Errorf("unexpected scanned Set; want %v, got %v", tc.set, set)
+			}
+		})
+	}
+}
+
+func Test_MutableHashSet_Value_Nil(t *testing.T) {
+	var set *MutableHashSet[int]
+	value, err := set.Value()
+	if err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	if value != nil {
+		t.Errorf("unexpected driver.Value; want nil, got %v", value)
+	}
+}
+
+func Benchmark_MutableHashWithCapacity(b *testing.B) {
+	const size = 1000000
+	b.Run("without capacity", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			set := MutableHash[int]()
+			for j := 0; j < size; j++ {
+				set.Put(j)
+			}
+		}
+	})
+	b.Run("with capacity", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			set := MutableHashWithCapacity[int](size)
+			for j := 0; j < size; j++ {
+				set.Put(j)
+			}
+		}
+	})
+}
+
+func Benchmark_MutableHashSet_Grow(b *testing.B) {
+	const size = 100000
+	batch := make([]int, size)
+	for i := range batch {
+		batch[i] = size + i
+	}
+	b.Run("without Grow", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			set := MutableHash(1, 2, 3)
+			set.PutSlice(batch)
+		}
+	})
+	b.Run("with Grow", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			set := MutableHash(1, 2, 3)
+			set.Grow(len(batch)).PutSlice(batch)
+		}
+	})
+	b.Run("with repeated Grow", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			set := MutableHash(1, 2, 3).Grow(len(batch))
+			for _, element := range batch {
+				set.Grow(1).Put(element)
+			}
+		}
+	})
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2023 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sets
+
+import "github.com/neocotic/go-sets/internal"
+
+// Pipeline is a fluent wrapper around a Set that allows multiple transformations (e.g. Pipeline.Filter followed by
+// Pipeline.Map) to be chained lazily. Rather than building a new Set for each transformation, each stage only composes
+// functions and nothing is evaluated until Pipeline.Collect is called, at which point the elements are materialized
+// into a single Set. This reduces intermediate allocations across multi-step transformations.
+//
+// A Pipeline is never modified by chaining stages; each method returns a new Pipeline, so a Pipeline can be reused as
+// the base of multiple Pipeline. As stages are only evaluated by Pipeline.Collect, any changes made to the underlying
+// Set, or any Set passed to a stage, before then will be reflected in the result.
+type Pipeline[E comparable] struct {
+	source func(iter func(element E) bool)
+}
+
+// Collect evaluates all stages of the Pipeline and returns a new HashSet struct containing the resulting elements.
+//
+// If the Pipeline is nil, Pipeline.Collect returns an empty HashSet.
+func (p *Pipeline[E]) Collect() *HashSet[E] {
+	hash := make(internal.Hash[E])
+	p.iterate(func(element E) bool {
+		hash[element] = struct{}{}
+		return false
+	})
+	return &HashSet[E]{elements: hash}
+}
+
+// Diff returns a new Pipeline with an additional stage that only passes elements that do not exist in another Set.
+//
+// If the other Set is nil it is treated as having no elements and so Pipeline.Diff returns the Pipeline.
+func (p *Pipeline[E]) Diff(other Set[E]) *Pipeline[E] {
+	if internal.IsNil(other) {
+		return p
+	}
+	return p.Filter(func(element E) bool {
+		return !other.Contains(element)
+	})
+}
+
+// Filter returns a new Pipeline with an additional stage that only passes elements that match the filter function.
+func (p *Pipeline[E]) Filter(filter func(element E) bool) *Pipeline[E] {
+	return &Pipeline[E]{source: func(iter func(element E) bool) {
+		p.iterate(func(element E) bool {
+			return filter(element) && iter(element)
+		})
+	}}
+}
+
+// Map returns a new Pipeline with an additional stage that converts each element using the mapper function. As with
+// the Map function, multiple elements may be converted into the same element, which is only stored once when the
+// Pipeline is collected.
+func (p *Pipeline[E]) Map(mapper func(element E) E) *Pipeline[E] {
+	return &Pipeline[E]{source: func(iter func(element E) bool) {
+		p.iterate(func(element E) bool {
+			return iter(mapper(element))
+		})
+	}}
+}
+
+// Union returns a new Pipeline with an additional stage that also passes all elements within another Set. Only stages
+// added after Union are applied to the elements within the other Set.
+//
+// If the other Set is nil it is treated as having no elements and so Pipeline.Union returns the Pipeline.
+func (p *Pipeline[E]) Union(other Set[E]) *Pipeline[E] {
+	if internal.IsNil(other) {
+		return p
+	}
+	return &Pipeline[E]{source: func(iter func(element E) bool) {
+		var stopped bool
+		p.iterate(func(element E) bool {
+			stopped = iter(element)
+			return stopped
+		})
+		if !stopped {
+			other.Range(iter)
+		}
+	}}
+}
+
+// iterate calls the iter function with each element passed by all stages of the Pipeline but will stop early whenever
+// the iter function returns true. Elements may be passed more than once.
+//
+// If the Pipeline is nil, iterate is a no-op.
+func (p *Pipeline[E]) iterate(iter func(element E) bool) {
+	if p != nil && p.source != nil {
+		p.source(iter)
+	}
+}
+
+// Pipe returns a Pipeline that can be used to chain lazy transformations of the Set provided.
+//
+// If the Set is nil it is treated as having no elements.
+func Pipe[E comparable](set Set[E]) *Pipeline[E] {
+	if internal.IsNil(set) {
+		return &Pipeline[E]{}
+	}
+	return &Pipeline[E]{source: set.Range}
+}
@@ -0,0 +1,197 @@
+// Copyright (C) 2023 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sets
+
+import "testing"
+
+func Test_Pipe(t *testing.T) {
+	testCases := map[string]struct {
+		expect Set[int]
+		set    Set[int]
+	}{
+		"with Set containing elements": {
+			expect: Hash(123, 456, 789),
+			set:    Hash(123, 456, 789),
+		},
+		"with Set containing no elements": {
+			expect: Hash[int](),
+			set:    Hash[int](),
+		},
+		"with nil Set": {
+			expect: Hash[int](),
+			set:    nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if result := Pipe(tc.set).Collect(); !result.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_Pipeline(t *testing.T) {
+	isEven := func(element int) bool { return element%2 == 0 }
+	double := func(element int) int { return element * 2 }
+	testCases := map[string]struct {
+		eager    func(set Set[int]) Set[int]
+		pipeline func(pipeline *Pipeline[int]) *Pipeline[int]
+	}{
+		"with Filter": {
+			eager:    func(set Set[int]) Set[int] { return set.Filter(isEven) },
+			pipeline: func(pipeline *Pipeline[int]) *Pipeline[int] { return pipeline.Filter(isEven) },
+		},
+		"with Map": {
+			eager:    func(set Set[int]) Set[int] { return Map(set, double) },
+			pipeline: func(pipeline *Pipeline[int]) *Pipeline[int] { return pipeline.Map(double) },
+		},
+		"with Union": {
+			eager:    func(set Set[int]) Set[int] { return set.Union(Hash(4, 999)) },
+			pipeline: func(pipeline *Pipeline[int]) *Pipeline[int] { return pipeline.Union(Hash(4, 999)) },
+		},
+		"with Diff": {
+			eager:    func(set Set[int]) Set[int] { return set.Diff(Hash(4, 999)) },
+			pipeline: func(pipeline *Pipeline[int]) *Pipeline[int] { return pipeline.Diff(Hash(4, 999)) },
+		},
+		"with nil Set for Union and Diff": {
+			eager: func(set Set[int]) Set[int] { return set },
+			pipeline: func(pipeline *Pipeline[int]) *Pipeline[int] {
+				return pipeline.Union(nil).Diff(nil)
+			},
+		},
+		"with Filter, Map, and Union": {
+			eager: func(set Set[int]) Set[int] {
+				return Map(set.Filter(isEven), double).Union(Hash(1, 2))
+			},
+			pipeline: func(pipeline *Pipeline[int]) *Pipeline[int] {
+				return pipeline.Filter(isEven).Map(double).Union(Hash(1, 2))
+			},
+		},
+		"with Union followed by Filter": {
+			eager: func(set Set[int]) Set[int] {
+				return set.Union(Hash(10, 11)).Filter(isEven)
+			},
+			pipeline: func(pipeline *Pipeline[int]) *Pipeline[int] {
+				return pipeline.Union(Hash(10, 11)).Filter(isEven)
+			},
+		},
+		"with Map producing duplicates followed by Diff": {
+			eager: func(set Set[int]) Set[int] {
+				return Map(set, func(element int) int { return element / 2 }).Diff(Hash(0))
+			},
+			pipeline: func(pipeline *Pipeline[int]) *Pipeline[int] {
+				return pipeline.Map(func(element int) int { return element / 2 }).Diff(Hash(0))
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Hash(1, 2, 3, 4, 5, 6)
+			expect := tc.eager(set)
+			if result := tc.pipeline(Pipe[int](set)).Collect(); !result.Equal(expect) {
+				t.Errorf("unexpected Set; want %v, got %v", expect, result)
+			}
+		})
+	}
+}
+
+func Test_Pipeline_Allocations(t *testing.T) {
+	elements := make([]int, 1_000)
+	for i := range elements {
+		elements[i] = i
+	}
+	set := HashFromSlice(elements)
+	other := Hash(1_000, 1_001, 1_002)
+	isEven := func(element int) bool { return element%2 == 0 }
+	increment := func(element int) int { return element + 1 }
+
+	eager := func() Set[int] {
+		return Map(set.Filter(isEven), increment).Union(other)
+	}
+	pipeline := func() Set[int] {
+		return Pipe[int](set).Filter(isEven).Map(increment).Union(other).Collect()
+	}
+	if expect, result := eager(), pipeline(); !result.Equal(expect) {
+		t.Fatalf("unexpected Set; want %v, got %v", expect, result)
+	}
+
+	eagerAllocs := testing.AllocsPerRun(10, func() { eager() })
+	pipelineAllocs := testing.AllocsPerRun(10, func() { pipeline() })
+	if pipelineAllocs >= eagerAllocs {
+		t.Errorf("unexpected allocations; want fewer than %v, got %v", eagerAllocs, pipelineAllocs)
+	}
+}
+
+func Test_Pipeline_Lazy(t *testing.T) {
+	var calls int
+	set := MutableHash(1, 2, 3)
+	pipeline := Pipe[int](set).Filter(func(element int) bool {
+		calls++
+		return element > 1
+	})
+	if calls != 0 {
+		t.Errorf("unexpected calls before Collect; want 0, got %v", calls)
+	}
+	set.Put(4)
+	if expect, result := Hash(2, 3, 4), pipeline.Collect(); !result.Equal(expect) {
+		t.Errorf("unexpected Set; want %v, got %v", expect, result)
+	}
+	if calls != 4 {
+		t.Errorf("unexpected calls after Collect; want 4, got %v", calls)
+	}
+}
+
+func Test_Pipeline_Nil(t *testing.T) {
+	var pipeline *Pipeline[int]
+	if result := pipeline.Collect(); !result.IsEmpty() {
+		t.Errorf("unexpected Set; want empty, got %v", result)
+	}
+	if expect, result := Hash(123), pipeline.Union(Hash(123)).Collect(); !result.Equal(expect) {
+		t.Errorf("unexpected Set; want %v, got %v", expect, result)
+	}
+}
+
+func Benchmark_Pipeline(b *testing.B) {
+	elements := make([]int, 1_000)
+	for i := range elements {
+		elements[i] = i
+	}
+	set := HashFromSlice(elements)
+	other := Hash(1_000, 1_001, 1_002)
+	isEven := func(element int) bool { return element%2 == 0 }
+	increment := func(element int) int { return element + 1 }
+
+	b.Run("eager", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Map(set.Filter(isEven), increment).Union(other)
+		}
+	})
+	b.Run("pipeline", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Pipe[int](set).Filter(isEven).Map(increment).Union(other).Collect()
+		}
+	})
+}
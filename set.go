@@ -20,9 +20,44 @@
 
 package sets
 
+import (
+	"context"
+	"math/rand"
+)
+
 type (
 	// Set represents a data set which contains only unique elements.
 	Set[E comparable] interface {
+		// All returns an iterator over each element within the Set, which is compatible with iter.Seq so can be used
+		// with range-over-func (e.g. `for element := range set.All()`) from Go 1.23 onwards.
+		//
+		// The semantics mirror those of Set.Range. That is; each element is yielded exactly once, iteration order is
+		// not guaranteed to be consistent, and iteration stops early whenever the consumer stops (e.g. by breaking out
+		// of the loop).
+		//
+		// If the Set is nil, the iterator returned by Set.All yields no elements.
+		All() func(yield func(element E) bool)
+		// AppendSlice appends all elements of the Set to the dst slice and returns the extended slice, following the
+		// same convention as the built-in append function. This allows a buffer to be reused across calls, avoiding the
+		// allocation made by Set.Slice each time.
+		//
+		// The order in which elements are appended is the same as that of Set.Slice.
+		//
+		// If the Set is nil or contains no elements, Set.AppendSlice returns dst unchanged.
+		AppendSlice(dst []E) []E
+		// Canonical returns a minimal byte representation of the Set that is stable for its elements, which can be
+		// useful for hashing, cache keys, or as a shortcut for equality checks. Unlike JSON, it is intended purely for
+		// identity and not to be decoded.
+		//
+		// Each element is converted to a string using the provided convert function, within which any backslash is
+		// escaped as `\\` and any comma as `\,`. The escaped strings are then sorted and joined using a comma
+		// separator. Equal Sets therefore always produce byte-identical output, and Sets whose elements convert to
+		// distinct strings produce distinct output, with the exception that a Set containing only an element that
+		// converts to an empty string produces the same output as an empty Set. The convert function must be injective
+		// for distinct Sets to be guaranteed distinct output.
+		//
+		// If the Set is nil it is treated as having no elements and so Set.Canonical returns an empty slice.
+		Canonical(convert func(element E) string) []byte
 		// Clone returns a clone of the Set.
 		//
 		// The returned struct implementation of Set will always match that of the Set being cloned.
@@ -33,6 +68,41 @@ type (
 		//
 		// If the Set is nil, Set.Contains returns false.
 		Contains(element E) bool
+		// ContainsAll returns whether the Set contains the element as well as all additional elements specified,
+		// stopping as soon as any element is found not to be contained. For a Set that is safe for concurrent use, this
+		// is done within a single lock.
+		//
+		// If the Set is nil, Set.ContainsAll returns false.
+		ContainsAll(element E, elements ...E) bool
+		// ContainsAny returns whether the Set contains the element or any of the additional elements specified,
+		// stopping as soon as any element is found to be contained. For a Set that is safe for concurrent use, this is
+		// done within a single lock.
+		//
+		// If the Set is nil, Set.ContainsAny returns false.
+		ContainsAny(element E, elements ...E) bool
+		// ContainsEach returns a slice containing whether the Set contains each of the elements provided, where each
+		// result is at the same index as its element. This can be useful for validating a batch of elements at once,
+		// which, for a Set that is safe for concurrent use, is done within a single lock.
+		//
+		// The returned slice always has the same length as the slice of elements provided.
+		//
+		// If the Set is nil, Set.ContainsEach returns a slice of false values.
+		ContainsEach(elements []E) []bool
+		// Count returns the number of elements within the Set that match the predicate function. For a Set that is safe
+		// for concurrent use, this is done within a single lock.
+		//
+		// If the Set is nil, Set.Count returns zero.
+		Count(predicate func(element E) bool) int
+		// Covers returns whether the Set is a superset of every other Set. That is; whether the Set contains all
+		// elements within each other Set. This can be useful for capability checks (e.g. whether granted scopes cover
+		// all requested scopes). Any other Set containing more elements than the Set is never covered and so is
+		// rejected before its elements are checked.
+		//
+		// Any nil other Set is treated as having no elements and so is always covered. If no other Set is provided,
+		// Set.Covers returns true.
+		//
+		// If the Set is nil it is treated as having no elements.
+		Covers(others ...Set[E]) bool
 		// Diff returns a new Set struct containing only elements of the Set that do not exist in another Set.
 		//
 		// The returned struct implementation of Set should match that of the Set, where possible, but must never differ
@@ -84,6 +154,15 @@ type (
 		//
 		// If the Set is nil, Set.Intersection returns nil.
 		Intersection(other Set[E]) Set[E]
+		// IsDisjoint returns whether the Set has no elements in common with another Set. Only the elements within the
+		// smaller of the two are iterated, with each being checked for within the larger, so the cost is proportional
+		// to the length of the smaller Set and no allocation is required, unlike checking the result of
+		// Set.Intersection.
+		//
+		// Any nil Set, or Set containing no elements, is disjoint from every other Set.
+		//
+		// If the Set is nil, Set.IsDisjoint returns true.
+		IsDisjoint(other Set[E]) bool
 		// IsEmpty returns whether the Set contains no elements.
 		//
 		// If the Set is nil, Set.IsEmpty returns true.
@@ -98,6 +177,11 @@ type (
 		//
 		// If the Set is nil, Set.Join returns an empty string.
 		Join(sep string, convert func(element E) string) string
+		// Kind returns the SetKind identifying the struct implementation of the Set, allowing generic code to choose
+		// optimized paths without the need for type switches.
+		//
+		// Set.Kind returns the same SetKind even if the Set is nil.
+		Kind() SetKind
 		// Len returns the number of elements within the Set.
 		//
 		// If the Set is nil, Set.Len returns zero.
@@ -127,6 +211,33 @@ type (
 		//
 		// If the Set is nil, Set.Range is a no-op.
 		Range(iter func(element E) bool)
+		// RangeContext calls the iter function with each element within the Set, the same as Set.Range, except that the
+		// context is checked before each element. If the context is done, iteration stops and the error of the context
+		// is returned. This can be useful for iterating over a large Set where the caller may go away (e.g. within a
+		// request handler).
+		//
+		// If the Set is nil, Set.RangeContext is a no-op and returns nil.
+		RangeContext(ctx context.Context, iter func(element E) bool) error
+		// Sample returns a pseudo-random element within the Set as well as an indication of whether the Set contained
+		// any elements.
+		//
+		// If the Set is nil, Set.Sample returns the zero value for E and false.
+		Sample() (E, bool)
+		// SampleN returns a new Set struct containing up to n distinct elements of the Set chosen pseudo-randomly. All
+		// elements are included if n is greater than or equal to the number of elements within the Set, while none are
+		// included if n is not positive.
+		//
+		// The returned struct implementation of Set should match that of the Set being sampled, where possible, but
+		// must never differ in mutability.
+		//
+		// If the Set is nil, Set.SampleN returns nil.
+		SampleN(n int) Set[E]
+		// SampleNWithRand returns a new Set struct containing up to n distinct elements of the Set chosen
+		// pseudo-randomly using the provided source, the same as Set.SampleN otherwise. If the source is nil, the
+		// default source is used.
+		//
+		// If the Set is nil, Set.SampleNWithRand returns nil.
+		SampleNWithRand(n int, r *rand.Rand) Set[E]
 		// Slice returns a slice containing all elements of the Set.
 		//
 		// The order of elements within the resulting slice is not guaranteed to be consistent. Set.SortedSlice should
@@ -138,15 +249,38 @@ type (
 		//
 		// If the Set is nil, Set.Some returns false.
 		Some(predicate func(element E) bool) bool
+		// SortedAppendSlice appends all elements of the Set to the dst slice, sorted using the provided less function,
+		// and returns the extended slice. Only the appended elements are sorted so any elements already within dst are
+		// left untouched. This allows a buffer to be reused across calls, avoiding the allocation made by
+		// Set.SortedSlice each time.
+		//
+		// If the Set is nil or contains no elements, Set.SortedAppendSlice returns dst unchanged.
+		SortedAppendSlice(dst []E, less func(x, y E) bool) []E
 		// SortedJoin sorts the elements within the Set using the provided less function and then converts those
 		// elements into strings which are then joined using the specified separator to create the resulting string.
 		//
 		// If the Set is nil, Set.SortedJoin returns an empty string.
 		SortedJoin(sep string, convert func(element E) string, less func(x, y E) bool) string
+		// SortedRange calls the iter function with each element within the Set, sorted using the provided less
+		// function, along with the zero-based index of that element within the sorted order, but will stop early
+		// whenever the iter function returns true.
+		//
+		// Unlike Set.Range, iteration order is deterministic.
+		//
+		// If the Set is nil, Set.SortedRange is a no-op.
+		SortedRange(less func(x, y E) bool, iter func(index int, element E) bool)
 		// SortedSlice returns a slice containing all elements of the Set sorted using the provided less function.
 		//
 		// If the Set is nil, Set.SortedSlice returns nil.
 		SortedSlice(less func(x, y E) bool) []E
+		// ToMap returns a map containing all elements of the Set as keys, which can be useful when integrating with
+		// APIs that expect a map rather than a Set or slice.
+		//
+		// The returned map is always a copy so can be modified freely without affecting the Set. For a Set that is safe
+		// for concurrent use, the copy is taken within a single lock.
+		//
+		// If the Set is nil, Set.ToMap returns nil.
+		ToMap() map[E]struct{}
 		// TryRange calls the iter function with each element within the Set but will stop early whenever the iter
 		// function returns an error.
 		//
@@ -183,6 +317,28 @@ type (
 		//
 		// A reference to the MutableSet is returned for method chaining.
 		DeleteAll(elements Set[E]) MutableSet[E]
+		// DeleteAnyOf removes all elements from the MutableSet that exist within any of the specified Set. Any nil Set
+		// is skipped.
+		//
+		// If the MutableSet is nil, MutableSet.DeleteAnyOf is a no-op.
+		//
+		// A reference to the MutableSet is returned for method chaining.
+		DeleteAnyOf(sets ...Set[E]) MutableSet[E]
+		// DeleteExisting removes the element from the MutableSet as well as any additional elements specified, and
+		// returns the number of those elements that were present and therefore removed. Unlike MutableSet.Delete, this
+		// allows the caller to learn whether anything was actually removed.
+		//
+		// If the MutableSet is nil, MutableSet.DeleteExisting is a no-op and returns zero.
+		DeleteExisting(element E, elements ...E) int
+		// DeleteNth removes the element at index i from the MutableSet, were its elements sorted using the provided
+		// less function, and returns the removed element as well as an indication of whether an element was removed.
+		// This can be useful for removing the smallest or median elements, for example.
+		//
+		// If i is out of range (i.e. negative or not less than MutableSet.Len), MutableSet.DeleteNth is a no-op and
+		// returns the zero value for E and false.
+		//
+		// If the MutableSet is nil, MutableSet.DeleteNth is a no-op and returns the zero value for E and false.
+		DeleteNth(less func(x, y E) bool, i int) (E, bool)
 		// DeleteSlice removes all elements in the specified slice from the MutableSet.
 		//
 		// If the MutableSet is nil, MutableSet.DeleteSlice is a no-op.
@@ -195,6 +351,30 @@ type (
 		//
 		// A reference to the MutableSet is returned for method chaining.
 		DeleteWhere(predicate func(element E) bool) MutableSet[E]
+		// DrainWhere removes all elements that match the predicate function from the MutableSet and returns them within
+		// a new Set struct. Unlike MutableSet.DeleteWhere, this allows the caller to inspect the elements that were
+		// removed. Together, the MutableSet and the returned Set contain exactly the elements that the MutableSet
+		// contained beforehand.
+		//
+		// The returned struct implementation of Set should match that of the MutableSet being drained.
+		//
+		// If the MutableSet is nil, MutableSet.DrainWhere returns nil.
+		DrainWhere(predicate func(element E) bool) Set[E]
+		// Grow increases the capacity of the MutableSet, if necessary, to guarantee space for another n elements. After
+		// Grow(n), at least n elements can be added to the MutableSet without it needing to allocate further. This can
+		// be useful when the number of elements about to be added is known in advance. If n is not positive, nothing
+		// changes. Implementations whose storage cannot be sized by number of elements may treat this as a no-op.
+		//
+		// If the MutableSet is nil, MutableSet.Grow is a no-op.
+		//
+		// A reference to the MutableSet is returned for method chaining.
+		Grow(n int) MutableSet[E]
+		// Pop removes an arbitrary element from the MutableSet and returns it as well as an indication of whether the
+		// MutableSet contained any elements. This can be useful for worklist-style algorithms. For a MutableSet that is
+		// safe for concurrent use, this is done within a single lock so that the same element is never popped twice.
+		//
+		// If the MutableSet is nil, MutableSet.Pop is a no-op and returns the zero value for E and false.
+		Pop() (E, bool)
 		// Put adds the element to the MutableSet as well as any additional elements specified. Nothing changes for
 		// elements that already exist within the MutableSet.
 		//
@@ -216,6 +396,13 @@ type (
 		//
 		// A reference to the MutableSet is returned for method chaining.
 		PutSlice(elements []E) MutableSet[E]
+		// ReplaceElement removes the old element from the MutableSet and adds the new element in its place, but only if
+		// the old element is present, and returns whether it was present. If the new element already exists within the
+		// MutableSet, the old element is simply removed. Unlike calling MutableSet.Delete followed by MutableSet.Put,
+		// this is atomic for a MutableSet that is safe for concurrent use, so no transient state is observable.
+		//
+		// If the MutableSet is nil, MutableSet.ReplaceElement is a no-op and returns false.
+		ReplaceElement(oldElement, newElement E) bool
 		// Retain removes all elements from the MutableSet except the element(s) specified.
 		//
 		// If the MutableSet is nil, MutableSet.Retain is a no-op.
@@ -228,6 +415,14 @@ type (
 		//
 		// A reference to the MutableSet is returned for method chaining.
 		RetainAll(elements Set[E]) MutableSet[E]
+		// RetainAllOf removes all elements from the MutableSet except those that exist within all the specified Set.
+		// That is; the MutableSet is intersected with each Set in place. As with MutableSet.RetainAll, any nil Set is
+		// treated as having no elements. If no Set is specified, nothing is removed.
+		//
+		// If the MutableSet is nil, MutableSet.RetainAllOf is a no-op.
+		//
+		// A reference to the MutableSet is returned for method chaining.
+		RetainAllOf(sets ...Set[E]) MutableSet[E]
 		// RetainSlice removes all elements from the MutableSet except those in the specified slice.
 		//
 		// If the MutableSet is nil, MutableSet.RetainSlice is a no-op.
@@ -240,6 +435,76 @@ type (
 		//
 		// A reference to the MutableSet is returned for method chaining.
 		RetainWhere(predicate func(element E) bool) MutableSet[E]
+		// Take removes the element from the MutableSet and returns the element that was stored within the MutableSet
+		// as well as an indication of whether it was present. As elements are compared using equality, the returned
+		// element is always equal to the element provided when present.
+		//
+		// If the MutableSet is nil, MutableSet.Take is a no-op and returns the zero value for E and false.
+		Take(element E) (E, bool)
 		Set[E]
 	}
 )
+
+// SetKind identifies a struct implementation of Set.
+type SetKind uint8
+
+const (
+	// UnknownKind identifies a struct implementation of Set that is not provided by this package.
+	UnknownKind SetKind = iota
+	// EmptyKind identifies EmptySet.
+	EmptyKind
+	// HashKind identifies HashSet.
+	HashKind
+	// MutableHashKind identifies MutableHashSet.
+	MutableHashKind
+	// SingletonKind identifies SingletonSet.
+	SingletonKind
+	// SyncHashKind identifies SyncHashSet.
+	SyncHashKind
+	// SmallKind identifies SmallSet.
+	SmallKind
+	// AdaptiveKind identifies AdaptiveSet.
+	AdaptiveKind
+	// TimedKind identifies TimedSet.
+	TimedKind
+	// FloatHashKind identifies FloatHashSet.
+	FloatHashKind
+	// TreeKind identifies TreeSet.
+	TreeKind
+	// LinkedHashKind identifies LinkedHashSet.
+	LinkedHashKind
+	// BitKind identifies BitSet.
+	BitKind
+)
+
+// String returns the name of the struct implementation of Set identified by the SetKind.
+func (k SetKind) String() string {
+	switch k {
+	case AdaptiveKind:
+		return "Adaptive"
+	case BitKind:
+		return "Bit"
+	case EmptyKind:
+		return "Empty"
+	case FloatHashKind:
+		return "FloatHash"
+	case HashKind:
+		return "Hash"
+	case LinkedHashKind:
+		return "LinkedHash"
+	case MutableHashKind:
+		return "MutableHash"
+	case SingletonKind:
+		return "Singleton"
+	case SmallKind:
+		return "Small"
+	case SyncHashKind:
+		return "SyncHash"
+	case TimedKind:
+		return "Timed"
+	case TreeKind:
+		return "Tree"
+	default:
+		return "Unknown"
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2023 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sets
+
+import "testing"
+
+func Test_SetKind_String(t *testing.T) {
+	testCases := map[string]struct {
+		expect string
+		kind   SetKind
+	}{
+		"with UnknownKind": {
+			expect: "Unknown",
+			kind:   UnknownKind,
+		},
+		"with AdaptiveKind": {
+			expect: "Adaptive",
+			kind:   AdaptiveKind,
+		},
+		"with EmptyKind": {
+			expect: "Empty",
+			kind:   EmptyKind,
+		},
+		"with HashKind": {
+			expect: "Hash",
+			kind:   HashKind,
+		},
+		"with MutableHashKind": {
+			expect: "MutableHash",
+			kind:   MutableHashKind,
+		},
+		"with SingletonKind": {
+			expect: "Singleton",
+			kind:   SingletonKind,
+		},
+		"with SyncHashKind": {
+			expect: "SyncHash",
+			kind:   SyncHashKind,
+		},
+		"with SmallKind": {
+			expect: "Small",
+			kind:   SmallKind,
+		},
+		"with TimedKind": {
+			expect: "Timed",
+			kind:   TimedKind,
+		},
+		"with FloatHashKind": {
+			expect: "FloatHash",
+			kind:   FloatHashKind,
+		},
+		"with TreeKind": {
+			expect: "Tree",
+			kind:   TreeKind,
+		},
+		"with LinkedHashKind": {
+			expect: "LinkedHash",
+			kind:   LinkedHashKind,
+		},
+		"with BitKind": {
+			expect: "Bit",
+			kind:   BitKind,
+		},
+		"with undefined SetKind": {
+			expect: "Unknown",
+			kind:   SetKind(255),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if result := tc.kind.String(); result != tc.expect {
+				t.Errorf("unexpected string; want %q, got %q", tc.expect, result)
+			}
+		})
+	}
+}
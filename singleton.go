@@ -21,9 +21,11 @@
 package sets
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/neocotic/go-sets/internal"
+	"math/rand"
 )
 
 // SingletonSet is an immutable implementation of Set that contains a single datum.
@@ -46,6 +48,37 @@ var (
 	_ json.Unmarshaler = (*SingletonSet[any])(nil)
 )
 
+// All returns an iterator over each element within the SingletonSet, which is compatible with iter.Seq so can be used
+// with range-over-func (e.g. `for element := range set.All()`) from Go 1.23 onwards.
+//
+// Iteration order is not guaranteed to be consistent.
+//
+// If the SingletonSet is nil, the iterator returned by SingletonSet.All yields no elements.
+func (s *SingletonSet[E]) All() func(yield func(element E) bool) {
+	return seq[E](s.Range)
+}
+
+// AppendSlice appends the element within the SingletonSet to the dst slice and returns the extended slice, following
+// the same convention as the built-in append function. This allows a buffer to be reused across calls, avoiding the
+// allocation made by SingletonSet.Slice each time.
+//
+// If the SingletonSet is nil, SingletonSet.AppendSlice returns dst unchanged.
+func (s *SingletonSet[E]) AppendSlice(dst []E) []E {
+	if s == nil {
+		return dst
+	}
+	return append(dst, s.element)
+}
+
+// Canonical returns a minimal byte representation of the SingletonSet that is stable for its elements, which can be
+// useful for hashing, cache keys, or as a shortcut for equality checks. See Set.Canonical for the escaping and
+// separator used.
+//
+// If the SingletonSet is nil it is treated as having no elements and so SingletonSet.Canonical returns an empty slice.
+func (s *SingletonSet[E]) Canonical(convert func(element E) string) []byte {
+	return canonical[E](s, convert)
+}
+
 // Clone returns a clone of the SingletonSet.
 //
 // If the SingletonSet is nil, SingletonSet.Clone returns nil.
@@ -64,6 +97,51 @@ func (s *SingletonSet[E]) Contains(element E) bool {
 	return s != nil && s.element == element
 }
 
+// ContainsAll returns whether the SingletonSet contains the element as well as all additional elements specified,
+// stopping as soon as any element is found not to be contained.
+//
+// If the SingletonSet is nil, SingletonSet.ContainsAll returns false.
+func (s *SingletonSet[E]) ContainsAll(element E, elements ...E) bool {
+	return containsAll[E](element, elements, s.Contains)
+}
+
+// ContainsAny returns whether the SingletonSet contains the element or any of the additional elements specified,
+// stopping as soon as any element is found to be contained.
+//
+// If the SingletonSet is nil, SingletonSet.ContainsAny returns false.
+func (s *SingletonSet[E]) ContainsAny(element E, elements ...E) bool {
+	return containsAny[E](element, elements, s.Contains)
+}
+
+// ContainsEach returns a slice containing whether the SingletonSet contains each of the elements provided, where each
+// result is at the same index as its element.
+//
+// The returned slice always has the same length as the slice of elements provided.
+//
+// If the SingletonSet is nil, SingletonSet.ContainsEach returns a slice of false values.
+func (s *SingletonSet[E]) ContainsEach(elements []E) []bool {
+	return containsEach[E](elements, s.Contains)
+}
+
+// Count returns the number of elements within the SingletonSet that match the predicate function.
+//
+// If the SingletonSet is nil, SingletonSet.Count returns zero.
+func (s *SingletonSet[E]) Count(predicate func(element E) bool) int {
+	return count[E](s.Range, predicate)
+}
+
+// Covers returns whether the SingletonSet is a superset of every other Set. That is; whether the SingletonSet contains
+// all elements within each other Set. Any other Set containing more elements than the SingletonSet is never covered and
+// so is rejected before its elements are checked.
+//
+// Any nil other Set is treated as having no elements and so is always covered. If no other Set is provided,
+// SingletonSet.Covers returns true.
+//
+// If the SingletonSet is nil it is treated as having no elements.
+func (s *SingletonSet[E]) Covers(others ...Set[E]) bool {
+	return covers[E](s.Len(), s.Contains, others)
+}
+
 // Diff returns a new SingletonSet struct containing the element of the SingletonSet if it does not exist in another
 // Set; otherwise an EmptySet.
 //
@@ -89,7 +167,7 @@ func (s *SingletonSet[E]) DiffSymmetric(other Set[E]) Set[E] {
 		var ns *SingletonSet[E]
 		return ns
 	}
-	return &HashSet[E]{internal.DiffSymmetric[E](internal.Singleton(s.element), other)}
+	return &HashSet[E]{elements: internal.DiffSymmetric[E](internal.Singleton(s.element), other)}
 }
 
 // Equal returns whether the other Set also contains the same element.
@@ -164,6 +242,16 @@ func (s *SingletonSet[E]) Intersection(other Set[E]) Set[E] {
 	return &EmptySet[E]{}
 }
 
+// IsDisjoint returns whether the SingletonSet has no elements in common with another Set. Only the elements within the
+// smaller of the two are iterated, with each being checked for within the larger.
+//
+// Any nil other Set, or other Set containing no elements, is disjoint from the SingletonSet.
+//
+// If the SingletonSet is nil, SingletonSet.IsDisjoint returns true.
+func (s *SingletonSet[E]) IsDisjoint(other Set[E]) bool {
+	return disjoint[E](s.Len(), s.Contains, s.Range, other)
+}
+
 // IsEmpty returns whether the SingletonSet is nil to conform with Set.IsEmpty.
 func (s *SingletonSet[E]) IsEmpty() bool {
 	return s == nil
@@ -184,6 +272,11 @@ func (s *SingletonSet[E]) Join(_ string, convert func(element E) string) string
 	return convert(s.element)
 }
 
+// Kind always returns SingletonKind to conform with Set.Kind.
+func (s *SingletonSet[E]) Kind() SetKind {
+	return SingletonKind
+}
+
 // Len returns one if the SingletonSet is not nil; otherwise zero.
 func (s *SingletonSet[E]) Len() int {
 	if s == nil {
@@ -242,6 +335,44 @@ func (s *SingletonSet[E]) Range(iter func(element E) bool) {
 	iter(s.element)
 }
 
+// RangeContext calls the iter function with the element within the SingletonSet, the same as SingletonSet.Range,
+// except that the context is checked first. If the context is done, the iter function is not called and the error of
+// the context is returned.
+//
+// If the SingletonSet is nil, SingletonSet.RangeContext is a no-op and returns nil.
+func (s *SingletonSet[E]) RangeContext(ctx context.Context, iter func(element E) bool) error {
+	if s == nil {
+		return nil
+	}
+	return rangeContext[E](ctx, s.Range, iter)
+}
+
+// Sample returns the element within the SingletonSet to conform with Set.Sample.
+//
+// If the SingletonSet is nil, SingletonSet.Sample returns the zero value for E and false.
+func (s *SingletonSet[E]) Sample() (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	return s.element, true
+}
+
+// SampleN returns a clone of the SingletonSet if n is positive; otherwise an EmptySet.
+//
+// If the SingletonSet is nil, SingletonSet.SampleN returns nil.
+func (s *SingletonSet[E]) SampleN(n int) Set[E] {
+	return s.SampleNWithRand(n, nil)
+}
+
+// SampleNWithRand returns a clone of the SingletonSet if n is positive; otherwise an EmptySet. The source is never
+// used as there is no choice to be made.
+//
+// If the SingletonSet is nil, SingletonSet.SampleNWithRand returns nil.
+func (s *SingletonSet[E]) SampleNWithRand(n int, _ *rand.Rand) Set[E] {
+	return s.Filter(func(_ E) bool { return n > 0 })
+}
+
 // Slice returns a slice containing the element within the SingletonSet.
 //
 // If the SingletonSet is nil, SingletonSet.Slice returns nil.
@@ -259,6 +390,14 @@ func (s *SingletonSet[E]) Some(predicate func(element E) bool) bool {
 	return s != nil && predicate(s.element)
 }
 
+// SortedAppendSlice appends the element within the SingletonSet to the dst slice to conform with
+// Set.SortedAppendSlice.
+//
+// If the SingletonSet is nil, SingletonSet.SortedAppendSlice returns dst unchanged.
+func (s *SingletonSet[E]) SortedAppendSlice(dst []E, _ func(x, y E) bool) []E {
+	return s.AppendSlice(dst)
+}
+
 // SortedJoin returns the element within the SingletonSet converted to a string to conform with Set.SortedJoin.
 //
 // If the SingletonSet is nil, SingletonSet.SortedJoin returns an empty string.
@@ -266,6 +405,16 @@ func (s *SingletonSet[E]) SortedJoin(sep string, convert func(element E) string,
 	return s.Join(sep, convert)
 }
 
+// SortedRange calls the iter function with the element within the SingletonSet at index zero to conform with
+// Set.SortedRange.
+//
+// If the SingletonSet is nil, SingletonSet.SortedRange is a no-op.
+func (s *SingletonSet[E]) SortedRange(_ func(x, y E) bool, iter func(index int, element E) bool) {
+	if s != nil {
+		iter(0, s.element)
+	}
+}
+
 // SortedSlice returns a slice containing the element within the SingletonSet to conform with Set.SortedSlice.
 //
 // If the SingletonSet is nil, SingletonSet.SortedSlice returns nil.
@@ -273,6 +422,18 @@ func (s *SingletonSet[E]) SortedSlice(_ func(x, y E) bool) []E {
 	return s.Slice()
 }
 
+// ToMap returns a map containing only the element within the SingletonSet as a key.
+//
+// The returned map is always a copy so can be modified freely without affecting the SingletonSet.
+//
+// If the SingletonSet is nil, SingletonSet.ToMap returns nil.
+func (s *SingletonSet[E]) ToMap() map[E]struct{} {
+	if s == nil {
+		return nil
+	}
+	return internal.Singleton[E](s.element)
+}
+
 // TryRange calls the iter function with the element within the SingletonSet, which may return an error.
 //
 // If the SingletonSet is nil, SingletonSet.TryRange is a no-op.
@@ -293,7 +454,7 @@ func (s *SingletonSet[E]) Union(other Set[E]) Set[E] {
 				return &SingletonSet[E]{element}
 			}
 		}
-		return &HashSet[E]{elements}
+		return &HashSet[E]{elements: elements}
 	}
 	var ns *SingletonSet[E]
 	return ns
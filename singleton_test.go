@@ -21,9 +21,11 @@
 package sets
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/neocotic/go-sets/internal"
 	"testing"
 )
@@ -95,6 +97,112 @@ func Test_SingletonFromJSON(t *testing.T) {
 	}
 }
 
+func Test_SingletonSet_All(t *testing.T) {
+	var elements []int
+	Singleton(123).All()(func(element int) bool {
+		elements = append(elements, element)
+		return true
+	})
+	if diff := cmp.Diff([]int{123}, elements); diff != "" {
+		t.Errorf("unexpected yielded elements (-want +got):\n%s", diff)
+	}
+}
+
+func Test_SingletonSet_All_Nil(t *testing.T) {
+	var set *SingletonSet[int]
+	var callCount int
+	set.All()(func(_ int) bool {
+		callCount++
+		return true
+	})
+	if callCount != 0 {
+		t.Errorf("unexpected number of calls to yield; want 0, got %v", callCount)
+	}
+}
+
+func Test_SingletonSet_AppendSlice(t *testing.T) {
+	testCases := map[string]struct {
+		set *SingletonSet[int]
+		dst []int
+	}{
+		"with nil dst on non-empty *SingletonSet": {
+			set: Singleton(123),
+			dst: nil,
+		},
+		"with non-empty dst on non-empty *SingletonSet": {
+			set: Singleton(123),
+			dst: []int{1, 2},
+		},
+		"with dst having spare capacity on non-empty *SingletonSet": {
+			set: Singleton(123),
+			dst: make([]int, 0, 8),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dst := append([]int(nil), tc.dst...)
+			ret := tc.set.AppendSlice(tc.dst)
+
+			if expectLen := len(tc.dst) + tc.set.Len(); len(ret) != expectLen {
+				t.Fatalf("unexpected slice length; want %v, got %v", expectLen, len(ret))
+			}
+			if !cmp.Equal(dst, ret[:len(tc.dst)], cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected leading elements; want %v, got %v", dst, ret[:len(tc.dst)])
+			}
+			if tail := ret[len(tc.dst):]; !cmp.Equal(tc.set.Slice(), tail, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected appended elements; want %v, got %v", tc.set.Slice(), tail)
+			}
+			if cap(tc.dst) >= len(ret) && len(ret) > 0 && &tc.dst[:1][0] != &ret[0] {
+				t.Error("unexpected slice allocation; want dst to be reused")
+			}
+		})
+	}
+}
+
+func Test_SingletonSet_AppendSlice_Nil(t *testing.T) {
+	var set *SingletonSet[int]
+	dst := []int{1, 2}
+	ret := set.AppendSlice(dst)
+
+	if !cmp.Equal(dst, ret) {
+		t.Errorf("unexpected slice; want %v, got %v", dst, ret)
+	}
+}
+
+func Test_SingletonSet_Canonical(t *testing.T) {
+	testCases := map[string]struct {
+		expect string
+		set    *SingletonSet[string]
+	}{
+		"with element": {
+			expect: "foo",
+			set:    Singleton("foo"),
+		},
+		"with element requiring escaping": {
+			expect: `a\,b\\`,
+			set:    Singleton(`a,b\`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			canonical := string(tc.set.Canonical(func(element string) string { return element }))
+			if canonical != tc.expect {
+				t.Errorf("unexpected canonical; want %q, got %q", tc.expect, canonical)
+			}
+		})
+	}
+}
+
+func Test_SingletonSet_Canonical_Nil(t *testing.T) {
+	var set *SingletonSet[string]
+	canonical := set.Canonical(func(element string) string { return element })
+	if canonical == nil || len(canonical) != 0 {
+		t.Errorf("unexpected canonical; want %#v, got %#v", []byte{}, canonical)
+	}
+}
+
 func Test_SingletonSet_Clone(t *testing.T) {
 	set := Singleton(123)
 	clone := set.Clone()
@@ -159,6 +267,82 @@ func Test_SingletonSet_Contains(t *testing.T) {
 	}
 }
 
+func Test_SingletonSet_ContainsAll(t *testing.T) {
+	testCases := map[string]struct {
+		element  int
+		elements []int
+		expect   bool
+	}{
+		"with contained element": {
+			element: 123,
+			expect:  true,
+		},
+		"with duplicate contained elements": {
+			element:  123,
+			elements: []int{123},
+			expect:   true,
+		},
+		"with element not contained": {
+			element:  123,
+			elements: []int{456},
+			expect:   false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if result := Singleton(123).ContainsAll(tc.element, tc.elements...); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_SingletonSet_ContainsAll_Nil(t *testing.T) {
+	var set *SingletonSet[int]
+	if set.ContainsAll(0) {
+		t.Error("unexpected result; want false, got true")
+	}
+}
+
+func Test_SingletonSet_ContainsAny(t *testing.T) {
+	testCases := map[string]struct {
+		element  int
+		elements []int
+		expect   bool
+	}{
+		"with contained element": {
+			element: 123,
+			expect:  true,
+		},
+		"with last element contained": {
+			element:  -123,
+			elements: []int{456, 123},
+			expect:   true,
+		},
+		"with no elements contained": {
+			element:  -123,
+			elements: []int{456},
+			expect:   false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if result := Singleton(123).ContainsAny(tc.element, tc.elements...); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_SingletonSet_ContainsAny_Nil(t *testing.T) {
+	var set *SingletonSet[int]
+	if set.ContainsAny(0) {
+		t.Error("unexpected result; want false, got true")
+	}
+}
+
 func Test_SingletonSet_Contains_Nil(t *testing.T) {
 	testCases := map[string]struct {
 		element int
@@ -177,6 +361,102 @@ func Test_SingletonSet_Contains_Nil(t *testing.T) {
 	}
 }
 
+func Test_SingletonSet_ContainsEach(t *testing.T) {
+	testCases := map[string]struct {
+		elements []int
+		expect   []bool
+	}{
+		"with no elements": {
+			elements: nil,
+			expect:   []bool{},
+		},
+		"with mix of present and absent elements": {
+			elements: []int{999, 123, 0, 123},
+			expect:   []bool{false, true, false, true},
+		},
+		"with duplicate elements": {
+			elements: []int{0, 0},
+			expect:   []bool{false, false},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Singleton(123)
+			if diff := cmp.Diff(tc.expect, set.ContainsEach(tc.elements)); diff != "" {
+				t.Errorf("unexpected results (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_SingletonSet_ContainsEach_Nil(t *testing.T) {
+	var set *SingletonSet[int]
+	if diff := cmp.Diff([]bool{false, false}, set.ContainsEach([]int{123, 0})); diff != "" {
+		t.Errorf("unexpected results (-want +got):\n%s", diff)
+	}
+}
+
+func Test_SingletonSet_Count(t *testing.T) {
+	set := Singleton(123)
+	if result := set.Count(func(element int) bool { return element == 123 }); result != 1 {
+		t.Errorf("unexpected count; want 1, got %v", result)
+	}
+	if result := set.Count(func(element int) bool { return element != 123 }); result != 0 {
+		t.Errorf("unexpected count; want 0, got %v", result)
+	}
+}
+
+func Test_SingletonSet_Count_Nil(t *testing.T) {
+	var set *SingletonSet[int]
+	if result := set.Count(func(_ int) bool { return true }); result != 0 {
+		t.Errorf("unexpected count; want 0, got %v", result)
+	}
+}
+
+func Test_SingletonSet_Covers(t *testing.T) {
+	testCases := map[string]struct {
+		expect bool
+		others []Set[int]
+	}{
+		"with no other Sets": {
+			expect: true,
+			others: nil,
+		},
+		"with covered Sets": {
+			expect: true,
+			others: []Set[int]{Hash(123), Singleton(123), Hash[int](), nil},
+		},
+		"with Set containing other element": {
+			expect: false,
+			others: []Set[int]{Hash(123), Hash(456)},
+		},
+		"with Set containing more elements": {
+			expect: false,
+			others: []Set[int]{Hash(123, 456)},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Singleton(123)
+			if covers := set.Covers(tc.others...); covers != tc.expect {
+				t.Errorf("unexpected covers; want %v, got %v", tc.expect, covers)
+			}
+		})
+	}
+}
+
+func Test_SingletonSet_Covers_Nil(t *testing.T) {
+	var set *SingletonSet[int]
+	if !set.Covers(Hash[int](), nil) {
+		t.Error("unexpected covers of empty Set; want true, got false")
+	}
+	if set.Covers(Singleton(123)) {
+		t.Error("unexpected covers of non-empty Set; want false, got true")
+	}
+}
+
 func Test_SingletonSet_Diff(t *testing.T) {
 	testCases := map[string]struct {
 		expect Set[int]
@@ -851,6 +1131,46 @@ func Test_SingletonSet_Intersection_Nil(t *testing.T) {
 	}
 }
 
+func Test_SingletonSet_IsDisjoint(t *testing.T) {
+	testCases := map[string]struct {
+		expect bool
+		other  Set[int]
+	}{
+		"with Set containing element": {
+			expect: false,
+			other:  Hash(-123, 0, 123),
+		},
+		"with Set not containing element": {
+			expect: true,
+			other:  Hash(-123, 0, 456),
+		},
+		"with empty Set": {
+			expect: true,
+			other:  Hash[int](),
+		},
+		"with nil Set": {
+			expect: true,
+			other:  nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Singleton(123)
+			if result := set.IsDisjoint(tc.other); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_SingletonSet_IsDisjoint_Nil(t *testing.T) {
+	var set *SingletonSet[int]
+	if !set.IsDisjoint(Hash(123)) {
+		t.Error("unexpected result; want true, got false")
+	}
+}
+
 func Test_SingletonSet_IsEmpty(t *testing.T) {
 	set := Singleton(123)
 	if set.IsEmpty() {
@@ -896,6 +1216,20 @@ func Test_SingletonSet_Join_Nil(t *testing.T) {
 	}
 }
 
+func Test_SingletonSet_Kind(t *testing.T) {
+	set := Singleton(123)
+	if kind := set.Kind(); kind != SingletonKind {
+		t.Errorf("unexpected kind; want %v, got %v", SingletonKind, kind)
+	}
+}
+
+func Test_SingletonSet_Kind_Nil(t *testing.T) {
+	var set *SingletonSet[int]
+	if kind := set.Kind(); kind != SingletonKind {
+		t.Errorf("unexpected kind; want %v, got %v", SingletonKind, kind)
+	}
+}
+
 func Test_SingletonSet_Len(t *testing.T) {
 	set := Singleton(123)
 	if l := set.Len(); l != 1 {
@@ -1121,6 +1455,55 @@ func Test_SingletonSet_Range(t *testing.T) {
 	}
 }
 
+func Test_SingletonSet_RangeContext(t *testing.T) {
+	set := Singleton(123)
+	var elements []int
+	err := set.RangeContext(context.Background(), func(element int) bool {
+		elements = append(elements, element)
+		return false
+	})
+	if err != nil {
+		t.Errorf("unexpected error; want nil, got %q", err)
+	}
+	if expect := set.Len(); len(elements) != expect {
+		t.Errorf("unexpected number of iter function calls; want %v, got %v", expect, len(elements))
+	}
+	if actual := HashFromSlice(elements); !actual.Equal(set) {
+		t.Errorf("unexpected iterated elements; want %v, got %v", set, actual)
+	}
+}
+
+func Test_SingletonSet_RangeContext_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var called bool
+	err := Singleton(123).RangeContext(ctx, func(_ int) bool {
+		called = true
+		return false
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error; want %q, got %q", context.Canceled, err)
+	}
+	if called {
+		t.Error("unexpected iter function call")
+	}
+}
+
+func Test_SingletonSet_RangeContext_Nil(t *testing.T) {
+	var set *SingletonSet[int]
+	var called bool
+	err := set.RangeContext(context.Background(), func(_ int) bool {
+		called = true
+		return false
+	})
+	if err != nil {
+		t.Errorf("unexpected error; want nil, got %q", err)
+	}
+	if called {
+		t.Error("unexpected iter function call")
+	}
+}
+
 func Test_SingletonSet_Range_Nil(t *testing.T) {
 	var funcCallCount int
 	var set *SingletonSet[int]
@@ -1133,6 +1516,66 @@ func Test_SingletonSet_Range_Nil(t *testing.T) {
 	}
 }
 
+func Test_SingletonSet_Sample(t *testing.T) {
+	set := Singleton(123)
+	if element, ok := set.Sample(); !ok || element != 123 {
+		t.Errorf("unexpected element; want 123, got %v (%v)", element, ok)
+	}
+}
+
+func Test_SingletonSet_Sample_Nil(t *testing.T) {
+	var set *SingletonSet[int]
+	if element, ok := set.Sample(); ok {
+		t.Errorf("unexpected element; want none, got %v", element)
+	}
+}
+
+func Test_SingletonSet_SampleN(t *testing.T) {
+	testCases := map[string]struct {
+		expect     Set[int]
+		expectKind SetKind
+		n          int
+	}{
+		"with positive n": {
+			expect:     Singleton(123),
+			expectKind: SingletonKind,
+			n:          2,
+		},
+		"with zero n": {
+			expect:     Empty[int](),
+			expectKind: EmptyKind,
+			n:          0,
+		},
+		"with negative n": {
+			expect:     Empty[int](),
+			expectKind: EmptyKind,
+			n:          -1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sampled := Singleton(123).SampleN(tc.n)
+			if internal.IsNil(sampled) {
+				t.Fatal("unexpected nil Set")
+			}
+			if kind := sampled.Kind(); kind != tc.expectKind {
+				t.Errorf("unexpected SetKind; want %v, got %v", tc.expectKind, kind)
+			}
+			if !sampled.Equal(tc.expect) {
+				t.Errorf("unexpected sampled Set; want %v, got %v", tc.expect, sampled)
+			}
+		})
+	}
+}
+
+func Test_SingletonSet_SampleN_Nil(t *testing.T) {
+	var set *SingletonSet[int]
+	if sampled := set.SampleN(2); sampled != (*SingletonSet[int])(nil) {
+		t.Errorf("unexpected sampled Set; want nil, got %v", sampled)
+	}
+}
+
 func Test_SingletonSet_Slice(t *testing.T) {
 	set := Singleton(123)
 	elements := set.Slice()
@@ -1230,6 +1673,44 @@ func Test_SingletonSet_Some_Nil(t *testing.T) {
 	}
 }
 
+func Test_SingletonSet_SortedAppendSlice(t *testing.T) {
+	testCases := map[string]struct {
+		set    *SingletonSet[int]
+		dst    []int
+		expect []int
+	}{
+		"with nil dst on non-empty *SingletonSet": {
+			set:    Singleton(123),
+			dst:    nil,
+			expect: []int{123},
+		},
+		"with non-empty dst on non-empty *SingletonSet": {
+			set:    Singleton(123),
+			dst:    []int{1, 999},
+			expect: []int{1, 999, 123},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ret := tc.set.SortedAppendSlice(tc.dst, Desc[int])
+			if !cmp.Equal(tc.expect, ret, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected slice; want %v, got %v", tc.expect, ret)
+			}
+		})
+	}
+}
+
+func Test_SingletonSet_SortedAppendSlice_Nil(t *testing.T) {
+	var set *SingletonSet[int]
+	dst := []int{1, 999}
+	ret := set.SortedAppendSlice(dst, Desc[int])
+
+	if !cmp.Equal(dst, ret) {
+		t.Errorf("unexpected slice; want %v, got %v", dst, ret)
+	}
+}
+
 func Test_SingletonSet_SortedJoin(t *testing.T) {
 	set := Singleton(123)
 	result := set.SortedJoin(",", getIntStringConverterWithDefaultOptions[int](), Asc[int])
@@ -1246,6 +1727,32 @@ func Test_SingletonSet_SortedJoin_Nil(t *testing.T) {
 	}
 }
 
+func Test_SingletonSet_SortedRange(t *testing.T) {
+	set := Singleton(123)
+	var calls int
+	set.SortedRange(Asc[int], func(index int, element int) bool {
+		calls++
+		if index != 0 {
+			t.Errorf("unexpected index; want 0, got %v", index)
+		}
+		if element != 123 {
+			t.Errorf("unexpected element; want 123, got %v", element)
+		}
+		return false
+	})
+	if calls != 1 {
+		t.Errorf("unexpected number of calls to iter; want 1, got %v", calls)
+	}
+}
+
+func Test_SingletonSet_SortedRange_Nil(t *testing.T) {
+	var set *SingletonSet[int]
+	set.SortedRange(Asc[int], func(index int, element int) bool {
+		t.Errorf("unexpected call to iter with %v at index %v", element, index)
+		return false
+	})
+}
+
 func Test_SingletonSet_SortedSlice(t *testing.T) {
 	set := Singleton(123)
 	elements := set.SortedSlice(Asc[int])
@@ -1265,6 +1772,25 @@ func Test_SingletonSet_SortedSlice_Nil(t *testing.T) {
 	}
 }
 
+func Test_SingletonSet_ToMap(t *testing.T) {
+	set := Singleton(123)
+	m := set.ToMap()
+	if exp := map[int]struct{}{123: {}}; !cmp.Equal(exp, m) {
+		t.Errorf("unexpected map; want %v, got %v", exp, m)
+	}
+	delete(m, 123)
+	if !set.Contains(123) {
+		t.Error("unexpected Set containment of 123; want true, got false")
+	}
+}
+
+func Test_SingletonSet_ToMap_Nil(t *testing.T) {
+	var set *SingletonSet[int]
+	if m := set.ToMap(); m != nil {
+		t.Errorf("unexpected map; want nil, got %v", m)
+	}
+}
+
 func Test_SingletonSet_TryRange(t *testing.T) {
 	testError := errors.New("test")
 	testCases := map[string]struct {
@@ -0,0 +1,1012 @@
+// Copyright (C) 2023 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/neocotic/go-sets/internal"
+	"math/rand"
+	"sort"
+)
+
+// SmallSet is an implementation of MutableSet that contains a unique data set backed by a slice kept sorted using a
+// less function.
+//
+// For small data sets (e.g. no more than 16 elements), SmallSet is typically cheaper to create and populate than
+// map-based implementations, such as MutableHashSet, due to better cache locality and the absence of any map overhead,
+// which is particularly beneficial where many small sets are created (e.g. per-node adjacency). SmallSet.Contains uses
+// binary search so remains competitive, however, SmallSet.Put and SmallSet.Delete must shift elements within the slice,
+// so SmallSet scales poorly as its number of elements grows.
+//
+// The less function must define a strict total ordering of E, such that two elements are equal if, and only if, neither
+// is less than the other. Asc and Desc can be used for ordered types.
+//
+// As SmallSet is mutable it is not safe for concurrent use by multiple goroutines.
+type SmallSet[E comparable] struct {
+	elements []E
+	less     func(x, y E) bool
+}
+
+var (
+	_ MutableSet[any]  = (*SmallSet[any])(nil)
+	_ fmt.Stringer     = (*SmallSet[any])(nil)
+	_ json.Marshaler   = (*SmallSet[any])(nil)
+	_ json.Unmarshaler = (*SmallSet[any])(nil)
+)
+
+// All returns an iterator over each element within the SmallSet, which is compatible with iter.Seq so can be used with
+// range-over-func (e.g. `for element := range set.All()`) from Go 1.23 onwards.
+//
+// Iteration order is not guaranteed to be consistent.
+//
+// If the SmallSet is nil, the iterator returned by SmallSet.All yields no elements.
+func (s *SmallSet[E]) All() func(yield func(element E) bool) {
+	return seq[E](s.Range)
+}
+
+// AppendSlice appends all elements of the SmallSet to the dst slice and returns the extended slice, following the same
+// convention as the built-in append function. This allows a buffer to be reused across calls, avoiding the allocation
+// made by SmallSet.Slice each time.
+//
+// Elements are appended in the order determined by the less function of the SmallSet.
+//
+// If the SmallSet is nil, SmallSet.AppendSlice returns dst unchanged.
+func (s *SmallSet[E]) AppendSlice(dst []E) []E {
+	if s == nil {
+		return dst
+	}
+	return append(dst, s.elements...)
+}
+
+// Canonical returns a minimal byte representation of the SmallSet that is stable for its elements, which can be useful
+// for hashing, cache keys, or as a shortcut for equality checks. See Set.Canonical for the escaping and separator used.
+//
+// If the SmallSet is nil it is treated as having no elements and so SmallSet.Canonical returns an empty slice.
+func (s *SmallSet[E]) Canonical(convert func(element E) string) []byte {
+	return canonical[E](s, convert)
+}
+
+// Clear removes all elements from the SmallSet.
+//
+// If the SmallSet is nil, SmallSet.Clear is a no-op.
+//
+// A reference to the SmallSet is returned for method chaining.
+func (s *SmallSet[E]) Clear() MutableSet[E] {
+	if s == nil {
+		var ns *SmallSet[E]
+		return ns
+	}
+	s.elements = nil
+	return s
+}
+
+// Clone returns a clone of the SmallSet.
+//
+// If the SmallSet is nil, SmallSet.Clone returns nil.
+func (s *SmallSet[E]) Clone() Set[E] {
+	if s == nil {
+		var ns *SmallSet[E]
+		return ns
+	}
+	return s.with(s.clone())
+}
+
+// Contains returns whether the SmallSet contains the element.
+//
+// If the SmallSet is nil, SmallSet.Contains returns false.
+func (s *SmallSet[E]) Contains(element E) bool {
+	if s == nil {
+		return false
+	}
+	_, ok := internal.SortedSearch(s.elements, s.less, element)
+	return ok
+}
+
+// ContainsAll returns whether the SmallSet contains the element as well as all additional elements specified, stopping
+// as soon as any element is found not to be contained.
+//
+// If the SmallSet is nil, SmallSet.ContainsAll returns false.
+func (s *SmallSet[E]) ContainsAll(element E, elements ...E) bool {
+	return containsAll[E](element, elements, s.Contains)
+}
+
+// ContainsAny returns whether the SmallSet contains the element or any of the additional elements specified, stopping
+// as soon as any element is found to be contained.
+//
+// If the SmallSet is nil, SmallSet.ContainsAny returns false.
+func (s *SmallSet[E]) ContainsAny(element E, elements ...E) bool {
+	return containsAny[E](element, elements, s.Contains)
+}
+
+// ContainsEach returns a slice containing whether the SmallSet contains each of the elements provided, where each
+// result is at the same index as its element.
+//
+// The returned slice always has the same length as the slice of elements provided.
+//
+// If the SmallSet is nil, SmallSet.ContainsEach returns a slice of false values.
+func (s *SmallSet[E]) ContainsEach(elements []E) []bool {
+	return containsEach[E](elements, s.Contains)
+}
+
+// Count returns the number of elements within the SmallSet that match the predicate function.
+//
+// If the SmallSet is nil, SmallSet.Count returns zero.
+func (s *SmallSet[E]) Count(predicate func(element E) bool) int {
+	return count[E](s.Range, predicate)
+}
+
+// Covers returns whether the SmallSet is a superset of every other Set. That is; whether the SmallSet contains all
+// elements within each other Set. Any other Set containing more elements than the SmallSet is never covered and so is
+// rejected before its elements are checked.
+//
+// Any nil other Set is treated as having no elements and so is always covered. If no other Set is provided,
+// SmallSet.Covers returns true.
+//
+// If the SmallSet is nil it is treated as having no elements.
+func (s *SmallSet[E]) Covers(others ...Set[E]) bool {
+	return covers[E](s.Len(), s.Contains, others)
+}
+
+// Delete removes the element from the SmallSet as well as any additional elements specified.
+//
+// If the SmallSet is nil, SmallSet.Delete is a no-op.
+//
+// A reference to the SmallSet is returned for method chaining.
+func (s *SmallSet[E]) Delete(element E, elements ...E) MutableSet[E] {
+	if s == nil {
+		var ns *SmallSet[E]
+		return ns
+	}
+	s.elements = internal.SortedDelete(s.elements, s.less, element)
+	for _, _element := range elements {
+		s.elements = internal.SortedDelete(s.elements, s.less, _element)
+	}
+	return s
+}
+
+// DeleteAll removes all elements in the specified Set from the SmallSet.
+//
+// If the SmallSet is nil, SmallSet.DeleteAll is a no-op.
+//
+// A reference to the SmallSet is returned for method chaining.
+func (s *SmallSet[E]) DeleteAll(elements Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *SmallSet[E]
+		return ns
+	}
+	if elements != nil {
+		s.elements = s.filter(func(element E) bool { return !elements.Contains(element) })
+	}
+	return s
+}
+
+// DeleteAnyOf removes all elements from the SmallSet that exist within any of the specified Set. Any nil Set is
+// skipped.
+//
+// If the SmallSet is nil, SmallSet.DeleteAnyOf is a no-op.
+//
+// A reference to the SmallSet is returned for method chaining.
+func (s *SmallSet[E]) DeleteAnyOf(sets ...Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *SmallSet[E]
+		return ns
+	}
+	s.elements = s.filter(func(element E) bool { return !containedByAny(sets, element) })
+	return s
+}
+
+// DeleteExisting removes the element from the SmallSet as well as any additional elements specified, and returns the
+// number of those elements that were present and therefore removed. Unlike SmallSet.Delete, this allows the caller to
+// learn whether anything was actually removed.
+//
+// If the SmallSet is nil, SmallSet.DeleteExisting is a no-op and returns zero.
+func (s *SmallSet[E]) DeleteExisting(element E, elements ...E) int {
+	if s == nil {
+		return 0
+	}
+	return deleteExisting[E](s.Take, element, elements)
+}
+
+// DeleteNth removes the element at index i from the SmallSet, were its elements sorted using the provided less
+// function, and returns the removed element as well as an indication of whether an element was removed.
+//
+// If i is out of range (i.e. negative or not less than SmallSet.Len), SmallSet.DeleteNth is a no-op and returns the
+// zero value for E and false.
+//
+// If the SmallSet is nil, SmallSet.DeleteNth is a no-op and returns the zero value for E and false.
+func (s *SmallSet[E]) DeleteNth(less func(x, y E) bool, i int) (E, bool) {
+	if s == nil || i < 0 || i >= len(s.elements) {
+		var zero E
+		return zero, false
+	}
+	element := s.SortedSlice(less)[i]
+	s.elements = internal.SortedDelete(s.elements, s.less, element)
+	return element, true
+}
+
+// DeleteSlice removes all elements in the specified slice from the SmallSet.
+//
+// If the SmallSet is nil, SmallSet.DeleteSlice is a no-op.
+//
+// A reference to the SmallSet is returned for method chaining.
+func (s *SmallSet[E]) DeleteSlice(elements []E) MutableSet[E] {
+	if s == nil {
+		var ns *SmallSet[E]
+		return ns
+	}
+	for _, element := range elements {
+		s.elements = internal.SortedDelete(s.elements, s.less, element)
+	}
+	return s
+}
+
+// DeleteWhere removes all elements that match the predicate function from the SmallSet.
+//
+// If the SmallSet is nil, SmallSet.DeleteWhere is a no-op.
+//
+// A reference to the SmallSet is returned for method chaining.
+func (s *SmallSet[E]) DeleteWhere(predicate func(element E) bool) MutableSet[E] {
+	if s == nil {
+		var ns *SmallSet[E]
+		return ns
+	}
+	s.elements = s.filter(func(element E) bool { return !predicate(element) })
+	return s
+}
+
+// Diff returns a new SmallSet struct containing only elements of the SmallSet that do not exist in another Set.
+//
+// If the SmallSet is nil, SmallSet.Diff returns nil.
+func (s *SmallSet[E]) Diff(other Set[E]) Set[E] {
+	if s == nil {
+		var ns *SmallSet[E]
+		return ns
+	}
+	if other == nil {
+		return s.Clone()
+	}
+	return s.with(s.filter(func(element E) bool { return !other.Contains(element) }))
+}
+
+// DiffSymmetric returns a new SmallSet struct containing elements that exist within the SmallSet or another Set, but
+// not both.
+//
+// If the SmallSet is nil, SmallSet.DiffSymmetric returns nil.
+func (s *SmallSet[E]) DiffSymmetric(other Set[E]) Set[E] {
+	if s == nil {
+		var ns *SmallSet[E]
+		return ns
+	}
+	if other == nil {
+		return s.Clone()
+	}
+	elements := s.filter(func(element E) bool { return !other.Contains(element) })
+	other.Range(func(element E) bool {
+		if !s.Contains(element) {
+			elements = append(elements, element)
+		}
+		return false
+	})
+	return s.with(internal.SortedFromSlice(elements, s.less))
+}
+
+// DrainWhere removes all elements that match the predicate function from the SmallSet and returns them within a new
+// SmallSet struct. Unlike SmallSet.DeleteWhere, this allows the caller to inspect the elements that were removed.
+// Together, the SmallSet and the returned SmallSet contain exactly the elements that the SmallSet contained beforehand.
+//
+// If the SmallSet is nil, SmallSet.DrainWhere returns nil.
+func (s *SmallSet[E]) DrainWhere(predicate func(element E) bool) Set[E] {
+	if s == nil {
+		var ns *SmallSet[E]
+		return ns
+	}
+	drained := s.Filter(predicate)
+	s.DeleteAll(drained)
+	return drained
+}
+
+// Equal returns whether the SmallSet contains the exact same elements as another Set.
+//
+// If the SmallSet is nil it is treated as having no elements and the same logic applies to the other Set. To clarify;
+// this means that a nil Set is equal to a non-nil Set that contains no elements.
+func (s *SmallSet[E]) Equal(other Set[E]) bool {
+	if s == nil {
+		return other == nil || other.IsEmpty()
+	} else if other == nil {
+		return s.IsEmpty()
+	}
+	if len(s.elements) != other.Len() {
+		return false
+	}
+	for _, element := range s.elements {
+		if !other.Contains(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// Every returns whether the SmallSet contains elements that all match the predicate function.
+//
+// If the SmallSet is nil, SmallSet.Every returns false.
+func (s *SmallSet[E]) Every(predicate func(element E) bool) bool {
+	if s == nil || len(s.elements) == 0 {
+		return false
+	}
+	for _, element := range s.elements {
+		if !predicate(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// Filter returns a new SmallSet struct containing only elements of the SmallSet that match the filter function.
+//
+// If the SmallSet is nil, SmallSet.Filter returns nil.
+func (s *SmallSet[E]) Filter(filter func(element E) bool) Set[E] {
+	if s == nil {
+		var ns *SmallSet[E]
+		return ns
+	}
+	return s.with(s.filter(filter))
+}
+
+// Find returns an element within the SmallSet that matches the search function as well as an indication of whether a
+// match was found.
+//
+// Elements are searched in the order determined by the less function of the SmallSet.
+//
+// If the SmallSet is nil, SmallSet.Find returns the zero value for E and false.
+func (s *SmallSet[E]) Find(search func(element E) bool) (E, bool) {
+	if s != nil {
+		for _, element := range s.elements {
+			if search(element) {
+				return element, true
+			}
+		}
+	}
+	var zero E
+	return zero, false
+}
+
+// Grow increases the capacity of the SmallSet, if necessary, to guarantee space for another n elements. After Grow(n),
+// at least n elements can be added to the SmallSet without it needing to allocate further.
+//
+// If n is not positive, SmallSet.Grow is a no-op.
+//
+// If the SmallSet is nil, SmallSet.Grow is a no-op.
+//
+// A reference to the SmallSet is returned for method chaining.
+func (s *SmallSet[E]) Grow(n int) MutableSet[E] {
+	if s == nil {
+		var ns *SmallSet[E]
+		return ns
+	}
+	s.elements = internal.GrowSlice(s.elements, n)
+	return s
+}
+
+// Immutable returns an immutable clone of the SmallSet.
+//
+// If the SmallSet is nil, SmallSet.Immutable returns nil.
+func (s *SmallSet[E]) Immutable() Set[E] {
+	if s == nil {
+		var ns *HashSet[E]
+		return ns
+	}
+	return &HashSet[E]{elements: internal.FromSlice(s.elements)}
+}
+
+// Intersection returns a new SmallSet struct containing only elements of the SmallSet that also exist in another Set.
+//
+// If the SmallSet is nil, SmallSet.Intersection returns nil.
+func (s *SmallSet[E]) Intersection(other Set[E]) Set[E] {
+	if s == nil {
+		var ns *SmallSet[E]
+		return ns
+	}
+	if other == nil {
+		return s.with(nil)
+	}
+	return s.with(s.filter(other.Contains))
+}
+
+// IsDisjoint returns whether the SmallSet has no elements in common with another Set. Only the elements within the
+// smaller of the two are iterated, with each being checked for within the larger.
+//
+// Any nil other Set, or other Set containing no elements, is disjoint from the SmallSet.
+//
+// If the SmallSet is nil, SmallSet.IsDisjoint returns true.
+func (s *SmallSet[E]) IsDisjoint(other Set[E]) bool {
+	return disjoint[E](s.Len(), s.Contains, s.Range, other)
+}
+
+// IsEmpty returns whether the SmallSet contains no elements.
+//
+// If the SmallSet is nil, SmallSet.IsEmpty returns true.
+func (s *SmallSet[E]) IsEmpty() bool {
+	if s == nil {
+		return true
+	}
+	return len(s.elements) == 0
+}
+
+// IsMutable always returns true to conform with Set.IsMutable.
+func (s *SmallSet[E]) IsMutable() bool {
+	return true
+}
+
+// Join converts the elements within the SmallSet to strings which are then concatenated to create a single string,
+// placing sep between the converted elements in the resulting string.
+//
+// Elements are joined in the order determined by the less function of the SmallSet.
+//
+// If the SmallSet is nil, SmallSet.Join returns an empty string.
+func (s *SmallSet[E]) Join(sep string, convert func(element E) string) string {
+	if s == nil {
+		return ""
+	}
+	return joinSlice(s.elements, sep, convert)
+}
+
+// Kind always returns SmallKind to conform with Set.Kind.
+func (s *SmallSet[E]) Kind() SetKind {
+	return SmallKind
+}
+
+// Len returns the number of elements within the SmallSet.
+//
+// If the SmallSet is nil, SmallSet.Len returns zero.
+func (s *SmallSet[E]) Len() int {
+	if s == nil {
+		return 0
+	}
+	return len(s.elements)
+}
+
+// Max returns the maximum element within the SmallSet using the provided less function.
+//
+// If the SmallSet is nil, SmallSet.Max returns the zero value for E and false.
+func (s *SmallSet[E]) Max(less func(x, y E) bool) (E, bool) {
+	if s == nil || len(s.elements) == 0 {
+		var zero E
+		return zero, false
+	}
+	max := s.elements[0]
+	for _, element := range s.elements[1:] {
+		if less(max, element) {
+			max = element
+		}
+	}
+	return max, true
+}
+
+// Min returns the minimum element within the SmallSet using the provided less function.
+//
+// If the SmallSet is nil, SmallSet.Min returns the zero value for E and false.
+func (s *SmallSet[E]) Min(less func(x, y E) bool) (E, bool) {
+	if s == nil || len(s.elements) == 0 {
+		var zero E
+		return zero, false
+	}
+	min := s.elements[0]
+	for _, element := range s.elements[1:] {
+		if less(element, min) {
+			min = element
+		}
+	}
+	return min, true
+}
+
+// Mutable returns a reference to itself to conform with Set.Mutable.
+//
+// If the SmallSet is nil, SmallSet.Mutable returns nil.
+func (s *SmallSet[E]) Mutable() MutableSet[E] {
+	if s == nil {
+		var ns *SmallSet[E]
+		return ns
+	}
+	return s
+}
+
+// None returns whether the SmallSet contains no elements that match the predicate function.
+//
+// If the SmallSet is nil, SmallSet.None returns true.
+func (s *SmallSet[E]) None(predicate func(element E) bool) bool {
+	return !s.Some(predicate)
+}
+
+// Pop removes an element from the SmallSet and returns it as well as an indication of whether the SmallSet contained
+// any elements. As the SmallSet is sorted, the element removed is always its maximum according to its less function,
+// which can be done without shifting any other elements.
+//
+// If the SmallSet is nil, SmallSet.Pop is a no-op and returns the zero value for E and false.
+func (s *SmallSet[E]) Pop() (E, bool) {
+	var zero E
+	if s == nil || len(s.elements) == 0 {
+		return zero, false
+	}
+	last := len(s.elements) - 1
+	element := s.elements[last]
+	s.elements[last] = zero
+	s.elements = s.elements[:last]
+	return element, true
+}
+
+// Put adds the element to the SmallSet as well as any additional elements specified. Nothing changes for elements that
+// already exist within the SmallSet.
+//
+// If the SmallSet is nil, SmallSet.Put is a no-op.
+//
+// A reference to the SmallSet is returned for method chaining.
+func (s *SmallSet[E]) Put(element E, elements ...E) MutableSet[E] {
+	if s == nil {
+		var ns *SmallSet[E]
+		return ns
+	}
+	s.elements = internal.SortedInsert(s.elements, s.less, element)
+	for _, _element := range elements {
+		s.elements = internal.SortedInsert(s.elements, s.less, _element)
+	}
+	return s
+}
+
+// PutAll adds all elements in the specified Set to the SmallSet. Nothing changes for elements that already exist within
+// the SmallSet.
+//
+// If the SmallSet is nil, SmallSet.PutAll is a no-op.
+//
+// A reference to the SmallSet is returned for method chaining.
+func (s *SmallSet[E]) PutAll(elements Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *SmallSet[E]
+		return ns
+	}
+	if elements != nil {
+		elements.Range(func(element E) bool {
+			s.elements = internal.SortedInsert(s.elements, s.less, element)
+			return false
+		})
+	}
+	return s
+}
+
+// PutSlice adds all elements in the specified slice to the SmallSet. Nothing changes for elements that already exist
+// within the SmallSet.
+//
+// If the SmallSet is nil, SmallSet.PutSlice is a no-op.
+//
+// A reference to the SmallSet is returned for method chaining.
+func (s *SmallSet[E]) PutSlice(elements []E) MutableSet[E] {
+	if s == nil {
+		var ns *SmallSet[E]
+		return ns
+	}
+	for _, element := range elements {
+		s.elements = internal.SortedInsert(s.elements, s.less, element)
+	}
+	return s
+}
+
+// Range calls the iter function with each element within the SmallSet but will stop early whenever the iter function
+// returns true.
+//
+// Elements are iterated in the order determined by the less function of the SmallSet.
+//
+// If the SmallSet is nil, SmallSet.Range is a no-op.
+func (s *SmallSet[E]) Range(iter func(element E) bool) {
+	if s != nil {
+		for _, element := range s.elements {
+			if iter(element) {
+				break
+			}
+		}
+	}
+}
+
+// RangeContext calls the iter function with each element within the SmallSet, the same as SmallSet.Range, except that
+// the context is checked before each element. If the context is done, iteration stops and the error of the context is
+// returned.
+//
+// Elements are iterated in the order determined by the less function of the SmallSet.
+//
+// If the SmallSet is nil, SmallSet.RangeContext is a no-op and returns nil.
+func (s *SmallSet[E]) RangeContext(ctx context.Context, iter func(element E) bool) error {
+	if s == nil {
+		return nil
+	}
+	return rangeContext[E](ctx, s.Range, iter)
+}
+
+// ReplaceElement removes the old element from the SmallSet and adds the new element in its place, but only if the old
+// element is present, and returns whether it was present. If the new element already exists within the SmallSet, the
+// old element is simply removed.
+//
+// If the SmallSet is nil, SmallSet.ReplaceElement is a no-op and returns false.
+func (s *SmallSet[E]) ReplaceElement(oldElement, newElement E) bool {
+	if s == nil {
+		return false
+	}
+	if _, ok := internal.SortedSearch(s.elements, s.less, oldElement); !ok {
+		return false
+	}
+	s.elements = internal.SortedDelete(s.elements, s.less, oldElement)
+	s.elements = internal.SortedInsert(s.elements, s.less, newElement)
+	return true
+}
+
+// Retain removes all elements from the SmallSet except the element(s) specified.
+//
+// If the SmallSet is nil, SmallSet.Retain is a no-op.
+//
+// A reference to the SmallSet is returned for method chaining.
+func (s *SmallSet[E]) Retain(element E, elements ...E) MutableSet[E] {
+	if s == nil {
+		var ns *SmallSet[E]
+		return ns
+	}
+	retained := internal.FromSlice(elements)
+	retained[element] = struct{}{}
+	s.elements = s.filter(func(element E) bool {
+		_, ok := retained[element]
+		return ok
+	})
+	return s
+}
+
+// RetainAll removes all elements from the SmallSet except those in the specified Set.
+//
+// If the SmallSet is nil, SmallSet.RetainAll is a no-op.
+//
+// A reference to the SmallSet is returned for method chaining.
+func (s *SmallSet[E]) RetainAll(elements Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *SmallSet[E]
+		return ns
+	}
+	if elements == nil {
+		s.elements = nil
+	} else {
+		s.elements = s.filter(elements.Contains)
+	}
+	return s
+}
+
+// RetainAllOf removes all elements from the SmallSet except those that exist within all the specified Set. That is; the
+// SmallSet is intersected with each Set in place. As with SmallSet.RetainAll, any nil Set is treated as having no
+// elements. If no Set is specified, nothing is removed.
+//
+// If the SmallSet is nil, SmallSet.RetainAllOf is a no-op.
+//
+// A reference to the SmallSet is returned for method chaining.
+func (s *SmallSet[E]) RetainAllOf(sets ...Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *SmallSet[E]
+		return ns
+	}
+	s.elements = s.filter(func(element E) bool { return containedByAll(sets, element) })
+	return s
+}
+
+// RetainSlice removes all elements from the SmallSet except those in the specified slice.
+//
+// If the SmallSet is nil, SmallSet.RetainSlice is a no-op.
+//
+// A reference to the SmallSet is returned for method chaining.
+func (s *SmallSet[E]) RetainSlice(elements []E) MutableSet[E] {
+	if s == nil {
+		var ns *SmallSet[E]
+		return ns
+	}
+	retained := internal.FromSlice(elements)
+	s.elements = s.filter(func(element E) bool {
+		_, ok := retained[element]
+		return ok
+	})
+	return s
+}
+
+// RetainWhere removes all elements except those that match the predicate function from the SmallSet.
+//
+// If the SmallSet is nil, SmallSet.RetainWhere is a no-op.
+//
+// A reference to the SmallSet is returned for method chaining.
+func (s *SmallSet[E]) RetainWhere(predicate func(element E) bool) MutableSet[E] {
+	if s == nil {
+		var ns *SmallSet[E]
+		return ns
+	}
+	s.elements = s.filter(predicate)
+	return s
+}
+
+// Sample returns a pseudo-random element within the SmallSet as well as an indication of whether the SmallSet contained
+// any elements.
+//
+// If the SmallSet is nil, SmallSet.Sample returns the zero value for E and false.
+func (s *SmallSet[E]) Sample() (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	return sample[E](s.Range, s.Len())
+}
+
+// SampleN returns a new SmallSet struct containing up to n distinct elements of the SmallSet chosen pseudo-randomly.
+// All elements are included if n is greater than or equal to the number of elements within the SmallSet, while none are
+// included if n is not positive.
+//
+// If the SmallSet is nil, SmallSet.SampleN returns nil.
+func (s *SmallSet[E]) SampleN(n int) Set[E] {
+	return s.SampleNWithRand(n, nil)
+}
+
+// SampleNWithRand returns a new SmallSet struct containing up to n distinct elements of the SmallSet chosen
+// pseudo-randomly using the provided source, the same as SmallSet.SampleN otherwise. If the source is nil, the default
+// source is used.
+//
+// Elements are chosen in the order maintained by the SmallSet, so repeated calls on an equal SmallSet using sources
+// that are seeded identically always choose the same elements.
+//
+// If the SmallSet is nil, SmallSet.SampleNWithRand returns nil.
+func (s *SmallSet[E]) SampleNWithRand(n int, r *rand.Rand) Set[E] {
+	if s == nil {
+		var ns *SmallSet[E]
+		return ns
+	}
+	return sampleFilter[E](s, n, r)
+}
+
+// Slice returns a slice containing all elements of the SmallSet.
+//
+// Elements within the resulting slice are in the order determined by the less function of the SmallSet.
+//
+// If the SmallSet is nil, SmallSet.Slice returns nil.
+func (s *SmallSet[E]) Slice() []E {
+	if s == nil {
+		return nil
+	}
+	return s.clone()
+}
+
+// Some returns whether the SmallSet contains any element that matches the predicate function.
+//
+// If the SmallSet is nil, SmallSet.Some returns false.
+func (s *SmallSet[E]) Some(predicate func(element E) bool) bool {
+	_, ok := s.Find(predicate)
+	return ok
+}
+
+// SortedAppendSlice appends all elements of the SmallSet to the dst slice, sorted using the provided less function, and
+// returns the extended slice. Only the appended elements are sorted so any elements already within dst are left
+// untouched. This allows a buffer to be reused across calls, avoiding the allocation made by SmallSet.SortedSlice each
+// time.
+//
+// If the SmallSet is nil, SmallSet.SortedAppendSlice returns dst unchanged.
+func (s *SmallSet[E]) SortedAppendSlice(dst []E, less func(x, y E) bool) []E {
+	if s == nil {
+		return dst
+	}
+	return sortedAppendSlice[E](s, dst, less)
+}
+
+// SortedJoin sorts the elements within the SmallSet using the provided less function and then converts those elements
+// into strings which are then joined using the specified separator to create the resulting string.
+//
+// If the SmallSet is nil, SmallSet.SortedJoin returns an empty string.
+func (s *SmallSet[E]) SortedJoin(sep string, convert func(element E) string, less func(x, y E) bool) string {
+	if s == nil {
+		return ""
+	}
+	return joinSlice(s.SortedSlice(less), sep, convert)
+}
+
+// SortedRange calls the iter function with each element within the SmallSet, sorted using the provided less function,
+// along with the zero-based index of that element within the sorted order, but will stop early whenever the iter
+// function returns true.
+//
+// Unlike SmallSet.Range, iteration order is deterministic.
+//
+// If the SmallSet is nil, SmallSet.SortedRange is a no-op.
+func (s *SmallSet[E]) SortedRange(less func(x, y E) bool, iter func(index int, element E) bool) {
+	if s == nil {
+		return
+	}
+	sortedRange[E](s, less, iter)
+}
+
+// SortedSlice returns a slice containing all elements of the SmallSet sorted using the provided less function.
+//
+// If the SmallSet is nil, SmallSet.SortedSlice returns nil.
+func (s *SmallSet[E]) SortedSlice(less func(x, y E) bool) []E {
+	if s == nil {
+		return nil
+	}
+	elements := s.clone()
+	sort.SliceStable(elements, func(i, j int) bool { return less(elements[i], elements[j]) })
+	return elements
+}
+
+// Take removes the element from the SmallSet and returns the element that was stored within the SmallSet as well as an
+// indication of whether it was present. As elements are compared using equality, the returned element is always equal
+// to the element provided when present.
+//
+// If the SmallSet is nil, SmallSet.Take is a no-op and returns the zero value for E and false.
+func (s *SmallSet[E]) Take(element E) (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	i, ok := internal.SortedSearch(s.elements, s.less, element)
+	if !ok {
+		var zero E
+		return zero, false
+	}
+	element = s.elements[i]
+	s.elements = internal.SortedDelete(s.elements, s.less, element)
+	return element, true
+}
+
+// ToMap returns a map containing all elements of the SmallSet as keys, which can be useful when integrating with APIs
+// that expect a map rather than a Set or slice.
+//
+// The returned map is always a copy so can be modified freely without affecting the SmallSet.
+//
+// If the SmallSet is nil, SmallSet.ToMap returns nil.
+func (s *SmallSet[E]) ToMap() map[E]struct{} {
+	if s == nil {
+		return nil
+	}
+	return internal.FromSlice[E](s.elements)
+}
+
+// TryRange calls the iter function with each element within the SmallSet but will stop early whenever the iter
+// function returns an error.
+//
+// Elements are iterated in the order determined by the less function of the SmallSet.
+//
+// If the SmallSet is nil, SmallSet.TryRange is a no-op.
+func (s *SmallSet[E]) TryRange(iter func(element E) error) error {
+	if s != nil {
+		for _, element := range s.elements {
+			if err := iter(element); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Union returns a new SmallSet containing a union of the SmallSet with another Set.
+//
+// As a nil SmallSet has no less function, if the SmallSet is nil but the other Set is not, a MutableHashSet containing
+// the elements of the other Set is returned instead.
+//
+// If the SmallSet and the other Set are both nil, SmallSet.Union returns nil.
+func (s *SmallSet[E]) Union(other Set[E]) Set[E] {
+	if s == nil {
+		if elements := internal.Union[E](nil, other); elements != nil {
+			return &MutableHashSet[E]{elements: elements}
+		}
+		var ns *SmallSet[E]
+		return ns
+	}
+	elements := s.clone()
+	if other != nil {
+		other.Range(func(element E) bool {
+			if !s.Contains(element) {
+				elements = append(elements, element)
+			}
+			return false
+		})
+	}
+	return s.with(internal.SortedFromSlice(elements, s.less))
+}
+
+func (s *SmallSet[E]) String() string {
+	if s == nil {
+		return internal.NilString
+	}
+	return fmt.Sprintf("%v", s.elements)
+}
+
+func (s *SmallSet[E]) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return internal.MarshalJSONNil()
+	}
+	return json.Marshal(s.elements)
+}
+
+// UnmarshalJSON deserializes the given JSON data as a JSON array into the SmallSet, replacing any existing elements.
+//
+// As the elements must be sorted, the SmallSet must have been created with a less function (e.g. using Small),
+// otherwise ErrJSONLess is returned.
+func (s *SmallSet[E]) UnmarshalJSON(data []byte) error {
+	if s.less == nil {
+		return ErrJSONLess
+	}
+	var elements []E
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	s.elements = internal.SortedFromSlice(elements, s.less)
+	return nil
+}
+
+// clone returns a copy of the sorted slice of elements within the SmallSet.
+func (s *SmallSet[E]) clone() []E {
+	elements := make([]E, len(s.elements))
+	copy(elements, s.elements)
+	return elements
+}
+
+// filter returns a new sorted slice containing only elements of the SmallSet that match the filter function.
+func (s *SmallSet[E]) filter(filter func(element E) bool) []E {
+	var elements []E
+	for _, element := range s.elements {
+		if filter(element) {
+			elements = append(elements, element)
+		}
+	}
+	return elements
+}
+
+// with returns a new SmallSet containing the sorted slice of elements provided and sharing the same less function.
+func (s *SmallSet[E]) with(elements []E) *SmallSet[E] {
+	return &SmallSet[E]{elements: elements, less: s.less}
+}
+
+// Small returns a SmallSet struct that implements MutableSet containing each unique element provided, kept sorted using
+// the less function.
+//
+// The less function must define a strict total ordering of E and must not be nil.
+//
+// As Small returns a mutable struct it is not safe for concurrent use by multiple goroutines.
+func Small[E comparable](less func(x, y E) bool, elements ...E) *SmallSet[E] {
+	return &SmallSet[E]{elements: internal.SortedFromSlice(elements, less), less: less}
+}
+
+// SmallFromJSON returns a SmallSet struct that implements MutableSet containing each unique element parsed from the
+// JSON data provided, kept sorted using the less function.
+//
+// The less function must define a strict total ordering of E and must not be nil.
+//
+// As SmallFromJSON returns a mutable struct it is not safe for concurrent use by multiple goroutines.
+func SmallFromJSON[E comparable](less func(x, y E) bool, data []byte) (*SmallSet[E], error) {
+	s := &SmallSet[E]{less: less}
+	if err := s.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// SmallFromSlice returns a SmallSet struct that implements MutableSet containing each unique element from the slice
+// provided, kept sorted using the less function.
+//
+// The less function must define a strict total ordering of E and must not be nil.
+//
+// As SmallFromSlice returns a mutable struct it is not safe for concurrent use by multiple goroutines.
+func SmallFromSlice[E comparable](less func(x, y E) bool, elements []E) *SmallSet[E] {
+	return &SmallSet[E]{elements: internal.SortedFromSlice(elements, less), less: less}
+}
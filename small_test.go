@@ -0,0 +1,1732 @@
+// Copyright (C) 2023 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/neocotic/go-sets/internal"
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+func Test_Small(t *testing.T) {
+	testCases := map[string]struct {
+		elements       []int
+		expectElements []int
+	}{
+		"with multiple elements": {
+			elements:       []int{789, 123, 456},
+			expectElements: []int{123, 456, 789},
+		},
+		"with single element": {
+			elements:       []int{123},
+			expectElements: []int{123},
+		},
+		"with duplicated elements": {
+			elements:       []int{456, 123, 789, 123, 456},
+			expectElements: []int{123, 456, 789},
+		},
+		"with no elements": {
+			elements:       nil,
+			expectElements: []int{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Small(Asc[int], tc.elements...)
+			if diff := cmp.Diff(tc.expectElements, set.Slice()); diff != "" {
+				t.Errorf("unexpected elements (-want +got):\n%s", diff)
+			}
+			if !set.IsMutable() {
+				t.Error("unexpected Set mutability; want true, got false")
+			}
+		})
+	}
+}
+
+func Test_SmallFromJSON(t *testing.T) {
+	testCases := map[string]struct {
+		expectElements []int
+		json           string
+	}{
+		"with JSON string for array containing multiple elements": {
+			expectElements: []int{123, 456, 789},
+			json:           "[789,123,456]",
+		},
+		"with JSON string for array containing duplicated elements": {
+			expectElements: []int{123, 456, 789},
+			json:           "[123,456,789,456,123]",
+		},
+		"with JSON string for empty array": {
+			expectElements: []int{},
+			json:           "[]",
+		},
+		"with JSON string for null": {
+			expectElements: []int{},
+			json:           "null",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set, err := SmallFromJSON(Asc[int], []byte(tc.json))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.expectElements, set.Slice()); diff != "" {
+				t.Errorf("unexpected elements (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_SmallFromJSON_Error(t *testing.T) {
+	testCases := map[string]struct {
+		expectError error
+		json        string
+		less        func(x, y int) bool
+	}{
+		"with invalid JSON": {
+			json: "[123,",
+			less: Asc[int],
+		},
+		"with nil less function": {
+			expectError: ErrJSONLess,
+			json:        "[123]",
+			less:        nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set, err := SmallFromJSON(tc.less, []byte(tc.json))
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if tc.expectError != nil && !errors.Is(err, tc.expectError) {
+				t.Errorf("unexpected error; want %v, got %v", tc.expectError, err)
+			}
+			if set != nil {
+				t.Errorf("unexpected Set; want nil, got %v", set)
+			}
+		})
+	}
+}
+
+func Test_SmallFromSlice(t *testing.T) {
+	elements := []int{789, 123, 456, 123}
+	set := SmallFromSlice(Desc[int], elements)
+	if diff := cmp.Diff([]int{789, 456, 123}, set.Slice()); diff != "" {
+		t.Errorf("unexpected elements (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]int{789, 123, 456, 123}, elements); diff != "" {
+		t.Errorf("unexpected modification of slice (-want +got):\n%s", diff)
+	}
+}
+
+func Test_SmallSet_All(t *testing.T) {
+	var elements []int
+	Small(Asc[int], 789, 123, 456).All()(func(element int) bool {
+		elements = append(elements, element)
+		return true
+	})
+	if diff := cmp.Diff([]int{123, 456, 789}, elements, cmpopts.SortSlices(Asc[int])); diff != "" {
+		t.Errorf("unexpected yielded elements (-want +got):\n%s", diff)
+	}
+}
+
+func Test_SmallSet_All_Break(t *testing.T) {
+	set := Small(Asc[int], 789, 123, 456)
+	var callCount int
+	set.All()(func(element int) bool {
+		callCount++
+		if !set.Contains(element) {
+			t.Errorf("unexpected yielded element; got %v", element)
+		}
+		return callCount < 2
+	})
+	if callCount != 2 {
+		t.Errorf("unexpected number of calls to yield; want 2, got %v", callCount)
+	}
+}
+
+func Test_SmallSet_All_Nil(t *testing.T) {
+	var set *SmallSet[int]
+	var callCount int
+	set.All()(func(_ int) bool {
+		callCount++
+		return true
+	})
+	if callCount != 0 {
+		t.Errorf("unexpected number of calls to yield; want 0, got %v", callCount)
+	}
+}
+
+func Test_SmallSet_AppendSlice(t *testing.T) {
+	testCases := map[string]struct {
+		set *SmallSet[int]
+		dst []int
+	}{
+		"with nil dst on non-empty *SmallSet": {
+			set: Small(Asc[int], 123, 456, 789),
+			dst: nil,
+		},
+		"with non-empty dst on non-empty *SmallSet": {
+			set: Small(Asc[int], 123, 456, 789),
+			dst: []int{1, 2},
+		},
+		"with dst having spare capacity on non-empty *SmallSet": {
+			set: Small(Asc[int], 123, 456, 789),
+			dst: make([]int, 0, 8),
+		},
+		"with nil dst on empty *SmallSet": {
+			set: Small[int](Asc[int]),
+			dst: nil,
+		},
+		"with non-empty dst on empty *SmallSet": {
+			set: Small[int](Asc[int]),
+			dst: []int{1, 2},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dst := append([]int(nil), tc.dst...)
+			ret := tc.set.AppendSlice(tc.dst)
+
+			if expectLen := len(tc.dst) + tc.set.Len(); len(ret) != expectLen {
+				t.Fatalf("unexpected slice length; want %v, got %v", expectLen, len(ret))
+			}
+			if !cmp.Equal(dst, ret[:len(tc.dst)], cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected leading elements; want %v, got %v", dst, ret[:len(tc.dst)])
+			}
+			if tail := ret[len(tc.dst):]; !cmp.Equal(tc.set.Slice(), tail, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected appended elements; want %v, got %v", tc.set.Slice(), tail)
+			}
+			if cap(tc.dst) >= len(ret) && len(ret) > 0 && &tc.dst[:1][0] != &ret[0] {
+				t.Error("unexpected slice allocation; want dst to be reused")
+			}
+		})
+	}
+}
+
+func Test_SmallSet_AppendSlice_Nil(t *testing.T) {
+	var set *SmallSet[int]
+	dst := []int{1, 2}
+	ret := set.AppendSlice(dst)
+
+	if !cmp.Equal(dst, ret) {
+		t.Errorf("unexpected slice; want %v, got %v", dst, ret)
+	}
+}
+
+func Test_SmallSet_Canonical(t *testing.T) {
+	testCases := map[string]struct {
+		expect string
+		set    *SmallSet[string]
+	}{
+		"with no elements": {
+			expect: "",
+			set:    Small(Asc[string]),
+		},
+		"with elements": {
+			expect: "bar,baz,foo",
+			set:    Small(Asc[string], "foo", "bar", "baz"),
+		},
+		"with elements requiring escaping": {
+			expect: `a\,,b,c\\`,
+			set:    Small(Asc[string], "b", `c\`, "a,"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			canonical := string(tc.set.Canonical(func(element string) string { return element }))
+			if canonical != tc.expect {
+				t.Errorf("unexpected canonical; want %q, got %q", tc.expect, canonical)
+			}
+		})
+	}
+}
+
+func Test_SmallSet_Canonical_Nil(t *testing.T) {
+	var set *SmallSet[string]
+	canonical := set.Canonical(func(element string) string { return element })
+	if canonical == nil || len(canonical) != 0 {
+		t.Errorf("unexpected canonical; want %#v, got %#v", []byte{}, canonical)
+	}
+}
+
+func Test_SmallSet_Clear(t *testing.T) {
+	set := Small(Asc[int], 123, 456, 789)
+	set.Clear()
+	if !set.IsEmpty() {
+		t.Errorf("unexpected Set; want empty, got %v", set)
+	}
+	set.Put(456, 123)
+	if diff := cmp.Diff([]int{123, 456}, set.Slice()); diff != "" {
+		t.Errorf("unexpected elements after Put (-want +got):\n%s", diff)
+	}
+}
+
+func Test_SmallSet_Clone(t *testing.T) {
+	set := Small(Asc[int], 123, 456, 789)
+	clone := set.Clone()
+	if _, ok := clone.(*SmallSet[int]); !ok {
+		t.Fatalf("unexpected Set type; want *SmallSet[int], got %T", clone)
+	}
+	if !clone.Equal(set) {
+		t.Errorf("unexpected cloned Set; want %v, got %v", set, clone)
+	}
+	set.Put(0)
+	if clone.Contains(0) {
+		t.Error("unexpected modification of cloned Set")
+	}
+}
+
+func Test_SmallSet_Contains(t *testing.T) {
+	testCases := map[string]struct {
+		element int
+		expect  bool
+		set     *SmallSet[int]
+	}{
+		"with first element": {
+			element: 123,
+			expect:  true,
+			set:     Small(Asc[int], 123, 456, 789),
+		},
+		"with last element": {
+			element: 789,
+			expect:  true,
+			set:     Small(Asc[int], 123, 456, 789),
+		},
+		"with element less than all elements": {
+			element: 0,
+			expect:  false,
+			set:     Small(Asc[int], 123, 456, 789),
+		},
+		"with element between elements": {
+			element: 234,
+			expect:  false,
+			set:     Small(Asc[int], 123, 456, 789),
+		},
+		"with element greater than all elements": {
+			element: 999,
+			expect:  false,
+			set:     Small(Asc[int], 123, 456, 789),
+		},
+		"with no elements": {
+			element: 123,
+			expect:  false,
+			set:     Small[int](Asc[int]),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if contains := tc.set.Contains(tc.element); contains != tc.expect {
+				t.Errorf("unexpected contains; want %v, got %v", tc.expect, contains)
+			}
+		})
+	}
+}
+
+func Test_SmallSet_ContainsAll(t *testing.T) {
+	testCases := map[string]struct {
+		element  int
+		elements []int
+		expect   bool
+	}{
+		"with single contained element": {
+			element: 123,
+			expect:  true,
+		},
+		"with single element not contained": {
+			element: -123,
+			expect:  false,
+		},
+		"with all elements contained": {
+			element:  123,
+			elements: []int{456, 789},
+			expect:   true,
+		},
+		"with duplicate contained elements": {
+			element:  123,
+			elements: []int{123, 123},
+			expect:   true,
+		},
+		"with first element not contained": {
+			element:  -123,
+			elements: []int{456, 789},
+			expect:   false,
+		},
+		"with last element not contained": {
+			element:  123,
+			elements: []int{456, -789},
+			expect:   false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Small(Asc[int], 123, 456, 789)
+			if result := set.ContainsAll(tc.element, tc.elements...); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_SmallSet_ContainsAll_Nil(t *testing.T) {
+	var set *SmallSet[int]
+	if set.ContainsAll(123, 456) {
+		t.Error("unexpected result; want false, got true")
+	}
+}
+
+func Test_SmallSet_ContainsAny(t *testing.T) {
+	testCases := map[string]struct {
+		element  int
+		elements []int
+		expect   bool
+	}{
+		"with single contained element": {
+			element: 123,
+			expect:  true,
+		},
+		"with single element not contained": {
+			element: -123,
+			expect:  false,
+		},
+		"with first element contained": {
+			element:  123,
+			elements: []int{-456, -789},
+			expect:   true,
+		},
+		"with last element contained": {
+			element:  -123,
+			elements: []int{-456, 789},
+			expect:   true,
+		},
+		"with no elements contained": {
+			element:  -123,
+			elements: []int{-456, -789},
+			expect:   false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Small(Asc[int], 123, 456, 789)
+			if result := set.ContainsAny(tc.element, tc.elements...); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_SmallSet_ContainsAny_Nil(t *testing.T) {
+	var set *SmallSet[int]
+	if set.ContainsAny(123, 456) {
+		t.Error("unexpected result; want false, got true")
+	}
+}
+
+func Test_SmallSet_ContainsEach(t *testing.T) {
+	testCases := map[string]struct {
+		elements []int
+		expect   []bool
+	}{
+		"with no elements": {
+			elements: nil,
+			expect:   []bool{},
+		},
+		"with mix of present and absent elements": {
+			elements: []int{999, 123, 0, 789, 456, -1},
+			expect:   []bool{false, true, false, true, true, false},
+		},
+		"with duplicate elements": {
+			elements: []int{456, 999, 456},
+			expect:   []bool{true, false, true},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Small(Asc[int], 123, 456, 789)
+			if diff := cmp.Diff(tc.expect, set.ContainsEach(tc.elements)); diff != "" {
+				t.Errorf("unexpected results (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_SmallSet_ContainsEach_Nil(t *testing.T) {
+	var set *SmallSet[int]
+	if diff := cmp.Diff([]bool{false, false}, set.ContainsEach([]int{123, 0})); diff != "" {
+		t.Errorf("unexpected results (-want +got):\n%s", diff)
+	}
+}
+
+func Test_SmallSet_Count(t *testing.T) {
+	testCases := map[string]struct {
+		expect    int
+		predicate func(element int) bool
+	}{
+		"with predicate matching all elements": {
+			expect:    5,
+			predicate: func(_ int) bool { return true },
+		},
+		"with predicate matching some elements": {
+			expect:    3,
+			predicate: func(element int) bool { return element > 0 },
+		},
+		"with predicate matching single element": {
+			expect:    1,
+			predicate: func(element int) bool { return element == 0 },
+		},
+		"with predicate matching no elements": {
+			expect:    0,
+			predicate: func(_ int) bool { return false },
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Small(Asc[int], -123, 0, 123, 456, 789)
+			if result := set.Count(tc.predicate); result != tc.expect {
+				t.Errorf("unexpected count; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_SmallSet_Count_Nil(t *testing.T) {
+	var set *SmallSet[int]
+	if result := set.Count(func(_ int) bool { return true }); result != 0 {
+		t.Errorf("unexpected count; want 0, got %v", result)
+	}
+}
+
+func Test_SmallSet_Covers(t *testing.T) {
+	testCases := map[string]struct {
+		expect bool
+		others []Set[int]
+	}{
+		"with no other Sets": {
+			expect: true,
+			others: nil,
+		},
+		"with covered Sets": {
+			expect: true,
+			others: []Set[int]{Hash(123), Hash(456, 789), Hash(123, 456, 789)},
+		},
+		"with empty and nil Sets": {
+			expect: true,
+			others: []Set[int]{Hash[int](), nil, (*HashSet[int])(nil)},
+		},
+		"with mix of covered and not covered Sets": {
+			expect: false,
+			others: []Set[int]{Hash(123, 456), Hash(456, 999), Hash(789)},
+		},
+		"with Set containing more elements": {
+			expect: false,
+			others: []Set[int]{Hash(0, 123, 456, 789)},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Small(Asc[int], 123, 456, 789)
+			if covers := set.Covers(tc.others...); covers != tc.expect {
+				t.Errorf("unexpected covers; want %v, got %v", tc.expect, covers)
+			}
+		})
+	}
+}
+
+func Test_SmallSet_Covers_Nil(t *testing.T) {
+	var set *SmallSet[int]
+	if !set.Covers(Hash[int](), nil) {
+		t.Error("unexpected covers of empty Set; want true, got false")
+	}
+	if set.Covers(Hash(123)) {
+		t.Error("unexpected covers of non-empty Set; want false, got true")
+	}
+}
+
+func Test_SmallSet_Delete(t *testing.T) {
+	set := Small(Asc[int], 123, 456, 789)
+	set.Delete(456, 999, 123)
+	if diff := cmp.Diff([]int{789}, set.Slice()); diff != "" {
+		t.Errorf("unexpected elements (-want +got):\n%s", diff)
+	}
+}
+
+func Test_SmallSet_DeleteAnyOf(t *testing.T) {
+	testCases := map[string]struct {
+		expect Set[int]
+		sets   []Set[int]
+	}{
+		"with no Sets": {
+			expect: Hash(123, 456, 789),
+			sets:   nil,
+		},
+		"with single Set": {
+			expect: Hash(123, 789),
+			sets:   []Set[int]{Hash(456, 999)},
+		},
+		"with multiple Sets": {
+			expect: Hash(789),
+			sets:   []Set[int]{Hash(456, 999), Hash(123), Hash[int]()},
+		},
+		"with nil Sets": {
+			expect: Hash(123, 789),
+			sets:   []Set[int]{nil, Hash(456), (*HashSet[int])(nil)},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Small(Asc[int], 123, 456, 789)
+			composed := MutableHash(123, 456, 789)
+			for _, other := range tc.sets {
+				composed.DeleteAll(other)
+			}
+			set.DeleteAnyOf(tc.sets...)
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+			if !set.Equal(composed) {
+				t.Errorf("unexpected Set compared to composing DeleteAll; want %v, got %v", composed, set)
+			}
+		})
+	}
+}
+
+func Test_SmallSet_DeleteAnyOf_Nil(t *testing.T) {
+	var set *SmallSet[int]
+	if result := set.DeleteAnyOf(Hash(123)); internal.IsNotNil(result) {
+		t.Errorf("unexpected MutableSet; want nil, got %v", result)
+	}
+}
+
+func Test_SmallSet_DeleteExisting(t *testing.T) {
+	testCases := map[string]struct {
+		element        int
+		elements       []int
+		expect         int
+		expectElements []int
+	}{
+		"with no elements present": {
+			element:        999,
+			elements:       []int{-123},
+			expect:         0,
+			expectElements: []int{123, 456, 789},
+		},
+		"with some elements present": {
+			element:        456,
+			elements:       []int{999, 789},
+			expect:         2,
+			expectElements: []int{123},
+		},
+		"with all elements present": {
+			element:        123,
+			elements:       []int{456, 789},
+			expect:         3,
+			expectElements: []int{},
+		},
+		"with duplicate elements present": {
+			element:        456,
+			elements:       []int{456},
+			expect:         1,
+			expectElements: []int{123, 789},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Small(Asc[int], 123, 456, 789)
+			if deleted := set.DeleteExisting(tc.element, tc.elements...); deleted != tc.expect {
+				t.Errorf("unexpected number of deleted elements; want %v, got %v", tc.expect, deleted)
+			}
+			if expect := HashFromSlice(tc.expectElements); !set.Equal(expect) {
+				t.Errorf("unexpected Set; want %v, got %v", expect, set)
+			}
+		})
+	}
+}
+
+func Test_SmallSet_DeleteExisting_Nil(t *testing.T) {
+	var set *SmallSet[int]
+	if deleted := set.DeleteExisting(123, 456); deleted != 0 {
+		t.Errorf("unexpected number of deleted elements; want 0, got %v", deleted)
+	}
+}
+
+func Test_SmallSet_DeleteNth(t *testing.T) {
+	set := Small(Asc[int], 123, 456, 789)
+	element, ok := set.DeleteNth(Desc[int], 0)
+	if !ok || element != 789 {
+		t.Errorf("unexpected deleted element; want 789 and true, got %v and %v", element, ok)
+	}
+	if element, ok = set.DeleteNth(Asc[int], 2); ok {
+		t.Errorf("unexpected deleted element; want 0 and false, got %v and %v", element, ok)
+	}
+	if diff := cmp.Diff([]int{123, 456}, set.Slice()); diff != "" {
+		t.Errorf("unexpected elements (-want +got):\n%s", diff)
+	}
+}
+
+func Test_SmallSet_DeleteWhere(t *testing.T) {
+	set := Small(Asc[int], -456, -123, 0, 123, 456)
+	set.DeleteWhere(func(element int) bool { return element < 0 })
+	if diff := cmp.Diff([]int{0, 123, 456}, set.Slice()); diff != "" {
+		t.Errorf("unexpected elements (-want +got):\n%s", diff)
+	}
+}
+
+func Test_SmallSet_Diff(t *testing.T) {
+	set := Small(Asc[int], 123, 456, 789)
+	diff := set.Diff(Hash(456, 999))
+	if expect := Hash(123, 789); !diff.Equal(expect) {
+		t.Errorf("unexpected diff Set; want %v, got %v", expect, diff)
+	}
+	if kind := diff.Kind(); kind != SmallKind {
+		t.Errorf("unexpected kind; want %v, got %v", SmallKind, kind)
+	}
+}
+
+func Test_SmallSet_DiffSymmetric(t *testing.T) {
+	set := Small(Asc[int], 123, 456, 789)
+	diff := set.DiffSymmetric(Hash(456, 0, 999))
+	if cmpDiff := cmp.Diff([]int{0, 123, 789, 999}, diff.Slice()); cmpDiff != "" {
+		t.Errorf("unexpected elements (-want +got):\n%s", cmpDiff)
+	}
+}
+
+func Test_SmallSet_DrainWhere(t *testing.T) {
+	testCases := map[string]struct {
+		expectDrained  []int
+		expectElements []int
+		predicate      func(element int) bool
+	}{
+		"with some elements matching": {
+			expectDrained:  []int{2, 4},
+			expectElements: []int{1, 3, 5},
+			predicate:      func(element int) bool { return element%2 == 0 },
+		},
+		"with no elements matching": {
+			expectDrained:  []int{},
+			expectElements: []int{1, 2, 3, 4, 5},
+			predicate:      func(element int) bool { return element > 5 },
+		},
+		"with all elements matching": {
+			expectDrained:  []int{1, 2, 3, 4, 5},
+			expectElements: []int{},
+			predicate:      func(element int) bool { return element > 0 },
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Small(Asc[int], 1, 2, 3, 4, 5)
+			drained := set.DrainWhere(tc.predicate)
+			if internal.IsNil(drained) {
+				t.Fatal("unexpected nil Set")
+			}
+			if kind := drained.Kind(); kind != SmallKind {
+				t.Errorf("unexpected SetKind; want %v, got %v", SmallKind, kind)
+			}
+			if expect := HashFromSlice(tc.expectDrained); !drained.Equal(expect) {
+				t.Errorf("unexpected drained Set; want %v, got %v", expect, drained)
+			}
+			if expect := HashFromSlice(tc.expectElements); !set.Equal(expect) {
+				t.Errorf("unexpected Set; want %v, got %v", expect, set)
+			}
+		})
+	}
+}
+
+func Test_SmallSet_DrainWhere_Nil(t *testing.T) {
+	var set *SmallSet[int]
+	drained := set.DrainWhere(func(element int) bool {
+		t.Errorf("unexpected call to predicate with %v", element)
+		return true
+	})
+	if drained != (*SmallSet[int])(nil) {
+		t.Errorf("unexpected drained Set; want nil, got %v", drained)
+	}
+}
+
+func Test_SmallSet_Equal(t *testing.T) {
+	testCases := map[string]struct {
+		expect bool
+		other  Set[int]
+		set    *SmallSet[int]
+	}{
+		"with Set containing same elements": {
+			expect: true,
+			other:  Hash(789, 456, 123),
+			set:    Small(Asc[int], 123, 456, 789),
+		},
+		"with Set containing different elements": {
+			expect: false,
+			other:  Hash(123, 456, 999),
+			set:    Small(Asc[int], 123, 456, 789),
+		},
+		"with Set containing fewer elements": {
+			expect: false,
+			other:  Hash(123, 456),
+			set:    Small(Asc[int], 123, 456, 789),
+		},
+		"with nil Set": {
+			expect: false,
+			other:  nil,
+			set:    Small(Asc[int], 123),
+		},
+		"with nil Set on empty SmallSet": {
+			expect: true,
+			other:  nil,
+			set:    Small[int](Asc[int]),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if equal := tc.set.Equal(tc.other); equal != tc.expect {
+				t.Errorf("unexpected equality; want %v, got %v", tc.expect, equal)
+			}
+		})
+	}
+}
+
+func Test_SmallSet_Filter(t *testing.T) {
+	set := Small(Asc[int], -456, -123, 0, 123, 456)
+	filtered := set.Filter(func(element int) bool { return element > 0 })
+	if diff := cmp.Diff([]int{123, 456}, filtered.Slice()); diff != "" {
+		t.Errorf("unexpected elements (-want +got):\n%s", diff)
+	}
+	if set.Len() != 5 {
+		t.Errorf("unexpected Set length; want 5, got %v", set.Len())
+	}
+}
+
+func Test_SmallSet_Grow(t *testing.T) {
+	testCases := map[string]struct {
+		set    *SmallSet[int]
+		n      int
+		expect Set[int]
+	}{
+		"with positive n on non-empty *SmallSet": {
+			set:    Small(Asc[int], 123, 456, 789),
+			n:      100,
+			expect: Hash(123, 456, 789),
+		},
+		"with positive n on empty *SmallSet": {
+			set:    Small[int](Asc[int]),
+			n:      100,
+			expect: Hash[int](),
+		},
+		"with zero n on non-empty *SmallSet": {
+			set:    Small(Asc[int], 123, 456, 789),
+			n:      0,
+			expect: Hash(123, 456, 789),
+		},
+		"with negative n on non-empty *SmallSet": {
+			set:    Small(Asc[int], 123, 456, 789),
+			n:      -1,
+			expect: Hash(123, 456, 789),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ret := tc.set.Grow(tc.n)
+
+			if internal.IsNil(ret) {
+				t.Error("unexpected nil MutableSet")
+			}
+			if tc.set != ret {
+				t.Errorf("unexpected MutableSet; want %v, got %v", tc.set, ret)
+			}
+			if !tc.set.Equal(tc.expect) {
+				t.Errorf("unexpected MutableSet; want %v, got %v", tc.expect, tc.set)
+			}
+
+			tc.set.Put(1000)
+			if !tc.set.Contains(1000) {
+				t.Error("unexpected MutableSet element presence after Grow; want true, got false")
+			}
+		})
+	}
+}
+
+func Test_SmallSet_Grow_Nil(t *testing.T) {
+	var set *SmallSet[int]
+	ret := set.Grow(100)
+
+	if internal.IsNotNil(ret) {
+		t.Errorf("unexpected MutableSet; want nil, got %v", ret)
+	}
+	if !set.IsEmpty() {
+		t.Error("unexpected MutableSet emptiness; want true, got false")
+	}
+}
+
+func Test_SmallSet_Immutable(t *testing.T) {
+	set := Small(Asc[int], 123, 456, 789)
+	immutable := set.Immutable()
+	if immutable.IsMutable() {
+		t.Error("unexpected Set mutability; want false, got true")
+	}
+	if !immutable.Equal(set) {
+		t.Errorf("unexpected immutable Set; want %v, got %v", set, immutable)
+	}
+}
+
+func Test_SmallSet_Intersection(t *testing.T) {
+	set := Small(Asc[int], 123, 456, 789)
+	intersection := set.Intersection(Hash(789, 456, 999))
+	if diff := cmp.Diff([]int{456, 789}, intersection.Slice()); diff != "" {
+		t.Errorf("unexpected elements (-want +got):\n%s", diff)
+	}
+}
+
+func Test_SmallSet_IsDisjoint(t *testing.T) {
+	testCases := map[string]struct {
+		expect bool
+		other  Set[int]
+	}{
+		"with disjoint Set": {
+			expect: true,
+			other:  Hash(-789, -456, -123),
+		},
+		"with smaller disjoint Set": {
+			expect: true,
+			other:  Singleton(0),
+		},
+		"with larger disjoint Set": {
+			expect: true,
+			other:  Hash(-789, -456, -123, 0, 1),
+		},
+		"with overlapping Set": {
+			expect: false,
+			other:  Hash(-123, 0, 456),
+		},
+		"with smaller overlapping Set": {
+			expect: false,
+			other:  Singleton(789),
+		},
+		"with larger overlapping Set": {
+			expect: false,
+			other:  Hash(-789, -456, -123, 0, 123),
+		},
+		"with equal Set": {
+			expect: false,
+			other:  Hash(123, 456, 789),
+		},
+		"with empty Set": {
+			expect: true,
+			other:  Hash[int](),
+		},
+		"with nil Set": {
+			expect: true,
+			other:  nil,
+		},
+		"with nil *HashSet": {
+			expect: true,
+			other:  (*HashSet[int])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Small(Asc[int], 123, 456, 789)
+			if result := set.IsDisjoint(tc.other); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_SmallSet_IsDisjoint_Nil(t *testing.T) {
+	var set *SmallSet[int]
+	if !set.IsDisjoint(Hash(123)) {
+		t.Error("unexpected result; want true, got false")
+	}
+	if !set.IsDisjoint(nil) {
+		t.Error("unexpected result; want true, got false")
+	}
+}
+
+func Test_SmallSet_Join(t *testing.T) {
+	set := Small(Desc[int], 123, 456, 789)
+	if join := set.Join(",", strconv.Itoa); join != "789,456,123" {
+		t.Errorf("unexpected string; want %q, got %q", "789,456,123", join)
+	}
+	if join := set.SortedJoin(",", strconv.Itoa, Asc[int]); join != "123,456,789" {
+		t.Errorf("unexpected sorted string; want %q, got %q", "123,456,789", join)
+	}
+}
+
+func Test_SmallSet_Kind(t *testing.T) {
+	set := Small(Asc[int], 123, 456, 789)
+	if kind := set.Kind(); kind != SmallKind {
+		t.Errorf("unexpected kind; want %v, got %v", SmallKind, kind)
+	}
+}
+
+func Test_SmallSet_MaxMin(t *testing.T) {
+	set := Small(Asc[int], 456, 123, 789)
+	if max, ok := set.Max(Asc[int]); !ok || max != 789 {
+		t.Errorf("unexpected max; want 789 and true, got %v and %v", max, ok)
+	}
+	if min, ok := set.Min(Asc[int]); !ok || min != 123 {
+		t.Errorf("unexpected min; want 123 and true, got %v and %v", min, ok)
+	}
+	if max, ok := Small[int](Asc[int]).Max(Asc[int]); ok {
+		t.Errorf("unexpected max; want 0 and false, got %v and %v", max, ok)
+	}
+}
+
+func Test_SmallSet_Pop(t *testing.T) {
+	set := Small(Asc[int], 123, 456, 789)
+	var popped []int
+	for i := 0; i < 3; i++ {
+		element, ok := set.Pop()
+		if !ok {
+			t.Fatalf("unexpected pop result; want true, got false")
+		}
+		if set.Contains(element) {
+			t.Errorf("unexpected Set containment of %v; want false, got true", element)
+		}
+		if exp, act := 2-i, set.Len(); act != exp {
+			t.Errorf("unexpected Set length; want %v, got %v", exp, act)
+		}
+		popped = append(popped, element)
+	}
+	sort.Ints(popped)
+	if exp := []int{123, 456, 789}; !cmp.Equal(exp, popped) {
+		t.Errorf("unexpected popped elements; want %v, got %v", exp, popped)
+	}
+	if element, ok := set.Pop(); ok || element != 0 {
+		t.Errorf("unexpected pop result; want 0 and false, got %v and %v", element, ok)
+	}
+	if element, ok := Small(Asc[int]).Pop(); ok || element != 0 {
+		t.Errorf("unexpected pop result; want 0 and false, got %v and %v", element, ok)
+	}
+}
+
+func Test_SmallSet_Pop_Max(t *testing.T) {
+	set := Small(Desc[int], 123, 456, 789)
+	if element, ok := set.Pop(); !ok || element != 123 {
+		t.Errorf("unexpected pop result; want 123 and true, got %v and %v", element, ok)
+	}
+	if exp, act := []int{789, 456}, set.Slice(); !cmp.Equal(exp, act) {
+		t.Errorf("unexpected elements; want %v, got %v", exp, act)
+	}
+}
+
+func Test_SmallSet_Pop_Nil(t *testing.T) {
+	var set *SmallSet[int]
+	if element, ok := set.Pop(); ok || element != 0 {
+		t.Errorf("unexpected pop result; want 0 and false, got %v and %v", element, ok)
+	}
+}
+
+func Test_SmallSet_Put(t *testing.T) {
+	set := Small(Asc[int], 456)
+	set.Put(789, 123, 456).PutSlice([]int{0, 999}).PutAll(Hash(234, 123))
+	if diff := cmp.Diff([]int{0, 123, 234, 456, 789, 999}, set.Slice()); diff != "" {
+		t.Errorf("unexpected elements (-want +got):\n%s", diff)
+	}
+}
+
+func Test_SmallSet_Range(t *testing.T) {
+	set := Small(Asc[int], 789, 123, 456)
+	var elements []int
+	set.Range(func(element int) bool {
+		elements = append(elements, element)
+		return element == 456
+	})
+	if diff := cmp.Diff([]int{123, 456}, elements); diff != "" {
+		t.Errorf("unexpected iterated elements (-want +got):\n%s", diff)
+	}
+}
+
+func Test_SmallSet_RangeContext(t *testing.T) {
+	set := Small(Asc[int], 123, 456, 789)
+	var elements []int
+	err := set.RangeContext(context.Background(), func(element int) bool {
+		elements = append(elements, element)
+		return false
+	})
+	if err != nil {
+		t.Errorf("unexpected error; want nil, got %q", err)
+	}
+	if expect := set.Len(); len(elements) != expect {
+		t.Errorf("unexpected number of iter function calls; want %v, got %v", expect, len(elements))
+	}
+	if actual := HashFromSlice(elements); !actual.Equal(set) {
+		t.Errorf("unexpected iterated elements; want %v, got %v", set, actual)
+	}
+}
+
+func Test_SmallSet_RangeContext_Cancel(t *testing.T) {
+	set := Small(Asc[int], 123, 456, 789)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var count int
+	err := set.RangeContext(ctx, func(_ int) bool {
+		count++
+		cancel()
+		return false
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error; want %q, got %q", context.Canceled, err)
+	}
+	if count != 1 {
+		t.Errorf("unexpected number of iter function calls; want 1, got %v", count)
+	}
+}
+
+func Test_SmallSet_RangeContext_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var called bool
+	err := Small(Asc[int], 123, 456, 789).RangeContext(ctx, func(_ int) bool {
+		called = true
+		return false
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error; want %q, got %q", context.Canceled, err)
+	}
+	if called {
+		t.Error("unexpected iter function call")
+	}
+}
+
+func Test_SmallSet_RangeContext_Nil(t *testing.T) {
+	var set *SmallSet[int]
+	var called bool
+	err := set.RangeContext(context.Background(), func(_ int) bool {
+		called = true
+		return false
+	})
+	if err != nil {
+		t.Errorf("unexpected error; want nil, got %q", err)
+	}
+	if called {
+		t.Error("unexpected iter function call")
+	}
+}
+
+func Test_SmallSet_ReplaceElement(t *testing.T) {
+	testCases := map[string]struct {
+		expect     Set[int]
+		expectOk   bool
+		newElement int
+		oldElement int
+	}{
+		"with old element absent": {
+			expect:     Hash(123, 456, 789),
+			expectOk:   false,
+			newElement: 999,
+			oldElement: 0,
+		},
+		"with old element present and new element absent": {
+			expect:     Hash(123, 789, 999),
+			expectOk:   true,
+			newElement: 999,
+			oldElement: 456,
+		},
+		"with old element present and new element already present": {
+			expect:     Hash(123, 789),
+			expectOk:   true,
+			newElement: 789,
+			oldElement: 456,
+		},
+		"with old element equal to new element": {
+			expect:     Hash(123, 456, 789),
+			expectOk:   true,
+			newElement: 456,
+			oldElement: 456,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Small(Asc[int], 123, 456, 789)
+			if ok := set.ReplaceElement(tc.oldElement, tc.newElement); ok != tc.expectOk {
+				t.Errorf("unexpected replacement; want %v, got %v", tc.expectOk, ok)
+			}
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+		})
+	}
+}
+
+func Test_SmallSet_ReplaceElement_Nil(t *testing.T) {
+	var set *SmallSet[int]
+	if set.ReplaceElement(123, 456) {
+		t.Error("unexpected replacement; want false, got true")
+	}
+}
+
+func Test_SmallSet_Retain(t *testing.T) {
+	testCases := map[string]struct {
+		expectElements []int
+		retain         func(set *SmallSet[int])
+	}{
+		"with Retain": {
+			expectElements: []int{123, 789},
+			retain:         func(set *SmallSet[int]) { set.Retain(789, 123, 999) },
+		},
+		"with RetainAll": {
+			expectElements: []int{456},
+			retain:         func(set *SmallSet[int]) { set.RetainAll(Hash(456, 999)) },
+		},
+		"with RetainAll for nil Set": {
+			expectElements: []int{},
+			retain:         func(set *SmallSet[int]) { set.RetainAll(nil) },
+		},
+		"with RetainSlice": {
+			expectElements: []int{123, 456},
+			retain:         func(set *SmallSet[int]) { set.RetainSlice([]int{456, 123}) },
+		},
+		"with RetainWhere": {
+			expectElements: []int{456, 789},
+			retain:         func(set *SmallSet[int]) { set.RetainWhere(func(element int) bool { return element > 200 }) },
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Small(Asc[int], 123, 456, 789)
+			tc.retain(set)
+			if diff := cmp.Diff(tc.expectElements, set.Slice()); diff != "" {
+				t.Errorf("unexpected elements (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_SmallSet_RetainAllOf(t *testing.T) {
+	testCases := map[string]struct {
+		expect Set[int]
+		sets   []Set[int]
+	}{
+		"with no Sets": {
+			expect: Hash(123, 456, 789),
+			sets:   nil,
+		},
+		"with single Set": {
+			expect: Hash(456),
+			sets:   []Set[int]{Hash(456, 999)},
+		},
+		"with multiple Sets": {
+			expect: Hash(456),
+			sets:   []Set[int]{Hash(123, 456, 999), Hash(456, 789)},
+		},
+		"with disjoint Sets": {
+			expect: Hash[int](),
+			sets:   []Set[int]{Hash(123), Hash(456)},
+		},
+		"with nil Set": {
+			expect: Hash[int](),
+			sets:   []Set[int]{Hash(123, 456), nil},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Small(Asc[int], 123, 456, 789)
+			composed := MutableHash(123, 456, 789)
+			for _, other := range tc.sets {
+				composed.RetainAll(other)
+			}
+			set.RetainAllOf(tc.sets...)
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+			if !set.Equal(composed) {
+				t.Errorf("unexpected Set compared to composing RetainAll; want %v, got %v", composed, set)
+			}
+		})
+	}
+}
+
+func Test_SmallSet_RetainAllOf_Nil(t *testing.T) {
+	var set *SmallSet[int]
+	if result := set.RetainAllOf(Hash(123)); internal.IsNotNil(result) {
+		t.Errorf("unexpected MutableSet; want nil, got %v", result)
+	}
+}
+
+func Test_SmallSet_Sample(t *testing.T) {
+	set := Small(Asc[int], 123, 456, 789)
+	for i := 0; i < 10; i++ {
+		element, ok := set.Sample()
+		if !ok {
+			t.Fatal("unexpected indication of no elements; want true, got false")
+		}
+		if !set.Contains(element) {
+			t.Errorf("unexpected element; want element of %v, got %v", set, element)
+		}
+	}
+}
+
+func Test_SmallSet_Sample_Empty(t *testing.T) {
+	set := Small(Asc[int])
+	if element, ok := set.Sample(); ok {
+		t.Errorf("unexpected element; want none, got %v", element)
+	}
+}
+
+func Test_SmallSet_Sample_Nil(t *testing.T) {
+	var set *SmallSet[int]
+	if element, ok := set.Sample(); ok {
+		t.Errorf("unexpected element; want none, got %v", element)
+	}
+}
+
+func Test_SmallSet_SampleN(t *testing.T) {
+	testCases := map[string]struct {
+		expectLen int
+		n         int
+		set       *SmallSet[int]
+	}{
+		"with n less than length": {
+			expectLen: 2,
+			n:         2,
+			set:       Small(Asc[int], 123, 456, 789),
+		},
+		"with n equal to length": {
+			expectLen: 3,
+			n:         3,
+			set:       Small(Asc[int], 123, 456, 789),
+		},
+		"with n greater than length": {
+			expectLen: 3,
+			n:         10,
+			set:       Small(Asc[int], 123, 456, 789),
+		},
+		"with zero n": {
+			expectLen: 0,
+			n:         0,
+			set:       Small(Asc[int], 123, 456, 789),
+		},
+		"with negative n": {
+			expectLen: 0,
+			n:         -1,
+			set:       Small(Asc[int], 123, 456, 789),
+		},
+		"with empty *SmallSet[int]": {
+			expectLen: 0,
+			n:         2,
+			set:       Small(Asc[int]),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sampled := tc.set.SampleN(tc.n)
+			if internal.IsNil(sampled) {
+				t.Fatal("unexpected nil Set")
+			}
+			if kind, expectKind := sampled.Kind(), tc.set.Kind(); kind != expectKind {
+				t.Errorf("unexpected SetKind; want %v, got %v", expectKind, kind)
+			}
+			if l := sampled.Len(); l != tc.expectLen {
+				t.Errorf("unexpected Set length; want %v, got %v", tc.expectLen, l)
+			}
+			if !tc.set.Covers(sampled) {
+				t.Errorf("unexpected sampled Set; want subset of %v, got %v", tc.set, sampled)
+			}
+		})
+	}
+}
+
+func Test_SmallSet_SampleN_Nil(t *testing.T) {
+	var set *SmallSet[int]
+	if sampled := set.SampleN(2); sampled != (*SmallSet[int])(nil) {
+		t.Errorf("unexpected sampled Set; want nil, got %v", sampled)
+	}
+}
+
+func Test_SmallSet_SampleNWithRand(t *testing.T) {
+	set := Small(Asc[int], 123, 456, 789)
+	expect := set.SampleNWithRand(2, rand.New(rand.NewSource(42)))
+	for i := 0; i < 10; i++ {
+		if sampled := set.SampleNWithRand(2, rand.New(rand.NewSource(42))); !sampled.Equal(expect) {
+			t.Errorf("unexpected sampled Set; want %v, got %v", expect, sampled)
+		}
+	}
+}
+
+func Test_SmallSet_SortedAppendSlice(t *testing.T) {
+	testCases := map[string]struct {
+		set    *SmallSet[int]
+		dst    []int
+		expect []int
+	}{
+		"with nil dst on non-empty *SmallSet": {
+			set:    Small(Asc[int], 456, 123, 789),
+			dst:    nil,
+			expect: []int{789, 456, 123},
+		},
+		"with non-empty dst on non-empty *SmallSet": {
+			set:    Small(Asc[int], 456, 123, 789),
+			dst:    []int{1, 999},
+			expect: []int{1, 999, 789, 456, 123},
+		},
+		"with nil dst on empty *SmallSet": {
+			set:    Small[int](Asc[int]),
+			dst:    nil,
+			expect: nil,
+		},
+		"with non-empty dst on empty *SmallSet": {
+			set:    Small[int](Asc[int]),
+			dst:    []int{1, 999},
+			expect: []int{1, 999},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ret := tc.set.SortedAppendSlice(tc.dst, Desc[int])
+			if !cmp.Equal(tc.expect, ret, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected slice; want %v, got %v", tc.expect, ret)
+			}
+		})
+	}
+}
+
+func Test_SmallSet_SortedAppendSlice_Nil(t *testing.T) {
+	var set *SmallSet[int]
+	dst := []int{1, 999}
+	ret := set.SortedAppendSlice(dst, Desc[int])
+
+	if !cmp.Equal(dst, ret) {
+		t.Errorf("unexpected slice; want %v, got %v", dst, ret)
+	}
+}
+
+func Test_SmallSet_SortedRange(t *testing.T) {
+	testCases := map[string]struct {
+		expect []int
+		set    *SmallSet[int]
+		stopAt int
+	}{
+		"with non-empty *SmallSet[int]": {
+			expect: []int{789, 456, 345, 123, 12},
+			set:    Small(Asc[int], 456, 123, 789, 12, 345),
+			stopAt: -1,
+		},
+		"with non-empty *SmallSet[int] and early stop": {
+			expect: []int{789, 456, 345},
+			set:    Small(Asc[int], 456, 123, 789, 12, 345),
+			stopAt: 2,
+		},
+		"with empty *SmallSet[int]": {
+			expect: nil,
+			set:    Small(Asc[int]),
+			stopAt: -1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var elements []int
+			tc.set.SortedRange(Desc[int], func(index int, element int) bool {
+				if index != len(elements) {
+					t.Errorf("unexpected index; want %v, got %v", len(elements), index)
+				}
+				elements = append(elements, element)
+				return index == tc.stopAt
+			})
+			if !cmp.Equal(tc.expect, elements, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected elements; want %v, got %v", tc.expect, elements)
+			}
+		})
+	}
+}
+
+func Test_SmallSet_SortedRange_Nil(t *testing.T) {
+	var set *SmallSet[int]
+	set.SortedRange(Desc[int], func(index int, element int) bool {
+		t.Errorf("unexpected call to iter with %v at index %v", element, index)
+		return false
+	})
+}
+
+func Test_SmallSet_SortedSlice(t *testing.T) {
+	set := Small(Asc[int], 123, 456, 789)
+	if diff := cmp.Diff([]int{789, 456, 123}, set.SortedSlice(Desc[int])); diff != "" {
+		t.Errorf("unexpected elements (-want +got):\n%s", diff)
+	}
+}
+
+func Test_SmallSet_ToMap(t *testing.T) {
+	testCases := map[string]struct {
+		expect map[int]struct{}
+		set    *SmallSet[int]
+	}{
+		"with non-empty *SmallSet": {
+			expect: map[int]struct{}{123: {}, 456: {}, 789: {}},
+			set:    Small(Asc[int], 123, 456, 789),
+		},
+		"with empty *SmallSet": {
+			expect: map[int]struct{}{},
+			set:    Small(Asc[int]),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := tc.set.ToMap()
+			if m == nil {
+				t.Fatal("unexpected map; want non-nil, got nil")
+			}
+			if !cmp.Equal(tc.expect, m) {
+				t.Errorf("unexpected map; want %v, got %v", tc.expect, m)
+			}
+			m[-1] = struct{}{}
+			if tc.set.Contains(-1) {
+				t.Error("unexpected Set containment of -1; want false, got true")
+			}
+		})
+	}
+}
+
+func Test_SmallSet_ToMap_Nil(t *testing.T) {
+	var set *SmallSet[int]
+	if m := set.ToMap(); m != nil {
+		t.Errorf("unexpected map; want nil, got %v", m)
+	}
+}
+
+func Test_SmallSet_Union(t *testing.T) {
+	set := Small(Asc[int], 123, 456)
+	union := set.Union(Hash(789, 0, 123))
+	if diff := cmp.Diff([]int{0, 123, 456, 789}, union.Slice()); diff != "" {
+		t.Errorf("unexpected elements (-want +got):\n%s", diff)
+	}
+	if kind := union.Kind(); kind != SmallKind {
+		t.Errorf("unexpected kind; want %v, got %v", SmallKind, kind)
+	}
+}
+
+func Test_SmallSet_Union_Nil(t *testing.T) {
+	var set *SmallSet[int]
+	if union := set.Union(nil); internal.IsNotNil(union) {
+		t.Errorf("unexpected Set; want nil, got %v", union)
+	}
+	union := set.Union(Hash(123, 456))
+	if expect := Hash(123, 456); !union.Equal(expect) {
+		t.Errorf("unexpected union Set; want %v, got %v", expect, union)
+	}
+	if !union.IsMutable() {
+		t.Error("unexpected union Set mutability; want true, got false")
+	}
+}
+
+func Test_SmallSet_Nil(t *testing.T) {
+	var set *SmallSet[int]
+	if internal.IsNotNil(set.Clear()) || internal.IsNotNil(set.Put(123)) || internal.IsNotNil(set.Delete(123)) {
+		t.Error("unexpected non-nil MutableSet")
+	}
+	if internal.IsNotNil(set.Clone()) || internal.IsNotNil(set.Diff(Hash(123))) ||
+		internal.IsNotNil(set.Filter(func(int) bool { return true })) || internal.IsNotNil(set.Immutable()) {
+		t.Error("unexpected non-nil Set")
+	}
+	if set.Contains(123) || set.Len() != 0 || !set.IsEmpty() || set.Slice() != nil {
+		t.Error("unexpected elements within nil Set")
+	}
+	if !set.Equal(nil) || !set.Equal(Hash[int]()) || set.Equal(Hash(123)) {
+		t.Error("unexpected equality of nil Set")
+	}
+	if element, ok := set.DeleteNth(Asc[int], 0); ok {
+		t.Errorf("unexpected deleted element; want 0 and false, got %v and %v", element, ok)
+	}
+	if s := set.String(); s != "[]" {
+		t.Errorf("unexpected string; want %q, got %q", "[]", s)
+	}
+	if kind := set.Kind(); kind != SmallKind {
+		t.Errorf("unexpected kind; want %v, got %v", SmallKind, kind)
+	}
+}
+
+func Test_SmallSet_Random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	set := Small[int](Asc[int])
+	expect := MutableHash[int]()
+	for i := 0; i < 10_000; i++ {
+		element := rnd.Intn(64)
+		if rnd.Intn(2) == 0 {
+			set.Put(element)
+			expect.Put(element)
+		} else {
+			set.Delete(element)
+			expect.Delete(element)
+		}
+		if set.Len() != expect.Len() {
+			t.Fatalf("unexpected Set length after %v operations; want %v, got %v", i+1, expect.Len(), set.Len())
+		}
+	}
+	if !set.Equal(expect) {
+		t.Errorf("unexpected Set; want %v, got %v", expect, set)
+	}
+	if diff := cmp.Diff(expect.SortedSlice(Asc[int]), set.Slice()); diff != "" {
+		t.Errorf("unexpected elements (-want +got):\n%s", diff)
+	}
+}
+
+func Test_SmallSet_Take(t *testing.T) {
+	testCases := map[string]struct {
+		element        int
+		expectElement  int
+		expectElements []int
+		expectOk       bool
+	}{
+		"with element present": {
+			element:        456,
+			expectElement:  456,
+			expectElements: []int{123, 789},
+			expectOk:       true,
+		},
+		"with element not present": {
+			element:        999,
+			expectElement:  0,
+			expectElements: []int{123, 456, 789},
+			expectOk:       false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Small(Asc[int], 123, 456, 789)
+			element, ok := set.Take(tc.element)
+			if element != tc.expectElement || ok != tc.expectOk {
+				t.Errorf(
+					"unexpected taken element; want %v and %v, got %v and %v",
+					tc.expectElement,
+					tc.expectOk,
+					element,
+					ok,
+				)
+			}
+			if expect := HashFromSlice(tc.expectElements); !set.Equal(expect) {
+				t.Errorf("unexpected Set; want %v, got %v", expect, set)
+			}
+		})
+	}
+}
+
+func Test_SmallSet_Take_Nil(t *testing.T) {
+	var set *SmallSet[int]
+	if element, ok := set.Take(123); ok {
+		t.Errorf("unexpected taken element; want 0 and false, got %v and %v", element, ok)
+	}
+}
+
+func Test_SmallSet_String(t *testing.T) {
+	set := Small(Asc[int], 789, 123, 456)
+	if s := set.String(); s != "[123 456 789]" {
+		t.Errorf("unexpected string; want %q, got %q", "[123 456 789]", s)
+	}
+}
+
+func Test_SmallSet_MarshalJSON(t *testing.T) {
+	set := Small(Asc[int], 789, 123, 456)
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s := string(data); s != "[123,456,789]" {
+		t.Errorf("unexpected JSON; want %q, got %q", "[123,456,789]", s)
+	}
+}
+
+func Test_SmallSet_UnmarshalJSON(t *testing.T) {
+	set := Small(Asc[int], 999)
+	if err := json.Unmarshal([]byte("[789,123,456,123]"), set); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]int{123, 456, 789}, set.Slice()); diff != "" {
+		t.Errorf("unexpected elements (-want +got):\n%s", diff)
+	}
+	if err := json.Unmarshal([]byte("[123]"), &SmallSet[int]{}); !errors.Is(err, ErrJSONLess) {
+		t.Errorf("unexpected error; want %v, got %v", ErrJSONLess, err)
+	}
+}
+
+func Benchmark_SmallSet_Contains(b *testing.B) {
+	for _, size := range []int{4, 16, 64, 256} {
+		elements := make([]int, size)
+		for i := range elements {
+			elements[i] = i * 2
+		}
+		small := SmallFromSlice(Asc[int], elements)
+		hash := HashFromSlice(elements)
+		b.Run(fmt.Sprintf("SmallSet/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				small.Contains(i % (size * 2))
+			}
+		})
+		b.Run(fmt.Sprintf("HashSet/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				hash.Contains(i % (size * 2))
+			}
+		})
+	}
+}
+
+func Benchmark_SmallSet_Put(b *testing.B) {
+	for _, size := range []int{4, 16, 64, 256} {
+		b.Run(fmt.Sprintf("SmallSet/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				set := Small[int](Asc[int])
+				for j := 0; j < size; j++ {
+					set.Put(j * 7 % size)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("MutableHashSet/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				set := MutableHash[int]()
+				for j := 0; j < size; j++ {
+					set.Put(j * 7 % size)
+				}
+			}
+		})
+	}
+}
@@ -21,10 +21,18 @@
 package sets
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/gob"
 	"encoding/json"
 	"fmt"
 	"github.com/neocotic/go-sets/internal"
+	"golang.org/x/exp/constraints"
+	"io"
+	"math/rand"
 	"sync"
+	"sync/atomic"
 )
 
 // SyncHashSet is an implementation of MutableSet that contains a unique data set.
@@ -33,16 +41,63 @@ import (
 // coordination due to internal locking. If mutability is not required HashSet is a cheaper alternative.
 type SyncHashSet[E comparable] struct {
 	elements internal.Hash[E]
+	capacity int
+	order    atomic.Pointer[internal.Order[E]]
 	mu       sync.RWMutex
 }
 
 var (
 	_ MutableSet[any]  = (*SyncHashSet[any])(nil)
 	_ fmt.Stringer     = (*SyncHashSet[any])(nil)
+	_ driver.Valuer    = (*SyncHashSet[any])(nil)
+	_ gob.GobDecoder   = (*SyncHashSet[any])(nil)
+	_ gob.GobEncoder   = (*SyncHashSet[any])(nil)
 	_ json.Marshaler   = (*SyncHashSet[any])(nil)
 	_ json.Unmarshaler = (*SyncHashSet[any])(nil)
+	_ sql.Scanner      = (*SyncHashSet[any])(nil)
 )
 
+// All returns an iterator over each element within the SyncHashSet, which is compatible with iter.Seq so can be used
+// with range-over-func (e.g. `for element := range set.All()`) from Go 1.23 onwards.
+//
+// Like SyncHashSet.Range, a read lock is acquired when iteration starts and is held until the consumer stops (e.g. by
+// breaking out of the loop) or all elements have been yielded, at which point it is released. As such, the
+// SyncHashSet must not be mutated from within the loop as doing so will result in a deadlock. SyncHashSet.RangeMutable
+// should be used instead for such cases.
+//
+// Iteration order is not guaranteed to be consistent.
+//
+// If the SyncHashSet is nil, the iterator returned by SyncHashSet.All yields no elements.
+func (s *SyncHashSet[E]) All() func(yield func(element E) bool) {
+	return seq[E](s.Range)
+}
+
+// AppendSlice appends all elements of the SyncHashSet to the dst slice and returns the extended slice, following the
+// same convention as the built-in append function. This allows a buffer to be reused across calls, avoiding the
+// allocation made by SyncHashSet.Slice each time.
+//
+// The order in which elements are appended is not guaranteed to be consistent. SyncHashSet.SortedSlice should be used
+// instead for such cases where consistent ordering is required.
+//
+// If the SyncHashSet is nil, SyncHashSet.AppendSlice returns dst unchanged.
+func (s *SyncHashSet[E]) AppendSlice(dst []E) []E {
+	if s == nil {
+		return dst
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return internal.AppendSlice(dst, s.elements)
+}
+
+// Canonical returns a minimal byte representation of the SyncHashSet that is stable for its elements, which can be
+// useful for hashing, cache keys, or as a shortcut for equality checks. See Set.Canonical for the escaping and
+// separator used.
+//
+// If the SyncHashSet is nil it is treated as having no elements and so SyncHashSet.Canonical returns an empty slice.
+func (s *SyncHashSet[E]) Canonical(convert func(element E) string) []byte {
+	return canonical[E](s, convert)
+}
+
 // Clear removes all elements from the SyncHashSet.
 //
 // If the SyncHashSet is nil, SyncHashSet.Clear is a no-op.
@@ -56,11 +111,16 @@ func (s *SyncHashSet[E]) Clear() MutableSet[E] {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	s.elements = make(internal.Hash[E])
+	s.capacity = 0
+	s.order.Store(nil)
 	return s
 }
 
 // Clone returns a clone of the SyncHashSet.
 //
+// The clone is another SyncHashSet with its own lock. SyncHashSet.Immutable should be used instead for such cases where
+// a read-only copy is sufficient, as it avoids the locking overhead on the copy.
+//
 // If the SyncHashSet is nil, SyncHashSet.Clone returns nil.
 func (s *SyncHashSet[E]) Clone() Set[E] {
 	if s == nil {
@@ -72,6 +132,25 @@ func (s *SyncHashSet[E]) Clone() Set[E] {
 	return &SyncHashSet[E]{elements: internal.Clone[E](s.elements)}
 }
 
+// CompareAndDelete removes the element from the SyncHashSet, but only if it is present, and returns whether it was
+// removed. The check and removal are made under a single write lock so, when multiple goroutines race to remove the
+// same element, exactly one of them succeeds. Unlike SyncHashSet.Delete, this allows the caller to learn whether it
+// was responsible for the removal.
+//
+// If the SyncHashSet is nil, SyncHashSet.CompareAndDelete is a no-op and returns false.
+func (s *SyncHashSet[E]) CompareAndDelete(element E) bool {
+	if s == nil {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := internal.Take[E](s.elements, element)
+	if ok {
+		s.order.Load().Delete(element, nil)
+	}
+	return ok
+}
+
 // Contains returns whether the SyncHashSet contains the element.
 //
 // If the SyncHashSet is nil, SyncHashSet.Contains returns false.
@@ -85,6 +164,87 @@ func (s *SyncHashSet[E]) Contains(element E) bool {
 	return ok
 }
 
+// ContainsAll returns whether the SyncHashSet contains the element as well as all additional elements specified,
+// stopping as soon as any element is found not to be contained. All elements are checked within a single read lock so
+// the result is atomic with respect to other goroutines, unlike calling SyncHashSet.Contains for each element.
+//
+// If the SyncHashSet is nil, SyncHashSet.ContainsAll returns false.
+func (s *SyncHashSet[E]) ContainsAll(element E, elements ...E) bool {
+	if s == nil {
+		return false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return containsAll[E](element, elements, func(element E) bool {
+		_, ok := s.elements[element]
+		return ok
+	})
+}
+
+// ContainsAny returns whether the SyncHashSet contains the element or any of the additional elements specified,
+// stopping as soon as any element is found to be contained. All elements are checked within a single read lock so the
+// result is atomic with respect to other goroutines, unlike calling SyncHashSet.Contains for each element.
+//
+// If the SyncHashSet is nil, SyncHashSet.ContainsAny returns false.
+func (s *SyncHashSet[E]) ContainsAny(element E, elements ...E) bool {
+	if s == nil {
+		return false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return containsAny[E](element, elements, func(element E) bool {
+		_, ok := s.elements[element]
+		return ok
+	})
+}
+
+// ContainsEach returns a slice containing whether the SyncHashSet contains each of the elements provided, where each
+// result is at the same index as its element. All elements are checked within a single read lock, making this more
+// efficient than calling SyncHashSet.Contains for each element.
+//
+// The returned slice always has the same length as the slice of elements provided.
+//
+// If the SyncHashSet is nil, SyncHashSet.ContainsEach returns a slice of false values.
+func (s *SyncHashSet[E]) ContainsEach(elements []E) []bool {
+	if s == nil {
+		return containsEach[E](elements, s.Contains)
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return containsEach[E](elements, func(element E) bool {
+		_, ok := s.elements[element]
+		return ok
+	})
+}
+
+// Count returns the number of elements within the SyncHashSet that match the predicate function. All elements are
+// checked within a single read lock.
+//
+// If the SyncHashSet is nil, SyncHashSet.Count returns zero.
+func (s *SyncHashSet[E]) Count(predicate func(element E) bool) int {
+	return count[E](s.Range, predicate)
+}
+
+// Covers returns whether the SyncHashSet is a superset of every other Set. That is; whether the SyncHashSet contains
+// all elements within each other Set. Any other Set containing more elements than the SyncHashSet is never covered and
+// so is rejected before its elements are checked.
+//
+// Any nil other Set is treated as having no elements and so is always covered. If no other Set is provided,
+// SyncHashSet.Covers returns true.
+//
+// If the SyncHashSet is nil it is treated as having no elements.
+func (s *SyncHashSet[E]) Covers(others ...Set[E]) bool {
+	if s == nil {
+		return covers[E](0, s.Contains, others)
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return covers[E](len(s.elements), func(element E) bool {
+		_, ok := s.elements[element]
+		return ok
+	}, others)
+}
+
 // Delete removes the element from the SyncHashSet as well as any additional elements specified.
 //
 // If the SyncHashSet is nil, SyncHashSet.Delete is a no-op.
@@ -98,6 +258,7 @@ func (s *SyncHashSet[E]) Delete(element E, elements ...E) MutableSet[E] {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	internal.Delete[E](s.elements, element, elements)
+	s.order.Load().Delete(element, elements)
 	return s
 }
 
@@ -114,9 +275,69 @@ func (s *SyncHashSet[E]) DeleteAll(elements Set[E]) MutableSet[E] {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	internal.DeleteAll[E](s.elements, elements)
+	s.order.Load().Prune(s.elements)
 	return s
 }
 
+// DeleteAnyOf removes all elements from the SyncHashSet that exist within any of the specified Set. Any nil Set is
+// skipped.
+//
+// If the SyncHashSet is nil, SyncHashSet.DeleteAnyOf is a no-op.
+//
+// A reference to the SyncHashSet is returned for method chaining.
+func (s *SyncHashSet[E]) DeleteAnyOf(sets ...Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *SyncHashSet[E]
+		return ns
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	internal.DeleteAnyOf[E](s.elements, asCollections(sets))
+	s.order.Load().Prune(s.elements)
+	return s
+}
+
+// DeleteExisting removes the element from the SyncHashSet as well as any additional elements specified, and returns the
+// number of those elements that were present and therefore removed. Unlike SyncHashSet.Delete, this allows the caller
+// to learn whether anything was actually removed.
+//
+// All elements are removed under a single write lock, so the returned number is never affected by another goroutine.
+//
+// If the SyncHashSet is nil, SyncHashSet.DeleteExisting is a no-op and returns zero.
+func (s *SyncHashSet[E]) DeleteExisting(element E, elements ...E) int {
+	if s == nil {
+		return 0
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	deleted := deleteExisting[E](func(element E) (E, bool) {
+		return internal.Take[E](s.elements, element)
+	}, element, elements)
+	s.order.Load().Prune(s.elements)
+	return deleted
+}
+
+// DeleteNth removes the element at index i from the SyncHashSet, were its elements sorted using the provided less
+// function, and returns the removed element as well as an indication of whether an element was removed.
+//
+// If i is out of range (i.e. negative or not less than SyncHashSet.Len), SyncHashSet.DeleteNth is a no-op and returns
+// the zero value for E and false.
+//
+// If the SyncHashSet is nil, SyncHashSet.DeleteNth is a no-op and returns the zero value for E and false.
+func (s *SyncHashSet[E]) DeleteNth(less func(x, y E) bool, i int) (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	element, ok := internal.DeleteNth[E](s.elements, less, i)
+	if ok {
+		s.order.Load().Delete(element, nil)
+	}
+	return element, ok
+}
+
 // DeleteSlice removes all elements in the specified slice from the SyncHashSet.
 //
 // If the SyncHashSet is nil, SyncHashSet.DeleteSlice is a no-op.
@@ -130,6 +351,7 @@ func (s *SyncHashSet[E]) DeleteSlice(elements []E) MutableSet[E] {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	internal.DeleteSlice[E](s.elements, elements)
+	s.order.Load().Prune(s.elements)
 	return s
 }
 
@@ -146,6 +368,7 @@ func (s *SyncHashSet[E]) DeleteWhere(predicate func(element E) bool) MutableSet[
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	internal.DeleteWhere[E](s.elements, predicate)
+	s.order.Load().Prune(s.elements)
 	return s
 }
 
@@ -176,8 +399,30 @@ func (s *SyncHashSet[E]) DiffSymmetric(other Set[E]) Set[E] {
 	return &SyncHashSet[E]{elements: internal.DiffSymmetric[E](s.elements, other)}
 }
 
+// DrainWhere removes all elements that match the predicate function from the SyncHashSet and returns them within a new
+// SyncHashSet struct. Unlike SyncHashSet.DeleteWhere, this allows the caller to inspect the elements that were removed.
+// Together, the SyncHashSet and the returned SyncHashSet contain exactly the elements that the SyncHashSet contained
+// beforehand.
+//
+// The elements are removed under a single write lock, so no other goroutine can observe only some of them removed.
+//
+// If the SyncHashSet is nil, SyncHashSet.DrainWhere returns nil.
+func (s *SyncHashSet[E]) DrainWhere(predicate func(element E) bool) Set[E] {
+	if s == nil {
+		var ns *SyncHashSet[E]
+		return ns
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	drained := internal.DrainWhere[E](s.elements, predicate)
+	s.order.Load().Prune(s.elements)
+	return &SyncHashSet[E]{elements: drained}
+}
+
 // Equal returns whether the SyncHashSet contains the exact same elements as another Set.
 //
+// If the other Set is a HashSet or MutableHashSet, their elements are compared directly without any allocation.
+//
 // If the SyncHashSet is nil it is treated as having no elements and the same logic applies to the other Set. To
 // clarify; this means that a nil Set is equal to a non-nil Set that contains no elements.
 func (s *SyncHashSet[E]) Equal(other Set[E]) bool {
@@ -189,6 +434,9 @@ func (s *SyncHashSet[E]) Equal(other Set[E]) bool {
 	if other == nil {
 		return len(s.elements) == 0
 	}
+	if elements, ok := hashOf[E](other); ok {
+		return internal.Equal[E](s.elements, elements)
+	}
 	return internal.ContainsOnly[E](s.elements, other.Slice())
 }
 
@@ -233,8 +481,57 @@ func (s *SyncHashSet[E]) Find(search func(element E) bool) (E, bool) {
 	return internal.Find[E](s.elements, search)
 }
 
+// GetAndClear removes all elements from the SyncHashSet and returns them within a new HashSet struct. Both are done
+// under a single write lock so no element put by another goroutine can be lost between reading and clearing the
+// SyncHashSet, which can be useful when periodically flushing elements that are being produced concurrently.
+//
+// If the SyncHashSet is nil or contains no elements, SyncHashSet.GetAndClear returns an empty HashSet.
+func (s *SyncHashSet[E]) GetAndClear() Set[E] {
+	if s == nil {
+		return Hash[E]()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	elements := s.elements
+	s.elements = make(internal.Hash[E])
+	s.capacity = 0
+	s.order.Store(nil)
+	return &HashSet[E]{elements: elements}
+}
+
+// Grow increases the capacity of the SyncHashSet, if necessary, to guarantee space for another n elements. After
+// Grow(n), at least n elements can be added to the SyncHashSet without it needing to allocate further. As the capacity
+// of a map cannot be inspected, the capacity that the backing map was last sized for is recorded instead, and the
+// backing map is only rebuilt, while holding the write lock, when that capacity cannot hold another n elements. Only
+// the capacity reserved by Grow (or SyncHashWithCapacity) is recorded, so the backing map may be rebuilt when it
+// already has enough capacity (e.g. having grown as elements were added).
+//
+// If n is not positive, SyncHashSet.Grow is a no-op.
+//
+// If the SyncHashSet is nil, SyncHashSet.Grow is a no-op.
+//
+// A reference to the SyncHashSet is returned for method chaining.
+func (s *SyncHashSet[E]) Grow(n int) MutableSet[E] {
+	if s == nil {
+		var ns *SyncHashSet[E]
+		return ns
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if n > 0 && len(s.elements)+n > s.capacity {
+		s.capacity = len(s.elements) + n
+		s.elements = internal.Grow(s.elements, n)
+	}
+	return s
+}
+
 // Immutable returns an immutable clone of the SyncHashSet.
 //
+// The clone is a HashSet containing a snapshot of the elements taken under a read lock. As such, it requires no
+// locking when read and is independent of any subsequent mutations to the SyncHashSet. SyncHashSet.Clone should be used
+// instead for such cases where a mutable copy that is safe for concurrent use is required. SyncHashSet.Snapshot is an
+// alias of SyncHashSet.Immutable.
+//
 // If the SyncHashSet is nil, SyncHashSet.Immutable returns nil.
 func (s *SyncHashSet[E]) Immutable() Set[E] {
 	if s == nil {
@@ -243,7 +540,7 @@ func (s *SyncHashSet[E]) Immutable() Set[E] {
 	}
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	return &HashSet[E]{internal.Clone[E](s.elements)}
+	return &HashSet[E]{elements: internal.Clone[E](s.elements)}
 }
 
 // Intersection returns a new SyncHashSet struct containing only elements of the SyncHashSet that also exist in another
@@ -260,6 +557,26 @@ func (s *SyncHashSet[E]) Intersection(other Set[E]) Set[E] {
 	return &SyncHashSet[E]{elements: internal.Intersection[E](s.elements, other)}
 }
 
+// IsDisjoint returns whether the SyncHashSet has no elements in common with another Set. Only the elements within the
+// smaller of the two are iterated, with each being checked for within the larger.
+//
+// Any nil other Set, or other Set containing no elements, is disjoint from the SyncHashSet.
+//
+// If the SyncHashSet is nil, SyncHashSet.IsDisjoint returns true.
+func (s *SyncHashSet[E]) IsDisjoint(other Set[E]) bool {
+	if s == nil {
+		return true
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return disjoint[E](len(s.elements), func(element E) bool {
+		_, ok := s.elements[element]
+		return ok
+	}, func(iter func(element E) bool) {
+		internal.Range[E](s.elements, iter)
+	}, other)
+}
+
 // IsEmpty returns whether the SyncHashSet contains no elements.
 //
 // If the SyncHashSet is nil, SyncHashSet.IsEmpty returns true.
@@ -293,6 +610,26 @@ func (s *SyncHashSet[E]) Join(sep string, convert func(element E) string) string
 	return internal.Join[E](s.elements, sep, convert)
 }
 
+// Keys returns a map containing all elements of the SyncHashSet as keys, which can be useful when integrating with APIs
+// that expect a map rather than a slice.
+//
+// The returned map is always a copy so can be modified freely without affecting the SyncHashSet.
+//
+// If the SyncHashSet is nil, SyncHashSet.Keys returns nil.
+func (s *SyncHashSet[E]) Keys() map[E]struct{} {
+	if s == nil {
+		return nil
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return internal.Clone[E](s.elements)
+}
+
+// Kind always returns SyncHashKind to conform with Set.Kind.
+func (s *SyncHashSet[E]) Kind() SetKind {
+	return SyncHashKind
+}
+
 // Len returns the number of elements within the SyncHashSet.
 //
 // If the SyncHashSet is nil, SyncHashSet.Len returns zero.
@@ -354,6 +691,25 @@ func (s *SyncHashSet[E]) None(predicate func(element E) bool) bool {
 	return internal.None[E](s.elements, predicate)
 }
 
+// Pop removes an arbitrary element from the SyncHashSet and returns it as well as an indication of whether the
+// SyncHashSet contained any elements. The element is found and removed within a single write lock so no two goroutines
+// ever pop the same element.
+//
+// If the SyncHashSet is nil, SyncHashSet.Pop is a no-op and returns the zero value for E and false.
+func (s *SyncHashSet[E]) Pop() (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	element, ok := internal.Pop[E](s.elements)
+	if ok {
+		s.order.Load().Delete(element, nil)
+	}
+	return element, ok
+}
+
 // Put adds the element to the SyncHashSet as well as any additional elements specified. Nothing changes for elements
 // that already exist within the SyncHashSet.
 //
@@ -368,6 +724,7 @@ func (s *SyncHashSet[E]) Put(element E, elements ...E) MutableSet[E] {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	internal.Put[E](s.elements, element, elements)
+	s.order.Load().Put(element, elements)
 	return s
 }
 
@@ -385,6 +742,12 @@ func (s *SyncHashSet[E]) PutAll(elements Set[E]) MutableSet[E] {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	internal.PutAll[E](s.elements, elements)
+	if o := s.order.Load(); o != nil && elements != nil {
+		elements.Range(func(element E) bool {
+			o.Put(element, nil)
+			return false
+		})
+	}
 	return s
 }
 
@@ -402,6 +765,7 @@ func (s *SyncHashSet[E]) PutSlice(elements []E) MutableSet[E] {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	internal.PutSlice[E](s.elements, elements)
+	s.order.Load().PutSlice(elements)
 	return s
 }
 
@@ -420,6 +784,78 @@ func (s *SyncHashSet[E]) Range(iter func(element E) bool) {
 	internal.Range[E](s.elements, iter)
 }
 
+// RangeContext calls the iter function with each element within the SyncHashSet, the same as SyncHashSet.Range,
+// except that the context is checked before each element. If the context is done, iteration stops and the error of the
+// context is returned. This can be useful for iterating over a large SyncHashSet where the caller may go away (e.g.
+// within a request handler).
+//
+// Unlike SyncHashSet.Range, the read lock is not held during iteration as this could otherwise block writes for a long
+// time. Instead, a snapshot of the elements is taken under the read lock and then iterated once it has been released,
+// so elements put or deleted during iteration are not reflected, and the iter function may safely modify the
+// SyncHashSet.
+//
+// Iteration order is not guaranteed to be consistent.
+//
+// If the SyncHashSet is nil, SyncHashSet.RangeContext is a no-op and returns nil.
+func (s *SyncHashSet[E]) RangeContext(ctx context.Context, iter func(element E) bool) error {
+	if s == nil {
+		return nil
+	}
+	elements := s.Slice()
+	return rangeContext(ctx, func(iter func(element E) bool) {
+		for _, element := range elements {
+			if iter(element) {
+				break
+			}
+		}
+	}, iter)
+}
+
+// RangeMutable calls the iter function with each element within the SyncHashSet, along with the SyncHashSet itself so
+// that it can be safely mutated during iteration, but will stop early whenever the iter function returns true.
+//
+// Unlike SyncHashSet.Range, no lock is held while the iter function is called. Instead, iteration is performed over a
+// snapshot of the elements taken under a read lock, while any mutations made via the MutableSet passed to the iter
+// function are applied under the write lock as usual. As such, mutations never affect the current iteration. That is;
+// elements added during iteration are not iterated and elements deleted during iteration are still iterated if they had
+// not yet been reached.
+//
+// Iteration order is not guaranteed to be consistent.
+//
+// If the SyncHashSet is nil, SyncHashSet.RangeMutable is a no-op.
+func (s *SyncHashSet[E]) RangeMutable(iter func(element E, set MutableSet[E]) bool) {
+	if s == nil {
+		return
+	}
+	s.mu.RLock()
+	elements := internal.Slice[E](s.elements)
+	s.mu.RUnlock()
+	for _, element := range elements {
+		if iter(element, s) {
+			break
+		}
+	}
+}
+
+// ReplaceElement removes the old element from the SyncHashSet and adds the new element in its place, but only if the
+// old element is present, and returns whether it was present. If the new element already exists within the SyncHashSet,
+// the old element is simply removed. Unlike calling SyncHashSet.Delete followed by SyncHashSet.Put, this is done within
+// a single write lock so no transient state is observable by other goroutines.
+//
+// If the SyncHashSet is nil, SyncHashSet.ReplaceElement is a no-op and returns false.
+func (s *SyncHashSet[E]) ReplaceElement(oldElement, newElement E) bool {
+	if s == nil {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	replaced := internal.ReplaceElement[E](s.elements, oldElement, newElement)
+	if replaced {
+		s.order.Load().Replace(oldElement, newElement)
+	}
+	return replaced
+}
+
 // Retain removes all elements from the SyncHashSet except the element(s) specified.
 //
 // If the SyncHashSet is nil, SyncHashSet.Retain is a no-op.
@@ -433,6 +869,8 @@ func (s *SyncHashSet[E]) Retain(element E, elements ...E) MutableSet[E] {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	s.elements = internal.Retaining[E](s.elements, element, elements)
+	s.capacity = 0
+	s.order.Load().Prune(s.elements)
 	return s
 }
 
@@ -449,6 +887,28 @@ func (s *SyncHashSet[E]) RetainAll(elements Set[E]) MutableSet[E] {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	s.elements = internal.RetainingAll[E](s.elements, elements)
+	s.capacity = 0
+	s.order.Load().Prune(s.elements)
+	return s
+}
+
+// RetainAllOf removes all elements from the SyncHashSet except those that exist within all the specified Set. That is;
+// the SyncHashSet is intersected with each Set in place. As with SyncHashSet.RetainAll, any nil Set is treated as
+// having no elements. If no Set is specified, nothing is removed.
+//
+// If the SyncHashSet is nil, SyncHashSet.RetainAllOf is a no-op.
+//
+// A reference to the SyncHashSet is returned for method chaining.
+func (s *SyncHashSet[E]) RetainAllOf(sets ...Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *SyncHashSet[E]
+		return ns
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.elements = internal.RetainingAllOf[E](s.elements, asCollections(sets))
+	s.capacity = 0
+	s.order.Load().Prune(s.elements)
 	return s
 }
 
@@ -465,6 +925,8 @@ func (s *SyncHashSet[E]) RetainSlice(elements []E) MutableSet[E] {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	s.elements = internal.RetainingSlice[E](s.elements, elements)
+	s.capacity = 0
+	s.order.Load().Prune(s.elements)
 	return s
 }
 
@@ -481,9 +943,57 @@ func (s *SyncHashSet[E]) RetainWhere(predicate func(element E) bool) MutableSet[
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	s.elements = internal.RetainingWhere[E](s.elements, predicate)
+	s.capacity = 0
+	s.order.Load().Prune(s.elements)
 	return s
 }
 
+// Sample returns a pseudo-random element within the SyncHashSet as well as an indication of whether the SyncHashSet
+// contained any elements.
+//
+// If the SyncHashSet is nil, SyncHashSet.Sample returns the zero value for E and false.
+func (s *SyncHashSet[E]) Sample() (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return sample[E](func(iter func(element E) bool) {
+		internal.Range[E](s.elements, iter)
+	}, len(s.elements))
+}
+
+// SampleN returns a new SyncHashSet struct containing up to n distinct elements of the SyncHashSet chosen
+// pseudo-randomly. All elements are included if n is greater than or equal to the number of elements within the
+// SyncHashSet, while none are included if n is not positive.
+//
+// If the SyncHashSet is nil, SyncHashSet.SampleN returns nil.
+func (s *SyncHashSet[E]) SampleN(n int) Set[E] {
+	return s.SampleNWithRand(n, nil)
+}
+
+// SampleNWithRand returns a new SyncHashSet struct containing up to n distinct elements of the SyncHashSet chosen
+// pseudo-randomly using the provided source, the same as SyncHashSet.SampleN otherwise. If the source is nil, the
+// default source is used.
+//
+// Elements are chosen in an arbitrary order unless SyncHashSet.StableRange has already been called on the SyncHashSet,
+// in which case the order it maintains is used instead. SyncHashSet.SampleNWithRand never starts maintaining that order
+// itself. Therefore, repeated calls on the same SyncHashSet using sources that are seeded identically are only
+// guaranteed to choose the same elements once SyncHashSet.StableRange has been called, and only for as long as the
+// SyncHashSet is not modified.
+//
+// If the SyncHashSet is nil, SyncHashSet.SampleNWithRand returns nil.
+func (s *SyncHashSet[E]) SampleNWithRand(n int, r *rand.Rand) Set[E] {
+	if s == nil {
+		var ns *SyncHashSet[E]
+		return ns
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &SyncHashSet[E]{elements: sampleN[E](s.order.Load().Snapshot(s.elements), n, r)}
+}
+
 // Slice returns a slice containing all elements of the SyncHashSet.
 //
 // The order of elements within the resulting slice is not guaranteed to be consistent. SyncHashSet.SortedSlice should
@@ -499,6 +1009,18 @@ func (s *SyncHashSet[E]) Slice() []E {
 	return internal.Slice[E](s.elements)
 }
 
+// Snapshot returns a point-in-time copy of the SyncHashSet as an immutable HashSet, containing the elements copied
+// under a read lock.
+//
+// The snapshot can be read without any further locking and does not reflect any later changes made to the SyncHashSet,
+// nor is the SyncHashSet affected by the snapshot. SyncHashSet.Snapshot is an alias of SyncHashSet.Immutable, which
+// already returns such a snapshot, and exists to better convey intent where such independence is relied upon.
+//
+// If the SyncHashSet is nil, SyncHashSet.Snapshot returns nil.
+func (s *SyncHashSet[E]) Snapshot() Set[E] {
+	return s.Immutable()
+}
+
 // Some returns whether the SyncHashSet contains any element that matches the predicate function.
 //
 // If the SyncHashSet is nil, SyncHashSet.Some returns false.
@@ -511,6 +1033,19 @@ func (s *SyncHashSet[E]) Some(predicate func(element E) bool) bool {
 	return internal.Some[E](s.elements, predicate)
 }
 
+// SortedAppendSlice appends all elements of the SyncHashSet to the dst slice, sorted using the provided less function,
+// and returns the extended slice. Only the appended elements are sorted so any elements already within dst are left
+// untouched. This allows a buffer to be reused across calls, avoiding the allocation made by SyncHashSet.SortedSlice
+// each time.
+//
+// If the SyncHashSet is nil, SyncHashSet.SortedAppendSlice returns dst unchanged.
+func (s *SyncHashSet[E]) SortedAppendSlice(dst []E, less func(x, y E) bool) []E {
+	if s == nil {
+		return dst
+	}
+	return sortedAppendSlice[E](s, dst, less)
+}
+
 // SortedJoin sorts the elements within the SyncHashSet using the provided less function and then converts those
 // elements into strings which are then joined using the specified separator to create the resulting string.
 //
@@ -524,6 +1059,22 @@ func (s *SyncHashSet[E]) SortedJoin(sep string, convert func(element E) string,
 	return internal.SortedJoin[E](s.elements, sep, convert, less)
 }
 
+// SortedRange calls the iter function with each element within the SyncHashSet, sorted using the provided less
+// function, along with the zero-based index of that element within the sorted order, but will stop early whenever the
+// iter function returns true.
+//
+// Unlike SyncHashSet.Range, iteration order is deterministic and the read lock is not held during iteration. Instead, a
+// sorted snapshot of the elements is taken under the read lock and then iterated once it has been released, so elements
+// put or deleted during iteration are not reflected, and the iter function may safely modify the SyncHashSet.
+//
+// If the SyncHashSet is nil, SyncHashSet.SortedRange is a no-op.
+func (s *SyncHashSet[E]) SortedRange(less func(x, y E) bool, iter func(index int, element E) bool) {
+	if s == nil {
+		return
+	}
+	sortedRange[E](s, less, iter)
+}
+
 // SortedSlice returns a slice containing all elements of the SyncHashSet sorted using the provided less function.
 //
 // If the SyncHashSet is nil, SyncHashSet.SortedSlice returns nil.
@@ -536,6 +1087,82 @@ func (s *SyncHashSet[E]) SortedSlice(less func(x, y E) bool) []E {
 	return internal.SortedSlice[E](s.elements, less)
 }
 
+// StableRange calls the iter function with each element within the SyncHashSet but will stop early whenever the iter
+// function returns true.
+//
+// Unlike SyncHashSet.Range, iteration order is stable across repeated calls on the same SyncHashSet, which can be
+// useful for reproducibility (e.g. within tests). No less function is required, unlike SyncHashSet.SortedSlice.
+// Instead, the order of elements is only tracked from the first call to SyncHashSet.StableRange, so the cost of doing
+// so is only incurred when needed. Until then, the only cost is an atomic load whenever the SyncHashSet is modified to
+// check whether tracking has been enabled. Elements already within the SyncHashSet at that point are ordered
+// arbitrarily, while elements added afterwards are iterated after them in the order they were added. Elements that are
+// deleted from the SyncHashSet never affect the order of the remaining elements. Tracking stops, and its order is
+// discarded, whenever the SyncHashSet is cleared, decoded, or unmarshalled into.
+//
+// If the SyncHashSet is nil, SyncHashSet.StableRange is a no-op.
+func (s *SyncHashSet[E]) StableRange(iter func(element E) bool) {
+	if s == nil {
+		return
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, element := range s.stableOrder().Snapshot(s.elements) {
+		if iter(element) {
+			break
+		}
+	}
+}
+
+// Take removes the element from the SyncHashSet and returns the element that was stored within the SyncHashSet as well
+// as an indication of whether it was present. As elements are compared using equality, the returned element is always
+// equal to the element provided when present.
+//
+// If the SyncHashSet is nil, SyncHashSet.Take is a no-op and returns the zero value for E and false.
+func (s *SyncHashSet[E]) Take(element E) (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	element, ok := internal.Take[E](s.elements, element)
+	if ok {
+		s.order.Load().Delete(element, nil)
+	}
+	return element, ok
+}
+
+// ToMap returns a map containing all elements of the SyncHashSet as keys, which is equivalent to SyncHashSet.Keys.
+//
+// The returned map is always a copy so can be modified freely without affecting the SyncHashSet.
+//
+// If the SyncHashSet is nil, SyncHashSet.ToMap returns nil.
+func (s *SyncHashSet[E]) ToMap() map[E]struct{} {
+	return s.Keys()
+}
+
+// Transaction calls the fn function with a MutableSet that provides direct access to the elements within the
+// SyncHashSet while its write lock is held. This allows multiple operations (e.g. SyncHashSet.Contains followed by
+// SyncHashSet.Put) to be performed atomically with respect to other goroutines, which is not possible when calling
+// such methods on the SyncHashSet separately.
+//
+// The MutableSet passed to fn performs no locking and must not be retained or used once fn returns. Likewise, methods
+// of the SyncHashSet itself must not be called from within fn as doing so will result in a deadlock.
+//
+// If the SyncHashSet is nil, SyncHashSet.Transaction is a no-op.
+func (s *SyncHashSet[E]) Transaction(fn func(tx MutableSet[E])) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tx := &MutableHashSet[E]{elements: s.elements, capacity: s.capacity}
+	tx.order.Store(s.order.Load())
+	fn(tx)
+	s.elements, s.capacity = tx.elements, tx.capacity
+	s.order.Store(tx.order.Load())
+}
+
 // TryRange calls the iter function with each element within the SyncHashSet but will stop early whenever the iter
 // function returns an error.
 //
@@ -562,6 +1189,25 @@ func (s *SyncHashSet[E]) Union(other Set[E]) Set[E] {
 	return ns
 }
 
+// View calls the fn function with a Set that provides read-only access to the elements within the SyncHashSet while its
+// read lock is held. This allows multiple reads (e.g. Set.Len followed by Set.Min) to observe a consistent state of the
+// SyncHashSet, without any writes from other goroutines interleaving between them.
+//
+// The Set passed to fn performs no locking and must not be retained or used once fn returns, although any Set obtained
+// from it (e.g. via Set.Immutable or Set.Clone) is always a copy so can be retained safely. Likewise, no method of the
+// SyncHashSet may be called from within fn. Even methods that only acquire its read lock can result in a deadlock, as
+// the read lock cannot be acquired again while another goroutine is waiting to acquire its write lock.
+//
+// If the SyncHashSet is nil, SyncHashSet.View is a no-op.
+func (s *SyncHashSet[E]) View(fn func(view Set[E])) {
+	if s == nil {
+		return
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	fn(syncHashView[E]{&HashSet[E]{elements: s.elements}})
+}
+
 func (s *SyncHashSet[E]) String() string {
 	if s == nil {
 		return internal.NilString
@@ -571,6 +1217,28 @@ func (s *SyncHashSet[E]) String() string {
 	return internal.String[E](s.elements)
 }
 
+func (s *SyncHashSet[E]) GobEncode() ([]byte, error) {
+	if s == nil {
+		return internal.MarshalGobNil[E]()
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return internal.MarshalGob[E](s.elements)
+}
+
+func (s *SyncHashSet[E]) GobDecode(data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if elements, err := internal.UnmarshalGob[E](data); err != nil {
+		return err
+	} else {
+		s.elements = elements
+		s.capacity = 0
+		s.order.Store(nil)
+		return nil
+	}
+}
+
 func (s *SyncHashSet[E]) MarshalJSON() ([]byte, error) {
 	if s == nil {
 		return internal.MarshalJSONNil()
@@ -587,10 +1255,43 @@ func (s *SyncHashSet[E]) UnmarshalJSON(data []byte) error {
 		return err
 	} else {
 		s.elements = elements
+		s.capacity = 0
+		s.order.Store(nil)
 		return nil
 	}
 }
 
+func (s *SyncHashSet[E]) Scan(src any) error {
+	data, err := scanJSON(src)
+	if err != nil {
+		return err
+	}
+	return s.UnmarshalJSON(data)
+}
+
+func (s *SyncHashSet[E]) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	data, err := s.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// stableOrder returns the internal.Order used to maintain a stable iteration order for SyncHashSet.StableRange,
+// creating it from the elements currently within the SyncHashSet if it does not already exist.
+//
+// Once created, the internal.Order must be kept in sync with every change made to the elements within the SyncHashSet.
+func (s *SyncHashSet[E]) stableOrder() *internal.Order[E] {
+	if o := s.order.Load(); o != nil {
+		return o
+	}
+	s.order.CompareAndSwap(nil, internal.NewOrder[E](s.elements))
+	return s.order.Load()
+}
+
 // SyncHash returns a SyncHashSet struct that implements MutableSet containing each unique element provided.
 //
 // While SyncHash returns a mutable struct it is safe for concurrent use by multiple goroutines without additional
@@ -599,6 +1300,29 @@ func SyncHash[E comparable](elements ...E) *SyncHashSet[E] {
 	return &SyncHashSet[E]{elements: internal.FromSlice[E](elements)}
 }
 
+// SyncHashFromDelimited returns a SyncHashSet struct that implements MutableSet containing each unique element parsed
+// from the delimited string provided, where the string is split into tokens separated by sep and each token is
+// converted into an element using the parse function. This is useful for parsing configuration (e.g. an allowlist
+// within an environment variable or CLI flag) such as "a, b, c".
+//
+// Whitespace surrounding each token is trimmed and any token that is empty as a result is skipped, so a string that is
+// empty, or only contains separators and whitespace, produces an empty SyncHashSet. If sep is empty, the string is
+// split after each UTF-8 sequence, as with strings.Split.
+//
+// If the parse function returns an error for any token, parsing stops and an ErrDelimitedToken is returned, including
+// the offending token and wrapping the error.
+//
+// While SyncHashFromDelimited returns a mutable struct it is safe for concurrent use by multiple goroutines without
+// additional locking or coordination due to internal locking. If mutability is not required HashFromDelimited provides
+// a cheaper alternative.
+func SyncHashFromDelimited[E comparable](s, sep string, parse func(token string) (E, error)) (*SyncHashSet[E], error) {
+	elements, err := parseDelimited[E](s, sep, parse)
+	if err != nil {
+		return nil, err
+	}
+	return &SyncHashSet[E]{elements: elements}, nil
+}
+
 // SyncHashFromJSON returns a SyncHashSet struct that implements MutableSet containing each unique element parsed from
 // the JSON-encoded data provided.
 //
@@ -613,6 +1337,43 @@ func SyncHashFromJSON[E comparable](data []byte) (*SyncHashSet[E], error) {
 	return set, nil
 }
 
+// SyncHashFromJSONInteger returns a SyncHashSet struct that implements MutableSet containing each unique integer
+// element parsed from the JSON-encoded data provided.
+//
+// While SyncHashFromJSONInteger returns a mutable struct it is safe for concurrent use by multiple goroutines without
+// additional locking or coordination due to internal locking. If mutability is not required HashFromJSONInteger
+// provides a cheaper alternative.
+//
+// Unlike SyncHashFromJSON, each number is first decoded as a json.Number so that an ErrJSONInteger is returned for any
+// number that cannot be represented exactly by E (e.g. 1.5, or 256 when E is uint8) instead of it being silently
+// truncated. Numbers using exponents are accepted so long as they represent integers (e.g. 1e3).
+func SyncHashFromJSONInteger[E constraints.Integer](data []byte) (*SyncHashSet[E], error) {
+	elements, err := unmarshalJSONInteger[E](data)
+	if err != nil {
+		return nil, err
+	}
+	return &SyncHashSet[E]{elements: elements}, nil
+}
+
+// SyncHashFromJSONReader returns a SyncHashSet struct that implements MutableSet containing each unique element decoded
+// from the JSON array read from the io.Reader provided.
+//
+// Unlike SyncHashFromJSON, elements are decoded and added one at a time so that the full array is never held in memory,
+// which is better suited to large arrays. A top-level JSON null produces an empty SyncHashSet, while an ErrJSONArray is
+// returned for any other value that is not an array. Any data following the array may be read from the io.Reader into a
+// buffer and lost.
+//
+// While SyncHashFromJSONReader returns a mutable struct it is safe for concurrent use by multiple goroutines without
+// additional locking or coordination due to internal locking. If mutability is not required HashFromJSONReader
+// provides a cheaper alternative.
+func SyncHashFromJSONReader[E comparable](r io.Reader) (*SyncHashSet[E], error) {
+	elements, err := unmarshalJSONReader[E](r)
+	if err != nil {
+		return nil, err
+	}
+	return &SyncHashSet[E]{elements: elements}, nil
+}
+
 // SyncHashFromSlice returns a SyncHashSet struct that implements MutableSet containing each unique element from the
 // slice provided.
 //
@@ -622,3 +1383,59 @@ func SyncHashFromJSON[E comparable](data []byte) (*SyncHashSet[E], error) {
 func SyncHashFromSlice[E comparable](elements []E) *SyncHashSet[E] {
 	return &SyncHashSet[E]{elements: internal.FromSlice[E](elements)}
 }
+
+// SyncHashFromSliceFilter returns a SyncHashSet struct that implements MutableSet containing each unique element from
+// the slice provided for which the keep function returns true. This avoids building a SyncHashSet only to then filter
+// it.
+//
+// If keep is nil, all elements within the slice are kept, making SyncHashFromSliceFilter equivalent to
+// SyncHashFromSlice.
+//
+// While SyncHashFromSliceFilter returns a mutable struct it is safe for concurrent use by multiple goroutines without
+// additional locking or coordination due to internal locking. If mutability is not required HashFromSliceFilter
+// provides a cheaper alternative.
+func SyncHashFromSliceFilter[E comparable](elements []E, keep func(element E) bool) *SyncHashSet[E] {
+	return &SyncHashSet[E]{elements: internal.FromSliceFilter[E](elements, keep)}
+}
+
+// SyncHashWithCapacity returns a SyncHashSet struct that implements MutableSet containing each unique element provided,
+// pre-sized to hold at least capacity elements. This avoids the cost of the underlying map being grown repeatedly while
+// it is being populated (e.g. via SyncHashSet.Put) when the number of elements is known, or can be estimated, in
+// advance.
+//
+// If capacity is less than the number of elements provided, the SyncHashSet is instead sized to hold all of them.
+//
+// While SyncHashWithCapacity returns a mutable struct it is safe for concurrent use by multiple goroutines without
+// additional locking or coordination due to internal locking. If mutability is not required HashWithCapacity provides
+// a cheaper alternative.
+func SyncHashWithCapacity[E comparable](capacity int, elements ...E) *SyncHashSet[E] {
+	if capacity < len(elements) {
+		capacity = len(elements)
+	}
+	return &SyncHashSet[E]{elements: internal.FromSliceWithCapacity[E](capacity, elements), capacity: capacity}
+}
+
+// SyncProject returns a SyncHashSet struct that implements MutableSet containing each unique value returned by the
+// field function for each item in the slice provided. For example; this can be used to collect the IDs of a slice of
+// users.
+//
+// If items is nil, an empty SyncHashSet is returned.
+//
+// While SyncProject returns a mutable struct it is safe for concurrent use by multiple goroutines without additional
+// locking or coordination due to internal locking. If mutability is not required Project provides a cheaper
+// alternative.
+func SyncProject[T any, K comparable](items []T, field func(item T) K) *SyncHashSet[K] {
+	return &SyncHashSet[K]{elements: internal.Project(items, field)}
+}
+
+// syncHashView is the Set passed to the function provided to SyncHashSet.View, which provides read-only access to the
+// elements within the SyncHashSet, the same as a HashSet, except that syncHashView.Immutable returns a copy rather
+// than the view itself, so that no Set obtained from the view shares the elements once the read lock is released.
+type syncHashView[E comparable] struct {
+	*HashSet[E]
+}
+
+// Immutable returns an immutable clone of the syncHashView.
+func (v syncHashView[E]) Immutable() Set[E] {
+	return v.Clone()
+}
@@ -21,12 +21,21 @@
 package sets
 
 import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"encoding/gob"
 	"encoding/json"
 	"errors"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/neocotic/go-sets/internal"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 )
 
@@ -60,6 +69,81 @@ func Test_SyncHash(t *testing.T) {
 	}
 }
 
+func Test_SyncHashFromDelimited(t *testing.T) {
+	testCases := map[string]struct {
+		expectElements []int
+		s              string
+		sep            string
+	}{
+		"with comma-separated string": {
+			expectElements: []int{123, 456, 789},
+			s:              "123,456,789",
+			sep:            ",",
+		},
+		"with whitespace surrounding tokens": {
+			expectElements: []int{123, 456, 789},
+			s:              " 123 ,\t456,789\n",
+			sep:            ",",
+		},
+		"with empty tokens": {
+			expectElements: []int{123, 456},
+			s:              ",123,, ,456,",
+			sep:            ",",
+		},
+		"with duplicated tokens": {
+			expectElements: []int{123, 456},
+			s:              "123,456,123",
+			sep:            ",",
+		},
+		"with multi-character separator": {
+			expectElements: []int{123, 456},
+			s:              "123 | 456",
+			sep:            " | ",
+		},
+		"with empty string": {
+			expectElements: []int{},
+			s:              "",
+			sep:            ",",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set, err := SyncHashFromDelimited(tc.s, tc.sep, strconv.Atoi)
+			if err != nil {
+				t.Errorf("unexpected error; want nil, got %q", err)
+			} else if set == nil {
+				t.Error("unexpected nil Set")
+			} else {
+				if set.IsMutable() != true {
+					t.Errorf("unexpected Set mutability; want %v, got %v", true, set.IsMutable())
+				}
+
+				opts := []cmp.Option{cmpopts.SortSlices(Asc[int])}
+				if diff := cmp.Diff(tc.expectElements, set.Slice(), opts...); diff != "" {
+					t.Errorf("unexpected parsed elements (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func Test_SyncHashFromDelimited_Error(t *testing.T) {
+	set, err := SyncHashFromDelimited("123, abc ,456", ",", strconv.Atoi)
+	if set != nil {
+		t.Errorf("unexpected Set; want nil, got %v", set)
+	}
+	if !errors.Is(err, ErrDelimitedToken) {
+		t.Errorf("unexpected error; want %q, got %q", ErrDelimitedToken, err)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("unexpected wrapped error; want %q, got %q", strconv.ErrSyntax, err)
+	}
+	if err != nil && !strings.Contains(err.Error(), `"abc"`) {
+		t.Errorf("unexpected error message; want offending token %q, got %q", "abc", err)
+	}
+}
+
 func Test_SyncHashFromJSON(t *testing.T) {
 	testCases := map[string]struct {
 		expectElements []int
@@ -112,6 +196,209 @@ func Test_SyncHashFromJSON(t *testing.T) {
 	}
 }
 
+func Test_SyncHashFromJSONInteger(t *testing.T) {
+	testCases := map[string]struct {
+		expectElements []int64
+		json           string
+	}{
+		"with JSON string for array containing multiple elements": {
+			expectElements: []int64{-123, 456, 789},
+			json:           "[-123,456,789]",
+		},
+		"with JSON string for array containing elements using exponents": {
+			expectElements: []int64{1000, 120},
+			json:           "[1e3,1.2E2,1000]",
+		},
+		"with JSON string for array containing elements using zero fractional parts": {
+			expectElements: []int64{123},
+			json:           "[123.0]",
+		},
+		"with JSON string for array containing duplicated elements": {
+			expectElements: []int64{123, 456},
+			json:           "[123,456,123]",
+		},
+		"with JSON string for array containing null element": {
+			expectElements: []int64{0},
+			json:           "[null]",
+		},
+		"with JSON string for array containing large elements": {
+			expectElements: []int64{9223372036854775807, -9223372036854775808},
+			json:           "[9223372036854775807,-9223372036854775808]",
+		},
+		"with JSON string for empty array": {
+			expectElements: []int64{},
+			json:           "[]",
+		},
+		"with JSON string for null": {
+			expectElements: []int64{},
+			json:           "null",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set, err := SyncHashFromJSONInteger[int64]([]byte(tc.json))
+			if err != nil {
+				t.Errorf("unexpected error; want nil, got %q", err)
+			} else if set == nil {
+				t.Error("unexpected nil Set")
+			} else {
+				if !set.IsMutable() {
+					t.Error("unexpected Set mutability; want true, got false")
+				}
+
+				opts := []cmp.Option{cmpopts.SortSlices(Asc[int64])}
+				if actualElements := set.Slice(); !cmp.Equal(tc.expectElements, actualElements, opts...) {
+					t.Errorf("unexpected unmarshalled elements; got diff %v", cmp.Diff(tc.expectElements, actualElements, opts...))
+				}
+			}
+		})
+	}
+}
+
+func Test_SyncHashFromJSONInteger_Error(t *testing.T) {
+	testCases := map[string]struct {
+		expectErr error
+		json      string
+	}{
+		"with JSON string for array containing element with fractional part": {
+			expectErr: ErrJSONInteger,
+			json:      "[123,1.5]",
+		},
+		"with JSON string for array containing element using exponent with fractional part": {
+			expectErr: ErrJSONInteger,
+			json:      "[1e-3]",
+		},
+		"with JSON string for array containing element overflowing int8": {
+			expectErr: ErrJSONInteger,
+			json:      "[128]",
+		},
+		"with JSON string for array containing element underflowing int8": {
+			expectErr: ErrJSONInteger,
+			json:      "[-129]",
+		},
+		"with JSON string for array containing string element": {
+			json: "[\"abc\"]",
+		},
+		"with JSON string for object": {
+			json: "{}",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set, err := SyncHashFromJSONInteger[int8]([]byte(tc.json))
+			if err == nil {
+				t.Error("unexpected nil error")
+			} else if tc.expectErr != nil && !errors.Is(err, tc.expectErr) {
+				t.Errorf("unexpected error; want %q, got %q", tc.expectErr, err)
+			}
+			if set != nil {
+				t.Errorf("unexpected Set; want nil, got %v", set)
+			}
+		})
+	}
+}
+
+func Test_SyncHashFromJSONReader(t *testing.T) {
+	testCases := map[string]struct {
+		expectElements []int
+		json           string
+	}{
+		"with JSON string for array containing multiple elements": {
+			expectElements: []int{123, 456, 789},
+			json:           "[123,456,789]",
+		},
+		"with JSON string for array containing single element": {
+			expectElements: []int{123},
+			json:           "[123]",
+		},
+		"with JSON string for array containing duplicated elements": {
+			expectElements: []int{123, 456, 789},
+			json:           "[123,456,789,456,123]",
+		},
+		"with JSON string for array containing null element": {
+			expectElements: []int{0},
+			json:           "[null]",
+		},
+		"with JSON string for array containing whitespace": {
+			expectElements: []int{123, 456},
+			json:           " [ 123 ,\n 456 ] ",
+		},
+		"with JSON string for empty array": {
+			expectElements: []int{},
+			json:           "[]",
+		},
+		"with JSON string for null": {
+			expectElements: []int{},
+			json:           "null",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set, err := SyncHashFromJSONReader[int](strings.NewReader(tc.json))
+			if err != nil {
+				t.Errorf("unexpected error; want nil, got %q", err)
+			} else if set == nil {
+				t.Error("unexpected nil Set")
+			} else {
+				if !set.IsMutable() {
+					t.Error("unexpected Set mutability; want true, got false")
+				}
+
+				opts := []cmp.Option{cmpopts.SortSlices(Asc[int])}
+				if actualElements := set.Slice(); !cmp.Equal(tc.expectElements, actualElements, opts...) {
+					t.Errorf("unexpected unmarshalled elements; got diff %v", cmp.Diff(tc.expectElements, actualElements, opts...))
+				}
+			}
+		})
+	}
+}
+
+func Test_SyncHashFromJSONReader_Error(t *testing.T) {
+	testCases := map[string]struct {
+		expectErr error
+		json      string
+	}{
+		"with JSON string for object": {
+			expectErr: ErrJSONArray,
+			json:      "{\"foo\":123}",
+		},
+		"with JSON string for string": {
+			expectErr: ErrJSONArray,
+			json:      "\"abc\"",
+		},
+		"with JSON string for number": {
+			expectErr: ErrJSONArray,
+			json:      "123",
+		},
+		"with JSON string for array containing string element": {
+			json: "[123,\"abc\"]",
+		},
+		"with JSON string for unterminated array": {
+			json: "[123,456",
+		},
+		"with empty JSON string": {
+			json: "",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set, err := SyncHashFromJSONReader[int](strings.NewReader(tc.json))
+			if err == nil {
+				t.Error("unexpected nil error")
+			} else if tc.expectErr != nil && !errors.Is(err, tc.expectErr) {
+				t.Errorf("unexpected error; want %q, got %q", tc.expectErr, err)
+			}
+			if set != nil {
+				t.Errorf("unexpected Set; want nil, got %v", set)
+			}
+		})
+	}
+}
+
 func Test_SyncHashFromSlice(t *testing.T) {
 	testCases := map[string]struct {
 		elements []int
@@ -140,144 +427,878 @@ func Test_SyncHashFromSlice(t *testing.T) {
 	}
 }
 
-func Test_SyncHashSet_Clear(t *testing.T) {
+func Test_SyncHashSet_All(t *testing.T) {
+	var elements []int
+	SyncHash(123, 456, 789).All()(func(element int) bool {
+		elements = append(elements, element)
+		return true
+	})
+	if diff := cmp.Diff([]int{123, 456, 789}, elements, cmpopts.SortSlices(Asc[int])); diff != "" {
+		t.Errorf("unexpected yielded elements (-want +got):\n%s", diff)
+	}
+}
+
+func Test_SyncHashSet_All_Break(t *testing.T) {
+	set := SyncHash(123, 456, 789)
+	var callCount int
+	set.All()(func(_ int) bool {
+		callCount++
+		if set.mu.TryLock() {
+			set.mu.Unlock()
+			t.Fatal("unexpected lock state during iteration; want read locked, got unlocked")
+		}
+		return false
+	})
+	if callCount != 1 {
+		t.Errorf("unexpected number of calls to yield; want 1, got %v", callCount)
+	}
+	if !set.mu.TryLock() {
+		t.Fatal("unexpected lock state after iteration; want unlocked, got locked")
+	}
+	set.mu.Unlock()
+	set.Put(0)
+	if !set.Contains(0) {
+		t.Error("unexpected Set containment of 0; want true, got false")
+	}
+}
+
+func Test_SyncHashSet_All_Complete(t *testing.T) {
+	set := SyncHash(123, 456, 789)
+	set.All()(func(_ int) bool { return true })
+	if !set.mu.TryLock() {
+		t.Fatal("unexpected lock state after iteration; want unlocked, got locked")
+	}
+	set.mu.Unlock()
+}
+
+func Test_SyncHashSet_All_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], _ int) {
+		set.All()(func(_ int) bool { return true })
+	})
+}
+
+func Test_SyncHashSet_All_Panic(t *testing.T) {
+	set := SyncHash(123, 456, 789)
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("unexpected recovery; want panic, got nil")
+			}
+		}()
+		set.All()(func(_ int) bool {
+			panic("stop")
+		})
+	}()
+	if !set.mu.TryLock() {
+		t.Fatal("unexpected lock state after panic; want unlocked, got locked")
+	}
+	set.mu.Unlock()
+}
+
+func Test_SyncHashSet_All_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	var callCount int
+	set.All()(func(_ int) bool {
+		callCount++
+		return true
+	})
+	if callCount != 0 {
+		t.Errorf("unexpected number of calls to yield; want 0, got %v", callCount)
+	}
+}
+
+func Test_SyncHashSet_AppendSlice(t *testing.T) {
+	testCases := map[string]struct {
+		set *SyncHashSet[int]
+		dst []int
+	}{
+		"with nil dst on non-empty *SyncHashSet": {
+			set: SyncHash(123, 456, 789),
+			dst: nil,
+		},
+		"with non-empty dst on non-empty *SyncHashSet": {
+			set: SyncHash(123, 456, 789),
+			dst: []int{1, 2},
+		},
+		"with dst having spare capacity on non-empty *SyncHashSet": {
+			set: SyncHash(123, 456, 789),
+			dst: make([]int, 0, 8),
+		},
+		"with nil dst on empty *SyncHashSet": {
+			set: SyncHash[int](),
+			dst: nil,
+		},
+		"with non-empty dst on empty *SyncHashSet": {
+			set: SyncHash[int](),
+			dst: []int{1, 2},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dst := append([]int(nil), tc.dst...)
+			ret := tc.set.AppendSlice(tc.dst)
+
+			if expectLen := len(tc.dst) + tc.set.Len(); len(ret) != expectLen {
+				t.Fatalf("unexpected slice length; want %v, got %v", expectLen, len(ret))
+			}
+			if !cmp.Equal(dst, ret[:len(tc.dst)], cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected leading elements; want %v, got %v", dst, ret[:len(tc.dst)])
+			}
+			if tail := ret[len(tc.dst):]; !HashFromSlice(tail).Equal(tc.set) {
+				t.Errorf("unexpected appended elements; want %v, got %v", tc.set, tail)
+			}
+			if cap(tc.dst) >= len(ret) && len(ret) > 0 && &tc.dst[:1][0] != &ret[0] {
+				t.Error("unexpected slice allocation; want dst to be reused")
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_AppendSlice_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], _ int) {
+		_ = set.AppendSlice(nil)
+	})
+}
+
+func Test_SyncHashSet_AppendSlice_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	dst := []int{1, 2}
+	ret := set.AppendSlice(dst)
+
+	if !cmp.Equal(dst, ret) {
+		t.Errorf("unexpected slice; want %v, got %v", dst, ret)
+	}
+}
+
+func Test_SyncHashSet_Canonical(t *testing.T) {
+	testCases := map[string]struct {
+		expect string
+		set    *SyncHashSet[string]
+	}{
+		"with no elements": {
+			expect: "",
+			set:    SyncHash[string](),
+		},
+		"with elements": {
+			expect: "bar,baz,foo",
+			set:    SyncHash[string]("foo", "bar", "baz"),
+		},
+		"with elements requiring escaping": {
+			expect: `a\,,b,c\\`,
+			set:    SyncHash[string]("b", `c\`, "a,"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			canonical := string(tc.set.Canonical(func(element string) string { return element }))
+			if canonical != tc.expect {
+				t.Errorf("unexpected canonical; want %q, got %q", tc.expect, canonical)
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_Canonical_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], _ int) {
+		canonical := string(set.Canonical(getIntStringConverterWithDefaultOptions[int]()))
+		if canonical != "123,456,789" {
+			t.Errorf("unexpected canonical; want %q, got %q", "123,456,789", canonical)
+		}
+	})
+}
+
+func Test_SyncHashSet_Canonical_Nil(t *testing.T) {
+	var set *SyncHashSet[string]
+	canonical := set.Canonical(func(element string) string { return element })
+	if canonical == nil || len(canonical) != 0 {
+		t.Errorf("unexpected canonical; want %#v, got %#v", []byte{}, canonical)
+	}
+}
+
+func Test_SyncHashSet_Clear(t *testing.T) {
+	testCases := map[string]struct {
+		set *SyncHashSet[int]
+	}{
+		"on non-empty *SyncHashSet": {
+			set: SyncHash(123, 456, 789),
+		},
+		"on empty *SyncHashSet": {
+			set: SyncHash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ret := tc.set.Clear()
+
+			if internal.IsNil(ret) {
+				t.Error("unexpected nil MutableSet")
+			}
+			if tc.set != ret {
+				t.Errorf("unexpected MutableSet; want %v, got %v", tc.set, ret)
+			}
+			if !tc.set.IsEmpty() {
+				t.Error("unexpected MutableSet emptiness; want true, got false")
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_Clear_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], _ int) {
+		_ = set.Clear()
+	})
+}
+
+func Test_SyncHashSet_Clear_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	ret := set.Clear()
+
+	if internal.IsNotNil(ret) {
+		t.Errorf("unexpected MutableSet; want nil, got %v", ret)
+	}
+	if !set.IsEmpty() {
+		t.Error("unexpected MutableSet emptiness; want true, got false")
+	}
+}
+
+func Test_SyncHashFromSliceFilter(t *testing.T) {
+	testCases := map[string]struct {
+		elements []int
+		keep     func(element int) bool
+		expect   Set[int]
+	}{
+		"with nil slice": {
+			elements: nil,
+			keep:     func(element int) bool { return element > 0 },
+			expect:   Hash[int](),
+		},
+		"with slice containing no elements": {
+			elements: []int{},
+			keep:     func(element int) bool { return element > 0 },
+			expect:   Hash[int](),
+		},
+		"with slice containing elements all kept": {
+			elements: []int{123, 456, 789},
+			keep:     func(element int) bool { return element > 0 },
+			expect:   Hash(123, 456, 789),
+		},
+		"with slice containing elements some excluded": {
+			elements: []int{-123, 123, 456, -456, 456, 789},
+			keep:     func(element int) bool { return element > 0 },
+			expect:   Hash(123, 456, 789),
+		},
+		"with slice containing elements all excluded": {
+			elements: []int{-123, -456, -789},
+			keep:     func(element int) bool { return element > 0 },
+			expect:   Hash[int](),
+		},
+		"with nil keep": {
+			elements: []int{-123, 123, 456, 456},
+			keep:     nil,
+			expect:   Hash(-123, 123, 456),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := SyncHashFromSliceFilter(tc.elements, tc.keep)
+			if internal.IsNil(set) {
+				t.Fatal("unexpected nil Set")
+			}
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+			if set.IsMutable() != true {
+				t.Error("unexpected Set mutability; want true, got false")
+			}
+		})
+	}
+}
+
+func Test_SyncHashWithCapacity(t *testing.T) {
+	testCases := map[string]struct {
+		capacity int
+		elements []int
+		expect   Set[int]
+	}{
+		"with zero capacity and no elements": {
+			capacity: 0,
+			elements: nil,
+			expect:   Hash[int](),
+		},
+		"with negative capacity and no elements": {
+			capacity: -1,
+			elements: nil,
+			expect:   Hash[int](),
+		},
+		"with capacity and no elements": {
+			capacity: 16,
+			elements: nil,
+			expect:   Hash[int](),
+		},
+		"with capacity greater than number of elements": {
+			capacity: 16,
+			elements: []int{123, 456, 789},
+			expect:   Hash(123, 456, 789),
+		},
+		"with capacity less than number of elements": {
+			capacity: 1,
+			elements: []int{123, 456, 456, 789},
+			expect:   Hash(123, 456, 789),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := SyncHashWithCapacity(tc.capacity, tc.elements...)
+			if internal.IsNil(set) {
+				t.Fatal("unexpected nil Set")
+			}
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+			if set.IsMutable() != true {
+				t.Error("unexpected Set mutability; want true, got false")
+			}
+		})
+	}
+}
+
+func Test_SyncProject(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	testCases := map[string]struct {
+		items  []user
+		expect Set[int]
+	}{
+		"with nil slice": {
+			items:  nil,
+			expect: Hash[int](),
+		},
+		"with slice containing no items": {
+			items:  []user{},
+			expect: Hash[int](),
+		},
+		"with slice containing single item": {
+			items:  []user{{id: 123, name: "alpha"}},
+			expect: Hash(123),
+		},
+		"with slice containing multiple items with unique field values": {
+			items:  []user{{id: 123, name: "alpha"}, {id: 456, name: "beta"}, {id: 789, name: "gamma"}},
+			expect: Hash(123, 456, 789),
+		},
+		"with slice containing multiple items with duplicate field values": {
+			items:  []user{{id: 123, name: "alpha"}, {id: 456, name: "beta"}, {id: 123, name: "gamma"}},
+			expect: Hash(123, 456),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := SyncProject(tc.items, func(item user) int { return item.id })
+			if internal.IsNil(set) {
+				t.Fatal("unexpected nil Set")
+			}
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+			if set.IsMutable() != true {
+				t.Error("unexpected Set mutability; want true, got false")
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_Clone(t *testing.T) {
+	set := SyncHash(123, 456, 789)
+	clone := set.Clone()
+	if internal.IsNil(clone) {
+		t.Error("unexpected nil Set")
+	}
+	if l := clone.Len(); l != 3 {
+		t.Errorf("unexpected cloned Set length; want 3, got %v", l)
+	}
+	if !clone.Equal(set) {
+		t.Errorf("unexpected cloned Set; want %v, got %v", set, clone)
+	}
+	if !clone.IsMutable() {
+		t.Error("unexpected cloned Set mutability; want true, got false")
+	}
+}
+
+func Test_SyncHashSet_Clone_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], _ int) {
+		_ = set.Clone()
+	})
+}
+
+func Test_SyncHashSet_Clone_Independent(t *testing.T) {
+	set := SyncHash(123, 456, 789)
+	clone, ok := set.Clone().(*SyncHashSet[int])
+	if !ok {
+		t.Fatalf("unexpected cloned Set type; want *SyncHashSet[int], got %T", set.Clone())
+	}
+	var wg sync.WaitGroup
+	wg.Add(DefaultTestConcurrency * 2)
+	for i := 0; i < DefaultTestConcurrency; i++ {
+		go func(i int) {
+			defer wg.Done()
+			clone.Put(-i)
+			_ = clone.Contains(i)
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+			set.Delete(i)
+			_ = clone.Len()
+		}(i)
+	}
+	wg.Wait()
+	if !clone.ContainsAll(123, 456, 789) {
+		t.Errorf("unexpected cloned Set; want to contain 123, 456, and 789, got %v", clone)
+	}
+}
+
+func Test_SyncHashSet_Clone_Kind(t *testing.T) {
+	set := SyncHash(123, 456, 789)
+	testCases := map[string]struct {
+		result Set[int]
+	}{
+		"with Clone": {
+			result: set.Clone(),
+		},
+		"with Diff": {
+			result: set.Diff(Hash(123)),
+		},
+		"with DiffSymmetric": {
+			result: set.DiffSymmetric(Hash(123, 999)),
+		},
+		"with Filter": {
+			result: set.Filter(func(element int) bool { return element > 200 }),
+		},
+		"with Intersection": {
+			result: set.Intersection(Hash(123, 456)),
+		},
+		"with Union": {
+			result: set.Union(Hash(999)),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if _, ok := tc.result.(*SyncHashSet[int]); !ok {
+				t.Errorf("unexpected Set type; want *SyncHashSet[int], got %T", tc.result)
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_Clone_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	clone := set.Clone()
+	if clone == nil {
+		t.Error("unexpected nil Set")
+	}
+	if internal.IsNotNil(clone) {
+		t.Errorf("unexpected cloned Set; want nil, got %#v", clone)
+	}
+	if !clone.IsEmpty() {
+		t.Error("unexpected cloned Set emptiness; want true, got false")
+	}
+	if !clone.IsMutable() {
+		t.Error("unexpected cloned Set mutability; want true, got false")
+	}
+}
+
+func Test_SyncHashSet_CompareAndDelete(t *testing.T) {
+	testCases := map[string]struct {
+		element        int
+		expect         bool
+		expectElements []int
+	}{
+		"with element present": {
+			element:        456,
+			expect:         true,
+			expectElements: []int{123, 789},
+		},
+		"with element not present": {
+			element:        999,
+			expect:         false,
+			expectElements: []int{123, 456, 789},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := SyncHash(123, 456, 789)
+			if deleted := set.CompareAndDelete(tc.element); deleted != tc.expect {
+				t.Errorf("unexpected deletion; want %v, got %v", tc.expect, deleted)
+			}
+			if expect := HashFromSlice(tc.expectElements); !set.Equal(expect) {
+				t.Errorf("unexpected Set; want %v, got %v", expect, set)
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_CompareAndDelete_Concurrent(t *testing.T) {
+	set := SyncHash(123, 456, 789)
+	var (
+		deleted int
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+	)
+	wg.Add(DefaultTestConcurrency)
+	for i := 0; i < DefaultTestConcurrency; i++ {
+		go func() {
+			defer wg.Done()
+			if set.CompareAndDelete(456) {
+				mu.Lock()
+				deleted++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if deleted != 1 {
+		t.Errorf("unexpected number of deletions; want 1, got %v", deleted)
+	}
+	if expect := Hash(123, 789); !set.Equal(expect) {
+		t.Errorf("unexpected Set; want %v, got %v", expect, set)
+	}
+}
+
+func Test_SyncHashSet_CompareAndDelete_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	if set.CompareAndDelete(123) {
+		t.Error("unexpected deletion; want false, got true")
+	}
+}
+
+func Test_SyncHashSet_Contains(t *testing.T) {
+	testCases := map[string]struct {
+		element int
+		expect  bool
+	}{
+		"with matching element": {
+			element: 123,
+			expect:  true,
+		},
+		"with non-matching zero value for element": {
+			element: 0,
+			expect:  false,
+		},
+		"with non-matching non-zero value for element": {
+			element: 1,
+			expect:  false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := SyncHash(123, 456, 789)
+			result := set.Contains(tc.element)
+			if result != tc.expect {
+				t.Errorf("unexpected element contained within Set: %q; want %v, got %v", tc.element, tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_ContainsAll(t *testing.T) {
+	testCases := map[string]struct {
+		element  int
+		elements []int
+		expect   bool
+	}{
+		"with single contained element": {
+			element: 123,
+			expect:  true,
+		},
+		"with single element not contained": {
+			element: -123,
+			expect:  false,
+		},
+		"with all elements contained": {
+			element:  123,
+			elements: []int{456, 789},
+			expect:   true,
+		},
+		"with duplicate contained elements": {
+			element:  123,
+			elements: []int{123, 123},
+			expect:   true,
+		},
+		"with first element not contained": {
+			element:  -123,
+			elements: []int{456, 789},
+			expect:   false,
+		},
+		"with last element not contained": {
+			element:  123,
+			elements: []int{456, -789},
+			expect:   false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := SyncHash(123, 456, 789)
+			if result := set.ContainsAll(tc.element, tc.elements...); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_ContainsAll_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], i int) {
+		set.Put(-i)
+		if !set.ContainsAll(123, 456, 789, -i) {
+			t.Error("unexpected result; want true, got false")
+		}
+		set.Delete(-i)
+	})
+}
+
+func Test_SyncHashSet_ContainsAll_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	if set.ContainsAll(123, 456) {
+		t.Error("unexpected result; want false, got true")
+	}
+}
+
+func Test_SyncHashSet_ContainsAny(t *testing.T) {
+	testCases := map[string]struct {
+		element  int
+		elements []int
+		expect   bool
+	}{
+		"with single contained element": {
+			element: 123,
+			expect:  true,
+		},
+		"with single element not contained": {
+			element: -123,
+			expect:  false,
+		},
+		"with first element contained": {
+			element:  123,
+			elements: []int{-456, -789},
+			expect:   true,
+		},
+		"with last element contained": {
+			element:  -123,
+			elements: []int{-456, 789},
+			expect:   true,
+		},
+		"with no elements contained": {
+			element:  -123,
+			elements: []int{-456, -789},
+			expect:   false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := SyncHash(123, 456, 789)
+			if result := set.ContainsAny(tc.element, tc.elements...); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_ContainsAny_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], i int) {
+		if !set.ContainsAny(-i, 456) {
+			t.Error("unexpected result; want true, got false")
+		}
+	})
+}
+
+func Test_SyncHashSet_ContainsAny_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	if set.ContainsAny(123, 456) {
+		t.Error("unexpected result; want false, got true")
+	}
+}
+
+func Test_SyncHashSet_Contains_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], _ int) {
+		_ = set.Contains(123)
+	})
+}
+
+func Test_SyncHashSet_Contains_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		element int
+	}{
+		"with non-matching zero value for element":     {0},
+		"with non-matching non-zero value for element": {1},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var set *SyncHashSet[int]
+			if set.Contains(tc.element) {
+				t.Errorf("unexpected element contained within Set: %q; want false, got true", tc.element)
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_ContainsEach(t *testing.T) {
 	testCases := map[string]struct {
-		set *SyncHashSet[int]
+		elements []int
+		expect   []bool
 	}{
-		"on non-empty *SyncHashSet": {
-			set: SyncHash(123, 456, 789),
+		"with no elements": {
+			elements: nil,
+			expect:   []bool{},
 		},
-		"on empty *SyncHashSet": {
-			set: SyncHash[int](),
+		"with mix of present and absent elements": {
+			elements: []int{999, 123, 0, 789, 456, -1},
+			expect:   []bool{false, true, false, true, true, false},
+		},
+		"with duplicate elements": {
+			elements: []int{456, 999, 456},
+			expect:   []bool{true, false, true},
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			ret := tc.set.Clear()
-
-			if internal.IsNil(ret) {
-				t.Error("unexpected nil MutableSet")
-			}
-			if tc.set != ret {
-				t.Errorf("unexpected MutableSet; want %v, got %v", tc.set, ret)
-			}
-			if !tc.set.IsEmpty() {
-				t.Error("unexpected MutableSet emptiness; want true, got false")
+			set := SyncHash(123, 456, 789)
+			if diff := cmp.Diff(tc.expect, set.ContainsEach(tc.elements)); diff != "" {
+				t.Errorf("unexpected results (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func Test_SyncHashSet_Clear_Concurrent(t *testing.T) {
-	testConcurrently(func(set *SyncHashSet[int], _ int) {
-		_ = set.Clear()
+func Test_SyncHashSet_ContainsEach_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], i int) {
+		element := i % 1000
+		set.Put(element)
+		results := set.ContainsEach([]int{123, element, -1})
+		if diff := cmp.Diff([]bool{true, true, false}, results); diff != "" {
+			t.Errorf("unexpected results (-want +got):\n%s", diff)
+		}
 	})
 }
 
-func Test_SyncHashSet_Clear_Nil(t *testing.T) {
+func Test_SyncHashSet_ContainsEach_Nil(t *testing.T) {
 	var set *SyncHashSet[int]
-	ret := set.Clear()
-
-	if internal.IsNotNil(ret) {
-		t.Errorf("unexpected MutableSet; want nil, got %v", ret)
-	}
-	if !set.IsEmpty() {
-		t.Error("unexpected MutableSet emptiness; want true, got false")
+	if diff := cmp.Diff([]bool{false, false}, set.ContainsEach([]int{123, 0})); diff != "" {
+		t.Errorf("unexpected results (-want +got):\n%s", diff)
 	}
 }
 
-func Test_SyncHashSet_Clone(t *testing.T) {
-	set := SyncHash(123, 456, 789)
-	clone := set.Clone()
-	if internal.IsNil(clone) {
-		t.Error("unexpected nil Set")
-	}
-	if l := clone.Len(); l != 3 {
-		t.Errorf("unexpected cloned Set length; want 3, got %v", l)
-	}
-	if !clone.Equal(set) {
-		t.Errorf("unexpected cloned Set; want %v, got %v", set, clone)
+func Test_SyncHashSet_Count(t *testing.T) {
+	testCases := map[string]struct {
+		expect    int
+		predicate func(element int) bool
+	}{
+		"with predicate matching all elements": {
+			expect:    5,
+			predicate: func(_ int) bool { return true },
+		},
+		"with predicate matching some elements": {
+			expect:    3,
+			predicate: func(element int) bool { return element > 0 },
+		},
+		"with predicate matching single element": {
+			expect:    1,
+			predicate: func(element int) bool { return element == 0 },
+		},
+		"with predicate matching no elements": {
+			expect:    0,
+			predicate: func(_ int) bool { return false },
+		},
 	}
-	if !clone.IsMutable() {
-		t.Error("unexpected cloned Set mutability; want true, got false")
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := SyncHash(-123, 0, 123, 456, 789)
+			if result := set.Count(tc.predicate); result != tc.expect {
+				t.Errorf("unexpected count; want %v, got %v", tc.expect, result)
+			}
+		})
 	}
 }
 
-func Test_SyncHashSet_Clone_Concurrent(t *testing.T) {
+func Test_SyncHashSet_Count_Concurrent(t *testing.T) {
 	testConcurrently(func(set *SyncHashSet[int], _ int) {
-		_ = set.Clone()
+		if result := set.Count(func(element int) bool { return element > 200 }); result != 2 {
+			t.Errorf("unexpected count; want 2, got %v", result)
+		}
 	})
 }
 
-func Test_SyncHashSet_Clone_Nil(t *testing.T) {
+func Test_SyncHashSet_Count_Nil(t *testing.T) {
 	var set *SyncHashSet[int]
-	clone := set.Clone()
-	if clone == nil {
-		t.Error("unexpected nil Set")
-	}
-	if internal.IsNotNil(clone) {
-		t.Errorf("unexpected cloned Set; want nil, got %#v", clone)
-	}
-	if !clone.IsEmpty() {
-		t.Error("unexpected cloned Set emptiness; want true, got false")
-	}
-	if !clone.IsMutable() {
-		t.Error("unexpected cloned Set mutability; want true, got false")
+	if result := set.Count(func(_ int) bool { return true }); result != 0 {
+		t.Errorf("unexpected count; want 0, got %v", result)
 	}
 }
 
-func Test_SyncHashSet_Contains(t *testing.T) {
+func Test_SyncHashSet_Covers(t *testing.T) {
 	testCases := map[string]struct {
-		element int
-		expect  bool
+		expect bool
+		others []Set[int]
 	}{
-		"with matching element": {
-			element: 123,
-			expect:  true,
+		"with no other Sets": {
+			expect: true,
+			others: nil,
 		},
-		"with non-matching zero value for element": {
-			element: 0,
-			expect:  false,
+		"with covered Sets": {
+			expect: true,
+			others: []Set[int]{Hash(123), Hash(456, 789), Hash(123, 456, 789)},
 		},
-		"with non-matching non-zero value for element": {
-			element: 1,
-			expect:  false,
+		"with empty and nil Sets": {
+			expect: true,
+			others: []Set[int]{Hash[int](), nil, (*HashSet[int])(nil)},
+		},
+		"with mix of covered and not covered Sets": {
+			expect: false,
+			others: []Set[int]{Hash(123, 456), Hash(456, 999), Hash(789)},
+		},
+		"with Set containing more elements": {
+			expect: false,
+			others: []Set[int]{Hash(0, 123, 456, 789)},
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			set := SyncHash(123, 456, 789)
-			result := set.Contains(tc.element)
-			if result != tc.expect {
-				t.Errorf("unexpected element contained within Set: %q; want %v, got %v", tc.element, tc.expect, result)
+			if covers := set.Covers(tc.others...); covers != tc.expect {
+				t.Errorf("unexpected covers; want %v, got %v", tc.expect, covers)
 			}
 		})
 	}
 }
 
-func Test_SyncHashSet_Contains_Concurrent(t *testing.T) {
+func Test_SyncHashSet_Covers_Concurrent(t *testing.T) {
 	testConcurrently(func(set *SyncHashSet[int], _ int) {
-		_ = set.Contains(123)
+		if !set.Covers(Hash(123, 456), Hash(789)) {
+			t.Error("unexpected covers; want true, got false")
+		}
 	})
 }
 
-func Test_SyncHashSet_Contains_Nil(t *testing.T) {
-	testCases := map[string]struct {
-		element int
-	}{
-		"with non-matching zero value for element":     {0},
-		"with non-matching non-zero value for element": {1},
+func Test_SyncHashSet_Covers_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	if !set.Covers(Hash[int](), nil) {
+		t.Error("unexpected covers of empty Set; want true, got false")
 	}
-
-	for name, tc := range testCases {
-		t.Run(name, func(t *testing.T) {
-			var set *SyncHashSet[int]
-			if set.Contains(tc.element) {
-				t.Errorf("unexpected element contained within Set: %q; want false, got true", tc.element)
-			}
-		})
+	if set.Covers(Hash(123)) {
+		t.Error("unexpected covers of non-empty Set; want false, got true")
 	}
 }
 
@@ -346,6 +1367,88 @@ func Test_SyncHashSet_Delete(t *testing.T) {
 	}
 }
 
+func Test_SyncHashSet_DeleteExisting(t *testing.T) {
+	testCases := map[string]struct {
+		element        int
+		elements       []int
+		expect         int
+		expectElements []int
+	}{
+		"with no elements present": {
+			element:        999,
+			elements:       []int{-123},
+			expect:         0,
+			expectElements: []int{123, 456, 789},
+		},
+		"with some elements present": {
+			element:        456,
+			elements:       []int{999, 789},
+			expect:         2,
+			expectElements: []int{123},
+		},
+		"with all elements present": {
+			element:        123,
+			elements:       []int{456, 789},
+			expect:         3,
+			expectElements: []int{},
+		},
+		"with duplicate elements present": {
+			element:        456,
+			elements:       []int{456},
+			expect:         1,
+			expectElements: []int{123, 789},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := SyncHash(123, 456, 789)
+			if deleted := set.DeleteExisting(tc.element, tc.elements...); deleted != tc.expect {
+				t.Errorf("unexpected number of deleted elements; want %v, got %v", tc.expect, deleted)
+			}
+			if expect := HashFromSlice(tc.expectElements); !set.Equal(expect) {
+				t.Errorf("unexpected Set; want %v, got %v", expect, set)
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_DeleteExisting_Concurrent(t *testing.T) {
+	set := SyncHash[int]()
+	for i := 0; i < DefaultTestConcurrency; i++ {
+		set.Put(i)
+	}
+	var (
+		deleted int
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+	)
+	wg.Add(DefaultTestConcurrency)
+	for i := 0; i < DefaultTestConcurrency; i++ {
+		go func(i int) {
+			defer wg.Done()
+			n := set.DeleteExisting(i, (i+1)%DefaultTestConcurrency)
+			mu.Lock()
+			deleted += n
+			mu.Unlock()
+		}(i)
+	}
+	wg.Wait()
+	if deleted != DefaultTestConcurrency {
+		t.Errorf("unexpected number of deleted elements; want %v, got %v", DefaultTestConcurrency, deleted)
+	}
+	if !set.IsEmpty() {
+		t.Errorf("unexpected Set; want empty, got %v", set)
+	}
+}
+
+func Test_SyncHashSet_DeleteExisting_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	if deleted := set.DeleteExisting(123, 456); deleted != 0 {
+		t.Errorf("unexpected number of deleted elements; want 0, got %v", deleted)
+	}
+}
+
 func Test_SyncHashSet_Delete_Concurrent(t *testing.T) {
 	testConcurrently(func(set *SyncHashSet[int], _ int) {
 		_ = set.Delete(123)
@@ -441,8 +1544,179 @@ func Test_SyncHashSet_DeleteAll(t *testing.T) {
 			if internal.IsNil(ret) {
 				t.Error("unexpected nil MutableSet")
 			}
-			if tc.set != ret {
-				t.Errorf("unexpected MutableSet; want %v, got %v", tc.set, ret)
+			if tc.set != ret {
+				t.Errorf("unexpected MutableSet; want %v, got %v", tc.set, ret)
+			}
+			if !tc.expect.Equal(tc.set) {
+				t.Errorf("unexpected MutableSet; want %v, got %v", tc.expect, tc.set)
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_DeleteAll_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], _ int) {
+		_ = set.DeleteAll(Singleton(123))
+	})
+}
+
+func Test_SyncHashSet_DeleteAll_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		elements Set[int]
+	}{
+		"with Set containing multiple elements": {
+			elements: Hash(123, 456, 789),
+		},
+		"with Set containing single element": {
+			elements: Hash(123),
+		},
+		"with Set containing no elements": {
+			elements: Hash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var set *SyncHashSet[int]
+			set.DeleteAll(tc.elements)
+
+			if !set.IsEmpty() {
+				t.Error("unexpected MutableSet emptiness; want true, got false")
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_DeleteAnyOf(t *testing.T) {
+	testCases := map[string]struct {
+		expect Set[int]
+		sets   []Set[int]
+	}{
+		"with no Sets": {
+			expect: Hash(123, 456, 789),
+			sets:   nil,
+		},
+		"with single Set": {
+			expect: Hash(123, 789),
+			sets:   []Set[int]{Hash(456, 999)},
+		},
+		"with multiple Sets": {
+			expect: Hash(789),
+			sets:   []Set[int]{Hash(456, 999), Hash(123), Hash[int]()},
+		},
+		"with nil Sets": {
+			expect: Hash(123, 789),
+			sets:   []Set[int]{nil, Hash(456), (*HashSet[int])(nil)},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := SyncHash(123, 456, 789)
+			composed := MutableHash(123, 456, 789)
+			for _, other := range tc.sets {
+				composed.DeleteAll(other)
+			}
+			set.DeleteAnyOf(tc.sets...)
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+			if !set.Equal(composed) {
+				t.Errorf("unexpected Set compared to composing DeleteAll; want %v, got %v", composed, set)
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_DeleteAnyOf_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], i int) {
+		set.Put(i)
+		set.DeleteAnyOf(Hash(i), SyncHash(i+1))
+	})
+}
+
+func Test_SyncHashSet_DeleteAnyOf_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	if result := set.DeleteAnyOf(Hash(123)); internal.IsNotNil(result) {
+		t.Errorf("unexpected MutableSet; want nil, got %v", result)
+	}
+}
+
+func Test_SyncHashSet_DeleteNth(t *testing.T) {
+	testCases := map[string]struct {
+		expect        Set[int]
+		expectElement int
+		expectOK      bool
+		i             int
+		lessFunc      func(x, y int) bool
+		set           *SyncHashSet[int]
+	}{
+		"with first index and ascending less on non-empty *SyncHashSet": {
+			expect:        Hash(0, 123, 456, 789),
+			expectElement: -123,
+			expectOK:      true,
+			i:             0,
+			lessFunc:      Asc[int],
+			set:           SyncHash(-123, 0, 123, 456, 789),
+		},
+		"with last index and ascending less on non-empty *SyncHashSet": {
+			expect:        Hash(-123, 0, 123, 456),
+			expectElement: 789,
+			expectOK:      true,
+			i:             4,
+			lessFunc:      Asc[int],
+			set:           SyncHash(-123, 0, 123, 456, 789),
+		},
+		"with middle index and ascending less on non-empty *SyncHashSet": {
+			expect:        Hash(-123, 0, 456, 789),
+			expectElement: 123,
+			expectOK:      true,
+			i:             2,
+			lessFunc:      Asc[int],
+			set:           SyncHash(-123, 0, 123, 456, 789),
+		},
+		"with first index and descending less on non-empty *SyncHashSet": {
+			expect:        Hash(-123, 0, 123, 456),
+			expectElement: 789,
+			expectOK:      true,
+			i:             0,
+			lessFunc:      Desc[int],
+			set:           SyncHash(-123, 0, 123, 456, 789),
+		},
+		"with negative index on non-empty *SyncHashSet": {
+			expect:        Hash(-123, 0, 123, 456, 789),
+			expectElement: 0,
+			expectOK:      false,
+			i:             -1,
+			lessFunc:      Asc[int],
+			set:           SyncHash(-123, 0, 123, 456, 789),
+		},
+		"with out of range index on non-empty *SyncHashSet": {
+			expect:        Hash(-123, 0, 123, 456, 789),
+			expectElement: 0,
+			expectOK:      false,
+			i:             5,
+			lessFunc:      Asc[int],
+			set:           SyncHash(-123, 0, 123, 456, 789),
+		},
+		"with first index on empty *SyncHashSet": {
+			expect:        Hash[int](),
+			expectElement: 0,
+			expectOK:      false,
+			i:             0,
+			lessFunc:      Asc[int],
+			set:           SyncHash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			element, ok := tc.set.DeleteNth(tc.lessFunc, tc.i)
+			if ok != tc.expectOK {
+				t.Errorf("unexpected bool result; want %v, got %v", tc.expectOK, ok)
+			}
+			if element != tc.expectElement {
+				t.Errorf("unexpected element result; want %v, got %v", tc.expectElement, element)
 			}
 			if !tc.expect.Equal(tc.set) {
 				t.Errorf("unexpected MutableSet; want %v, got %v", tc.expect, tc.set)
@@ -451,36 +1725,20 @@ func Test_SyncHashSet_DeleteAll(t *testing.T) {
 	}
 }
 
-func Test_SyncHashSet_DeleteAll_Concurrent(t *testing.T) {
-	testConcurrently(func(set *SyncHashSet[int], _ int) {
-		_ = set.DeleteAll(Singleton(123))
+func Test_SyncHashSet_DeleteNth_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], i int) {
+		_, _ = set.DeleteNth(Asc[int], i%3)
 	})
 }
 
-func Test_SyncHashSet_DeleteAll_Nil(t *testing.T) {
-	testCases := map[string]struct {
-		elements Set[int]
-	}{
-		"with Set containing multiple elements": {
-			elements: Hash(123, 456, 789),
-		},
-		"with Set containing single element": {
-			elements: Hash(123),
-		},
-		"with Set containing no elements": {
-			elements: Hash[int](),
-		},
+func Test_SyncHashSet_DeleteNth_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	element, ok := set.DeleteNth(Asc[int], 0)
+	if ok {
+		t.Error("unexpected bool result; want false, got true")
 	}
-
-	for name, tc := range testCases {
-		t.Run(name, func(t *testing.T) {
-			var set *SyncHashSet[int]
-			set.DeleteAll(tc.elements)
-
-			if !set.IsEmpty() {
-				t.Error("unexpected MutableSet emptiness; want true, got false")
-			}
-		})
+	if element != 0 {
+		t.Errorf("unexpected non-zero value for element result; want 0, got %v", element)
 	}
 }
 
@@ -744,6 +2002,9 @@ func Test_SyncHashSet_Diff_Concurrent(t *testing.T) {
 	testConcurrently(func(set *SyncHashSet[int], _ int) {
 		_ = set.Diff(Singleton(123))
 	})
+	testDerivedConcurrently(t, func(set *SyncHashSet[int]) Set[int] {
+		return set.Diff(Singleton(123))
+	})
 }
 
 func Test_SyncHashSet_Diff_Nil(t *testing.T) {
@@ -844,6 +2105,9 @@ func Test_SyncHashSet_DiffSymmetric_Concurrent(t *testing.T) {
 	testConcurrently(func(set *SyncHashSet[int], _ int) {
 		_ = set.DiffSymmetric(Singleton(123))
 	})
+	testDerivedConcurrently(t, func(set *SyncHashSet[int]) Set[int] {
+		return set.DiffSymmetric(Singleton(123))
+	})
 }
 
 func Test_SyncHashSet_DiffSymmetric_Nil(t *testing.T) {
@@ -881,6 +2145,92 @@ func Test_SyncHashSet_DiffSymmetric_Nil(t *testing.T) {
 	}
 }
 
+func Test_SyncHashSet_DrainWhere(t *testing.T) {
+	testCases := map[string]struct {
+		expectDrained  []int
+		expectElements []int
+		predicate      func(element int) bool
+	}{
+		"with some elements matching": {
+			expectDrained:  []int{2, 4},
+			expectElements: []int{1, 3, 5},
+			predicate:      func(element int) bool { return element%2 == 0 },
+		},
+		"with no elements matching": {
+			expectDrained:  []int{},
+			expectElements: []int{1, 2, 3, 4, 5},
+			predicate:      func(element int) bool { return element > 5 },
+		},
+		"with all elements matching": {
+			expectDrained:  []int{1, 2, 3, 4, 5},
+			expectElements: []int{},
+			predicate:      func(element int) bool { return element > 0 },
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := SyncHash(1, 2, 3, 4, 5)
+			drained := set.DrainWhere(tc.predicate)
+			if internal.IsNil(drained) {
+				t.Fatal("unexpected nil Set")
+			}
+			if kind := drained.Kind(); kind != SyncHashKind {
+				t.Errorf("unexpected SetKind; want %v, got %v", SyncHashKind, kind)
+			}
+			if expect := HashFromSlice(tc.expectDrained); !drained.Equal(expect) {
+				t.Errorf("unexpected drained Set; want %v, got %v", expect, drained)
+			}
+			if expect := HashFromSlice(tc.expectElements); !set.Equal(expect) {
+				t.Errorf("unexpected Set; want %v, got %v", expect, set)
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_DrainWhere_Concurrent(t *testing.T) {
+	set := SyncHash[int]()
+	for i := 0; i < DefaultTestConcurrency; i++ {
+		set.Put(i)
+	}
+	var (
+		drained = MutableHash[int]()
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+	)
+	wg.Add(DefaultTestConcurrency)
+	for i := 0; i < DefaultTestConcurrency; i++ {
+		go func(i int) {
+			defer wg.Done()
+			result := set.DrainWhere(func(element int) bool { return element <= i })
+			mu.Lock()
+			defer mu.Unlock()
+			if !drained.IsDisjoint(result) {
+				t.Errorf("unexpected drained Set; want disjoint from %v, got %v", drained, result)
+			}
+			drained.PutAll(result)
+		}(i)
+	}
+	wg.Wait()
+	if l := drained.Len(); l != DefaultTestConcurrency {
+		t.Errorf("unexpected number of drained elements; want %v, got %v", DefaultTestConcurrency, l)
+	}
+	if !set.IsEmpty() {
+		t.Errorf("unexpected Set; want empty, got %v", set)
+	}
+}
+
+func Test_SyncHashSet_DrainWhere_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	drained := set.DrainWhere(func(element int) bool {
+		t.Errorf("unexpected call to predicate with %v", element)
+		return true
+	})
+	if drained != (*SyncHashSet[int])(nil) {
+		t.Errorf("unexpected drained Set; want nil, got %v", drained)
+	}
+}
+
 func Test_SyncHashSet_Equal(t *testing.T) {
 	testCases := map[string]struct {
 		expect bool
@@ -1310,6 +2660,9 @@ func Test_SyncHashSet_Filter_Concurrent(t *testing.T) {
 	testConcurrently(func(set *SyncHashSet[int], _ int) {
 		_ = set.Filter(func(_ int) bool { return true })
 	})
+	testDerivedConcurrently(t, func(set *SyncHashSet[int]) Set[int] {
+		return set.Filter(func(_ int) bool { return true })
+	})
 }
 
 func Test_SyncHashSet_Filter_Nil(t *testing.T) {
@@ -1450,6 +2803,155 @@ func Test_SyncHashSet_Find_Nil(t *testing.T) {
 	}
 }
 
+func Test_SyncHashSet_GetAndClear(t *testing.T) {
+	testCases := map[string]struct {
+		expect []int
+		set    *SyncHashSet[int]
+	}{
+		"with non-empty *SyncHashSet": {
+			expect: []int{123, 456, 789},
+			set:    SyncHash(123, 456, 789),
+		},
+		"with empty *SyncHashSet": {
+			expect: []int{},
+			set:    SyncHash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := tc.set.GetAndClear()
+			if internal.IsNil(result) {
+				t.Fatal("unexpected nil Set")
+			}
+			if kind := result.Kind(); kind != HashKind {
+				t.Errorf("unexpected SetKind; want %v, got %v", HashKind, kind)
+			}
+			if expect := HashFromSlice(tc.expect); !result.Equal(expect) {
+				t.Errorf("unexpected Set; want %v, got %v", expect, result)
+			}
+			if !tc.set.IsEmpty() {
+				t.Errorf("unexpected cleared Set; want empty, got %v", tc.set)
+			}
+			tc.set.Put(999)
+			if result.Contains(999) {
+				t.Errorf("unexpected Set; want independent of *SyncHashSet, got %v", result)
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_GetAndClear_Concurrent(t *testing.T) {
+	set := SyncHash[int]()
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	flushes := make(chan int)
+	go func() {
+		var n int
+		for {
+			select {
+			case <-done:
+				flushes <- n + set.GetAndClear().Len()
+				return
+			default:
+				n += set.GetAndClear().Len()
+			}
+		}
+	}()
+	wg.Add(DefaultTestConcurrency)
+	for i := 0; i < DefaultTestConcurrency; i++ {
+		go func(i int) {
+			defer wg.Done()
+			set.Put(i)
+		}(i)
+	}
+	wg.Wait()
+	close(done)
+	flushed := <-flushes
+	if flushed != DefaultTestConcurrency {
+		t.Errorf("unexpected number of flushed elements; want %v, got %v", DefaultTestConcurrency, flushed)
+	}
+}
+
+func Test_SyncHashSet_GetAndClear_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	result := set.GetAndClear()
+	if internal.IsNil(result) {
+		t.Fatal("unexpected nil Set")
+	}
+	if !result.IsEmpty() {
+		t.Errorf("unexpected Set; want empty, got %v", result)
+	}
+}
+
+func Test_SyncHashSet_Grow(t *testing.T) {
+	testCases := map[string]struct {
+		set    *SyncHashSet[int]
+		n      int
+		expect Set[int]
+	}{
+		"with positive n on non-empty *SyncHashSet": {
+			set:    SyncHash(123, 456, 789),
+			n:      100,
+			expect: Hash(123, 456, 789),
+		},
+		"with positive n on empty *SyncHashSet": {
+			set:    SyncHash[int](),
+			n:      100,
+			expect: Hash[int](),
+		},
+		"with zero n on non-empty *SyncHashSet": {
+			set:    SyncHash(123, 456, 789),
+			n:      0,
+			expect: Hash(123, 456, 789),
+		},
+		"with negative n on non-empty *SyncHashSet": {
+			set:    SyncHash(123, 456, 789),
+			n:      -1,
+			expect: Hash(123, 456, 789),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ret := tc.set.Grow(tc.n)
+
+			if internal.IsNil(ret) {
+				t.Error("unexpected nil MutableSet")
+			}
+			if tc.set != ret {
+				t.Errorf("unexpected MutableSet; want %v, got %v", tc.set, ret)
+			}
+			if !tc.set.Equal(tc.expect) {
+				t.Errorf("unexpected MutableSet; want %v, got %v", tc.expect, tc.set)
+			}
+
+			tc.set.Put(1000)
+			if !tc.set.Contains(1000) {
+				t.Error("unexpected MutableSet element presence after Grow; want true, got false")
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_Grow_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], i int) {
+		_ = set.Grow(i)
+	})
+}
+
+func Test_SyncHashSet_Grow_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	ret := set.Grow(100)
+
+	if internal.IsNotNil(ret) {
+		t.Errorf("unexpected MutableSet; want nil, got %v", ret)
+	}
+	if !set.IsEmpty() {
+		t.Error("unexpected MutableSet emptiness; want true, got false")
+	}
+}
+
 func Test_SyncHashSet_Immutable(t *testing.T) {
 	testCases := map[string]struct {
 		set *SyncHashSet[int]
@@ -1478,6 +2980,21 @@ func Test_SyncHashSet_Immutable(t *testing.T) {
 	}
 }
 
+func Test_SyncHashSet_Immutable_Independence(t *testing.T) {
+	set := SyncHash(123, 456, 789)
+	immutable := set.Immutable()
+	if _, ok := immutable.(*HashSet[int]); !ok {
+		t.Errorf("unexpected immutable Set type; want *HashSet[int], got %T", immutable)
+	}
+
+	set.Put(0)
+	set.Delete(123)
+
+	if expect := Hash(123, 456, 789); !immutable.Equal(expect) {
+		t.Errorf("unexpected immutable Set following mutation of source; want %v, got %v", expect, immutable)
+	}
+}
+
 func Test_SyncHashSet_Immutable_Concurrent(t *testing.T) {
 	testConcurrently(func(set *SyncHashSet[int], _ int) {
 		_ = set.Immutable()
@@ -1546,58 +3063,136 @@ func TestSyncHashSet_Intersection(t *testing.T) {
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			intersection := tc.set.Intersection(tc.other)
-			if internal.IsNil(intersection) {
-				t.Error("unexpected nil Set")
-			}
-			if !intersection.Equal(tc.expect) {
-				t.Errorf("unexpected intersection Set; want %v, got %v", tc.expect, intersection)
-			}
-			if !intersection.IsMutable() {
-				t.Error("unexpected intersection Set mutability; want true, got false")
+			intersection := tc.set.Intersection(tc.other)
+			if internal.IsNil(intersection) {
+				t.Error("unexpected nil Set")
+			}
+			if !intersection.Equal(tc.expect) {
+				t.Errorf("unexpected intersection Set; want %v, got %v", tc.expect, intersection)
+			}
+			if !intersection.IsMutable() {
+				t.Error("unexpected intersection Set mutability; want true, got false")
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_Intersection_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], _ int) {
+		_ = set.Intersection(Singleton(123))
+	})
+	testDerivedConcurrently(t, func(set *SyncHashSet[int]) Set[int] {
+		return set.Intersection(Singleton(123))
+	})
+}
+
+func Test_SyncHashSet_Intersection_Nil(t *testing.T) {
+	testCases := map[string]struct {
+		other Set[int]
+	}{
+		"with non-empty Set": {
+			other: Hash(123, 456, 789),
+		},
+		"with empty Set": {
+			other: Hash[int](),
+		},
+		"with nil Set": {
+			other: nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var set *SyncHashSet[int]
+			intersection := set.Intersection(tc.other)
+			if intersection == nil {
+				t.Error("unexpected nil Set")
+			}
+			if internal.IsNotNil(intersection) {
+				t.Errorf("unexpected intersection Set; want nil, got %#v", intersection)
+			}
+			if !intersection.IsEmpty() {
+				t.Error("unexpected intersection Set emptiness; want true, got false")
+			}
+			if !intersection.IsMutable() {
+				t.Error("unexpected intersection Set mutability; want true, got false")
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_IsDisjoint(t *testing.T) {
+	testCases := map[string]struct {
+		expect bool
+		other  Set[int]
+	}{
+		"with disjoint Set": {
+			expect: true,
+			other:  Hash(-789, -456, -123),
+		},
+		"with smaller disjoint Set": {
+			expect: true,
+			other:  Singleton(0),
+		},
+		"with larger disjoint Set": {
+			expect: true,
+			other:  Hash(-789, -456, -123, 0, 1),
+		},
+		"with overlapping Set": {
+			expect: false,
+			other:  Hash(-123, 0, 456),
+		},
+		"with smaller overlapping Set": {
+			expect: false,
+			other:  Singleton(789),
+		},
+		"with larger overlapping Set": {
+			expect: false,
+			other:  Hash(-789, -456, -123, 0, 123),
+		},
+		"with equal Set": {
+			expect: false,
+			other:  Hash(123, 456, 789),
+		},
+		"with empty Set": {
+			expect: true,
+			other:  Hash[int](),
+		},
+		"with nil Set": {
+			expect: true,
+			other:  nil,
+		},
+		"with nil *HashSet": {
+			expect: true,
+			other:  (*HashSet[int])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := SyncHash(123, 456, 789)
+			if result := set.IsDisjoint(tc.other); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
 			}
 		})
 	}
 }
 
-func Test_SyncHashSet_Intersection_Concurrent(t *testing.T) {
+func Test_SyncHashSet_IsDisjoint_Concurrent(t *testing.T) {
 	testConcurrently(func(set *SyncHashSet[int], _ int) {
-		_ = set.Intersection(Singleton(123))
+		if set.IsDisjoint(Hash(123, 456)) {
+			t.Error("unexpected result; want false, got true")
+		}
 	})
 }
 
-func Test_SyncHashSet_Intersection_Nil(t *testing.T) {
-	testCases := map[string]struct {
-		other Set[int]
-	}{
-		"with non-empty Set": {
-			other: Hash(123, 456, 789),
-		},
-		"with empty Set": {
-			other: Hash[int](),
-		},
-		"with nil Set": {
-			other: nil,
-		},
+func Test_SyncHashSet_IsDisjoint_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	if !set.IsDisjoint(Hash(123)) {
+		t.Error("unexpected result; want true, got false")
 	}
-
-	for name, tc := range testCases {
-		t.Run(name, func(t *testing.T) {
-			var set *SyncHashSet[int]
-			intersection := set.Intersection(tc.other)
-			if intersection == nil {
-				t.Error("unexpected nil Set")
-			}
-			if internal.IsNotNil(intersection) {
-				t.Errorf("unexpected intersection Set; want nil, got %#v", intersection)
-			}
-			if !intersection.IsEmpty() {
-				t.Error("unexpected intersection Set emptiness; want true, got false")
-			}
-			if !intersection.IsMutable() {
-				t.Error("unexpected intersection Set mutability; want true, got false")
-			}
-		})
+	if !set.IsDisjoint(nil) {
+		t.Error("unexpected result; want true, got false")
 	}
 }
 
@@ -1693,6 +3288,65 @@ func Test_SyncHashSet_Join_Nil(t *testing.T) {
 	assertSetJoin(t, set.Join(sep, getIntStringConverterWithDefaultOptions[int]()), sep, []string{})
 }
 
+func Test_SyncHashSet_Keys(t *testing.T) {
+	testCases := map[string]struct {
+		set *SyncHashSet[int]
+	}{
+		"on *SyncHashSet containing multiple elements": {
+			set: SyncHash(123, 456, 789),
+		},
+		"on *SyncHashSet containing single element": {
+			set: SyncHash(123),
+		},
+		"on *SyncHashSet containing no elements": {
+			set: SyncHash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			keys := tc.set.Keys()
+			if keys == nil {
+				t.Error("unexpected nil map")
+			}
+			if !internal.ContainsOnly[int](keys, tc.set.Slice()) {
+				t.Errorf("unexpected map keys; want %v, got %v", tc.set.Slice(), keys)
+			}
+			keys[0] = struct{}{}
+			if tc.set.Contains(0) {
+				t.Error("unexpected element within SyncHashSet following modification of map; want false, got true")
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_Keys_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], _ int) {
+		_ = set.Keys()
+	})
+}
+
+func Test_SyncHashSet_Keys_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	if keys := set.Keys(); keys != nil {
+		t.Errorf("unexpected map; want nil, got %v", keys)
+	}
+}
+
+func Test_SyncHashSet_Kind(t *testing.T) {
+	set := SyncHash(123, 456, 789)
+	if kind := set.Kind(); kind != SyncHashKind {
+		t.Errorf("unexpected kind; want %v, got %v", SyncHashKind, kind)
+	}
+}
+
+func Test_SyncHashSet_Kind_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	if kind := set.Kind(); kind != SyncHashKind {
+		t.Errorf("unexpected kind; want %v, got %v", SyncHashKind, kind)
+	}
+}
+
 func Test_SyncHashSet_Len(t *testing.T) {
 	testCases := map[string]struct {
 		expect int
@@ -1962,6 +3616,71 @@ func Test_SyncHashSet_None_Nil(t *testing.T) {
 	}
 }
 
+func Test_SyncHashSet_Pop(t *testing.T) {
+	set := SyncHash(123, 456, 789)
+	var popped []int
+	for i := 0; i < 3; i++ {
+		element, ok := set.Pop()
+		if !ok {
+			t.Fatalf("unexpected pop result; want true, got false")
+		}
+		if set.Contains(element) {
+			t.Errorf("unexpected Set containment of %v; want false, got true", element)
+		}
+		if exp, act := 2-i, set.Len(); act != exp {
+			t.Errorf("unexpected Set length; want %v, got %v", exp, act)
+		}
+		popped = append(popped, element)
+	}
+	sort.Ints(popped)
+	if exp := []int{123, 456, 789}; !cmp.Equal(exp, popped) {
+		t.Errorf("unexpected popped elements; want %v, got %v", exp, popped)
+	}
+	if element, ok := set.Pop(); ok || element != 0 {
+		t.Errorf("unexpected pop result; want 0 and false, got %v and %v", element, ok)
+	}
+	if element, ok := SyncHash[int]().Pop(); ok || element != 0 {
+		t.Errorf("unexpected pop result; want 0 and false, got %v and %v", element, ok)
+	}
+}
+
+func Test_SyncHashSet_Pop_Concurrent(t *testing.T) {
+	elements := make([]int, DefaultTestConcurrency)
+	for i := range elements {
+		elements[i] = i
+	}
+	set := SyncHashFromSlice(elements)
+	var mu sync.Mutex
+	var popped []int
+	var wg sync.WaitGroup
+	wg.Add(DefaultTestConcurrency * 2)
+	for i := 0; i < DefaultTestConcurrency*2; i++ {
+		go func() {
+			defer wg.Done()
+			if element, ok := set.Pop(); ok {
+				mu.Lock()
+				popped = append(popped, element)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	sort.Ints(popped)
+	if !cmp.Equal(elements, popped) {
+		t.Errorf("unexpected popped elements; want %v, got %v", elements, popped)
+	}
+	if !set.IsEmpty() {
+		t.Errorf("unexpected Set; want empty, got %v", set)
+	}
+}
+
+func Test_SyncHashSet_Pop_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	if element, ok := set.Pop(); ok || element != 0 {
+		t.Errorf("unexpected pop result; want 0 and false, got %v and %v", element, ok)
+	}
+}
+
 func Test_SyncHashSet_Put(t *testing.T) {
 	testCases := map[string]struct {
 		element  int
@@ -2308,6 +4027,96 @@ func Test_SyncHashSet_Range(t *testing.T) {
 	}
 }
 
+func Test_SyncHashSet_RangeContext(t *testing.T) {
+	set := SyncHash(123, 456, 789)
+	var elements []int
+	err := set.RangeContext(context.Background(), func(element int) bool {
+		elements = append(elements, element)
+		return false
+	})
+	if err != nil {
+		t.Errorf("unexpected error; want nil, got %q", err)
+	}
+	if expect := set.Len(); len(elements) != expect {
+		t.Errorf("unexpected number of iter function calls; want %v, got %v", expect, len(elements))
+	}
+	if actual := HashFromSlice(elements); !actual.Equal(set) {
+		t.Errorf("unexpected iterated elements; want %v, got %v", set, actual)
+	}
+}
+
+func Test_SyncHashSet_RangeContext_Cancel(t *testing.T) {
+	set := SyncHash(123, 456, 789)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var count int
+	err := set.RangeContext(ctx, func(_ int) bool {
+		count++
+		cancel()
+		return false
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error; want %q, got %q", context.Canceled, err)
+	}
+	if count != 1 {
+		t.Errorf("unexpected number of iter function calls; want 1, got %v", count)
+	}
+}
+
+func Test_SyncHashSet_RangeContext_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var called bool
+	err := SyncHash(123, 456, 789).RangeContext(ctx, func(_ int) bool {
+		called = true
+		return false
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error; want %q, got %q", context.Canceled, err)
+	}
+	if called {
+		t.Error("unexpected iter function call")
+	}
+}
+
+func Test_SyncHashSet_RangeContext_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], _ int) {
+		_ = set.RangeContext(context.Background(), func(_ int) bool {
+			return false
+		})
+	})
+}
+
+func Test_SyncHashSet_RangeContext_Mutate(t *testing.T) {
+	set := SyncHash(123, 456, 789)
+	err := set.RangeContext(context.Background(), func(element int) bool {
+		set.Delete(element)
+		set.Put(-element)
+		return false
+	})
+	if err != nil {
+		t.Errorf("unexpected error; want nil, got %q", err)
+	}
+	if expect := Hash(-123, -456, -789); !set.Equal(expect) {
+		t.Errorf("unexpected Set; want %v, got %v", expect, set)
+	}
+}
+
+func Test_SyncHashSet_RangeContext_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	var called bool
+	err := set.RangeContext(context.Background(), func(_ int) bool {
+		called = true
+		return false
+	})
+	if err != nil {
+		t.Errorf("unexpected error; want nil, got %q", err)
+	}
+	if called {
+		t.Error("unexpected iter function call")
+	}
+}
+
 func Test_SyncHashSet_Range_Concurrent(t *testing.T) {
 	testConcurrently(func(set *SyncHashSet[int], _ int) {
 		set.Range(func(_ int) bool { return false })
@@ -2326,6 +4135,179 @@ func Test_SyncHashSet_Range_Nil(t *testing.T) {
 	}
 }
 
+func Test_SyncHashSet_RangeMutable(t *testing.T) {
+	testCases := map[string]struct {
+		expectCallCount int
+		expectElements  []int
+		iterFunc        func(element int, set MutableSet[int]) bool
+		set             *SyncHashSet[int]
+	}{
+		"with iterator deleting each element": {
+			expectCallCount: 3,
+			expectElements:  []int{},
+			iterFunc: func(element int, set MutableSet[int]) bool {
+				set.Delete(element)
+				return false
+			},
+			set: SyncHash(123, 456, 789),
+		},
+		"with iterator deleting all elements on first call": {
+			expectCallCount: 3,
+			expectElements:  []int{},
+			iterFunc: func(_ int, set MutableSet[int]) bool {
+				set.Clear()
+				return false
+			},
+			set: SyncHash(123, 456, 789),
+		},
+		"with iterator deleting negative elements": {
+			expectCallCount: 6,
+			expectElements:  []int{123, 456, 789},
+			iterFunc: func(element int, set MutableSet[int]) bool {
+				if element < 0 {
+					set.Delete(element)
+				}
+				return false
+			},
+			set: SyncHash(-789, -456, -123, 123, 456, 789),
+		},
+		"with iterator adding elements": {
+			expectCallCount: 3,
+			expectElements:  []int{-789, -456, -123, 123, 456, 789},
+			iterFunc: func(element int, set MutableSet[int]) bool {
+				set.Put(-element)
+				return false
+			},
+			set: SyncHash(123, 456, 789),
+		},
+		"with breaking iterator deleting elements": {
+			expectCallCount: 1,
+			expectElements:  []int{123, 456},
+			iterFunc: func(_ int, set MutableSet[int]) bool {
+				set.Delete(789)
+				return true
+			},
+			set: SyncHash(123, 456, 789),
+		},
+		"with iterator on empty *SyncHashSet": {
+			expectCallCount: 0,
+			expectElements:  []int{},
+			iterFunc:        func(_ int, _ MutableSet[int]) bool { return false },
+			set:             SyncHash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var funcCallCount int
+			tc.set.RangeMutable(func(element int, set MutableSet[int]) bool {
+				funcCallCount++
+				if set != tc.set {
+					t.Errorf("unexpected MutableSet; want %v, got %v", tc.set, set)
+				}
+				return tc.iterFunc(element, set)
+			})
+			if funcCallCount != tc.expectCallCount {
+				t.Errorf("unexpected number of calls to iterator; want %v, got %v", tc.expectCallCount, funcCallCount)
+			}
+			elements := tc.set.SortedSlice(Asc[int])
+			if !cmp.Equal(tc.expectElements, elements, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected elements; want %v, got %v", tc.expectElements, elements)
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_RangeMutable_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], i int) {
+		set.RangeMutable(func(element int, set MutableSet[int]) bool {
+			set.Delete(element)
+			set.Put(i)
+			return false
+		})
+	})
+}
+
+func Test_SyncHashSet_RangeMutable_Nil(t *testing.T) {
+	var funcCallCount int
+	var set *SyncHashSet[int]
+	set.RangeMutable(func(_ int, _ MutableSet[int]) bool {
+		funcCallCount++
+		return false
+	})
+	if funcCallCount != 0 {
+		t.Errorf("unexpected number of calls to iterator; want 0, got %v", funcCallCount)
+	}
+}
+
+func Test_SyncHashSet_ReplaceElement(t *testing.T) {
+	testCases := map[string]struct {
+		expect     Set[int]
+		expectOk   bool
+		newElement int
+		oldElement int
+	}{
+		"with old element absent": {
+			expect:     Hash(123, 456, 789),
+			expectOk:   false,
+			newElement: 999,
+			oldElement: 0,
+		},
+		"with old element present and new element absent": {
+			expect:     Hash(123, 789, 999),
+			expectOk:   true,
+			newElement: 999,
+			oldElement: 456,
+		},
+		"with old element present and new element already present": {
+			expect:     Hash(123, 789),
+			expectOk:   true,
+			newElement: 789,
+			oldElement: 456,
+		},
+		"with old element equal to new element": {
+			expect:     Hash(123, 456, 789),
+			expectOk:   true,
+			newElement: 456,
+			oldElement: 456,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := SyncHash(123, 456, 789)
+			if ok := set.ReplaceElement(tc.oldElement, tc.newElement); ok != tc.expectOk {
+				t.Errorf("unexpected replacement; want %v, got %v", tc.expectOk, ok)
+			}
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_ReplaceElement_Concurrent(t *testing.T) {
+	var replaced int32
+	testConcurrently(func(set *SyncHashSet[int], i int) {
+		if set.ReplaceElement(456, 1000+i) {
+			atomic.AddInt32(&replaced, 1)
+		}
+		if l := set.Len(); l != 3 {
+			t.Errorf("unexpected Set length; want 3, got %v", l)
+		}
+	})
+	if replaced != 1 {
+		t.Errorf("unexpected number of replacements; want 1, got %v", replaced)
+	}
+}
+
+func Test_SyncHashSet_ReplaceElement_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	if set.ReplaceElement(123, 456) {
+		t.Error("unexpected replacement; want false, got true")
+	}
+}
+
 func Test_SyncHashSet_Retain(t *testing.T) {
 	testCases := map[string]struct {
 		element  int
@@ -2503,29 +4485,88 @@ func Test_SyncHashSet_RetainAll_Nil(t *testing.T) {
 	testCases := map[string]struct {
 		elements Set[int]
 	}{
-		"with slice containing multiple elements": {
-			elements: Hash(123, 456, 789),
+		"with slice containing multiple elements": {
+			elements: Hash(123, 456, 789),
+		},
+		"with slice containing single element": {
+			elements: Hash(123),
+		},
+		"with slice containing no elements": {
+			elements: Hash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var set *SyncHashSet[int]
+			set.RetainAll(tc.elements)
+
+			if !set.IsEmpty() {
+				t.Error("unexpected MutableSet emptiness; want true, got false")
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_RetainAllOf(t *testing.T) {
+	testCases := map[string]struct {
+		expect Set[int]
+		sets   []Set[int]
+	}{
+		"with no Sets": {
+			expect: Hash(123, 456, 789),
+			sets:   nil,
 		},
-		"with slice containing single element": {
-			elements: Hash(123),
+		"with single Set": {
+			expect: Hash(456),
+			sets:   []Set[int]{Hash(456, 999)},
 		},
-		"with slice containing no elements": {
-			elements: Hash[int](),
+		"with multiple Sets": {
+			expect: Hash(456),
+			sets:   []Set[int]{Hash(123, 456, 999), Hash(456, 789)},
+		},
+		"with disjoint Sets": {
+			expect: Hash[int](),
+			sets:   []Set[int]{Hash(123), Hash(456)},
+		},
+		"with nil Set": {
+			expect: Hash[int](),
+			sets:   []Set[int]{Hash(123, 456), nil},
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			var set *SyncHashSet[int]
-			set.RetainAll(tc.elements)
-
-			if !set.IsEmpty() {
-				t.Error("unexpected MutableSet emptiness; want true, got false")
+			set := SyncHash(123, 456, 789)
+			composed := MutableHash(123, 456, 789)
+			for _, other := range tc.sets {
+				composed.RetainAll(other)
+			}
+			set.RetainAllOf(tc.sets...)
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+			if !set.Equal(composed) {
+				t.Errorf("unexpected Set compared to composing RetainAll; want %v, got %v", composed, set)
 			}
 		})
 	}
 }
 
+func Test_SyncHashSet_RetainAllOf_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], i int) {
+		set.Put(i)
+		set.RetainAllOf(Hash(123, 456, i), SyncHash(123, i))
+	})
+}
+
+func Test_SyncHashSet_RetainAllOf_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	if result := set.RetainAllOf(Hash(123)); internal.IsNotNil(result) {
+		t.Errorf("unexpected MutableSet; want nil, got %v", result)
+	}
+}
+
 func Test_SyncHashSet_RetainSlice(t *testing.T) {
 	testCases := map[string]struct {
 		elements []int
@@ -2723,6 +4764,120 @@ func Test_SyncHashSet_RetainWhere_Nil(t *testing.T) {
 	}
 }
 
+func Test_SyncHashSet_Sample(t *testing.T) {
+	set := SyncHash(123, 456, 789)
+	for i := 0; i < 10; i++ {
+		element, ok := set.Sample()
+		if !ok {
+			t.Fatal("unexpected indication of no elements; want true, got false")
+		}
+		if !set.Contains(element) {
+			t.Errorf("unexpected element; want element of %v, got %v", set, element)
+		}
+	}
+}
+
+func Test_SyncHashSet_Sample_Empty(t *testing.T) {
+	set := SyncHash[int]()
+	if element, ok := set.Sample(); ok {
+		t.Errorf("unexpected element; want none, got %v", element)
+	}
+}
+
+func Test_SyncHashSet_Sample_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	if element, ok := set.Sample(); ok {
+		t.Errorf("unexpected element; want none, got %v", element)
+	}
+}
+
+func Test_SyncHashSet_SampleN(t *testing.T) {
+	testCases := map[string]struct {
+		expectLen int
+		n         int
+		set       *SyncHashSet[int]
+	}{
+		"with n less than length": {
+			expectLen: 2,
+			n:         2,
+			set:       SyncHash(123, 456, 789),
+		},
+		"with n equal to length": {
+			expectLen: 3,
+			n:         3,
+			set:       SyncHash(123, 456, 789),
+		},
+		"with n greater than length": {
+			expectLen: 3,
+			n:         10,
+			set:       SyncHash(123, 456, 789),
+		},
+		"with zero n": {
+			expectLen: 0,
+			n:         0,
+			set:       SyncHash(123, 456, 789),
+		},
+		"with negative n": {
+			expectLen: 0,
+			n:         -1,
+			set:       SyncHash(123, 456, 789),
+		},
+		"with empty *SyncHashSet[int]": {
+			expectLen: 0,
+			n:         2,
+			set:       SyncHash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sampled := tc.set.SampleN(tc.n)
+			if internal.IsNil(sampled) {
+				t.Fatal("unexpected nil Set")
+			}
+			if kind, expectKind := sampled.Kind(), tc.set.Kind(); kind != expectKind {
+				t.Errorf("unexpected SetKind; want %v, got %v", expectKind, kind)
+			}
+			if l := sampled.Len(); l != tc.expectLen {
+				t.Errorf("unexpected Set length; want %v, got %v", tc.expectLen, l)
+			}
+			if !tc.set.Covers(sampled) {
+				t.Errorf("unexpected sampled Set; want subset of %v, got %v", tc.set, sampled)
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_SampleN_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	if sampled := set.SampleN(2); sampled != (*SyncHashSet[int])(nil) {
+		t.Errorf("unexpected sampled Set; want nil, got %v", sampled)
+	}
+}
+
+func Test_SyncHashSet_SampleNWithRand(t *testing.T) {
+	set := SyncHash(123, 456, 789)
+	set.SampleNWithRand(2, rand.New(rand.NewSource(42)))
+	if set.order.Load() != nil {
+		t.Fatal("unexpected order tracked by SampleNWithRand")
+	}
+
+	set.StableRange(func(_ int) bool { return false })
+	expect := set.SampleNWithRand(2, rand.New(rand.NewSource(42)))
+	for i := 0; i < 10; i++ {
+		if sampled := set.SampleNWithRand(2, rand.New(rand.NewSource(42))); !sampled.Equal(expect) {
+			t.Errorf("unexpected sampled Set; want %v, got %v", expect, sampled)
+		}
+	}
+}
+
+func Test_SyncHashSet_SampleN_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], i int) {
+		set.Put(i)
+		set.SampleN(i)
+	})
+}
+
 func Test_SyncHashSet_Slice(t *testing.T) {
 	testCases := map[string]struct {
 		expect []int
@@ -2766,6 +4921,41 @@ func Test_SyncHashSet_Slice_Nil(t *testing.T) {
 	}
 }
 
+func Test_SyncHashSet_Snapshot(t *testing.T) {
+	set := SyncHash(123, 456, 789)
+	snapshot := set.Snapshot()
+	if _, ok := snapshot.(*HashSet[int]); !ok {
+		t.Errorf("unexpected snapshot Set type; want *HashSet[int], got %T", snapshot)
+	}
+	set.Delete(123).Put(999)
+	if exp := Hash(123, 456, 789); !snapshot.Equal(exp) {
+		t.Errorf("unexpected snapshot Set; want %v, got %v", exp, snapshot)
+	}
+	if exp := Hash(456, 789, 999); !set.Equal(exp) {
+		t.Errorf("unexpected Set; want %v, got %v", exp, set)
+	}
+}
+
+func Test_SyncHashSet_Snapshot_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], i int) {
+		set.Put(-i)
+		if snapshot := set.Snapshot(); !snapshot.ContainsAll(123, 456, 789) {
+			t.Errorf("unexpected snapshot Set; want to contain 123, 456, and 789, got %v", snapshot)
+		}
+	})
+}
+
+func Test_SyncHashSet_Snapshot_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	snapshot := set.Snapshot()
+	if internal.IsNotNil(snapshot) {
+		t.Errorf("unexpected snapshot Set; want nil, got %#v", snapshot)
+	}
+	if snapshot.IsMutable() {
+		t.Error("unexpected snapshot Set mutability; want false, got true")
+	}
+}
+
 func Test_SyncHashSet_Some(t *testing.T) {
 	testCases := map[string]struct {
 		expect        bool
@@ -2853,88 +5043,470 @@ func Test_SyncHashSet_Some_Nil(t *testing.T) {
 	}
 }
 
-func Test_SyncHashSet_SortedJoin(t *testing.T) {
+func Test_SyncHashSet_SortedAppendSlice(t *testing.T) {
+	testCases := map[string]struct {
+		set    *SyncHashSet[int]
+		dst    []int
+		expect []int
+	}{
+		"with nil dst on non-empty *SyncHashSet": {
+			set:    SyncHash(456, 123, 789),
+			dst:    nil,
+			expect: []int{789, 456, 123},
+		},
+		"with non-empty dst on non-empty *SyncHashSet": {
+			set:    SyncHash(456, 123, 789),
+			dst:    []int{1, 999},
+			expect: []int{1, 999, 789, 456, 123},
+		},
+		"with nil dst on empty *SyncHashSet": {
+			set:    SyncHash[int](),
+			dst:    nil,
+			expect: nil,
+		},
+		"with non-empty dst on empty *SyncHashSet": {
+			set:    SyncHash[int](),
+			dst:    []int{1, 999},
+			expect: []int{1, 999},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ret := tc.set.SortedAppendSlice(tc.dst, Desc[int])
+			if !cmp.Equal(tc.expect, ret, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected slice; want %v, got %v", tc.expect, ret)
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_SortedAppendSlice_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], _ int) {
+		_ = set.SortedAppendSlice(nil, Asc[int])
+	})
+}
+
+func Test_SyncHashSet_SortedAppendSlice_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	dst := []int{1, 999}
+	ret := set.SortedAppendSlice(dst, Desc[int])
+
+	if !cmp.Equal(dst, ret) {
+		t.Errorf("unexpected slice; want %v, got %v", dst, ret)
+	}
+}
+
+func Test_SyncHashSet_SortedJoin(t *testing.T) {
+	testCases := map[string]struct {
+		expect string
+		set    *SyncHashSet[int]
+	}{
+		"on *SyncHashSet containing multiple elements": {
+			expect: "-789,-456,-123,0,123,456,789",
+			set:    SyncHash(-789, -456, -123, 0, 123, 456, 789),
+		},
+		"on *SyncHashSet containing single element": {
+			expect: "123",
+			set:    SyncHash(123),
+		},
+		"on *SyncHashSet containing no elements": {
+			expect: "",
+			set:    SyncHash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := tc.set.SortedJoin(",", getIntStringConverterWithDefaultOptions[int](), Asc[int])
+			if result != tc.expect {
+				t.Errorf("unexpected result; want %q, got %q", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_SortedJoin_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], _ int) {
+		_ = set.SortedJoin(",", getIntStringConverterWithDefaultOptions[int](), Asc[int])
+	})
+}
+
+func Test_SyncHashSet_SortedJoin_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	result := set.SortedJoin(",", getIntStringConverterWithDefaultOptions[int](), Asc[int])
+	if exp := ""; result != exp {
+		t.Errorf("unexpected result; want %q, got %q", exp, result)
+	}
+}
+
+func Test_SyncHashSet_SortedRange(t *testing.T) {
+	testCases := map[string]struct {
+		expect []int
+		set    *SyncHashSet[int]
+		stopAt int
+	}{
+		"with non-empty *SyncHashSet[int]": {
+			expect: []int{789, 456, 345, 123, 12},
+			set:    SyncHash(456, 123, 789, 12, 345),
+			stopAt: -1,
+		},
+		"with non-empty *SyncHashSet[int] and early stop": {
+			expect: []int{789, 456, 345},
+			set:    SyncHash(456, 123, 789, 12, 345),
+			stopAt: 2,
+		},
+		"with empty *SyncHashSet[int]": {
+			expect: nil,
+			set:    SyncHash[int](),
+			stopAt: -1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var elements []int
+			tc.set.SortedRange(Desc[int], func(index int, element int) bool {
+				if index != len(elements) {
+					t.Errorf("unexpected index; want %v, got %v", len(elements), index)
+				}
+				elements = append(elements, element)
+				return index == tc.stopAt
+			})
+			if !cmp.Equal(tc.expect, elements, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected elements; want %v, got %v", tc.expect, elements)
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_SortedRange_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], i int) {
+		set.SortedRange(Asc[int], func(_ int, _ int) bool { return false })
+		set.Put(i)
+	})
+}
+
+func Test_SyncHashSet_SortedRange_Mutate(t *testing.T) {
+	set := SyncHash(123, 456, 789)
+	var elements []int
+	set.SortedRange(Asc[int], func(_ int, element int) bool {
+		elements = append(elements, element)
+		set.Delete(element)
+		set.Put(-element)
+		return false
+	})
+	if expect := []int{123, 456, 789}; !cmp.Equal(expect, elements) {
+		t.Errorf("unexpected elements; want %v, got %v", expect, elements)
+	}
+	if expect := Hash(-123, -456, -789); !set.Equal(expect) {
+		t.Errorf("unexpected Set; want %v, got %v", expect, set)
+	}
+}
+
+func Test_SyncHashSet_SortedRange_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	set.SortedRange(Desc[int], func(index int, element int) bool {
+		t.Errorf("unexpected call to iter with %v at index %v", element, index)
+		return false
+	})
+}
+
+func Test_SyncHashSet_SortedSlice(t *testing.T) {
+	testCases := map[string]struct {
+		expect []int
+		set    *SyncHashSet[int]
+	}{
+		"on non-empty *SyncHashSet": {
+			expect: []int{123, 456, 789},
+			set:    SyncHash(123, 456, 789),
+		},
+		"on empty *SyncHashSet": {
+			expect: []int{},
+			set:    SyncHash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			elements := tc.set.SortedSlice(Asc[int])
+			if elements == nil {
+				t.Error("unexpected nil slice")
+			}
+			if !cmp.Equal(tc.expect, elements) {
+				t.Errorf("unexpected slice; got diff %v", cmp.Diff(tc.expect, elements))
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_SortedSlice_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], _ int) {
+		_ = set.SortedSlice(Asc[int])
+	})
+}
+
+func Test_SyncHashSet_SortedSlice_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	elements := set.SortedSlice(Asc[int])
+	if elements != nil {
+		t.Errorf("unexpected slice; want nil, got %v", elements)
+	}
+}
+
+func Test_SyncHashSet_StableRange(t *testing.T) {
+	set := SyncHash(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	collect := func() []int {
+		var elements []int
+		set.StableRange(func(element int) bool {
+			elements = append(elements, element)
+			return false
+		})
+		return elements
+	}
+
+	first := collect()
+	for i := 0; i < 10; i++ {
+		if diff := cmp.Diff(first, collect()); diff != "" {
+			t.Fatalf("unexpected order of iterated elements (-want +got):\n%s", diff)
+		}
+	}
+
+	set.Delete(first[3])
+	expectRetained := append(append([]int{}, first[:3]...), first[4:]...)
+	if diff := cmp.Diff(expectRetained, collect()); diff != "" {
+		t.Errorf("unexpected order of remaining elements (-want +got):\n%s", diff)
+	}
+
+	set.Put(13, 11).Put(12)
+	if diff := cmp.Diff(append(expectRetained, 13, 11, 12), collect()); diff != "" {
+		t.Errorf("unexpected order of added elements (-want +got):\n%s", diff)
+	}
+}
+
+func Test_SyncHashSet_StableRange_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], i int) {
+		set.StableRange(func(_ int) bool { return false })
+		set.Put(i)
+	})
+}
+
+func Test_SyncHashSet_StableRange_Tracking(t *testing.T) {
+	set := SyncHash(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	if set.order.Load() != nil {
+		t.Fatal("unexpected order tracked before StableRange")
+	}
+
+	set.StableRange(func(_ int) bool { return false })
+	set.Delete(1)
+	set.CompareAndDelete(2)
+	set.Pop()
+	set.Transaction(func(tx MutableSet[int]) {
+		tx.Take(10)
+	})
+	set.RetainWhere(func(element int) bool { return element%2 == 0 })
+	if got, want := set.order.Load().Len(), set.Len(); got != want {
+		t.Errorf("unexpected number of tracked elements; want %v, got %v", want, got)
+	}
+
+	set.GetAndClear()
+	if set.order.Load() != nil {
+		t.Error("unexpected order tracked after GetAndClear")
+	}
+}
+
+func Test_SyncHashSet_StableRange_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	var callCount int
+	set.StableRange(func(_ int) bool {
+		callCount++
+		return false
+	})
+	if callCount != 0 {
+		t.Errorf("unexpected number of calls to iter; want 0, got %v", callCount)
+	}
+}
+
+func Test_SyncHashSet_Take(t *testing.T) {
 	testCases := map[string]struct {
-		expect string
-		set    *SyncHashSet[int]
+		element        int
+		expectElement  int
+		expectElements []int
+		expectOk       bool
 	}{
-		"on *SyncHashSet containing multiple elements": {
-			expect: "-789,-456,-123,0,123,456,789",
-			set:    SyncHash(-789, -456, -123, 0, 123, 456, 789),
-		},
-		"on *SyncHashSet containing single element": {
-			expect: "123",
-			set:    SyncHash(123),
-		},
-		"on *SyncHashSet containing no elements": {
-			expect: "",
-			set:    SyncHash[int](),
+		"with element present": {
+			element:        456,
+			expectElement:  456,
+			expectElements: []int{123, 789},
+			expectOk:       true,
+		},
+		"with element not present": {
+			element:        999,
+			expectElement:  0,
+			expectElements: []int{123, 456, 789},
+			expectOk:       false,
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			result := tc.set.SortedJoin(",", getIntStringConverterWithDefaultOptions[int](), Asc[int])
-			if result != tc.expect {
-				t.Errorf("unexpected result; want %q, got %q", tc.expect, result)
+			set := SyncHash(123, 456, 789)
+			element, ok := set.Take(tc.element)
+			if element != tc.expectElement || ok != tc.expectOk {
+				t.Errorf(
+					"unexpected taken element; want %v and %v, got %v and %v",
+					tc.expectElement,
+					tc.expectOk,
+					element,
+					ok,
+				)
+			}
+			if expect := HashFromSlice(tc.expectElements); !set.Equal(expect) {
+				t.Errorf("unexpected Set; want %v, got %v", expect, set)
 			}
 		})
 	}
 }
 
-func Test_SyncHashSet_SortedJoin_Concurrent(t *testing.T) {
-	testConcurrently(func(set *SyncHashSet[int], _ int) {
-		_ = set.SortedJoin(",", getIntStringConverterWithDefaultOptions[int](), Asc[int])
-	})
+func Test_SyncHashSet_Take_Concurrent(t *testing.T) {
+	set := SyncHash[int]()
+	for i := 0; i < DefaultTestConcurrency; i++ {
+		set.Put(i)
+	}
+	var (
+		mu    sync.Mutex
+		taken int
+		wg    sync.WaitGroup
+	)
+	wg.Add(DefaultTestConcurrency * 2)
+	for i := 0; i < DefaultTestConcurrency*2; i++ {
+		go func(i int) {
+			defer wg.Done()
+			if _, ok := set.Take(i % DefaultTestConcurrency); ok {
+				mu.Lock()
+				taken++
+				mu.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+	if taken != DefaultTestConcurrency {
+		t.Errorf("unexpected number of taken elements; want %v, got %v", DefaultTestConcurrency, taken)
+	}
+	if !set.IsEmpty() {
+		t.Errorf("unexpected Set; want empty, got %v", set)
+	}
 }
 
-func Test_SyncHashSet_SortedJoin_Nil(t *testing.T) {
+func Test_SyncHashSet_Take_Nil(t *testing.T) {
 	var set *SyncHashSet[int]
-	result := set.SortedJoin(",", getIntStringConverterWithDefaultOptions[int](), Asc[int])
-	if exp := ""; result != exp {
-		t.Errorf("unexpected result; want %q, got %q", exp, result)
+	if element, ok := set.Take(123); ok {
+		t.Errorf("unexpected taken element; want 0 and false, got %v and %v", element, ok)
 	}
 }
 
-func Test_SyncHashSet_SortedSlice(t *testing.T) {
+func Test_SyncHashSet_ToMap(t *testing.T) {
 	testCases := map[string]struct {
-		expect []int
+		expect map[int]struct{}
 		set    *SyncHashSet[int]
 	}{
-		"on non-empty *SyncHashSet": {
-			expect: []int{123, 456, 789},
+		"with non-empty *SyncHashSet": {
+			expect: map[int]struct{}{123: {}, 456: {}, 789: {}},
 			set:    SyncHash(123, 456, 789),
 		},
-		"on empty *SyncHashSet": {
-			expect: []int{},
+		"with empty *SyncHashSet": {
+			expect: map[int]struct{}{},
 			set:    SyncHash[int](),
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			elements := tc.set.SortedSlice(Asc[int])
-			if elements == nil {
-				t.Error("unexpected nil slice")
+			m := tc.set.ToMap()
+			if m == nil {
+				t.Fatal("unexpected map; want non-nil, got nil")
 			}
-			if !cmp.Equal(tc.expect, elements) {
-				t.Errorf("unexpected slice; got diff %v", cmp.Diff(tc.expect, elements))
+			if !cmp.Equal(tc.expect, m) {
+				t.Errorf("unexpected map; want %v, got %v", tc.expect, m)
+			}
+			m[-1] = struct{}{}
+			if tc.set.Contains(-1) {
+				t.Error("unexpected Set containment of -1; want false, got true")
 			}
 		})
 	}
 }
 
-func Test_SyncHashSet_SortedSlice_Concurrent(t *testing.T) {
-	testConcurrently(func(set *SyncHashSet[int], _ int) {
-		_ = set.SortedSlice(Asc[int])
+func Test_SyncHashSet_ToMap_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], i int) {
+		set.Put(-i)
+		if m := set.ToMap(); len(m) < 3 {
+			t.Errorf("unexpected map length; want at least 3, got %v", len(m))
+		}
 	})
 }
 
-func Test_SyncHashSet_SortedSlice_Nil(t *testing.T) {
+func Test_SyncHashSet_ToMap_Nil(t *testing.T) {
 	var set *SyncHashSet[int]
-	elements := set.SortedSlice(Asc[int])
-	if elements != nil {
-		t.Errorf("unexpected slice; want nil, got %v", elements)
+	if m := set.ToMap(); m != nil {
+		t.Errorf("unexpected map; want nil, got %v", m)
+	}
+}
+
+func Test_SyncHashSet_Transaction(t *testing.T) {
+	set := SyncHash(123, 456, 789)
+	var funcCallCount int
+	set.Transaction(func(tx MutableSet[int]) {
+		funcCallCount++
+		if tx.Contains(123) {
+			tx.Delete(123)
+			tx.Put(0)
+		}
+		tx.Clear().Put(-123, -456)
+		tx.RetainWhere(func(element int) bool { return element < -200 })
+	})
+
+	if funcCallCount != 1 {
+		t.Errorf("unexpected number of calls to fn; want 1, got %v", funcCallCount)
+	}
+	if expect := Hash(-456); !expect.Equal(set) {
+		t.Errorf("unexpected MutableSet; want %v, got %v", expect, set)
+	}
+}
+
+func Test_SyncHashSet_Transaction_Concurrent(t *testing.T) {
+	set := SyncHash(0)
+	var wg sync.WaitGroup
+	wg.Add(DefaultTestConcurrency)
+	for i := 0; i < DefaultTestConcurrency; i++ {
+		go func() {
+			defer wg.Done()
+			set.Transaction(func(tx MutableSet[int]) {
+				// Read-modify-write that would lose updates were it not atomic
+				max, _ := tx.Max(Asc[int])
+				tx.Put(max + 1)
+			})
+		}()
+	}
+	wg.Wait()
+
+	expect := MutableHash[int]()
+	for i := 0; i <= DefaultTestConcurrency; i++ {
+		expect.Put(i)
+	}
+	if !expect.Equal(set) {
+		t.Errorf("unexpected MutableSet; want %v, got %v", expect, set)
+	}
+}
+
+func Test_SyncHashSet_Transaction_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	var funcCallCount int
+	set.Transaction(func(_ MutableSet[int]) {
+		funcCallCount++
+	})
+	if funcCallCount != 0 {
+		t.Errorf("unexpected number of calls to fn; want 0, got %v", funcCallCount)
 	}
 }
 
@@ -3240,6 +5812,9 @@ func Test_SyncHashSet_Union_Concurrent(t *testing.T) {
 	testConcurrently(func(set *SyncHashSet[int], _ int) {
 		_ = set.Union(Singleton(0))
 	})
+	testDerivedConcurrently(t, func(set *SyncHashSet[int]) Set[int] {
+		return set.Union(Singleton(0))
+	})
 }
 
 func Test_SyncHashSet_Union_Nil(t *testing.T) {
@@ -3328,6 +5903,64 @@ func Test_SyncHashSet_Union_Nil(t *testing.T) {
 	}
 }
 
+func Test_SyncHashSet_View(t *testing.T) {
+	set := SyncHash(123, 456, 789)
+	var funcCallCount int
+	var retained Set[int]
+	set.View(func(view Set[int]) {
+		funcCallCount++
+		if expect := Hash(123, 456, 789); !expect.Equal(view) {
+			t.Errorf("unexpected Set; want %v, got %v", expect, view)
+		}
+		if view.IsMutable() {
+			t.Error("unexpected Set mutability; want false, got true")
+		}
+		retained = view.Immutable()
+	})
+	set.Put(0)
+	if expect := Hash(123, 456, 789); !expect.Equal(retained) {
+		t.Errorf("unexpected retained Set; want %v, got %v", expect, retained)
+	}
+
+	if funcCallCount != 1 {
+		t.Errorf("unexpected number of calls to fn; want 1, got %v", funcCallCount)
+	}
+}
+
+func Test_SyncHashSet_View_Concurrent(t *testing.T) {
+	set := SyncHash[int]()
+	var wg sync.WaitGroup
+	wg.Add(DefaultTestConcurrency * 2)
+	for i := 0; i < DefaultTestConcurrency; i++ {
+		go func(i int) {
+			defer wg.Done()
+			set.Put(i)
+		}(i)
+		go func() {
+			defer wg.Done()
+			set.View(func(view Set[int]) {
+				// Multiple reads that would disagree were a write able to interleave between them
+				size := view.Len()
+				if elements := view.Slice(); len(elements) != size {
+					t.Errorf("unexpected number of elements; want %v, got %v", size, len(elements))
+				}
+			})
+		}()
+	}
+	wg.Wait()
+}
+
+func Test_SyncHashSet_View_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	var funcCallCount int
+	set.View(func(_ Set[int]) {
+		funcCallCount++
+	})
+	if funcCallCount != 0 {
+		t.Errorf("unexpected number of calls to fn; want 0, got %v", funcCallCount)
+	}
+}
+
 func Test_SyncHashSet_String(t *testing.T) {
 	set := SyncHash(123, 456, 789)
 	assertSetString(t, set.String(), []string{"123", "456", "789"})
@@ -3344,6 +5977,83 @@ func Test_SyncHashSet_String_Nil(t *testing.T) {
 	assertSetString(t, set.String(), []string{})
 }
 
+func Test_SyncHashSet_GobEncode(t *testing.T) {
+	testCases := map[string]struct {
+		set *SyncHashSet[int]
+	}{
+		"with empty *SyncHashSet": {
+			set: SyncHash[int](),
+		},
+		"with single element *SyncHashSet": {
+			set: SyncHash(123),
+		},
+		"with multiple elements *SyncHashSet": {
+			set: SyncHash(123, 456, 789),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := gob.NewEncoder(&buf).Encode(tc.set); err != nil {
+				t.Fatalf("unexpected error; want nil, got %q", err)
+			}
+			set := &SyncHashSet[int]{}
+			if err := gob.NewDecoder(&buf).Decode(set); err != nil {
+				t.Fatalf("unexpected error; want nil, got %q", err)
+			}
+			if !set.Equal(tc.set) {
+				t.Errorf("unexpected decoded Set; want %v, got %v", tc.set, set)
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_GobEncode_Concurrent(t *testing.T) {
+	testConcurrently(func(set *SyncHashSet[int], _ int) {
+		_, _ = set.GobEncode()
+	})
+}
+
+func Test_SyncHashSet_GobEncode_Field(t *testing.T) {
+	type wrapper struct {
+		Set *SyncHashSet[string]
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(wrapper{Set: SyncHash("foo", "bar")}); err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	var decoded wrapper
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	if expect := Hash("foo", "bar"); !expect.Equal(decoded.Set) {
+		t.Errorf("unexpected decoded Set; want %v, got %v", expect, decoded.Set)
+	}
+}
+
+func Test_SyncHashSet_GobEncode_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	data, err := set.GobEncode()
+	if err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	decoded := SyncHash(123)
+	if err = decoded.GobDecode(data); err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	if !decoded.IsEmpty() {
+		t.Errorf("unexpected decoded Set; want [], got %v", decoded)
+	}
+}
+
+func Test_SyncHashSet_GobDecode_Error(t *testing.T) {
+	set := SyncHash(123)
+	if err := set.GobDecode([]byte("invalid")); err == nil {
+		t.Error("unexpected error; want non-nil, got nil")
+	}
+}
+
 func Test_SyncHashSet_MarshalJSON(t *testing.T) {
 	testCases := map[string]struct {
 		expect []string
@@ -3437,12 +6147,163 @@ func Test_SyncHashSet_UnmarshalJSON(t *testing.T) {
 	}
 }
 
+func Test_SyncHashSet_UnmarshalJSON_Replace(t *testing.T) {
+	set := &SyncHashSet[int]{}
+	if err := json.Unmarshal([]byte("[1,2,3]"), set); err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	if err := json.Unmarshal([]byte("[4,5]"), set); err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	if expect := Hash(4, 5); !set.Equal(expect) {
+		t.Errorf("unexpected unmarshalled Set; want %v, got %v", expect, set)
+	}
+}
+
+func Test_SyncHashSet_Scan(t *testing.T) {
+	testCases := map[string]struct {
+		expect Set[int]
+		src    any
+	}{
+		"with string source": {
+			expect: Hash(1, 2, 3),
+			src:    "[1,2,3]",
+		},
+		"with []byte source": {
+			expect: Hash(1, 2, 3),
+			src:    []byte("[1,2,3]"),
+		},
+		"with string source for empty array": {
+			expect: Hash[int](),
+			src:    "[]",
+		},
+		"with string source for null": {
+			expect: Hash[int](),
+			src:    "null",
+		},
+		"with NULL source": {
+			expect: Hash[int](),
+			src:    nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := SyncHash(4, 5)
+			if err := set.Scan(tc.src); err != nil {
+				t.Fatalf("unexpected error; want nil, got %q", err)
+			}
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected scanned Set; want %v, got %v", tc.expect, set)
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_Scan_Error(t *testing.T) {
+	testCases := map[string]struct {
+		expectErr error
+		src       any
+	}{
+		"with unsupported source": {
+			expectErr: ErrScanSource,
+			src:       int64(123),
+		},
+		"with string source for invalid JSON": {
+			src: "[1,2",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := SyncHash(4, 5).Scan(tc.src)
+			if err == nil {
+				t.Error("unexpected nil error")
+			} else if tc.expectErr != nil && !errors.Is(err, tc.expectErr) {
+				t.Errorf("unexpected error; want %q, got %q", tc.expectErr, err)
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_Value(t *testing.T) {
+	testCases := map[string]struct {
+		set *SyncHashSet[int]
+	}{
+		"with empty *SyncHashSet": {
+			set: SyncHash[int](),
+		},
+		"with single element *SyncHashSet": {
+			set: SyncHash(123),
+		},
+		"with multiple elements *SyncHashSet": {
+			set: SyncHash(123, 456, 789),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			value, err := tc.set.Value()
+			if err != nil {
+				t.Fatalf("unexpected error; want nil, got %q", err)
+			}
+			if !driver.IsValue(value) {
+				t.Fatalf("unexpected driver.Value; got %T", value)
+			}
+			set := &SyncHashSet[int]{}
+			if err = set.Scan(value); err != nil {
+				t.Fatalf("unexpected error; want nil, got %q", err)
+			}
+			if !set.Equal(tc.set) {
+				t.Errorf("unexpected scanned Set; want %v, got %v", tc.set, set)
+			}
+		})
+	}
+}
+
+func Test_SyncHashSet_Value_Nil(t *testing.T) {
+	var set *SyncHashSet[int]
+	value, err := set.Value()
+	if err != nil {
+		t.Fatalf("unexpected error; want nil, got %q", err)
+	}
+	if value != nil {
+		t.Errorf("unexpected driver.Value; want nil, got %v", value)
+	}
+}
+
 func Test_SyncHashSet_UnmarshalJSON_Concurrent(t *testing.T) {
 	testConcurrently(func(set *SyncHashSet[int], _ int) {
 		_ = set.UnmarshalJSON([]byte(`[123, 456, 789]`))
 	})
 }
 
+func Benchmark_SyncHashSet_Contains_Parallel(b *testing.B) {
+	elements := make([]int, 1000)
+	for i := range elements {
+		elements[i] = i
+	}
+	set := SyncHashFromSlice(elements)
+	b.Run("SyncHashSet", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				set.Contains(i % 2000)
+			}
+		})
+	})
+	var mu sync.Mutex
+	hash := MutableHashFromSlice(elements)
+	b.Run("MutableHashSet with sync.Mutex", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				mu.Lock()
+				hash.Contains(i % 2000)
+				mu.Unlock()
+			}
+		})
+	})
+}
+
 func testConcurrently(fn func(set *SyncHashSet[int], i int), n ...int) {
 	_n := DefaultTestConcurrency
 	if len(n) > 0 {
@@ -3459,3 +6320,23 @@ func testConcurrently(fn func(set *SyncHashSet[int], i int), n ...int) {
 	}
 	wg.Wait()
 }
+
+func testDerivedConcurrently(t *testing.T, derive func(set *SyncHashSet[int]) Set[int]) {
+	result := derive(SyncHash(123, 456, 789))
+	derived, ok := result.(*SyncHashSet[int])
+	if !ok {
+		t.Fatalf("unexpected derived Set type; want *SyncHashSet[int], got %T", result)
+	}
+	var wg sync.WaitGroup
+	wg.Add(DefaultTestConcurrency)
+	for i := 0; i < DefaultTestConcurrency; i++ {
+		go func(i int) {
+			defer wg.Done()
+			derived.Put(i)
+			_ = derived.Contains(i)
+			_ = derived.Slice()
+			derived.Delete(i)
+		}(i)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,1017 @@
+// Copyright (C) 2023 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/neocotic/go-sets/internal"
+	"math/rand"
+	"time"
+)
+
+// TimedSet is an implementation of MutableSet that contains a unique data set while also recording when each element
+// was added, which can be useful for auditing and cleanup workflows (e.g. to find stale elements using
+// TimedSet.OlderThan).
+//
+// The time at which an element was added is only recorded when it does not already exist within the TimedSet, so
+// putting an existing element does not refresh it. Elements are never expired automatically.
+//
+// Time is read from the clock function of the TimedSet, which is time.Now unless specified using TimedWithClock.
+//
+// As TimedSet is mutable it is not safe for concurrent use by multiple goroutines.
+type TimedSet[E comparable] struct {
+	addedAt  map[E]time.Time
+	clock    func() time.Time
+	elements internal.Hash[E]
+}
+
+var (
+	_ MutableSet[any]  = (*TimedSet[any])(nil)
+	_ fmt.Stringer     = (*TimedSet[any])(nil)
+	_ json.Marshaler   = (*TimedSet[any])(nil)
+	_ json.Unmarshaler = (*TimedSet[any])(nil)
+)
+
+// AddedAt returns the time at which the element was added to the TimedSet as well as an indication of whether it
+// exists within the TimedSet.
+//
+// If the TimedSet is nil, TimedSet.AddedAt returns the zero value for time.Time and false.
+func (s *TimedSet[E]) AddedAt(element E) (time.Time, bool) {
+	if s == nil {
+		return time.Time{}, false
+	}
+	addedAt, ok := s.addedAt[element]
+	return addedAt, ok
+}
+
+// All returns an iterator over each element within the TimedSet, which is compatible with iter.Seq so can be used with
+// range-over-func (e.g. `for element := range set.All()`) from Go 1.23 onwards.
+//
+// Iteration order is not guaranteed to be consistent.
+//
+// If the TimedSet is nil, the iterator returned by TimedSet.All yields no elements.
+func (s *TimedSet[E]) All() func(yield func(element E) bool) {
+	return seq[E](s.Range)
+}
+
+// AppendSlice appends all elements of the TimedSet to the dst slice and returns the extended slice, following the same
+// convention as the built-in append function. This allows a buffer to be reused across calls, avoiding the allocation
+// made by TimedSet.Slice each time.
+//
+// The order in which elements are appended is not guaranteed to be consistent. TimedSet.SortedSlice should be used
+// instead for such cases where consistent ordering is required.
+//
+// If the TimedSet is nil, TimedSet.AppendSlice returns dst unchanged.
+func (s *TimedSet[E]) AppendSlice(dst []E) []E {
+	if s == nil {
+		return dst
+	}
+	return internal.AppendSlice(dst, s.elements)
+}
+
+// Canonical returns a minimal byte representation of the TimedSet that is stable for its elements, which can be useful
+// for hashing, cache keys, or as a shortcut for equality checks. See Set.Canonical for the escaping and separator used.
+//
+// If the TimedSet is nil it is treated as having no elements and so TimedSet.Canonical returns an empty slice.
+func (s *TimedSet[E]) Canonical(convert func(element E) string) []byte {
+	return canonical[E](s, convert)
+}
+
+// Clear removes all elements from the TimedSet.
+//
+// If the TimedSet is nil, TimedSet.Clear is a no-op.
+//
+// A reference to the TimedSet is returned for method chaining.
+func (s *TimedSet[E]) Clear() MutableSet[E] {
+	if s == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	s.addedAt, s.elements = make(map[E]time.Time), make(internal.Hash[E])
+	return s
+}
+
+// Clone returns a clone of the TimedSet, including the time at which each element was added.
+//
+// If the TimedSet is nil, TimedSet.Clone returns nil.
+func (s *TimedSet[E]) Clone() Set[E] {
+	if s == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	return s.with(internal.Clone[E](s.elements))
+}
+
+// Contains returns whether the TimedSet contains the element.
+//
+// If the TimedSet is nil, TimedSet.Contains returns false.
+func (s *TimedSet[E]) Contains(element E) bool {
+	if s == nil {
+		return false
+	}
+	_, ok := s.elements[element]
+	return ok
+}
+
+// ContainsAll returns whether the TimedSet contains the element as well as all additional elements specified, stopping
+// as soon as any element is found not to be contained.
+//
+// If the TimedSet is nil, TimedSet.ContainsAll returns false.
+func (s *TimedSet[E]) ContainsAll(element E, elements ...E) bool {
+	return containsAll[E](element, elements, s.Contains)
+}
+
+// ContainsAny returns whether the TimedSet contains the element or any of the additional elements specified, stopping
+// as soon as any element is found to be contained.
+//
+// If the TimedSet is nil, TimedSet.ContainsAny returns false.
+func (s *TimedSet[E]) ContainsAny(element E, elements ...E) bool {
+	return containsAny[E](element, elements, s.Contains)
+}
+
+// ContainsEach returns a slice containing whether the TimedSet contains each of the elements provided, where each
+// result is at the same index as its element.
+//
+// The returned slice always has the same length as the slice of elements provided.
+//
+// If the TimedSet is nil, TimedSet.ContainsEach returns a slice of false values.
+func (s *TimedSet[E]) ContainsEach(elements []E) []bool {
+	return containsEach[E](elements, s.Contains)
+}
+
+// Count returns the number of elements within the TimedSet that match the predicate function.
+//
+// If the TimedSet is nil, TimedSet.Count returns zero.
+func (s *TimedSet[E]) Count(predicate func(element E) bool) int {
+	return count[E](s.Range, predicate)
+}
+
+// Covers returns whether the TimedSet is a superset of every other Set. That is; whether the TimedSet contains all
+// elements within each other Set. Any other Set containing more elements than the TimedSet is never covered and so is
+// rejected before its elements are checked.
+//
+// Any nil other Set is treated as having no elements and so is always covered. If no other Set is provided,
+// TimedSet.Covers returns true.
+//
+// If the TimedSet is nil it is treated as having no elements.
+func (s *TimedSet[E]) Covers(others ...Set[E]) bool {
+	return covers[E](s.Len(), s.Contains, others)
+}
+
+// Delete removes the element from the TimedSet as well as any additional elements specified.
+//
+// If the TimedSet is nil, TimedSet.Delete is a no-op.
+//
+// A reference to the TimedSet is returned for method chaining.
+func (s *TimedSet[E]) Delete(element E, elements ...E) MutableSet[E] {
+	if s == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	s.delete(element)
+	for _, element := range elements {
+		s.delete(element)
+	}
+	return s
+}
+
+// DeleteAll removes all elements in the specified Set from the TimedSet.
+//
+// If the TimedSet is nil, TimedSet.DeleteAll is a no-op.
+//
+// A reference to the TimedSet is returned for method chaining.
+func (s *TimedSet[E]) DeleteAll(elements Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	internal.DeleteAll[E](s.elements, elements)
+	s.prune()
+	return s
+}
+
+// DeleteAnyOf removes all elements from the TimedSet that exist within any of the specified Set. Any nil Set is
+// skipped.
+//
+// If the TimedSet is nil, TimedSet.DeleteAnyOf is a no-op.
+//
+// A reference to the TimedSet is returned for method chaining.
+func (s *TimedSet[E]) DeleteAnyOf(sets ...Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	internal.DeleteAnyOf[E](s.elements, asCollections(sets))
+	s.prune()
+	return s
+}
+
+// DeleteExisting removes the element from the TimedSet as well as any additional elements specified, and returns the
+// number of those elements that were present and therefore removed. Unlike TimedSet.Delete, this allows the caller to
+// learn whether anything was actually removed.
+//
+// If the TimedSet is nil, TimedSet.DeleteExisting is a no-op and returns zero.
+func (s *TimedSet[E]) DeleteExisting(element E, elements ...E) int {
+	if s == nil {
+		return 0
+	}
+	return deleteExisting[E](s.Take, element, elements)
+}
+
+// DeleteNth removes the element at index i from the TimedSet, were its elements sorted using the provided less
+// function, and returns the removed element as well as an indication of whether an element was removed.
+//
+// If i is out of range (i.e. negative or not less than TimedSet.Len), TimedSet.DeleteNth is a no-op and returns the
+// zero value for E and false.
+//
+// If the TimedSet is nil, TimedSet.DeleteNth is a no-op and returns the zero value for E and false.
+func (s *TimedSet[E]) DeleteNth(less func(x, y E) bool, i int) (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	element, ok := internal.DeleteNth[E](s.elements, less, i)
+	if ok {
+		delete(s.addedAt, element)
+	}
+	return element, ok
+}
+
+// DeleteSlice removes all elements in the specified slice from the TimedSet.
+//
+// If the TimedSet is nil, TimedSet.DeleteSlice is a no-op.
+//
+// A reference to the TimedSet is returned for method chaining.
+func (s *TimedSet[E]) DeleteSlice(elements []E) MutableSet[E] {
+	if s == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	for _, element := range elements {
+		s.delete(element)
+	}
+	return s
+}
+
+// DeleteWhere removes all elements that match the predicate function from the TimedSet.
+//
+// If the TimedSet is nil, TimedSet.DeleteWhere is a no-op.
+//
+// A reference to the TimedSet is returned for method chaining.
+func (s *TimedSet[E]) DeleteWhere(predicate func(element E) bool) MutableSet[E] {
+	if s == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	internal.DeleteWhere[E](s.elements, predicate)
+	s.prune()
+	return s
+}
+
+// Diff returns a new TimedSet struct containing only elements of the TimedSet that do not exist in another Set.
+//
+// If the TimedSet is nil, TimedSet.Diff returns nil.
+func (s *TimedSet[E]) Diff(other Set[E]) Set[E] {
+	if s == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	return s.with(internal.Diff[E](s.elements, other))
+}
+
+// DiffSymmetric returns a new TimedSet struct containing elements that exist within the TimedSet or another Set, but
+// not both. Elements from the other Set are recorded as having been added at the current time.
+//
+// If the TimedSet is nil, TimedSet.DiffSymmetric returns nil.
+func (s *TimedSet[E]) DiffSymmetric(other Set[E]) Set[E] {
+	if s == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	return s.with(internal.DiffSymmetric[E](s.elements, other))
+}
+
+// DrainWhere removes all elements that match the predicate function from the TimedSet and returns them within a new
+// TimedSet struct. Unlike TimedSet.DeleteWhere, this allows the caller to inspect the elements that were removed.
+// Together, the TimedSet and the returned TimedSet contain exactly the elements that the TimedSet contained beforehand.
+//
+// If the TimedSet is nil, TimedSet.DrainWhere returns nil.
+func (s *TimedSet[E]) DrainWhere(predicate func(element E) bool) Set[E] {
+	if s == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	drained := s.Filter(predicate)
+	s.DeleteAll(drained)
+	return drained
+}
+
+// Equal returns whether the TimedSet contains the exact same elements as another Set. The time at which each element
+// was added is not considered.
+//
+// If the TimedSet is nil it is treated as having no elements and the same logic applies to the other Set. To clarify;
+// this means that a nil Set is equal to a non-nil Set that contains no elements.
+func (s *TimedSet[E]) Equal(other Set[E]) bool {
+	if s == nil {
+		return other == nil || other.IsEmpty()
+	} else if other == nil {
+		return s.IsEmpty()
+	}
+	return internal.ContainsOnly[E](s.elements, other.Slice())
+}
+
+// Every returns whether the TimedSet contains elements that all match the predicate function.
+//
+// If the TimedSet is nil, TimedSet.Every returns false.
+func (s *TimedSet[E]) Every(predicate func(element E) bool) bool {
+	if s == nil {
+		return false
+	}
+	return internal.Every[E](s.elements, predicate)
+}
+
+// Filter returns a new TimedSet struct containing only elements of the TimedSet that match the filter function.
+//
+// If the TimedSet is nil, TimedSet.Filter returns nil.
+func (s *TimedSet[E]) Filter(filter func(element E) bool) Set[E] {
+	if s == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	return s.with(internal.Filter[E](s.elements, filter))
+}
+
+// Find returns an element within the TimedSet that matches the search function as well as an indication of whether a
+// match was found.
+//
+// Iteration order is not guaranteed to be consistent so results may vary.
+//
+// If the TimedSet is nil, TimedSet.Find returns the zero value for E and false.
+func (s *TimedSet[E]) Find(search func(element E) bool) (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	return internal.Find[E](s.elements, search)
+}
+
+// Grow increases the capacity of the TimedSet, if necessary, to guarantee space for another n elements. After Grow(n),
+// at least n elements can be added to the TimedSet without it needing to allocate further. As the capacity of a map
+// cannot be inspected, the backing maps are rebuilt with the additional capacity whenever n is positive, so it is best
+// called once ahead of loading a batch of elements rather than repeatedly.
+//
+// If n is not positive, TimedSet.Grow is a no-op.
+//
+// If the TimedSet is nil, TimedSet.Grow is a no-op.
+//
+// A reference to the TimedSet is returned for method chaining.
+func (s *TimedSet[E]) Grow(n int) MutableSet[E] {
+	if s == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	if n > 0 {
+		addedAt := make(map[E]time.Time, len(s.addedAt)+n)
+		for element, t := range s.addedAt {
+			addedAt[element] = t
+		}
+		s.addedAt, s.elements = addedAt, internal.Grow(s.elements, n)
+	}
+	return s
+}
+
+// Immutable returns an immutable clone of the TimedSet. The time at which each element was added is not retained.
+//
+// If the TimedSet is nil, TimedSet.Immutable returns nil.
+func (s *TimedSet[E]) Immutable() Set[E] {
+	if s == nil {
+		var ns *HashSet[E]
+		return ns
+	}
+	return &HashSet[E]{elements: internal.Clone[E](s.elements)}
+}
+
+// Intersection returns a new TimedSet struct containing only elements of the TimedSet that also exist in another Set.
+//
+// If the TimedSet is nil, TimedSet.Intersection returns nil.
+func (s *TimedSet[E]) Intersection(other Set[E]) Set[E] {
+	if s == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	return s.with(internal.Intersection[E](s.elements, other))
+}
+
+// IsDisjoint returns whether the TimedSet has no elements in common with another Set. Only the elements within the
+// smaller of the two are iterated, with each being checked for within the larger.
+//
+// Any nil other Set, or other Set containing no elements, is disjoint from the TimedSet.
+//
+// If the TimedSet is nil, TimedSet.IsDisjoint returns true.
+func (s *TimedSet[E]) IsDisjoint(other Set[E]) bool {
+	return disjoint[E](s.Len(), s.Contains, s.Range, other)
+}
+
+// IsEmpty returns whether the TimedSet contains no elements.
+//
+// If the TimedSet is nil, TimedSet.IsEmpty returns true.
+func (s *TimedSet[E]) IsEmpty() bool {
+	if s == nil {
+		return true
+	}
+	return len(s.elements) == 0
+}
+
+// IsMutable always returns true to conform with Set.IsMutable.
+func (s *TimedSet[E]) IsMutable() bool {
+	return true
+}
+
+// Join converts the elements within the TimedSet to strings which are then concatenated to create a single string,
+// placing sep between the converted elements in the resulting string.
+//
+// The order of elements within the resulting string is not guaranteed to be consistent. TimedSet.SortedJoin should be
+// used instead for such cases where consistent ordering is required.
+//
+// If the TimedSet is nil, TimedSet.Join returns an empty string.
+func (s *TimedSet[E]) Join(sep string, convert func(element E) string) string {
+	if s == nil {
+		return ""
+	}
+	return internal.Join[E](s.elements, sep, convert)
+}
+
+// Kind always returns TimedKind to conform with Set.Kind.
+func (s *TimedSet[E]) Kind() SetKind {
+	return TimedKind
+}
+
+// Keys returns a map containing all elements of the TimedSet as keys, which can be useful when integrating with APIs
+// that expect a map rather than a slice.
+//
+// The returned map is always a copy so can be modified freely without affecting the TimedSet.
+//
+// If the TimedSet is nil, TimedSet.Keys returns nil.
+func (s *TimedSet[E]) Keys() map[E]struct{} {
+	if s == nil {
+		return nil
+	}
+	return internal.Clone[E](s.elements)
+}
+
+// Len returns the number of elements within the TimedSet.
+//
+// If the TimedSet is nil, TimedSet.Len returns zero.
+func (s *TimedSet[E]) Len() int {
+	if s == nil {
+		return 0
+	}
+	return len(s.elements)
+}
+
+// Max returns the maximum element within the TimedSet using the provided less function.
+//
+// If the TimedSet is nil, TimedSet.Max returns the zero value for E and false.
+func (s *TimedSet[E]) Max(less func(x, y E) bool) (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	return internal.Max[E](s.elements, less)
+}
+
+// Min returns the minimum element within the TimedSet using the provided less function.
+//
+// If the TimedSet is nil, TimedSet.Min returns the zero value for E and false.
+func (s *TimedSet[E]) Min(less func(x, y E) bool) (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	return internal.Min[E](s.elements, less)
+}
+
+// Mutable returns a reference to itself to conform with Set.Mutable.
+//
+// If the TimedSet is nil, TimedSet.Mutable returns nil.
+func (s *TimedSet[E]) Mutable() MutableSet[E] {
+	if s == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	return s
+}
+
+// None returns whether the TimedSet contains no elements that match the predicate function.
+//
+// If the TimedSet is nil, TimedSet.None returns true.
+func (s *TimedSet[E]) None(predicate func(element E) bool) bool {
+	if s == nil {
+		return true
+	}
+	return internal.None[E](s.elements, predicate)
+}
+
+// OlderThan returns a new TimedSet struct containing only elements of the TimedSet that were added more than the
+// specified duration before the current time, along with the time at which each was added. For example; this can be
+// used to find stale elements that are due to be cleaned up.
+//
+// If the TimedSet is nil, TimedSet.OlderThan returns nil.
+func (s *TimedSet[E]) OlderThan(d time.Duration) Set[E] {
+	if s == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	threshold := s.now().Add(-d)
+	return s.with(internal.Filter[E](s.elements, func(element E) bool {
+		return s.addedAt[element].Before(threshold)
+	}))
+}
+
+// Pop removes an arbitrary element from the TimedSet, along with the time at which it was added, and returns it as well
+// as an indication of whether the TimedSet contained any elements.
+//
+// If the TimedSet is nil, TimedSet.Pop is a no-op and returns the zero value for E and false.
+func (s *TimedSet[E]) Pop() (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	element, ok := internal.Pop[E](s.elements)
+	if ok {
+		delete(s.addedAt, element)
+	}
+	return element, ok
+}
+
+// Put adds the element to the TimedSet as well as any additional elements specified, recording each as having been
+// added at the current time. Nothing changes for elements that already exist within the TimedSet, including the time
+// at which they were added.
+//
+// If the TimedSet is nil, TimedSet.Put is a no-op.
+//
+// A reference to the TimedSet is returned for method chaining.
+func (s *TimedSet[E]) Put(element E, elements ...E) MutableSet[E] {
+	if s == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	now := s.now()
+	s.put(element, now)
+	for _, element := range elements {
+		s.put(element, now)
+	}
+	return s
+}
+
+// PutAll adds all elements in the specified Set to the TimedSet, recording each as having been added at the current
+// time. Nothing changes for elements that already exist within the TimedSet, including the time at which they were
+// added.
+//
+// If the TimedSet is nil, TimedSet.PutAll is a no-op.
+//
+// A reference to the TimedSet is returned for method chaining.
+func (s *TimedSet[E]) PutAll(elements Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	if elements != nil {
+		now := s.now()
+		elements.Range(func(element E) bool {
+			s.put(element, now)
+			return false
+		})
+	}
+	return s
+}
+
+// PutSlice adds all elements in the specified slice to the TimedSet, recording each as having been added at the
+// current time. Nothing changes for elements that already exist within the TimedSet, including the time at which they
+// were added.
+//
+// If the TimedSet is nil, TimedSet.PutSlice is a no-op.
+//
+// A reference to the TimedSet is returned for method chaining.
+func (s *TimedSet[E]) PutSlice(elements []E) MutableSet[E] {
+	if s == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	now := s.now()
+	for _, element := range elements {
+		s.put(element, now)
+	}
+	return s
+}
+
+// Range calls the iter function with each element within the TimedSet but will stop early whenever the iter function
+// returns true.
+//
+// Iteration order is not guaranteed to be consistent.
+//
+// If the TimedSet is nil, TimedSet.Range is a no-op.
+func (s *TimedSet[E]) Range(iter func(element E) bool) {
+	if s != nil {
+		internal.Range[E](s.elements, iter)
+	}
+}
+
+// RangeContext calls the iter function with each element within the TimedSet, the same as TimedSet.Range, except that
+// the context is checked before each element. If the context is done, iteration stops and the error of the context is
+// returned.
+//
+// Iteration order is not guaranteed to be consistent.
+//
+// If the TimedSet is nil, TimedSet.RangeContext is a no-op and returns nil.
+func (s *TimedSet[E]) RangeContext(ctx context.Context, iter func(element E) bool) error {
+	if s == nil {
+		return nil
+	}
+	return rangeContext[E](ctx, s.Range, iter)
+}
+
+// ReplaceElement removes the old element from the TimedSet and adds the new element in its place, but only if the old
+// element is present, and returns whether it was present. If the new element already exists within the TimedSet, the
+// old element is simply removed. The new element is recorded as having been added at the current time unless it already
+// exists within the TimedSet.
+//
+// If the TimedSet is nil, TimedSet.ReplaceElement is a no-op and returns false.
+func (s *TimedSet[E]) ReplaceElement(oldElement, newElement E) bool {
+	if s == nil {
+		return false
+	}
+	if !s.Contains(oldElement) {
+		return false
+	}
+	s.delete(oldElement)
+	s.put(newElement, s.now())
+	return true
+}
+
+// Retain removes all elements from the TimedSet except the element(s) specified.
+//
+// If the TimedSet is nil, TimedSet.Retain is a no-op.
+//
+// A reference to the TimedSet is returned for method chaining.
+func (s *TimedSet[E]) Retain(element E, elements ...E) MutableSet[E] {
+	if s == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	s.elements = internal.Retaining[E](s.elements, element, elements)
+	s.prune()
+	return s
+}
+
+// RetainAll removes all elements from the TimedSet except those in the specified Set.
+//
+// If the TimedSet is nil, TimedSet.RetainAll is a no-op.
+//
+// A reference to the TimedSet is returned for method chaining.
+func (s *TimedSet[E]) RetainAll(elements Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	s.elements = internal.RetainingAll[E](s.elements, elements)
+	s.prune()
+	return s
+}
+
+// RetainAllOf removes all elements from the TimedSet except those that exist within all the specified Set. That is;
+// the TimedSet is intersected with each Set in place. As with TimedSet.RetainAll, any nil Set is treated as having no
+// elements. If no Set is specified, nothing is removed.
+//
+// If the TimedSet is nil, TimedSet.RetainAllOf is a no-op.
+//
+// A reference to the TimedSet is returned for method chaining.
+func (s *TimedSet[E]) RetainAllOf(sets ...Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	s.elements = internal.RetainingAllOf[E](s.elements, asCollections(sets))
+	s.prune()
+	return s
+}
+
+// RetainSlice removes all elements from the TimedSet except those in the specified slice.
+//
+// If the TimedSet is nil, TimedSet.RetainSlice is a no-op.
+//
+// A reference to the TimedSet is returned for method chaining.
+func (s *TimedSet[E]) RetainSlice(elements []E) MutableSet[E] {
+	if s == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	s.elements = internal.RetainingSlice[E](s.elements, elements)
+	s.prune()
+	return s
+}
+
+// RetainWhere removes all elements except those that match the predicate function from the TimedSet.
+//
+// If the TimedSet is nil, TimedSet.RetainWhere is a no-op.
+//
+// A reference to the TimedSet is returned for method chaining.
+func (s *TimedSet[E]) RetainWhere(predicate func(element E) bool) MutableSet[E] {
+	if s == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	s.elements = internal.RetainingWhere[E](s.elements, predicate)
+	s.prune()
+	return s
+}
+
+// Sample returns a pseudo-random element within the TimedSet as well as an indication of whether the TimedSet contained
+// any elements.
+//
+// If the TimedSet is nil, TimedSet.Sample returns the zero value for E and false.
+func (s *TimedSet[E]) Sample() (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	return sample[E](s.Range, s.Len())
+}
+
+// SampleN returns a new TimedSet struct containing up to n distinct elements of the TimedSet chosen pseudo-randomly.
+// All elements are included if n is greater than or equal to the number of elements within the TimedSet, while none are
+// included if n is not positive.
+//
+// If the TimedSet is nil, TimedSet.SampleN returns nil.
+func (s *TimedSet[E]) SampleN(n int) Set[E] {
+	return s.SampleNWithRand(n, nil)
+}
+
+// SampleNWithRand returns a new TimedSet struct containing up to n distinct elements of the TimedSet chosen
+// pseudo-randomly using the provided source, the same as TimedSet.SampleN otherwise. If the source is nil, the default
+// source is used.
+//
+// Iteration order is not guaranteed to be consistent so, even when using sources that are seeded identically, the
+// chosen elements may vary.
+//
+// If the TimedSet is nil, TimedSet.SampleNWithRand returns nil.
+func (s *TimedSet[E]) SampleNWithRand(n int, r *rand.Rand) Set[E] {
+	if s == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	return sampleFilter[E](s, n, r)
+}
+
+// Slice returns a slice containing all elements of the TimedSet.
+//
+// The order of elements within the resulting slice is not guaranteed to be consistent. TimedSet.SortedSlice should be
+// used instead for such cases where consistent ordering is required.
+//
+// If the TimedSet is nil, TimedSet.Slice returns nil.
+func (s *TimedSet[E]) Slice() []E {
+	if s == nil {
+		return nil
+	}
+	return internal.Slice[E](s.elements)
+}
+
+// Some returns whether the TimedSet contains any element that matches the predicate function.
+//
+// If the TimedSet is nil, TimedSet.Some returns false.
+func (s *TimedSet[E]) Some(predicate func(element E) bool) bool {
+	if s == nil {
+		return false
+	}
+	return internal.Some[E](s.elements, predicate)
+}
+
+// SortedAppendSlice appends all elements of the TimedSet to the dst slice, sorted using the provided less function, and
+// returns the extended slice. Only the appended elements are sorted so any elements already within dst are left
+// untouched. This allows a buffer to be reused across calls, avoiding the allocation made by TimedSet.SortedSlice each
+// time.
+//
+// If the TimedSet is nil, TimedSet.SortedAppendSlice returns dst unchanged.
+func (s *TimedSet[E]) SortedAppendSlice(dst []E, less func(x, y E) bool) []E {
+	if s == nil {
+		return dst
+	}
+	return sortedAppendSlice[E](s, dst, less)
+}
+
+// SortedJoin sorts the elements within the TimedSet using the provided less function and then converts those elements
+// into strings which are then joined using the specified separator to create the resulting string.
+//
+// If the TimedSet is nil, TimedSet.SortedJoin returns an empty string.
+func (s *TimedSet[E]) SortedJoin(sep string, convert func(element E) string, less func(x, y E) bool) string {
+	if s == nil {
+		return ""
+	}
+	return internal.SortedJoin[E](s.elements, sep, convert, less)
+}
+
+// SortedRange calls the iter function with each element within the TimedSet, sorted using the provided less function,
+// along with the zero-based index of that element within the sorted order, but will stop early whenever the iter
+// function returns true.
+//
+// Unlike TimedSet.Range, iteration order is deterministic.
+//
+// If the TimedSet is nil, TimedSet.SortedRange is a no-op.
+func (s *TimedSet[E]) SortedRange(less func(x, y E) bool, iter func(index int, element E) bool) {
+	if s == nil {
+		return
+	}
+	sortedRange[E](s, less, iter)
+}
+
+// SortedSlice returns a slice containing all elements of the TimedSet sorted using the provided less function.
+//
+// If the TimedSet is nil, TimedSet.SortedSlice returns nil.
+func (s *TimedSet[E]) SortedSlice(less func(x, y E) bool) []E {
+	if s == nil {
+		return nil
+	}
+	return internal.SortedSlice[E](s.elements, less)
+}
+
+// Take removes the element from the TimedSet and returns the element that was stored within the TimedSet as well as an
+// indication of whether it was present. As elements are compared using equality, the returned element is always equal
+// to the element provided when present.
+//
+// If the TimedSet is nil, TimedSet.Take is a no-op and returns the zero value for E and false.
+func (s *TimedSet[E]) Take(element E) (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	element, ok := internal.Take[E](s.elements, element)
+	if ok {
+		delete(s.addedAt, element)
+	}
+	return element, ok
+}
+
+// ToMap returns a map containing all elements of the TimedSet as keys, which is equivalent to TimedSet.Keys.
+//
+// The returned map is always a copy so can be modified freely without affecting the TimedSet.
+//
+// If the TimedSet is nil, TimedSet.ToMap returns nil.
+func (s *TimedSet[E]) ToMap() map[E]struct{} {
+	return s.Keys()
+}
+
+// TryRange calls the iter function with each element within the TimedSet but will stop early whenever the iter
+// function returns an error.
+//
+// Iteration order is not guaranteed to be consistent.
+//
+// If the TimedSet is nil, TimedSet.TryRange is a no-op.
+func (s *TimedSet[E]) TryRange(iter func(element E) error) error {
+	if s == nil {
+		return nil
+	}
+	return internal.TryRange[E](s.elements, iter)
+}
+
+// Union returns a new TimedSet containing a union of the TimedSet with another Set. Elements only within the other Set
+// are recorded as having been added at the current time.
+//
+// If the TimedSet and the other Set are both nil, TimedSet.Union returns nil.
+func (s *TimedSet[E]) Union(other Set[E]) Set[E] {
+	elements := internal.Union[E](s, other)
+	if elements == nil {
+		var ns *TimedSet[E]
+		return ns
+	}
+	if s == nil {
+		return (&TimedSet[E]{}).with(elements)
+	}
+	return s.with(elements)
+}
+
+func (s *TimedSet[E]) String() string {
+	if s == nil {
+		return internal.NilString
+	}
+	return internal.String[E](s.elements)
+}
+
+func (s *TimedSet[E]) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return internal.MarshalJSONNil()
+	}
+	return internal.MarshalJSON[E](s.elements)
+}
+
+func (s *TimedSet[E]) UnmarshalJSON(data []byte) error {
+	if elements, err := internal.UnmarshalJSON[E](data); err != nil {
+		return err
+	} else {
+		ws := s.with(elements)
+		s.addedAt, s.elements = ws.addedAt, ws.elements
+		return nil
+	}
+}
+
+// delete removes the element from the TimedSet along with the time at which it was added.
+func (s *TimedSet[E]) delete(element E) {
+	delete(s.elements, element)
+	delete(s.addedAt, element)
+}
+
+// now returns the current time using the clock function of the TimedSet, if any, otherwise time.Now.
+func (s *TimedSet[E]) now() time.Time {
+	if s.clock != nil {
+		return s.clock()
+	}
+	return time.Now()
+}
+
+// prune removes the time at which each element was added for any element that no longer exists within the TimedSet.
+func (s *TimedSet[E]) prune() {
+	for element := range s.addedAt {
+		if _, ok := s.elements[element]; !ok {
+			delete(s.addedAt, element)
+		}
+	}
+}
+
+// put adds the element to the TimedSet, recording it as having been added at the time provided, but only if it does
+// not already exist within the TimedSet.
+func (s *TimedSet[E]) put(element E, now time.Time) {
+	if _, ok := s.elements[element]; !ok {
+		s.elements[element] = struct{}{}
+		s.addedAt[element] = now
+	}
+}
+
+// with returns a new TimedSet containing the elements within the internal.Hash provided and sharing the same clock
+// function. The time at which each element was added to the TimedSet is retained, while any other element is recorded
+// as having been added at the current time.
+func (s *TimedSet[E]) with(elements internal.Hash[E]) *TimedSet[E] {
+	ws := &TimedSet[E]{addedAt: make(map[E]time.Time, len(elements)), clock: s.clock, elements: elements}
+	var now time.Time
+	for element := range elements {
+		if addedAt, ok := s.addedAt[element]; ok {
+			ws.addedAt[element] = addedAt
+		} else {
+			if now.IsZero() {
+				now = s.now()
+			}
+			ws.addedAt[element] = now
+		}
+	}
+	return ws
+}
+
+// Timed returns a TimedSet struct that implements MutableSet containing each unique element provided, all recorded as
+// having been added at the current time according to time.Now.
+//
+// As Timed returns a mutable struct it is not safe for concurrent use by multiple goroutines.
+func Timed[E comparable](elements ...E) *TimedSet[E] {
+	return TimedWithClock(nil, elements...)
+}
+
+// TimedFromSlice returns a TimedSet struct that implements MutableSet containing each unique element from the slice
+// provided, all recorded as having been added at the current time according to time.Now.
+//
+// As TimedFromSlice returns a mutable struct it is not safe for concurrent use by multiple goroutines.
+func TimedFromSlice[E comparable](elements []E) *TimedSet[E] {
+	return TimedWithClock(nil, elements...)
+}
+
+// TimedWithClock returns a TimedSet struct that implements MutableSet containing each unique element provided, using
+// the clock function to read the current time whenever an element is added to the TimedSet, including those provided.
+// This is primarily intended to allow time to be controlled within tests.
+//
+// If clock is nil, time.Now is used.
+//
+// As TimedWithClock returns a mutable struct it is not safe for concurrent use by multiple goroutines.
+func TimedWithClock[E comparable](clock func() time.Time, elements ...E) *TimedSet[E] {
+	set := &TimedSet[E]{addedAt: make(map[E]time.Time, len(elements)), clock: clock, elements: make(internal.Hash[E])}
+	now := set.now()
+	for _, element := range elements {
+		set.put(element, now)
+	}
+	return set
+}
@@ -0,0 +1,1402 @@
+// Copyright (C) 2023 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/neocotic/go-sets/internal"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func Test_Timed(t *testing.T) {
+	before := time.Now()
+	set := Timed(123, 456, 789, 456)
+	after := time.Now()
+	if expect := Hash(123, 456, 789); !set.Equal(expect) {
+		t.Errorf("unexpected Set; want %v, got %v", expect, set)
+	}
+	for _, element := range []int{123, 456, 789} {
+		if addedAt, ok := set.AddedAt(element); !ok || addedAt.Before(before) || addedAt.After(after) {
+			t.Errorf(
+				"unexpected time added for element %v; want between %v and %v, got %v",
+				element,
+				before,
+				after,
+				addedAt,
+			)
+		}
+	}
+	if !set.IsMutable() {
+		t.Error("unexpected Set mutability; want true, got false")
+	}
+}
+
+func Test_TimedFromSlice(t *testing.T) {
+	set := TimedFromSlice([]int{123, 456, 789, 456})
+	if expect := Hash(123, 456, 789); !set.Equal(expect) {
+		t.Errorf("unexpected Set; want %v, got %v", expect, set)
+	}
+	if _, ok := set.AddedAt(123); !ok {
+		t.Error("unexpected missing time added for element 123")
+	}
+}
+
+func Test_TimedWithClock(t *testing.T) {
+	clock := newTestClock()
+	set := TimedWithClock(clock.Now, 123, 456, 789)
+	if expect := Hash(123, 456, 789); !set.Equal(expect) {
+		t.Errorf("unexpected Set; want %v, got %v", expect, set)
+	}
+	if addedAt, ok := set.AddedAt(123); !ok || !addedAt.Equal(clock.now) {
+		t.Errorf("unexpected time added; want %v and true, got %v and %v", clock.now, addedAt, ok)
+	}
+}
+
+func Test_TimedSet_AddedAt(t *testing.T) {
+	clock := newTestClock()
+	start := clock.now
+	set := TimedWithClock(clock.Now, 123)
+	clock.Advance(time.Minute)
+	set.Put(456, 123)
+	clock.Advance(time.Minute)
+	set.PutSlice([]int{789})
+	set.Delete(456)
+	set.Put(456)
+
+	testCases := map[string]struct {
+		element  int
+		expect   time.Time
+		expectOk bool
+	}{
+		"with element added initially and put again": {
+			element:  123,
+			expect:   start,
+			expectOk: true,
+		},
+		"with element added later": {
+			element:  789,
+			expect:   start.Add(2 * time.Minute),
+			expectOk: true,
+		},
+		"with element deleted and added again": {
+			element:  456,
+			expect:   start.Add(2 * time.Minute),
+			expectOk: true,
+		},
+		"with element not present": {
+			element:  999,
+			expect:   time.Time{},
+			expectOk: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			addedAt, ok := set.AddedAt(tc.element)
+			if !addedAt.Equal(tc.expect) || ok != tc.expectOk {
+				t.Errorf("unexpected time added; want %v and %v, got %v and %v", tc.expect, tc.expectOk, addedAt, ok)
+			}
+		})
+	}
+}
+
+func Test_TimedSet_AddedAt_Nil(t *testing.T) {
+	var set *TimedSet[int]
+	if addedAt, ok := set.AddedAt(123); ok || !addedAt.IsZero() {
+		t.Errorf("unexpected time added; want zero time and false, got %v and %v", addedAt, ok)
+	}
+}
+
+func Test_TimedSet_All(t *testing.T) {
+	var elements []int
+	Timed(123, 456, 789).All()(func(element int) bool {
+		elements = append(elements, element)
+		return true
+	})
+	if diff := cmp.Diff([]int{123, 456, 789}, elements, cmpopts.SortSlices(Asc[int])); diff != "" {
+		t.Errorf("unexpected yielded elements (-want +got):\n%s", diff)
+	}
+}
+
+func Test_TimedSet_All_Break(t *testing.T) {
+	set := Timed(123, 456, 789)
+	var callCount int
+	set.All()(func(element int) bool {
+		callCount++
+		if !set.Contains(element) {
+			t.Errorf("unexpected yielded element; got %v", element)
+		}
+		return callCount < 2
+	})
+	if callCount != 2 {
+		t.Errorf("unexpected number of calls to yield; want 2, got %v", callCount)
+	}
+}
+
+func Test_TimedSet_All_Nil(t *testing.T) {
+	var set *TimedSet[int]
+	var callCount int
+	set.All()(func(_ int) bool {
+		callCount++
+		return true
+	})
+	if callCount != 0 {
+		t.Errorf("unexpected number of calls to yield; want 0, got %v", callCount)
+	}
+}
+
+func Test_TimedSet_AppendSlice(t *testing.T) {
+	testCases := map[string]struct {
+		set *TimedSet[int]
+		dst []int
+	}{
+		"with nil dst on non-empty *TimedSet": {
+			set: Timed(123, 456, 789),
+			dst: nil,
+		},
+		"with non-empty dst on non-empty *TimedSet": {
+			set: Timed(123, 456, 789),
+			dst: []int{1, 2},
+		},
+		"with dst having spare capacity on non-empty *TimedSet": {
+			set: Timed(123, 456, 789),
+			dst: make([]int, 0, 8),
+		},
+		"with nil dst on empty *TimedSet": {
+			set: Timed[int](),
+			dst: nil,
+		},
+		"with non-empty dst on empty *TimedSet": {
+			set: Timed[int](),
+			dst: []int{1, 2},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dst := append([]int(nil), tc.dst...)
+			ret := tc.set.AppendSlice(tc.dst)
+
+			if expectLen := len(tc.dst) + tc.set.Len(); len(ret) != expectLen {
+				t.Fatalf("unexpected slice length; want %v, got %v", expectLen, len(ret))
+			}
+			if !cmp.Equal(dst, ret[:len(tc.dst)], cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected leading elements; want %v, got %v", dst, ret[:len(tc.dst)])
+			}
+			if tail := ret[len(tc.dst):]; !HashFromSlice(tail).Equal(tc.set) {
+				t.Errorf("unexpected appended elements; want %v, got %v", tc.set, tail)
+			}
+			if cap(tc.dst) >= len(ret) && len(ret) > 0 && &tc.dst[:1][0] != &ret[0] {
+				t.Error("unexpected slice allocation; want dst to be reused")
+			}
+		})
+	}
+}
+
+func Test_TimedSet_AppendSlice_Nil(t *testing.T) {
+	var set *TimedSet[int]
+	dst := []int{1, 2}
+	ret := set.AppendSlice(dst)
+
+	if !cmp.Equal(dst, ret) {
+		t.Errorf("unexpected slice; want %v, got %v", dst, ret)
+	}
+}
+
+func Test_TimedSet_DeleteExisting(t *testing.T) {
+	testCases := map[string]struct {
+		element        int
+		elements       []int
+		expect         int
+		expectElements []int
+	}{
+		"with no elements present": {
+			element:        999,
+			elements:       []int{-123},
+			expect:         0,
+			expectElements: []int{123, 456, 789},
+		},
+		"with some elements present": {
+			element:        456,
+			elements:       []int{999, 789},
+			expect:         2,
+			expectElements: []int{123},
+		},
+		"with all elements present": {
+			element:        123,
+			elements:       []int{456, 789},
+			expect:         3,
+			expectElements: []int{},
+		},
+		"with duplicate elements present": {
+			element:        456,
+			elements:       []int{456},
+			expect:         1,
+			expectElements: []int{123, 789},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Timed(123, 456, 789)
+			if deleted := set.DeleteExisting(tc.element, tc.elements...); deleted != tc.expect {
+				t.Errorf("unexpected number of deleted elements; want %v, got %v", tc.expect, deleted)
+			}
+			if expect := HashFromSlice(tc.expectElements); !set.Equal(expect) {
+				t.Errorf("unexpected Set; want %v, got %v", expect, set)
+			}
+		})
+	}
+}
+
+func Test_TimedSet_DeleteExisting_Nil(t *testing.T) {
+	var set *TimedSet[int]
+	if deleted := set.DeleteExisting(123, 456); deleted != 0 {
+		t.Errorf("unexpected number of deleted elements; want 0, got %v", deleted)
+	}
+}
+
+func Test_TimedSet_DrainWhere(t *testing.T) {
+	testCases := map[string]struct {
+		expectDrained  []int
+		expectElements []int
+		predicate      func(element int) bool
+	}{
+		"with some elements matching": {
+			expectDrained:  []int{2, 4},
+			expectElements: []int{1, 3, 5},
+			predicate:      func(element int) bool { return element%2 == 0 },
+		},
+		"with no elements matching": {
+			expectDrained:  []int{},
+			expectElements: []int{1, 2, 3, 4, 5},
+			predicate:      func(element int) bool { return element > 5 },
+		},
+		"with all elements matching": {
+			expectDrained:  []int{1, 2, 3, 4, 5},
+			expectElements: []int{},
+			predicate:      func(element int) bool { return element > 0 },
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Timed(1, 2, 3, 4, 5)
+			drained := set.DrainWhere(tc.predicate)
+			if internal.IsNil(drained) {
+				t.Fatal("unexpected nil Set")
+			}
+			if kind := drained.Kind(); kind != TimedKind {
+				t.Errorf("unexpected SetKind; want %v, got %v", TimedKind, kind)
+			}
+			if expect := HashFromSlice(tc.expectDrained); !drained.Equal(expect) {
+				t.Errorf("unexpected drained Set; want %v, got %v", expect, drained)
+			}
+			if expect := HashFromSlice(tc.expectElements); !set.Equal(expect) {
+				t.Errorf("unexpected Set; want %v, got %v", expect, set)
+			}
+		})
+	}
+}
+
+func Test_TimedSet_DrainWhere_Nil(t *testing.T) {
+	var set *TimedSet[int]
+	drained := set.DrainWhere(func(element int) bool {
+		t.Errorf("unexpected call to predicate with %v", element)
+		return true
+	})
+	if drained != (*TimedSet[int])(nil) {
+		t.Errorf("unexpected drained Set; want nil, got %v", drained)
+	}
+}
+
+func Test_TimedSet_Grow(t *testing.T) {
+	testCases := map[string]struct {
+		set    *TimedSet[int]
+		n      int
+		expect Set[int]
+	}{
+		"with positive n on non-empty *TimedSet": {
+			set:    Timed(123, 456, 789),
+			n:      100,
+			expect: Hash(123, 456, 789),
+		},
+		"with positive n on empty *TimedSet": {
+			set:    Timed[int](),
+			n:      100,
+			expect: Hash[int](),
+		},
+		"with zero n on non-empty *TimedSet": {
+			set:    Timed(123, 456, 789),
+			n:      0,
+			expect: Hash(123, 456, 789),
+		},
+		"with negative n on non-empty *TimedSet": {
+			set:    Timed(123, 456, 789),
+			n:      -1,
+			expect: Hash(123, 456, 789),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ret := tc.set.Grow(tc.n)
+
+			if internal.IsNil(ret) {
+				t.Error("unexpected nil MutableSet")
+			}
+			if tc.set != ret {
+				t.Errorf("unexpected MutableSet; want %v, got %v", tc.set, ret)
+			}
+			if !tc.set.Equal(tc.expect) {
+				t.Errorf("unexpected MutableSet; want %v, got %v", tc.expect, tc.set)
+			}
+
+			tc.set.Put(1000)
+			if !tc.set.Contains(1000) {
+				t.Error("unexpected MutableSet element presence after Grow; want true, got false")
+			}
+		})
+	}
+}
+
+func Test_TimedSet_Grow_Nil(t *testing.T) {
+	var set *TimedSet[int]
+	ret := set.Grow(100)
+
+	if internal.IsNotNil(ret) {
+		t.Errorf("unexpected MutableSet; want nil, got %v", ret)
+	}
+	if !set.IsEmpty() {
+		t.Error("unexpected MutableSet emptiness; want true, got false")
+	}
+}
+
+func Test_TimedSet_OlderThan(t *testing.T) {
+	clock := newTestClock()
+	set := TimedWithClock(clock.Now, 123)
+	clock.Advance(time.Hour)
+	set.Put(456)
+	clock.Advance(time.Hour)
+	set.Put(789)
+	clock.Advance(time.Minute)
+
+	testCases := map[string]struct {
+		d      time.Duration
+		expect Set[int]
+	}{
+		"with zero duration": {
+			d:      0,
+			expect: Hash(123, 456, 789),
+		},
+		"with duration less than age of all elements": {
+			d:      time.Second,
+			expect: Hash(123, 456, 789),
+		},
+		"with duration between ages of elements": {
+			d:      90 * time.Minute,
+			expect: Hash(123),
+		},
+		"with duration equal to age of element": {
+			d:      61 * time.Minute,
+			expect: Hash(123),
+		},
+		"with duration greater than age of all elements": {
+			d:      24 * time.Hour,
+			expect: Hash[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			older := set.OlderThan(tc.d)
+			if !older.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, older)
+			}
+			if kind := older.Kind(); kind != TimedKind {
+				t.Errorf("unexpected Set kind; want %v, got %v", TimedKind, kind)
+			}
+			older.Range(func(element int) bool {
+				exp, _ := set.AddedAt(element)
+				if act, _ := older.(*TimedSet[int]).AddedAt(element); !act.Equal(exp) {
+					t.Errorf("unexpected time added for element %v; want %v, got %v", element, exp, act)
+				}
+				return false
+			})
+		})
+	}
+}
+
+func Test_TimedSet_OlderThan_Nil(t *testing.T) {
+	var set *TimedSet[int]
+	if older := set.OlderThan(time.Hour); internal.IsNotNil(older) {
+		t.Errorf("unexpected Set; want nil, got %v", older)
+	}
+}
+
+func Test_TimedSet_Operations(t *testing.T) {
+	clock := newTestClock()
+	start := clock.now
+	set := TimedWithClock(clock.Now, 123, 456, 789)
+	clock.Advance(time.Hour)
+	other := Hash(456, 999)
+	assertAddedAt := func(name string, set Set[int], element int, expect time.Time) {
+		t.Helper()
+		if addedAt, ok := set.(*TimedSet[int]).AddedAt(element); !ok || !addedAt.Equal(expect) {
+			t.Errorf("unexpected time added for element %v of %s Set; want %v, got %v", element, name, expect, addedAt)
+		}
+	}
+
+	if result := set.Diff(other); !result.Equal(Hash(123, 789)) || result.Kind() != TimedKind {
+		t.Errorf("unexpected diff Set; want %v, got %v", Hash(123, 789), result)
+	} else {
+		assertAddedAt("diff", result, 123, start)
+	}
+	if result := set.DiffSymmetric(other); !result.Equal(Hash(123, 789, 999)) {
+		t.Errorf("unexpected symmetric diff Set; want %v, got %v", Hash(123, 789, 999), result)
+	} else {
+		assertAddedAt("symmetric diff", result, 123, start)
+		assertAddedAt("symmetric diff", result, 999, clock.now)
+	}
+	if result := set.Intersection(other); !result.Equal(Hash(456)) {
+		t.Errorf("unexpected intersection Set; want %v, got %v", Hash(456), result)
+	} else {
+		assertAddedAt("intersection", result, 456, start)
+	}
+	if result := set.Union(other); !result.Equal(Hash(123, 456, 789, 999)) {
+		t.Errorf("unexpected union Set; want %v, got %v", Hash(123, 456, 789, 999), result)
+	} else {
+		assertAddedAt("union", result, 456, start)
+		assertAddedAt("union", result, 999, clock.now)
+	}
+	if result := set.Filter(func(element int) bool { return element > 200 }); !result.Equal(Hash(456, 789)) {
+		t.Errorf("unexpected filtered Set; want %v, got %v", Hash(456, 789), result)
+	} else {
+		assertAddedAt("filtered", result, 789, start)
+	}
+	if join := set.SortedJoin(",", strconv.Itoa, Asc[int]); join != "123,456,789" {
+		t.Errorf("unexpected sorted string; want %q, got %q", "123,456,789", join)
+	}
+	if immutable := set.Immutable(); immutable.IsMutable() || !immutable.Equal(set) {
+		t.Errorf("unexpected immutable Set; want %v, got %v", set, immutable)
+	}
+
+	clone := set.Clone()
+	assertAddedAt("cloned", clone, 123, start)
+	if element, ok := set.DeleteNth(Asc[int], 0); !ok || element != 123 {
+		t.Errorf("unexpected deleted element; want 123 and true, got %v and %v", element, ok)
+	}
+	if !clone.Contains(123) {
+		t.Error("unexpected modification of cloned Set")
+	}
+	set.Retain(456, 789, 999).DeleteSlice([]int{789}).PutAll(other)
+	if expect := Hash(456, 999); !set.Equal(expect) {
+		t.Errorf("unexpected Set; want %v, got %v", expect, set)
+	}
+	assertAddedAt("modified", set, 456, start)
+	assertAddedAt("modified", set, 999, clock.now)
+	if l := len(set.addedAt); l != 2 {
+		t.Errorf("unexpected number of elements with time added; want 2, got %v", l)
+	}
+}
+
+func Test_TimedSet_Pop(t *testing.T) {
+	set := Timed(123, 456, 789)
+	var popped []int
+	for i := 0; i < 3; i++ {
+		element, ok := set.Pop()
+		if !ok {
+			t.Fatalf("unexpected pop result; want true, got false")
+		}
+		if set.Contains(element) {
+			t.Errorf("unexpected Set containment of %v; want false, got true", element)
+		}
+		if exp, act := 2-i, set.Len(); act != exp {
+			t.Errorf("unexpected Set length; want %v, got %v", exp, act)
+		}
+		popped = append(popped, element)
+	}
+	sort.Ints(popped)
+	if exp := []int{123, 456, 789}; !cmp.Equal(exp, popped) {
+		t.Errorf("unexpected popped elements; want %v, got %v", exp, popped)
+	}
+	if element, ok := set.Pop(); ok || element != 0 {
+		t.Errorf("unexpected pop result; want 0 and false, got %v and %v", element, ok)
+	}
+	if element, ok := Timed[int]().Pop(); ok || element != 0 {
+		t.Errorf("unexpected pop result; want 0 and false, got %v and %v", element, ok)
+	}
+}
+
+func Test_TimedSet_Pop_AddedAt(t *testing.T) {
+	set := Timed(123)
+	element, _ := set.Pop()
+	if _, ok := set.AddedAt(element); ok {
+		t.Error("unexpected added time; want false, got true")
+	}
+	if exp, act := 0, len(set.addedAt); act != exp {
+		t.Errorf("unexpected number of added times; want %v, got %v", exp, act)
+	}
+}
+
+func Test_TimedSet_Pop_Nil(t *testing.T) {
+	var set *TimedSet[int]
+	if element, ok := set.Pop(); ok || element != 0 {
+		t.Errorf("unexpected pop result; want 0 and false, got %v and %v", element, ok)
+	}
+}
+
+func Test_TimedSet_RangeContext(t *testing.T) {
+	set := Timed(123, 456, 789)
+	var elements []int
+	err := set.RangeContext(context.Background(), func(element int) bool {
+		elements = append(elements, element)
+		return false
+	})
+	if err != nil {
+		t.Errorf("unexpected error; want nil, got %q", err)
+	}
+	if expect := set.Len(); len(elements) != expect {
+		t.Errorf("unexpected number of iter function calls; want %v, got %v", expect, len(elements))
+	}
+	if actual := HashFromSlice(elements); !actual.Equal(set) {
+		t.Errorf("unexpected iterated elements; want %v, got %v", set, actual)
+	}
+}
+
+func Test_TimedSet_RangeContext_Cancel(t *testing.T) {
+	set := Timed(123, 456, 789)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var count int
+	err := set.RangeContext(ctx, func(_ int) bool {
+		count++
+		cancel()
+		return false
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error; want %q, got %q", context.Canceled, err)
+	}
+	if count != 1 {
+		t.Errorf("unexpected number of iter function calls; want 1, got %v", count)
+	}
+}
+
+func Test_TimedSet_RangeContext_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var called bool
+	err := Timed(123, 456, 789).RangeContext(ctx, func(_ int) bool {
+		called = true
+		return false
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error; want %q, got %q", context.Canceled, err)
+	}
+	if called {
+		t.Error("unexpected iter function call")
+	}
+}
+
+func Test_TimedSet_RangeContext_Nil(t *testing.T) {
+	var set *TimedSet[int]
+	var called bool
+	err := set.RangeContext(context.Background(), func(_ int) bool {
+		called = true
+		return false
+	})
+	if err != nil {
+		t.Errorf("unexpected error; want nil, got %q", err)
+	}
+	if called {
+		t.Error("unexpected iter function call")
+	}
+}
+
+func Test_TimedSet_Sample(t *testing.T) {
+	set := Timed(123, 456, 789)
+	for i := 0; i < 10; i++ {
+		element, ok := set.Sample()
+		if !ok {
+			t.Fatal("unexpected indication of no elements; want true, got false")
+		}
+		if !set.Contains(element) {
+			t.Errorf("unexpected element; want element of %v, got %v", set, element)
+		}
+	}
+}
+
+func Test_TimedSet_Sample_Empty(t *testing.T) {
+	set := Timed[int]()
+	if element, ok := set.Sample(); ok {
+		t.Errorf("unexpected element; want none, got %v", element)
+	}
+}
+
+func Test_TimedSet_Sample_Nil(t *testing.T) {
+	var set *TimedSet[int]
+	if element, ok := set.Sample(); ok {
+		t.Errorf("unexpected element; want none, got %v", element)
+	}
+}
+
+func Test_TimedSet_SampleN(t *testing.T) {
+	testCases := map[string]struct {
+		expectLen int
+		n         int
+		set       *TimedSet[int]
+	}{
+		"with n less than length": {
+			expectLen: 2,
+			n:         2,
+			set:       Timed(123, 456, 789),
+		},
+		"with n equal to length": {
+			expectLen: 3,
+			n:         3,
+			set:       Timed(123, 456, 789),
+		},
+		"with n greater than length": {
+			expectLen: 3,
+			n:         10,
+			set:       Timed(123, 456, 789),
+		},
+		"with zero n": {
+			expectLen: 0,
+			n:         0,
+			set:       Timed(123, 456, 789),
+		},
+		"with negative n": {
+			expectLen: 0,
+			n:         -1,
+			set:       Timed(123, 456, 789),
+		},
+		"with empty *TimedSet[int]": {
+			expectLen: 0,
+			n:         2,
+			set:       Timed[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sampled := tc.set.SampleN(tc.n)
+			if internal.IsNil(sampled) {
+				t.Fatal("unexpected nil Set")
+			}
+			if kind, expectKind := sampled.Kind(), tc.set.Kind(); kind != expectKind {
+				t.Errorf("unexpected SetKind; want %v, got %v", expectKind, kind)
+			}
+			if l := sampled.Len(); l != tc.expectLen {
+				t.Errorf("unexpected Set length; want %v, got %v", tc.expectLen, l)
+			}
+			if !tc.set.Covers(sampled) {
+				t.Errorf("unexpected sampled Set; want subset of %v, got %v", tc.set, sampled)
+			}
+		})
+	}
+}
+
+func Test_TimedSet_SampleN_Nil(t *testing.T) {
+	var set *TimedSet[int]
+	if sampled := set.SampleN(2); sampled != (*TimedSet[int])(nil) {
+		t.Errorf("unexpected sampled Set; want nil, got %v", sampled)
+	}
+}
+
+func Test_TimedSet_SortedAppendSlice(t *testing.T) {
+	testCases := map[string]struct {
+		set    *TimedSet[int]
+		dst    []int
+		expect []int
+	}{
+		"with nil dst on non-empty *TimedSet": {
+			set:    Timed(456, 123, 789),
+			dst:    nil,
+			expect: []int{789, 456, 123},
+		},
+		"with non-empty dst on non-empty *TimedSet": {
+			set:    Timed(456, 123, 789),
+			dst:    []int{1, 999},
+			expect: []int{1, 999, 789, 456, 123},
+		},
+		"with nil dst on empty *TimedSet": {
+			set:    Timed[int](),
+			dst:    nil,
+			expect: nil,
+		},
+		"with non-empty dst on empty *TimedSet": {
+			set:    Timed[int](),
+			dst:    []int{1, 999},
+			expect: []int{1, 999},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ret := tc.set.SortedAppendSlice(tc.dst, Desc[int])
+			if !cmp.Equal(tc.expect, ret, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected slice; want %v, got %v", tc.expect, ret)
+			}
+		})
+	}
+}
+
+func Test_TimedSet_SortedAppendSlice_Nil(t *testing.T) {
+	var set *TimedSet[int]
+	dst := []int{1, 999}
+	ret := set.SortedAppendSlice(dst, Desc[int])
+
+	if !cmp.Equal(dst, ret) {
+		t.Errorf("unexpected slice; want %v, got %v", dst, ret)
+	}
+}
+
+func Test_TimedSet_SortedRange(t *testing.T) {
+	testCases := map[string]struct {
+		expect []int
+		set    *TimedSet[int]
+		stopAt int
+	}{
+		"with non-empty *TimedSet[int]": {
+			expect: []int{789, 456, 345, 123, 12},
+			set:    Timed(456, 123, 789, 12, 345),
+			stopAt: -1,
+		},
+		"with non-empty *TimedSet[int] and early stop": {
+			expect: []int{789, 456, 345},
+			set:    Timed(456, 123, 789, 12, 345),
+			stopAt: 2,
+		},
+		"with empty *TimedSet[int]": {
+			expect: nil,
+			set:    Timed[int](),
+			stopAt: -1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var elements []int
+			tc.set.SortedRange(Desc[int], func(index int, element int) bool {
+				if index != len(elements) {
+					t.Errorf("unexpected index; want %v, got %v", len(elements), index)
+				}
+				elements = append(elements, element)
+				return index == tc.stopAt
+			})
+			if !cmp.Equal(tc.expect, elements, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected elements; want %v, got %v", tc.expect, elements)
+			}
+		})
+	}
+}
+
+func Test_TimedSet_SortedRange_Nil(t *testing.T) {
+	var set *TimedSet[int]
+	set.SortedRange(Desc[int], func(index int, element int) bool {
+		t.Errorf("unexpected call to iter with %v at index %v", element, index)
+		return false
+	})
+}
+
+func Test_TimedSet_Take(t *testing.T) {
+	testCases := map[string]struct {
+		element        int
+		expectElement  int
+		expectElements []int
+		expectOk       bool
+	}{
+		"with element present": {
+			element:        456,
+			expectElement:  456,
+			expectElements: []int{123, 789},
+			expectOk:       true,
+		},
+		"with element not present": {
+			element:        999,
+			expectElement:  0,
+			expectElements: []int{123, 456, 789},
+			expectOk:       false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Timed(123, 456, 789)
+			element, ok := set.Take(tc.element)
+			if element != tc.expectElement || ok != tc.expectOk {
+				t.Errorf(
+					"unexpected taken element; want %v and %v, got %v and %v",
+					tc.expectElement,
+					tc.expectOk,
+					element,
+					ok,
+				)
+			}
+			if expect := HashFromSlice(tc.expectElements); !set.Equal(expect) {
+				t.Errorf("unexpected Set; want %v, got %v", expect, set)
+			}
+			if _, ok = set.AddedAt(tc.element); ok {
+				t.Errorf("unexpected time added for element %v after being taken", tc.element)
+			}
+		})
+	}
+}
+
+func Test_TimedSet_Take_Nil(t *testing.T) {
+	var set *TimedSet[int]
+	if element, ok := set.Take(123); ok {
+		t.Errorf("unexpected taken element; want 0 and false, got %v and %v", element, ok)
+	}
+}
+
+func Test_TimedSet_DeleteAnyOf(t *testing.T) {
+	testCases := map[string]struct {
+		expect Set[int]
+		sets   []Set[int]
+	}{
+		"with no Sets": {
+			expect: Hash(123, 456, 789),
+			sets:   nil,
+		},
+		"with multiple Sets": {
+			expect: Hash(789),
+			sets:   []Set[int]{Hash(456, 999), Hash(123), Hash[int]()},
+		},
+		"with nil Sets": {
+			expect: Hash(123, 789),
+			sets:   []Set[int]{nil, Hash(456), (*HashSet[int])(nil)},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Timed(123, 456, 789)
+			set.DeleteAnyOf(tc.sets...)
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+			if expect, actual := tc.expect.Len(), len(set.addedAt); actual != expect {
+				t.Errorf("unexpected number of elements with time added; want %v, got %v", expect, actual)
+			}
+		})
+	}
+}
+
+func Test_TimedSet_ReplaceElement(t *testing.T) {
+	testCases := map[string]struct {
+		expect     Set[int]
+		expectOk   bool
+		newElement int
+		oldElement int
+	}{
+		"with old element absent": {
+			expect:     Hash(123, 456, 789),
+			expectOk:   false,
+			newElement: 999,
+			oldElement: 0,
+		},
+		"with old element present and new element absent": {
+			expect:     Hash(123, 789, 999),
+			expectOk:   true,
+			newElement: 999,
+			oldElement: 456,
+		},
+		"with old element present and new element already present": {
+			expect:     Hash(123, 789),
+			expectOk:   true,
+			newElement: 789,
+			oldElement: 456,
+		},
+		"with old element equal to new element": {
+			expect:     Hash(123, 456, 789),
+			expectOk:   true,
+			newElement: 456,
+			oldElement: 456,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Timed(123, 456, 789)
+			if ok := set.ReplaceElement(tc.oldElement, tc.newElement); ok != tc.expectOk {
+				t.Errorf("unexpected replacement; want %v, got %v", tc.expectOk, ok)
+			}
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+		})
+	}
+}
+
+func Test_TimedSet_ReplaceElement_AddedAt(t *testing.T) {
+	clock := newTestClock()
+	start := clock.now
+	set := TimedWithClock(clock.Now, 123, 456)
+	clock.Advance(time.Hour)
+	set.ReplaceElement(123, 789)
+	set.ReplaceElement(789, 456)
+	if _, ok := set.AddedAt(123); ok {
+		t.Error("unexpected time added for replaced element")
+	}
+	if addedAt, _ := set.AddedAt(456); !addedAt.Equal(start) {
+		t.Errorf("unexpected time added for already present element; want %v, got %v", start, addedAt)
+	}
+	set.ReplaceElement(456, 999)
+	if addedAt, _ := set.AddedAt(999); !addedAt.Equal(clock.now) {
+		t.Errorf("unexpected time added for new element; want %v, got %v", clock.now, addedAt)
+	}
+}
+
+func Test_TimedSet_ReplaceElement_Nil(t *testing.T) {
+	var set *TimedSet[int]
+	if set.ReplaceElement(123, 456) {
+		t.Error("unexpected replacement; want false, got true")
+	}
+}
+
+func Test_TimedSet_RetainAllOf(t *testing.T) {
+	testCases := map[string]struct {
+		expect Set[int]
+		sets   []Set[int]
+	}{
+		"with no Sets": {
+			expect: Hash(123, 456, 789),
+			sets:   nil,
+		},
+		"with multiple Sets": {
+			expect: Hash(456),
+			sets:   []Set[int]{Hash(123, 456, 999), Hash(456, 789)},
+		},
+		"with nil Set": {
+			expect: Hash[int](),
+			sets:   []Set[int]{Hash(123, 456), nil},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Timed(123, 456, 789)
+			set.RetainAllOf(tc.sets...)
+			if !set.Equal(tc.expect) {
+				t.Errorf("unexpected Set; want %v, got %v", tc.expect, set)
+			}
+			if expect, actual := tc.expect.Len(), len(set.addedAt); actual != expect {
+				t.Errorf("unexpected number of elements with time added; want %v, got %v", expect, actual)
+			}
+		})
+	}
+}
+
+func Test_TimedSet_Canonical(t *testing.T) {
+	set := Timed("foo", "bar", `a,b\`)
+	canonical := string(set.Canonical(func(element string) string { return element }))
+	if canonical != `a\,b\\,bar,foo` {
+		t.Errorf("unexpected canonical; want %q, got %q", `a\,b\\,bar,foo`, canonical)
+	}
+}
+
+func Test_TimedSet_ContainsAll(t *testing.T) {
+	testCases := map[string]struct {
+		element  int
+		elements []int
+		expect   bool
+	}{
+		"with single contained element": {
+			element: 123,
+			expect:  true,
+		},
+		"with single element not contained": {
+			element: -123,
+			expect:  false,
+		},
+		"with all elements contained": {
+			element:  123,
+			elements: []int{456, 789},
+			expect:   true,
+		},
+		"with duplicate contained elements": {
+			element:  123,
+			elements: []int{123, 123},
+			expect:   true,
+		},
+		"with first element not contained": {
+			element:  -123,
+			elements: []int{456, 789},
+			expect:   false,
+		},
+		"with last element not contained": {
+			element:  123,
+			elements: []int{456, -789},
+			expect:   false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Timed(123, 456, 789)
+			if result := set.ContainsAll(tc.element, tc.elements...); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_TimedSet_ContainsAll_Nil(t *testing.T) {
+	var set *TimedSet[int]
+	if set.ContainsAll(123, 456) {
+		t.Error("unexpected result; want false, got true")
+	}
+}
+
+func Test_TimedSet_ContainsAny(t *testing.T) {
+	testCases := map[string]struct {
+		element  int
+		elements []int
+		expect   bool
+	}{
+		"with single contained element": {
+			element: 123,
+			expect:  true,
+		},
+		"with single element not contained": {
+			element: -123,
+			expect:  false,
+		},
+		"with first element contained": {
+			element:  123,
+			elements: []int{-456, -789},
+			expect:   true,
+		},
+		"with last element contained": {
+			element:  -123,
+			elements: []int{-456, 789},
+			expect:   true,
+		},
+		"with no elements contained": {
+			element:  -123,
+			elements: []int{-456, -789},
+			expect:   false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Timed(123, 456, 789)
+			if result := set.ContainsAny(tc.element, tc.elements...); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_TimedSet_ContainsAny_Nil(t *testing.T) {
+	var set *TimedSet[int]
+	if set.ContainsAny(123, 456) {
+		t.Error("unexpected result; want false, got true")
+	}
+}
+
+func Test_TimedSet_ContainsEach(t *testing.T) {
+	testCases := map[string]struct {
+		elements []int
+		expect   []bool
+	}{
+		"with no elements": {
+			elements: nil,
+			expect:   []bool{},
+		},
+		"with mix of present and absent elements": {
+			elements: []int{999, 123, 0, 789, 456, -1},
+			expect:   []bool{false, true, false, true, true, false},
+		},
+		"with duplicate elements": {
+			elements: []int{456, 999, 456},
+			expect:   []bool{true, false, true},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Timed(123, 456, 789)
+			if diff := cmp.Diff(tc.expect, set.ContainsEach(tc.elements)); diff != "" {
+				t.Errorf("unexpected results (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_TimedSet_ContainsEach_Nil(t *testing.T) {
+	var set *TimedSet[int]
+	if diff := cmp.Diff([]bool{false, false}, set.ContainsEach([]int{123, 0})); diff != "" {
+		t.Errorf("unexpected results (-want +got):\n%s", diff)
+	}
+}
+
+func Test_TimedSet_Count(t *testing.T) {
+	testCases := map[string]struct {
+		expect    int
+		predicate func(element int) bool
+	}{
+		"with predicate matching all elements": {
+			expect:    5,
+			predicate: func(_ int) bool { return true },
+		},
+		"with predicate matching some elements": {
+			expect:    3,
+			predicate: func(element int) bool { return element > 0 },
+		},
+		"with predicate matching single element": {
+			expect:    1,
+			predicate: func(element int) bool { return element == 0 },
+		},
+		"with predicate matching no elements": {
+			expect:    0,
+			predicate: func(_ int) bool { return false },
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Timed(-123, 0, 123, 456, 789)
+			if result := set.Count(tc.predicate); result != tc.expect {
+				t.Errorf("unexpected count; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_TimedSet_Count_Nil(t *testing.T) {
+	var set *TimedSet[int]
+	if result := set.Count(func(_ int) bool { return true }); result != 0 {
+		t.Errorf("unexpected count; want 0, got %v", result)
+	}
+}
+
+func Test_TimedSet_Covers(t *testing.T) {
+	set := Timed(123, 456, 789)
+	if !set.Covers(Hash(123), Hash(456, 789), nil) {
+		t.Error("unexpected covers; want true, got false")
+	}
+	if set.Covers(Hash(123), Hash(999)) {
+		t.Error("unexpected covers; want false, got true")
+	}
+}
+
+func Test_TimedSet_IsDisjoint(t *testing.T) {
+	testCases := map[string]struct {
+		expect bool
+		other  Set[int]
+	}{
+		"with disjoint Set": {
+			expect: true,
+			other:  Hash(-789, -456, -123),
+		},
+		"with smaller disjoint Set": {
+			expect: true,
+			other:  Singleton(0),
+		},
+		"with larger disjoint Set": {
+			expect: true,
+			other:  Hash(-789, -456, -123, 0, 1),
+		},
+		"with overlapping Set": {
+			expect: false,
+			other:  Hash(-123, 0, 456),
+		},
+		"with smaller overlapping Set": {
+			expect: false,
+			other:  Singleton(789),
+		},
+		"with larger overlapping Set": {
+			expect: false,
+			other:  Hash(-789, -456, -123, 0, 123),
+		},
+		"with equal Set": {
+			expect: false,
+			other:  Hash(123, 456, 789),
+		},
+		"with empty Set": {
+			expect: true,
+			other:  Hash[int](),
+		},
+		"with nil Set": {
+			expect: true,
+			other:  nil,
+		},
+		"with nil *HashSet": {
+			expect: true,
+			other:  (*HashSet[int])(nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			set := Timed(123, 456, 789)
+			if result := set.IsDisjoint(tc.other); result != tc.expect {
+				t.Errorf("unexpected result; want %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func Test_TimedSet_IsDisjoint_Nil(t *testing.T) {
+	var set *TimedSet[int]
+	if !set.IsDisjoint(Hash(123)) {
+		t.Error("unexpected result; want true, got false")
+	}
+	if !set.IsDisjoint(nil) {
+		t.Error("unexpected result; want true, got false")
+	}
+}
+
+func Test_TimedSet_Kind(t *testing.T) {
+	set := Timed(123, 456, 789)
+	if kind := set.Kind(); kind != TimedKind {
+		t.Errorf("unexpected kind; want %v, got %v", TimedKind, kind)
+	}
+}
+
+func Test_TimedSet_Nil(t *testing.T) {
+	var set *TimedSet[int]
+	if internal.IsNotNil(set.Clear()) || internal.IsNotNil(set.Put(123)) || internal.IsNotNil(set.Delete(123)) {
+		t.Error("unexpected non-nil MutableSet")
+	}
+	if internal.IsNotNil(set.Clone()) || internal.IsNotNil(set.Diff(Hash(123))) ||
+		internal.IsNotNil(set.Filter(func(int) bool { return true })) || internal.IsNotNil(set.Immutable()) {
+		t.Error("unexpected non-nil Set")
+	}
+	if set.Contains(123) || set.Len() != 0 || !set.IsEmpty() || set.Slice() != nil {
+		t.Error("unexpected elements within nil Set")
+	}
+	if !set.Equal(nil) || !set.Equal(Hash[int]()) || set.Equal(Hash(123)) {
+		t.Error("unexpected equality of nil Set")
+	}
+	if s := set.String(); s != "[]" {
+		t.Errorf("unexpected string; want %q, got %q", "[]", s)
+	}
+	if union := set.Union(nil); internal.IsNotNil(union) {
+		t.Errorf("unexpected union Set; want nil, got %v", union)
+	}
+	if union := set.Union(Hash(123)); union.Kind() != TimedKind || !union.Equal(Hash(123)) {
+		t.Errorf("unexpected union Set; want %v, got %v", Hash(123), union)
+	}
+}
+
+func Test_TimedSet_MarshalJSON(t *testing.T) {
+	set := Timed(123, 456, 789)
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var elements []int
+	if err = json.Unmarshal(data, &elements); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]int{123, 456, 789}, elements, cmpopts.SortSlices(Asc[int])); diff != "" {
+		t.Errorf("unexpected elements (-want +got):\n%s", diff)
+	}
+}
+
+func Test_TimedSet_ToMap(t *testing.T) {
+	testCases := map[string]struct {
+		expect map[int]struct{}
+		set    *TimedSet[int]
+	}{
+		"with non-empty *TimedSet": {
+			expect: map[int]struct{}{123: {}, 456: {}, 789: {}},
+			set:    Timed(123, 456, 789),
+		},
+		"with empty *TimedSet": {
+			expect: map[int]struct{}{},
+			set:    Timed[int](),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := tc.set.ToMap()
+			if m == nil {
+				t.Fatal("unexpected map; want non-nil, got nil")
+			}
+			if !cmp.Equal(tc.expect, m) {
+				t.Errorf("unexpected map; want %v, got %v", tc.expect, m)
+			}
+			m[-1] = struct{}{}
+			if tc.set.Contains(-1) {
+				t.Error("unexpected Set containment of -1; want false, got true")
+			}
+		})
+	}
+}
+
+func Test_TimedSet_ToMap_Nil(t *testing.T) {
+	var set *TimedSet[int]
+	if m := set.ToMap(); m != nil {
+		t.Errorf("unexpected map; want nil, got %v", m)
+	}
+}
+
+func Test_TimedSet_UnmarshalJSON(t *testing.T) {
+	clock := newTestClock()
+	start := clock.now
+	set := TimedWithClock(clock.Now, 123, 999)
+	clock.Advance(time.Hour)
+	if err := json.Unmarshal([]byte("[123,456,789,456]"), set); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expect := Hash(123, 456, 789); !set.Equal(expect) {
+		t.Errorf("unexpected Set; want %v, got %v", expect, set)
+	}
+	if addedAt, _ := set.AddedAt(123); !addedAt.Equal(start) {
+		t.Errorf("unexpected time added for retained element; want %v, got %v", start, addedAt)
+	}
+	if addedAt, _ := set.AddedAt(456); !addedAt.Equal(clock.now) {
+		t.Errorf("unexpected time added for new element; want %v, got %v", clock.now, addedAt)
+	}
+	if _, ok := set.AddedAt(999); ok {
+		t.Error("unexpected time added for removed element")
+	}
+}
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func newTestClock() *testClock {
+	return &testClock{now: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)}
+}
@@ -0,0 +1,1098 @@
+// Copyright (C) 2023 neocotic
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/neocotic/go-sets/internal"
+	"golang.org/x/exp/constraints"
+	"math/rand"
+	"sort"
+)
+
+// TreeSet is an implementation of MutableSet that contains a unique data set of ordered elements that are always kept
+// sorted in ascending order.
+//
+// Unlike map-based implementations, such as MutableHashSet, TreeSet iterates over its elements in a deterministic order
+// so TreeSet.Range, TreeSet.Slice, TreeSet.All, and TreeSet.String all emit elements in ascending order without the
+// need to sort them on every call. TreeSet.Contains uses binary search, while TreeSet.First and TreeSet.Last are
+// constant time. However, TreeSet.Put and TreeSet.Delete must shift elements within the underlying slice, so are
+// typically slower than their map-based counterparts for large data sets. TreeSet.PutSlice, TreeSet.PutAll, and
+// TreeSet.Put with multiple elements instead sort the elements being added once and merge them with the existing
+// elements.
+//
+// For floating-point elements, NaN is ordered before all other elements and, as NaN is never equal to itself, all NaN
+// elements are treated as a single element, the same as within FloatHashSet, so that it is only ever contained once and
+// TreeSet.Contains returns true for any NaN once it has been added.
+//
+// The zero value of TreeSet is an empty set ready for use.
+//
+// As TreeSet is mutable it is not safe for concurrent use by multiple goroutines.
+type TreeSet[E constraints.Ordered] struct {
+	elements []E
+}
+
+var (
+	_ MutableSet[int]  = (*TreeSet[int])(nil)
+	_ fmt.Stringer     = (*TreeSet[int])(nil)
+	_ json.Marshaler   = (*TreeSet[int])(nil)
+	_ json.Unmarshaler = (*TreeSet[int])(nil)
+)
+
+// All returns an iterator over each element within the TreeSet, which is compatible with iter.Seq so can be used with
+// range-over-func (e.g. `for element := range set.All()`) from Go 1.23 onwards.
+//
+// Elements are yielded in ascending order.
+//
+// If the TreeSet is nil, the iterator returned by TreeSet.All yields no elements.
+func (s *TreeSet[E]) All() func(yield func(element E) bool) {
+	return seq[E](s.Range)
+}
+
+// AppendSlice appends all elements of the TreeSet to the dst slice and returns the extended slice, following the same
+// convention as the built-in append function. This allows a buffer to be reused across calls, avoiding the allocation
+// made by TreeSet.Slice each time.
+//
+// Elements are appended in ascending order.
+//
+// If the TreeSet is nil, TreeSet.AppendSlice returns dst unchanged.
+func (s *TreeSet[E]) AppendSlice(dst []E) []E {
+	if s == nil {
+		return dst
+	}
+	return append(dst, s.elements...)
+}
+
+// Canonical returns a minimal byte representation of the TreeSet that is stable for its elements, which can be useful
+// for hashing, cache keys, or as a shortcut for equality checks. See Set.Canonical for the escaping and separator used.
+//
+// If the TreeSet is nil it is treated as having no elements and so TreeSet.Canonical returns an empty slice.
+func (s *TreeSet[E]) Canonical(convert func(element E) string) []byte {
+	return canonical[E](s, convert)
+}
+
+// Clear removes all elements from the TreeSet.
+//
+// If the TreeSet is nil, TreeSet.Clear is a no-op.
+//
+// A reference to the TreeSet is returned for method chaining.
+func (s *TreeSet[E]) Clear() MutableSet[E] {
+	if s == nil {
+		var ns *TreeSet[E]
+		return ns
+	}
+	s.elements = nil
+	return s
+}
+
+// Clone returns a clone of the TreeSet.
+//
+// If the TreeSet is nil, TreeSet.Clone returns nil.
+func (s *TreeSet[E]) Clone() Set[E] {
+	if s == nil {
+		var ns *TreeSet[E]
+		return ns
+	}
+	return s.with(s.clone())
+}
+
+// Contains returns whether the TreeSet contains the element.
+//
+// If the TreeSet is nil, TreeSet.Contains returns false.
+func (s *TreeSet[E]) Contains(element E) bool {
+	if s == nil {
+		return false
+	}
+	_, ok := s.search(element)
+	return ok
+}
+
+// ContainsAll returns whether the TreeSet contains the element as well as all additional elements specified, stopping
+// as soon as any element is found not to be contained.
+//
+// If the TreeSet is nil, TreeSet.ContainsAll returns false.
+func (s *TreeSet[E]) ContainsAll(element E, elements ...E) bool {
+	return containsAll[E](element, elements, s.Contains)
+}
+
+// ContainsAny returns whether the TreeSet contains the element or any of the additional elements specified, stopping
+// as soon as any element is found to be contained.
+//
+// If the TreeSet is nil, TreeSet.ContainsAny returns false.
+func (s *TreeSet[E]) ContainsAny(element E, elements ...E) bool {
+	return containsAny[E](element, elements, s.Contains)
+}
+
+// ContainsEach returns a slice containing whether the TreeSet contains each of the elements provided, where each
+// result is at the same index as its element.
+//
+// The returned slice always has the same length as the slice of elements provided.
+//
+// If the TreeSet is nil, TreeSet.ContainsEach returns a slice of false values.
+func (s *TreeSet[E]) ContainsEach(elements []E) []bool {
+	return containsEach[E](elements, s.Contains)
+}
+
+// Count returns the number of elements within the TreeSet that match the predicate function.
+//
+// If the TreeSet is nil, TreeSet.Count returns zero.
+func (s *TreeSet[E]) Count(predicate func(element E) bool) int {
+	return count[E](s.Range, predicate)
+}
+
+// Covers returns whether the TreeSet is a superset of every other Set. That is; whether the TreeSet contains all
+// elements within each other Set. Any other Set containing more elements than the TreeSet is never covered and so is
+// rejected before its elements are checked.
+//
+// Any nil other Set is treated as having no elements and so is always covered. If no other Set is provided,
+// TreeSet.Covers returns true.
+//
+// If the TreeSet is nil it is treated as having no elements.
+func (s *TreeSet[E]) Covers(others ...Set[E]) bool {
+	return covers[E](s.Len(), s.Contains, others)
+}
+
+// Delete removes the element from the TreeSet as well as any additional elements specified.
+//
+// If the TreeSet is nil, TreeSet.Delete is a no-op.
+//
+// A reference to the TreeSet is returned for method chaining.
+func (s *TreeSet[E]) Delete(element E, elements ...E) MutableSet[E] {
+	if s == nil {
+		var ns *TreeSet[E]
+		return ns
+	}
+	s.delete(element)
+	for _, _element := range elements {
+		s.delete(_element)
+	}
+	return s
+}
+
+// DeleteAll removes all elements in the specified Set from the TreeSet.
+//
+// If the TreeSet is nil, TreeSet.DeleteAll is a no-op.
+//
+// A reference to the TreeSet is returned for method chaining.
+func (s *TreeSet[E]) DeleteAll(elements Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *TreeSet[E]
+		return ns
+	}
+	if elements != nil {
+		s.elements = s.filter(func(element E) bool { return !elements.Contains(element) })
+	}
+	return s
+}
+
+// DeleteAnyOf removes all elements from the TreeSet that exist within any of the specified Set. Any nil Set is
+// skipped.
+//
+// If the TreeSet is nil, TreeSet.DeleteAnyOf is a no-op.
+//
+// A reference to the TreeSet is returned for method chaining.
+func (s *TreeSet[E]) DeleteAnyOf(sets ...Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *TreeSet[E]
+		return ns
+	}
+	s.elements = s.filter(func(element E) bool { return !containedByAny(sets, element) })
+	return s
+}
+
+// DeleteExisting removes the element from the TreeSet as well as any additional elements specified, and returns the
+// number of those elements that were present and therefore removed. Unlike TreeSet.Delete, this allows the caller to
+// learn whether anything was actually removed.
+//
+// If the TreeSet is nil, TreeSet.DeleteExisting is a no-op and returns zero.
+func (s *TreeSet[E]) DeleteExisting(element E, elements ...E) int {
+	if s == nil {
+		return 0
+	}
+	return deleteExisting[E](s.Take, element, elements)
+}
+
+// DeleteNth removes the element at index i from the TreeSet, were its elements sorted using the provided less
+// function, and returns the removed element as well as an indication of whether an element was removed.
+//
+// If i is out of range (i.e. negative or not less than TreeSet.Len), TreeSet.DeleteNth is a no-op and returns the
+// zero value for E and false.
+//
+// If the TreeSet is nil, TreeSet.DeleteNth is a no-op and returns the zero value for E and false.
+func (s *TreeSet[E]) DeleteNth(less func(x, y E) bool, i int) (E, bool) {
+	if s == nil || i < 0 || i >= len(s.elements) {
+		var zero E
+		return zero, false
+	}
+	element := s.SortedSlice(less)[i]
+	s.delete(element)
+	return element, true
+}
+
+// DeleteSlice removes all elements in the specified slice from the TreeSet.
+//
+// If the TreeSet is nil, TreeSet.DeleteSlice is a no-op.
+//
+// A reference to the TreeSet is returned for method chaining.
+func (s *TreeSet[E]) DeleteSlice(elements []E) MutableSet[E] {
+	if s == nil {
+		var ns *TreeSet[E]
+		return ns
+	}
+	for _, element := range elements {
+		s.delete(element)
+	}
+	return s
+}
+
+// DeleteWhere removes all elements that match the predicate function from the TreeSet.
+//
+// If the TreeSet is nil, TreeSet.DeleteWhere is a no-op.
+//
+// A reference to the TreeSet is returned for method chaining.
+func (s *TreeSet[E]) DeleteWhere(predicate func(element E) bool) MutableSet[E] {
+	if s == nil {
+		var ns *TreeSet[E]
+		return ns
+	}
+	s.elements = s.filter(func(element E) bool { return !predicate(element) })
+	return s
+}
+
+// Diff returns a new TreeSet struct containing only elements of the TreeSet that do not exist in another Set.
+//
+// If the TreeSet is nil, TreeSet.Diff returns nil.
+func (s *TreeSet[E]) Diff(other Set[E]) Set[E] {
+	if s == nil {
+		var ns *TreeSet[E]
+		return ns
+	}
+	if other == nil {
+		return s.Clone()
+	}
+	return s.with(s.filter(func(element E) bool { return !other.Contains(element) }))
+}
+
+// DiffSymmetric returns a new TreeSet struct containing elements that exist within the TreeSet or another Set, but
+// not both.
+//
+// If the TreeSet is nil, TreeSet.DiffSymmetric returns nil.
+func (s *TreeSet[E]) DiffSymmetric(other Set[E]) Set[E] {
+	if s == nil {
+		var ns *TreeSet[E]
+		return ns
+	}
+	if other == nil {
+		return s.Clone()
+	}
+	elements := s.filter(func(element E) bool { return !other.Contains(element) })
+	other.Range(func(element E) bool {
+		if !s.Contains(element) {
+			elements = append(elements, element)
+		}
+		return false
+	})
+	return s.with(treeFromSlice(elements))
+}
+
+// DrainWhere removes all elements that match the predicate function from the TreeSet and returns them within a new
+// TreeSet struct. Unlike TreeSet.DeleteWhere, this allows the caller to inspect the elements that were removed.
+// Together, the TreeSet and the returned TreeSet contain exactly the elements that the TreeSet contained beforehand.
+//
+// If the TreeSet is nil, TreeSet.DrainWhere returns nil.
+func (s *TreeSet[E]) DrainWhere(predicate func(element E) bool) Set[E] {
+	if s == nil {
+		var ns *TreeSet[E]
+		return ns
+	}
+	drained := s.Filter(predicate)
+	s.DeleteAll(drained)
+	return drained
+}
+
+// Equal returns whether the TreeSet contains the exact same elements as another Set.
+//
+// If the TreeSet is nil it is treated as having no elements and the same logic applies to the other Set. To clarify;
+// this means that a nil Set is equal to a non-nil Set that contains no elements.
+func (s *TreeSet[E]) Equal(other Set[E]) bool {
+	if s == nil {
+		return other == nil || other.IsEmpty()
+	} else if other == nil {
+		return s.IsEmpty()
+	}
+	if len(s.elements) != other.Len() {
+		return false
+	}
+	for _, element := range s.elements {
+		if !other.Contains(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// Every returns whether the TreeSet contains elements that all match the predicate function.
+//
+// If the TreeSet is nil, TreeSet.Every returns false.
+func (s *TreeSet[E]) Every(predicate func(element E) bool) bool {
+	if s == nil || len(s.elements) == 0 {
+		return false
+	}
+	for _, element := range s.elements {
+		if !predicate(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// Filter returns a new TreeSet struct containing only elements of the TreeSet that match the filter function.
+//
+// If the TreeSet is nil, TreeSet.Filter returns nil.
+func (s *TreeSet[E]) Filter(filter func(element E) bool) Set[E] {
+	if s == nil {
+		var ns *TreeSet[E]
+		return ns
+	}
+	return s.with(s.filter(filter))
+}
+
+// Find returns an element within the TreeSet that matches the search function as well as an indication of whether a
+// match was found.
+//
+// Elements are searched in ascending order.
+//
+// If the TreeSet is nil, TreeSet.Find returns the zero value for E and false.
+func (s *TreeSet[E]) Find(search func(element E) bool) (E, bool) {
+	if s != nil {
+		for _, element := range s.elements {
+			if search(element) {
+				return element, true
+			}
+		}
+	}
+	var zero E
+	return zero, false
+}
+
+// First returns the minimum element within the TreeSet, which is always its first element, as well as an indication of
+// whether the TreeSet contained any elements.
+//
+// If the TreeSet is nil, TreeSet.First returns the zero value for E and false.
+func (s *TreeSet[E]) First() (E, bool) {
+	if s == nil || len(s.elements) == 0 {
+		var zero E
+		return zero, false
+	}
+	return s.elements[0], true
+}
+
+// Grow increases the capacity of the TreeSet, if necessary, to guarantee space for another n elements. After Grow(n),
+// at least n elements can be added to the TreeSet without it needing to allocate further.
+//
+// If n is not positive, TreeSet.Grow is a no-op.
+//
+// If the TreeSet is nil, TreeSet.Grow is a no-op.
+//
+// A reference to the TreeSet is returned for method chaining.
+func (s *TreeSet[E]) Grow(n int) MutableSet[E] {
+	if s == nil {
+		var ns *TreeSet[E]
+		return ns
+	}
+	s.elements = internal.GrowSlice(s.elements, n)
+	return s
+}
+
+// Immutable returns an immutable clone of the TreeSet.
+//
+// If the TreeSet is nil, TreeSet.Immutable returns nil.
+func (s *TreeSet[E]) Immutable() Set[E] {
+	if s == nil {
+		var ns *HashSet[E]
+		return ns
+	}
+	return &HashSet[E]{elements: internal.FromSlice(s.elements)}
+}
+
+// Intersection returns a new TreeSet struct containing only elements of the TreeSet that also exist in another Set.
+//
+// If the TreeSet is nil, TreeSet.Intersection returns nil.
+func (s *TreeSet[E]) Intersection(other Set[E]) Set[E] {
+	if s == nil {
+		var ns *TreeSet[E]
+		return ns
+	}
+	if other == nil {
+		return s.with(nil)
+	}
+	return s.with(s.filter(other.Contains))
+}
+
+// IsDisjoint returns whether the TreeSet has no elements in common with another Set. Only the elements within the
+// smaller of the two are iterated, with each being checked for within the larger.
+//
+// Any nil other Set, or other Set containing no elements, is disjoint from the TreeSet.
+//
+// If the TreeSet is nil, TreeSet.IsDisjoint returns true.
+func (s *TreeSet[E]) IsDisjoint(other Set[E]) bool {
+	return disjoint[E](s.Len(), s.Contains, s.Range, other)
+}
+
+// IsEmpty returns whether the TreeSet contains no elements.
+//
+// If the TreeSet is nil, TreeSet.IsEmpty returns true.
+func (s *TreeSet[E]) IsEmpty() bool {
+	if s == nil {
+		return true
+	}
+	return len(s.elements) == 0
+}
+
+// IsMutable always returns true to conform with Set.IsMutable.
+func (s *TreeSet[E]) IsMutable() bool {
+	return true
+}
+
+// Join converts the elements within the TreeSet to strings which are then concatenated to create a single string,
+// placing sep between the converted elements in the resulting string.
+//
+// Elements are joined in ascending order.
+//
+// If the TreeSet is nil, TreeSet.Join returns an empty string.
+func (s *TreeSet[E]) Join(sep string, convert func(element E) string) string {
+	if s == nil {
+		return ""
+	}
+	return joinSlice(s.elements, sep, convert)
+}
+
+// Kind always returns TreeKind to conform with Set.Kind.
+func (s *TreeSet[E]) Kind() SetKind {
+	return TreeKind
+}
+
+// Last returns the maximum element within the TreeSet, which is always its last element, as well as an indication of
+// whether the TreeSet contained any elements.
+//
+// If the TreeSet is nil, TreeSet.Last returns the zero value for E and false.
+func (s *TreeSet[E]) Last() (E, bool) {
+	if s == nil || len(s.elements) == 0 {
+		var zero E
+		return zero, false
+	}
+	return s.elements[len(s.elements)-1], true
+}
+
+// Len returns the number of elements within the TreeSet.
+//
+// If the TreeSet is nil, TreeSet.Len returns zero.
+func (s *TreeSet[E]) Len() int {
+	if s == nil {
+		return 0
+	}
+	return len(s.elements)
+}
+
+// Max returns the maximum element within the TreeSet using the provided less function.
+//
+// As the less function may order elements differently to the TreeSet, every element is compared. TreeSet.Last should be
+// used instead to retrieve the maximum element in ascending order in constant time.
+//
+// If the TreeSet is nil, TreeSet.Max returns the zero value for E and false.
+func (s *TreeSet[E]) Max(less func(x, y E) bool) (E, bool) {
+	if s == nil || len(s.elements) == 0 {
+		var zero E
+		return zero, false
+	}
+	max := s.elements[0]
+	for _, element := range s.elements[1:] {
+		if less(max, element) {
+			max = element
+		}
+	}
+	return max, true
+}
+
+// Min returns the minimum element within the TreeSet using the provided less function.
+//
+// As the less function may order elements differently to the TreeSet, every element is compared. TreeSet.First should
+// be used instead to retrieve the minimum element in ascending order in constant time.
+//
+// If the TreeSet is nil, TreeSet.Min returns the zero value for E and false.
+func (s *TreeSet[E]) Min(less func(x, y E) bool) (E, bool) {
+	if s == nil || len(s.elements) == 0 {
+		var zero E
+		return zero, false
+	}
+	min := s.elements[0]
+	for _, element := range s.elements[1:] {
+		if less(element, min) {
+			min = element
+		}
+	}
+	return min, true
+}
+
+// Mutable returns a reference to itself to conform with Set.Mutable.
+//
+// If the TreeSet is nil, TreeSet.Mutable returns nil.
+func (s *TreeSet[E]) Mutable() MutableSet[E] {
+	if s == nil {
+		var ns *TreeSet[E]
+		return ns
+	}
+	return s
+}
+
+// None returns whether the TreeSet contains no elements that match the predicate function.
+//
+// If the TreeSet is nil, TreeSet.None returns true.
+func (s *TreeSet[E]) None(predicate func(element E) bool) bool {
+	return !s.Some(predicate)
+}
+
+// Pop removes an element from the TreeSet and returns it as well as an indication of whether the TreeSet contained
+// any elements. As the TreeSet is sorted, the element removed is always its maximum (i.e. TreeSet.Last),
+// which can be done without shifting any other elements.
+//
+// If the TreeSet is nil, TreeSet.Pop is a no-op and returns the zero value for E and false.
+func (s *TreeSet[E]) Pop() (E, bool) {
+	var zero E
+	if s == nil || len(s.elements) == 0 {
+		return zero, false
+	}
+	last := len(s.elements) - 1
+	element := s.elements[last]
+	s.elements[last] = zero
+	s.elements = s.elements[:last]
+	return element, true
+}
+
+// Put adds the element to the TreeSet as well as any additional elements specified. Nothing changes for elements that
+// already exist within the TreeSet.
+//
+// If the TreeSet is nil, TreeSet.Put is a no-op.
+//
+// A reference to the TreeSet is returned for method chaining.
+func (s *TreeSet[E]) Put(element E, elements ...E) MutableSet[E] {
+	if s == nil {
+		var ns *TreeSet[E]
+		return ns
+	}
+	if len(elements) == 0 {
+		s.insert(element)
+	} else {
+		s.putSlice(append([]E{element}, elements...))
+	}
+	return s
+}
+
+// PutAll adds all elements in the specified Set to the TreeSet. Nothing changes for elements that already exist within
+// the TreeSet.
+//
+// If the TreeSet is nil, TreeSet.PutAll is a no-op.
+//
+// A reference to the TreeSet is returned for method chaining.
+func (s *TreeSet[E]) PutAll(elements Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *TreeSet[E]
+		return ns
+	}
+	if elements != nil {
+		s.putSlice(elements.Slice())
+	}
+	return s
+}
+
+// PutSlice adds all elements in the specified slice to the TreeSet. Nothing changes for elements that already exist
+// within the TreeSet.
+//
+// If the TreeSet is nil, TreeSet.PutSlice is a no-op.
+//
+// A reference to the TreeSet is returned for method chaining.
+func (s *TreeSet[E]) PutSlice(elements []E) MutableSet[E] {
+	if s == nil {
+		var ns *TreeSet[E]
+		return ns
+	}
+	s.putSlice(elements)
+	return s
+}
+
+// Range calls the iter function with each element within the TreeSet but will stop early whenever the iter function
+// returns true.
+//
+// Elements are iterated in ascending order.
+//
+// If the TreeSet is nil, TreeSet.Range is a no-op.
+func (s *TreeSet[E]) Range(iter func(element E) bool) {
+	if s != nil {
+		for _, element := range s.elements {
+			if iter(element) {
+				break
+			}
+		}
+	}
+}
+
+// RangeContext calls the iter function with each element within the TreeSet, the same as TreeSet.Range, except that the
+// context is checked before each element. If the context is done, iteration stops and the error of the context is
+// returned.
+//
+// Elements are iterated in ascending order.
+//
+// If the TreeSet is nil, TreeSet.RangeContext is a no-op and returns nil.
+func (s *TreeSet[E]) RangeContext(ctx context.Context, iter func(element E) bool) error {
+	if s == nil {
+		return nil
+	}
+	return rangeContext[E](ctx, s.Range, iter)
+}
+
+// ReplaceElement removes the old element from the TreeSet and adds the new element in its place, but only if the old
+// element is present, and returns whether it was present. If the new element already exists within the TreeSet, the
+// old element is simply removed.
+//
+// If the TreeSet is nil, TreeSet.ReplaceElement is a no-op and returns false.
+func (s *TreeSet[E]) ReplaceElement(oldElement, newElement E) bool {
+	if s == nil {
+		return false
+	}
+	if _, ok := s.search(oldElement); !ok {
+		return false
+	}
+	s.delete(oldElement)
+	s.insert(newElement)
+	return true
+}
+
+// Retain removes all elements from the TreeSet except the element(s) specified.
+//
+// If the TreeSet is nil, TreeSet.Retain is a no-op.
+//
+// A reference to the TreeSet is returned for method chaining.
+func (s *TreeSet[E]) Retain(element E, elements ...E) MutableSet[E] {
+	if s == nil {
+		var ns *TreeSet[E]
+		return ns
+	}
+	retained := internal.FromSlice(elements)
+	retained[element] = struct{}{}
+	s.elements = s.filter(func(element E) bool {
+		_, ok := retained[element]
+		return ok
+	})
+	return s
+}
+
+// RetainAll removes all elements from the TreeSet except those in the specified Set.
+//
+// If the TreeSet is nil, TreeSet.RetainAll is a no-op.
+//
+// A reference to the TreeSet is returned for method chaining.
+func (s *TreeSet[E]) RetainAll(elements Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *TreeSet[E]
+		return ns
+	}
+	if elements == nil {
+		s.elements = nil
+	} else {
+		s.elements = s.filter(elements.Contains)
+	}
+	return s
+}
+
+// RetainAllOf removes all elements from the TreeSet except those that exist within all the specified Set. That is; the
+// TreeSet is intersected with each Set in place. As with TreeSet.RetainAll, any nil Set is treated as having no
+// elements. If no Set is specified, nothing is removed.
+//
+// If the TreeSet is nil, TreeSet.RetainAllOf is a no-op.
+//
+// A reference to the TreeSet is returned for method chaining.
+func (s *TreeSet[E]) RetainAllOf(sets ...Set[E]) MutableSet[E] {
+	if s == nil {
+		var ns *TreeSet[E]
+		return ns
+	}
+	s.elements = s.filter(func(element E) bool { return containedByAll(sets, element) })
+	return s
+}
+
+// RetainSlice removes all elements from the TreeSet except those in the specified slice.
+//
+// If the TreeSet is nil, TreeSet.RetainSlice is a no-op.
+//
+// A reference to the TreeSet is returned for method chaining.
+func (s *TreeSet[E]) RetainSlice(elements []E) MutableSet[E] {
+	if s == nil {
+		var ns *TreeSet[E]
+		return ns
+	}
+	retained := internal.FromSlice(elements)
+	s.elements = s.filter(func(element E) bool {
+		_, ok := retained[element]
+		return ok
+	})
+	return s
+}
+
+// RetainWhere removes all elements except those that match the predicate function from the TreeSet.
+//
+// If the TreeSet is nil, TreeSet.RetainWhere is a no-op.
+//
+// A reference to the TreeSet is returned for method chaining.
+func (s *TreeSet[E]) RetainWhere(predicate func(element E) bool) MutableSet[E] {
+	if s == nil {
+		var ns *TreeSet[E]
+		return ns
+	}
+	s.elements = s.filter(predicate)
+	return s
+}
+
+// Sample returns a pseudo-random element within the TreeSet as well as an indication of whether the TreeSet contained
+// any elements.
+//
+// If the TreeSet is nil, TreeSet.Sample returns the zero value for E and false.
+func (s *TreeSet[E]) Sample() (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	return sample[E](s.Range, s.Len())
+}
+
+// SampleN returns a new TreeSet struct containing up to n distinct elements of the TreeSet chosen pseudo-randomly. All
+// elements are included if n is greater than or equal to the number of elements within the TreeSet, while none are
+// included if n is not positive.
+//
+// If the TreeSet is nil, TreeSet.SampleN returns nil.
+func (s *TreeSet[E]) SampleN(n int) Set[E] {
+	return s.SampleNWithRand(n, nil)
+}
+
+// SampleNWithRand returns a new TreeSet struct containing up to n distinct elements of the TreeSet chosen
+// pseudo-randomly using the provided source, the same as TreeSet.SampleN otherwise. If the source is nil, the default
+// source is used.
+//
+// Elements are chosen in ascending order, so repeated calls on an equal TreeSet using sources that are seeded
+// identically always choose the same elements.
+//
+// If the TreeSet is nil, TreeSet.SampleNWithRand returns nil.
+func (s *TreeSet[E]) SampleNWithRand(n int, r *rand.Rand) Set[E] {
+	if s == nil {
+		var ns *TreeSet[E]
+		return ns
+	}
+	return sampleFilter[E](s, n, r)
+}
+
+// Slice returns a slice containing all elements of the TreeSet.
+//
+// Elements within the resulting slice are in ascending order.
+//
+// If the TreeSet is nil, TreeSet.Slice returns nil.
+func (s *TreeSet[E]) Slice() []E {
+	if s == nil {
+		return nil
+	}
+	return s.clone()
+}
+
+// Some returns whether the TreeSet contains any element that matches the predicate function.
+//
+// If the TreeSet is nil, TreeSet.Some returns false.
+func (s *TreeSet[E]) Some(predicate func(element E) bool) bool {
+	_, ok := s.Find(predicate)
+	return ok
+}
+
+// SortedAppendSlice appends all elements of the TreeSet to the dst slice, sorted using the provided less function, and
+// returns the extended slice. Only the appended elements are sorted so any elements already within dst are left
+// untouched. This allows a buffer to be reused across calls, avoiding the allocation made by TreeSet.SortedSlice each
+// time.
+//
+// If the TreeSet is nil, TreeSet.SortedAppendSlice returns dst unchanged.
+func (s *TreeSet[E]) SortedAppendSlice(dst []E, less func(x, y E) bool) []E {
+	if s == nil {
+		return dst
+	}
+	return sortedAppendSlice[E](s, dst, less)
+}
+
+// SortedJoin sorts the elements within the TreeSet using the provided less function and then converts those elements
+// into strings which are then joined using the specified separator to create the resulting string.
+//
+// If the TreeSet is nil, TreeSet.SortedJoin returns an empty string.
+func (s *TreeSet[E]) SortedJoin(sep string, convert func(element E) string, less func(x, y E) bool) string {
+	if s == nil {
+		return ""
+	}
+	return joinSlice(s.SortedSlice(less), sep, convert)
+}
+
+// SortedRange calls the iter function with each element within the TreeSet, sorted using the provided less function,
+// along with the zero-based index of that element within the sorted order, but will stop early whenever the iter
+// function returns true.
+//
+// Unlike TreeSet.Range, iteration order is deterministic.
+//
+// If the TreeSet is nil, TreeSet.SortedRange is a no-op.
+func (s *TreeSet[E]) SortedRange(less func(x, y E) bool, iter func(index int, element E) bool) {
+	if s == nil {
+		return
+	}
+	sortedRange[E](s, less, iter)
+}
+
+// SortedSlice returns a slice containing all elements of the TreeSet sorted using the provided less function.
+//
+// If the TreeSet is nil, TreeSet.SortedSlice returns nil.
+func (s *TreeSet[E]) SortedSlice(less func(x, y E) bool) []E {
+	if s == nil {
+		return nil
+	}
+	elements := s.clone()
+	sort.SliceStable(elements, func(i, j int) bool { return less(elements[i], elements[j]) })
+	return elements
+}
+
+// Take removes the element from the TreeSet and returns the element that was stored within the TreeSet as well as an
+// indication of whether it was present. As elements are compared using equality, the returned element is always equal
+// to the element provided when present.
+//
+// If the TreeSet is nil, TreeSet.Take is a no-op and returns the zero value for E and false.
+func (s *TreeSet[E]) Take(element E) (E, bool) {
+	if s == nil {
+		var zero E
+		return zero, false
+	}
+	i, ok := s.search(element)
+	if !ok {
+		var zero E
+		return zero, false
+	}
+	element = s.elements[i]
+	s.delete(element)
+	return element, true
+}
+
+// ToMap returns a map containing all elements of the TreeSet as keys, which can be useful when integrating with APIs
+// that expect a map rather than a Set or slice.
+//
+// The returned map is always a copy so can be modified freely without affecting the TreeSet.
+//
+// If the TreeSet is nil, TreeSet.ToMap returns nil.
+func (s *TreeSet[E]) ToMap() map[E]struct{} {
+	if s == nil {
+		return nil
+	}
+	return internal.FromSlice[E](s.elements)
+}
+
+// TryRange calls the iter function with each element within the TreeSet but will stop early whenever the iter
+// function returns an error.
+//
+// Elements are iterated in ascending order.
+//
+// If the TreeSet is nil, TreeSet.TryRange is a no-op.
+func (s *TreeSet[E]) TryRange(iter func(element E) error) error {
+	if s != nil {
+		for _, element := range s.elements {
+			if err := iter(element); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Union returns a new TreeSet containing a union of the TreeSet with another Set.
+//
+// If the TreeSet and the other Set are both nil, TreeSet.Union returns nil.
+func (s *TreeSet[E]) Union(other Set[E]) Set[E] {
+	if s == nil {
+		if other == nil {
+			var ns *TreeSet[E]
+			return ns
+		}
+		return &TreeSet[E]{elements: treeFromSlice(other.Slice())}
+	}
+	elements := s.clone()
+	if other != nil {
+		other.Range(func(element E) bool {
+			if !s.Contains(element) {
+				elements = append(elements, element)
+			}
+			return false
+		})
+	}
+	return s.with(treeFromSlice(elements))
+}
+
+func (s *TreeSet[E]) String() string {
+	if s == nil {
+		return internal.NilString
+	}
+	return fmt.Sprintf("%v", s.elements)
+}
+
+func (s *TreeSet[E]) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return internal.MarshalJSONNil()
+	}
+	return json.Marshal(s.elements)
+}
+
+// UnmarshalJSON deserializes the given JSON data as a JSON array into the TreeSet, replacing any existing elements.
+func (s *TreeSet[E]) UnmarshalJSON(data []byte) error {
+	var elements []E
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	s.elements = treeFromSlice(elements)
+	return nil
+}
+
+// clone returns a copy of the sorted slice of elements within the TreeSet.
+func (s *TreeSet[E]) clone() []E {
+	elements := make([]E, len(s.elements))
+	copy(elements, s.elements)
+	return elements
+}
+
+// delete removes the element from the TreeSet, if present.
+func (s *TreeSet[E]) delete(element E) {
+	if i, ok := s.search(element); ok {
+		copy(s.elements[i:], s.elements[i+1:])
+		var zero E
+		s.elements[len(s.elements)-1] = zero
+		s.elements = s.elements[:len(s.elements)-1]
+	}
+}
+
+// filter returns a new sorted slice containing only elements of the TreeSet that match the filter function.
+func (s *TreeSet[E]) filter(filter func(element E) bool) []E {
+	var elements []E
+	for _, element := range s.elements {
+		if filter(element) {
+			elements = append(elements, element)
+		}
+	}
+	return elements
+}
+
+// insert adds the element to the TreeSet, if not already present, shifting any greater elements along to make room.
+func (s *TreeSet[E]) insert(element E) {
+	i, ok := s.search(element)
+	if ok {
+		return
+	}
+	var zero E
+	s.elements = append(s.elements, zero)
+	copy(s.elements[i+1:], s.elements[i:])
+	s.elements[i] = element
+}
+
+// putSlice adds all elements in the slice to the TreeSet, if not already present. Rather than inserting each element
+// individually, which would shift the existing elements every time, the elements are sorted once and then merged with
+// the existing elements.
+func (s *TreeSet[E]) putSlice(elements []E) {
+	if len(elements) == 0 {
+		return
+	}
+	sorted := treeFromSlice(elements)
+	merged := make([]E, 0, len(s.elements)+len(sorted))
+	i, j := 0, 0
+	for i < len(s.elements) && j < len(sorted) {
+		switch x, y := s.elements[i], sorted[j]; {
+		case treeLess(x, y):
+			merged = append(merged, x)
+			i++
+		case treeLess(y, x):
+			merged = append(merged, y)
+			j++
+		default:
+			merged = append(merged, x)
+			i++
+			j++
+		}
+	}
+	merged = append(merged, s.elements[i:]...)
+	s.elements = append(merged, sorted[j:]...)
+}
+
+// search uses binary search to find the index of the element within the TreeSet as well as an indication of whether it
+// was found. If the element was not found, the index at which it would be inserted is returned. As NaN is never equal
+// to itself, it is instead found whenever the TreeSet starts with NaN.
+func (s *TreeSet[E]) search(element E) (int, bool) {
+	if isNaN(element) {
+		return 0, len(s.elements) > 0 && isNaN(s.elements[0])
+	}
+	return internal.SortedSearch(s.elements, treeLess[E], element)
+}
+
+// with returns a new TreeSet containing the sorted slice of elements provided.
+func (s *TreeSet[E]) with(elements []E) *TreeSet[E] {
+	return &TreeSet[E]{elements: elements}
+}
+
+// Tree returns a TreeSet struct that implements MutableSet containing each unique element provided, kept sorted in
+// ascending order.
+//
+// As Tree returns a mutable struct it is not safe for concurrent use by multiple goroutines.
+func Tree[E constraints.Ordered](elements ...E) *TreeSet[E] {
+	return &TreeSet[E]{elements: treeFromSlice(elements)}
+}
+
+// TreeFromSlice returns a TreeSet struct that implements MutableSet containing each unique element from the slice
+// provided, kept sorted in ascending order.
+//
+// As TreeFromSlice returns a mutable struct it is not safe for concurrent use by multiple goroutines.
+func TreeFromSlice[E constraints.Ordered](elements []E) *TreeSet[E] {
+	return &TreeSet[E]{elements: treeFromSlice(elements)}
+}
+
+// treeFromSlice returns a slice containing each unique element from the slice provided sorted using treeLess, where all
+// NaN elements are treated as a single element.
+//
+// The slice provided is never modified.
+func treeFromSlice[E constraints.Ordered](elements []E) []E {
+	sorted := internal.SortedFromSlice(elements, treeLess[E])
+	n := 0
+	for n < len(sorted) && isNaN(sorted[n]) {
+		n++
+	}
+	if n > 1 {
+		sorted = append(sorted[:1], sorted[n:]...)
+	}
+	return sorted
+}
+
+// treeLess returns whether x is less than y, the same as Asc, except that NaN is ordered before all other elements so
+// that floating-point elements containing NaN can still be sorted consistently.
+func treeLess[E constraints.Ordered](x, y E) bool {
+	return (isNaN(x) && !isNaN(y)) || x < y
+}